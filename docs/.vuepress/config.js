@@ -218,6 +218,30 @@ module.exports = {
                 },
             ]
             },
+            {
+              title: "NFT Transfer",
+              directory: true,
+              path: "/apps",
+              children: [
+                {
+                  title: "Overview",
+                  directory: false,
+                  path: "/apps/nft-transfer/overview.html"
+                },
+            ]
+            },
+            {
+              title: "Interchain Queries",
+              directory: true,
+              path: "/apps",
+              children: [
+                {
+                  title: "Overview",
+                  directory: false,
+                  path: "/apps/icq/overview.html"
+                },
+            ]
+            },
           ]
         },
         {
@@ -260,6 +284,23 @@ module.exports = {
                 },
               ]
             },
+            {
+              title: "Callbacks Middleware",
+              directory: true,
+              path: "/middleware",
+              children: [
+                {
+                  title: "Overview",
+                  directory: false,
+                  path: "/middleware/callbacks/overview.html"
+                },
+                {
+                  title: "Integration",
+                  directory: false,
+                  path: "/middleware/callbacks/integration.html"
+                },
+              ]
+            },
           ]
         },
         {
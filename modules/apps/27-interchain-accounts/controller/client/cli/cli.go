@@ -15,7 +15,26 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdParams(),
+		GetCmdOutboxItems(),
+		GetCmdAcknowledgementResult(),
+		GetCmdAcknowledgementResults(),
 	)
 
 	return queryCmd
 }
+
+// GetTxCmd returns the transaction commands for the ICA controller submodule
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "controller",
+		Short:                      "interchain-accounts controller transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	txCmd.AddCommand(
+		NewCancelOutboxItemCmd(),
+	)
+
+	return txCmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -39,3 +40,124 @@ func GetCmdParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdOutboxItems returns the command handler for querying the outbox items of an interchain account owner.
+func GetCmdOutboxItems() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "outbox [owner] [connection-id]",
+		Short:   "Query the outbox items of an interchain account owner on a connection",
+		Long:    "Query the outbox items of an interchain account owner on a connection which are pending submission or acknowledgement",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller outbox cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OutboxItems(cmd.Context(), &types.QueryOutboxItemsRequest{
+				Owner:        args[0],
+				ConnectionId: args[1],
+				Pagination:   pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "outbox")
+
+	return cmd
+}
+
+// GetCmdAcknowledgementResult returns the command handler for querying the decoded acknowledgement result of an
+// interchain account packet.
+func GetCmdAcknowledgementResult() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ack-result [port-id] [channel-id] [sequence]",
+		Short:   "Query the decoded acknowledgement result of an interchain account packet",
+		Long:    "Query the decoded acknowledgement result of an interchain account packet, including the responses of the executed messages or the error code returned by the host chain",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query interchain-accounts controller ack-result icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs channel-0 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AcknowledgementResult(cmd.Context(), &types.QueryAcknowledgementResultRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  sequence,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAcknowledgementResults returns the command handler for querying the decoded acknowledgement results of the
+// interchain account packets sent on a channel.
+func GetCmdAcknowledgementResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ack-results [port-id] [channel-id]",
+		Short:   "Query the decoded acknowledgement results of the interchain account packets sent on a channel",
+		Long:    "Query the decoded acknowledgement results of the interchain account packets sent on a channel in order of packet sequence",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller ack-results icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs channel-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AcknowledgementResults(cmd.Context(), &types.QueryAcknowledgementResultsRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ack-results")
+
+	return cmd
+}
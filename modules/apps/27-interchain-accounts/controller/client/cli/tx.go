@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+)
+
+// NewCancelOutboxItemCmd returns the command to create a MsgCancelOutboxItem
+func NewCancelOutboxItemCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-outbox-item [connection-id] [id]",
+		Short:   "Cancel a pending interchain accounts outbox item",
+		Long:    "Cancel a pending outbox item of the sender on the provided connection. Outbox items which are in flight cannot be cancelled.",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller cancel-outbox-item connection-0 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelOutboxItem(clientCtx.GetFromAddress().String(), args[0], id)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
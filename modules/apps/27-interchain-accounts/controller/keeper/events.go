@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 )
@@ -29,3 +30,18 @@ func EmitAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, ack expo
 		),
 	)
 }
+
+// EmitOutboxItemEvent emits an event of the provided type for the provided outbox item
+func EmitOutboxItemEvent(ctx sdk.Context, eventType string, item types.OutboxItem) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyOutboxItemID, fmt.Sprintf("%d", item.Id)),
+			sdk.NewAttribute(icatypes.AttributeKeyPortID, item.PortId),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, item.ConnectionId),
+			sdk.NewAttribute(icatypes.AttributeKeyControllerChannelID, item.ChannelId),
+			sdk.NewAttribute(icatypes.AttributeKeySequence, fmt.Sprintf("%d", item.Sequence)),
+		),
+	)
+}
@@ -28,6 +28,20 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state icatypes.ControllerGenesi
 		keeper.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
 	}
 
+	for _, item := range state.OutboxItems {
+		keeper.SetOutboxItem(ctx, item)
+
+		if item.IsInFlight() {
+			keeper.SetOutboxSequence(ctx, item.PortId, item.ChannelId, item.Sequence, item.Id)
+		}
+	}
+
+	keeper.SetNextOutboxItemID(ctx, state.NextOutboxItemId)
+
+	for _, result := range state.AcknowledgementResults {
+		keeper.SetAcknowledgementResult(ctx, result)
+	}
+
 	keeper.SetParams(ctx, state.Params)
 }
 
@@ -38,5 +52,8 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) icatypes.ControllerGenesisSta
 		keeper.GetAllInterchainAccounts(ctx),
 		keeper.GetAllPorts(ctx),
 		keeper.GetParams(ctx),
+		keeper.GetAllOutboxItems(ctx),
+		keeper.GetNextOutboxItemID(ctx),
+		keeper.GetAllAcknowledgementResults(ctx),
 	)
 }
@@ -1,9 +1,15 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/gogo/protobuf/proto"
+
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/keeper"
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
 
@@ -26,6 +32,26 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Ports: []string{TestPortID},
+		OutboxItems: []types.OutboxItem{
+			{
+				Id:              0,
+				PortId:          TestPortID,
+				ConnectionId:    ibctesting.FirstConnectionID,
+				PacketData:      []byte("packet data"),
+				RelativeTimeout: 1,
+				ChannelId:       ibctesting.FirstChannelID,
+				Sequence:        1,
+			},
+		},
+		NextOutboxItemId: 1,
+		AcknowledgementResults: []types.AcknowledgementResult{
+			{
+				PortId:    TestPortID,
+				ChannelId: ibctesting.FirstChannelID,
+				Sequence:  1,
+				Success:   true,
+			},
+		},
 	}
 
 	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper, genesisState)
@@ -38,6 +64,20 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().True(found)
 	suite.Require().Equal(TestAccAddress.String(), accountAdrr)
 
+	item, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetOutboxItem(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID, 0)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.OutboxItems[0], item)
+
+	id, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetOutboxSequence(suite.chainA.GetContext(), TestPortID, ibctesting.FirstChannelID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(0), id)
+
+	suite.Require().Equal(uint64(1), suite.chainA.GetSimApp().ICAControllerKeeper.GetNextOutboxItemID(suite.chainA.GetContext()))
+
+	result, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetAcknowledgementResult(suite.chainA.GetContext(), TestPortID, ibctesting.FirstChannelID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.AcknowledgementResults[0], result)
+
 	expParams := types.NewParams(false)
 	params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
@@ -52,6 +92,13 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	err := SetupICAPath(path, TestOwnerAddress)
 	suite.Require().NoError(err)
 
+	// close the active channel such that the outbox item remains pending
+	err = path.EndpointA.SetChannelClosed()
+	suite.Require().NoError(err)
+
+	_, err = suite.chainA.GetSimApp().ICAControllerKeeper.SubmitTx(suite.chainA.GetContext(), nil, ibctesting.FirstConnectionID, TestPortID, suite.newOutboxPacketData(path), testRelativeTimeout)
+	suite.Require().NoError(err)
+
 	genesisState := keeper.ExportGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper)
 
 	suite.Require().Equal(path.EndpointA.ChannelID, genesisState.ActiveChannels[0].ChannelId)
@@ -62,6 +109,63 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 
 	suite.Require().Equal([]string{TestPortID}, genesisState.GetPorts())
 
+	suite.Require().Len(genesisState.OutboxItems, 1)
+	suite.Require().False(genesisState.OutboxItems[0].IsInFlight())
+	suite.Require().Equal(uint64(1), genesisState.NextOutboxItemId)
+
 	expParams := types.DefaultParams()
 	suite.Require().Equal(expParams, genesisState.GetParams())
 }
+
+func (suite *KeeperTestSuite) TestExportImportGenesisAcknowledgementResults() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	msgResponse, err := proto.Marshal(&govtypes.MsgSubmitProposalResponse{ProposalId: 1})
+	suite.Require().NoError(err)
+
+	txMsgData, err := proto.Marshal(&sdk.TxMsgData{
+		Data: []*sdk.MsgData{
+			{
+				MsgType: sdk.MsgTypeURL(&govtypes.MsgSubmitProposal{}),
+				Data:    msgResponse,
+			},
+		},
+	})
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket([]byte{}, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+	ack := channeltypes.NewResultAcknowledgement(txMsgData).Acknowledgement()
+
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, ack)
+	suite.Require().NoError(err)
+
+	// the msg response types are not registered in the interface registry, the exported genesis state must still be
+	// marshalled to JSON
+	cdc := suite.chainA.GetSimApp().AppCodec()
+	genesisState := icatypes.NewGenesisState(keeper.ExportGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper), icatypes.DefaultHostGenesis())
+	suite.Require().Len(genesisState.ControllerGenesisState.AcknowledgementResults, 1)
+	suite.Require().Len(genesisState.ControllerGenesisState.AcknowledgementResults[0].MsgResults, 1)
+
+	bz, err := cdc.MarshalJSON(genesisState)
+	suite.Require().NoError(err)
+
+	var importedState icatypes.GenesisState
+	err = cdc.UnmarshalJSON(bz, &importedState)
+	suite.Require().NoError(err)
+	suite.Require().NoError(importedState.Validate())
+
+	suite.SetupTest() // reset
+
+	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper, importedState.ControllerGenesisState)
+
+	result, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetAcknowledgementResult(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.ControllerGenesisState.AcknowledgementResults[0], result)
+	suite.Require().Equal(msgResponse, result.MsgResults[0].Response)
+}
@@ -3,9 +3,15 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -19,3 +25,104 @@ func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// OutboxItems implements the Query/OutboxItems gRPC method
+func (q Keeper) OutboxItems(c context.Context, req *types.QueryOutboxItemsRequest) (*types.QueryOutboxItemsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	portID, err := icatypes.NewControllerPortID(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var items []types.OutboxItem
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyOutboxPrefix(portID, req.ConnectionId))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var item types.OutboxItem
+		if err := q.cdc.Unmarshal(value, &item); err != nil {
+			return err
+		}
+
+		items = append(items, item)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOutboxItemsResponse{
+		Items:      items,
+		Pagination: pageRes,
+	}, nil
+}
+
+// AcknowledgementResult implements the Query/AcknowledgementResult gRPC method
+func (q Keeper) AcknowledgementResult(c context.Context, req *types.QueryAcknowledgementResultRequest) (*types.QueryAcknowledgementResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	result, found := q.GetAcknowledgementResult(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "acknowledgement result not found for port %s, channel %s and sequence %d", req.PortId, req.ChannelId, req.Sequence)
+	}
+
+	return &types.QueryAcknowledgementResultResponse{
+		Result: result,
+	}, nil
+}
+
+// AcknowledgementResults implements the Query/AcknowledgementResults gRPC method
+func (q Keeper) AcknowledgementResults(c context.Context, req *types.QueryAcknowledgementResultsRequest) (*types.QueryAcknowledgementResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var results []types.AcknowledgementResult
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyAcknowledgementResultPrefix(req.PortId, req.ChannelId))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var result types.AcknowledgementResult
+		if err := q.cdc.Unmarshal(value, &result); err != nil {
+			return err
+		}
+
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAcknowledgementResultsResponse{
+		Results:    results,
+		Pagination: pageRes,
+	}, nil
+}
@@ -2,8 +2,10 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -12,3 +14,272 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAControllerKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryOutboxItems() {
+	var (
+		req      *types.QueryOutboxItemsRequest
+		expItems []types.OutboxItem
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				req.ConnectionId = "invalid|connection"
+			},
+			false,
+		},
+		{
+			"invalid owner",
+			func() {
+				req.Owner = ""
+			},
+			false,
+		},
+		{
+			"success - empty outbox",
+			func() {
+				req.ConnectionId = "connection-100"
+				expItems = nil
+			},
+			true,
+		},
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success - pagination",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 1}
+				expItems = expItems[:1]
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			// close the active channel such that the outbox items remain pending
+			err = path.EndpointA.SetChannelClosed()
+			suite.Require().NoError(err)
+
+			expItems = nil
+			for i := 0; i < 2; i++ {
+				id, err := suite.chainA.GetSimApp().ICAControllerKeeper.SubmitTx(suite.chainA.GetContext(), nil, ibctesting.FirstConnectionID, TestPortID, suite.newOutboxPacketData(path), testRelativeTimeout)
+				suite.Require().NoError(err)
+
+				item, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetOutboxItem(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID, id)
+				suite.Require().True(found)
+
+				expItems = append(expItems, item)
+			}
+
+			req = &types.QueryOutboxItemsRequest{
+				Owner:        TestOwnerAddress,
+				ConnectionId: ibctesting.FirstConnectionID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.OutboxItems(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expItems, res.Items)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryAcknowledgementResult() {
+	var (
+		req       *types.QueryAcknowledgementResultRequest
+		expResult types.AcknowledgementResult
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"acknowledgement result not found",
+			func() {
+				req.Sequence = 2
+			},
+			false,
+		},
+		{
+			"success",
+			func() {},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+
+			expResult = types.AcknowledgementResult{
+				PortId:    TestPortID,
+				ChannelId: ibctesting.FirstChannelID,
+				Sequence:  1,
+				ErrorCode: 5,
+			}
+
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetAcknowledgementResult(suite.chainA.GetContext(), expResult)
+
+			req = &types.QueryAcknowledgementResultRequest{
+				PortId:    TestPortID,
+				ChannelId: ibctesting.FirstChannelID,
+				Sequence:  1,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.AcknowledgementResult(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResult, res.Result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryAcknowledgementResults() {
+	var (
+		req        *types.QueryAcknowledgementResultsRequest
+		expResults []types.AcknowledgementResult
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"success - no results",
+			func() {
+				req.ChannelId = "channel-100"
+				expResults = nil
+			},
+			true,
+		},
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success - pagination",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 1}
+				expResults = expResults[:1]
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+
+			expResults = nil
+			for sequence := uint64(1); sequence <= 2; sequence++ {
+				result := types.AcknowledgementResult{
+					PortId:    TestPortID,
+					ChannelId: ibctesting.FirstChannelID,
+					Sequence:  sequence,
+					Success:   true,
+				}
+
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetAcknowledgementResult(suite.chainA.GetContext(), result)
+				expResults = append(expResults, result)
+			}
+
+			req = &types.QueryAcknowledgementResultsRequest{
+				PortId:    TestPortID,
+				ChannelId: ibctesting.FirstChannelID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.AcknowledgementResults(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, res.Results)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -119,6 +119,11 @@ func (k Keeper) OnChanOpenAck(
 	k.SetActiveChannelID(ctx, metadata.ControllerConnectionId, portID, channelID)
 	k.SetInterchainAccountAddress(ctx, metadata.ControllerConnectionId, portID, metadata.Address)
 
+	// pending outbox items are resubmitted at the end of the block, once the channel is OPEN
+	if len(k.GetOutboxItems(ctx, portID, metadata.ControllerConnectionId)) != 0 {
+		k.SetOutboxFlush(ctx, portID, metadata.ControllerConnectionId)
+	}
+
 	return nil
 }
 
@@ -7,6 +7,7 @@ import (
 	baseapp "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -56,6 +57,13 @@ func NewKeeper(
 	}
 }
 
+// SetICS4Wrapper sets the ICS4Wrapper used to send packets. It allows a middleware, which
+// wraps the controller IBC middleware, to be set as the ICS4Wrapper after the keeper is constructed.
+// It must be called before the keeper is passed to the controller IBC middleware.
+func (k *Keeper) SetICS4Wrapper(wrapper icatypes.ICS4Wrapper) {
+	k.ics4Wrapper = wrapper
+}
+
 // Logger returns the application logger, scoped to the associated module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, icatypes.ModuleName))
@@ -206,3 +214,179 @@ func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, connectionID, portI
 	store := ctx.KVStore(k.storeKey)
 	store.Set(icatypes.KeyOwnerAccount(portID, connectionID), []byte(address))
 }
+
+// GetNextOutboxItemID returns the next outbox item identifier
+func (k Keeper) GetNextOutboxItemID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.NextOutboxItemIDKey))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextOutboxItemID stores the next outbox item identifier
+func (k Keeper) SetNextOutboxItemID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.NextOutboxItemIDKey), sdk.Uint64ToBigEndian(id))
+}
+
+// GetOutboxItem retrieves the outbox item with the provided identifier from the outbox of the provided port and connection
+func (k Keeper) GetOutboxItem(ctx sdk.Context, portID, connectionID string, id uint64) (types.OutboxItem, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyOutboxItem(portID, connectionID, id))
+	if bz == nil {
+		return types.OutboxItem{}, false
+	}
+
+	var item types.OutboxItem
+	k.cdc.MustUnmarshal(bz, &item)
+
+	return item, true
+}
+
+// GetOutboxItems returns the outbox items of the provided port and connection in order of submission
+func (k Keeper) GetOutboxItems(ctx sdk.Context, portID, connectionID string) []types.OutboxItem {
+	return k.getOutboxItemsWithPrefix(ctx, types.KeyOutboxPrefix(portID, connectionID))
+}
+
+// GetAllOutboxItems returns the outbox items of all ports and connections
+func (k Keeper) GetAllOutboxItems(ctx sdk.Context) []types.OutboxItem {
+	return k.getOutboxItemsWithPrefix(ctx, []byte(fmt.Sprintf("%s/", types.OutboxKeyPrefix)))
+}
+
+func (k Keeper) getOutboxItemsWithPrefix(ctx sdk.Context, prefix []byte) []types.OutboxItem {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var items []types.OutboxItem
+	for ; iterator.Valid(); iterator.Next() {
+		var item types.OutboxItem
+		k.cdc.MustUnmarshal(iterator.Value(), &item)
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// SetOutboxItem stores the provided outbox item
+func (k Keeper) SetOutboxItem(ctx sdk.Context, item types.OutboxItem) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyOutboxItem(item.PortId, item.ConnectionId, item.Id), k.cdc.MustMarshal(&item))
+}
+
+// DeleteOutboxItem removes the outbox item with the provided identifier from the outbox of the provided port and connection
+func (k Keeper) DeleteOutboxItem(ctx sdk.Context, portID, connectionID string, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyOutboxItem(portID, connectionID, id))
+}
+
+// GetOutboxSequence retrieves the identifier of the outbox item in flight with the provided port, channel and sequence
+func (k Keeper) GetOutboxSequence(ctx sdk.Context, portID, channelID string, sequence uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyOutboxSequence(portID, channelID, sequence))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetOutboxSequence stores the identifier of the outbox item in flight with the provided port, channel and sequence
+func (k Keeper) SetOutboxSequence(ctx sdk.Context, portID, channelID string, sequence, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyOutboxSequence(portID, channelID, sequence), sdk.Uint64ToBigEndian(id))
+}
+
+// DeleteOutboxSequence removes the identifier of the outbox item in flight with the provided port, channel and sequence
+func (k Keeper) DeleteOutboxSequence(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyOutboxSequence(portID, channelID, sequence))
+}
+
+// SetOutboxFlush marks the outbox of the provided port and connection for resubmission at the end of the block
+func (k Keeper) SetOutboxFlush(ctx sdk.Context, portID, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyOutboxFlush(portID, connectionID), []byte{0x01})
+}
+
+// parseOutboxFlushKey returns the port and connection identifiers of the provided outbox flush key
+func parseOutboxFlushKey(key []byte) (string, string, error) {
+	keySplit := strings.Split(string(key), "/")
+	if len(keySplit) != 3 {
+		return "", "", sdkerrors.Wrapf(host.ErrInvalidPath, "key %s is not a valid outbox flush key", string(key))
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
+// GetAcknowledgementResult retrieves the decoded acknowledgement result of the packet with the provided port, channel
+// and sequence
+func (k Keeper) GetAcknowledgementResult(ctx sdk.Context, portID, channelID string, sequence uint64) (types.AcknowledgementResult, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyAcknowledgementResult(portID, channelID, sequence))
+	if bz == nil {
+		return types.AcknowledgementResult{}, false
+	}
+
+	var result types.AcknowledgementResult
+	k.cdc.MustUnmarshal(bz, &result)
+
+	return result, true
+}
+
+// GetAllAcknowledgementResults returns the decoded acknowledgement results of all ports and channels
+func (k Keeper) GetAllAcknowledgementResults(ctx sdk.Context) []types.AcknowledgementResult {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(fmt.Sprintf("%s/", types.AcknowledgementResultKeyPrefix)))
+	defer iterator.Close()
+
+	var results []types.AcknowledgementResult
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.AcknowledgementResult
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// SetAcknowledgementResult stores the provided decoded acknowledgement result
+func (k Keeper) SetAcknowledgementResult(ctx sdk.Context, result types.AcknowledgementResult) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyAcknowledgementResult(result.PortId, result.ChannelId, result.Sequence), k.cdc.MustMarshal(&result))
+}
+
+// pruneAcknowledgementResults removes the oldest acknowledgement results of the provided port and channel, such that at
+// most MaxAcknowledgementResultsPerChannel results are kept
+func (k Keeper) pruneAcknowledgementResults(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.KeyAcknowledgementResultPrefix(portID, channelID))
+	defer iterator.Close()
+
+	var (
+		count  uint64
+		pruned [][]byte
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+		if count > types.MaxAcknowledgementResultsPerChannel {
+			pruned = append(pruned, iterator.Key())
+		}
+	}
+
+	for _, key := range pruned {
+		store.Delete(key)
+	}
+}
+
+// DeleteAcknowledgementResult removes the decoded acknowledgement result of the packet with the provided port, channel
+// and sequence. Authentication modules may use it to remove results which have been processed.
+func (k Keeper) DeleteAcknowledgementResult(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyAcknowledgementResult(portID, channelID, sequence))
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// CancelOutboxItem defines a rpc handler method for MsgCancelOutboxItem
+// CancelOutboxItem removes a pending outbox item from the outbox of the owner on the provided connection,
+// such that it is no longer submitted to the host chain
+func (k Keeper) CancelOutboxItem(goCtx context.Context, msg *types.MsgCancelOutboxItem) (*types.MsgCancelOutboxItemResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.cancelOutboxItem(ctx, msg.ConnectionId, portID, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelOutboxItemResponse{}, nil
+}
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// SubmitTx queues the provided packet data in the outbox of the provided connection and controller port and attempts
+// to send it over the active channel. The identifier of the outbox item is returned as a result.
+// Unlike SendTx, the packet data is not lost if no active channel is open or if the packet times out. The outbox
+// item remains queued until the packet is acknowledged, and is resubmitted in order once a new active channel is
+// opened. The timeout of each submission is relative to the block time at which the packet is sent.
+// The channel capability may be nil, in which case it is retrieved from the channel keeper.
+func (k Keeper) SubmitTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, relativeTimeout uint64) (uint64, error) {
+	if err := icaPacketData.ValidateBasic(); err != nil {
+		return 0, sdkerrors.Wrap(err, "invalid interchain account packet data")
+	}
+
+	if relativeTimeout == 0 {
+		return 0, sdkerrors.Wrap(types.ErrInvalidRelativeTimeout, "relative timeout must be greater than zero")
+	}
+
+	item := types.OutboxItem{
+		Id:              k.GetNextOutboxItemID(ctx),
+		PortId:          portID,
+		ConnectionId:    connectionID,
+		PacketData:      icaPacketData.GetBytes(),
+		RelativeTimeout: relativeTimeout,
+	}
+
+	k.SetOutboxItem(ctx, item)
+	k.SetNextOutboxItemID(ctx, item.Id+1)
+
+	EmitOutboxItemEvent(ctx, icatypes.EventTypeOutboxItemQueued, item)
+
+	k.flushOutbox(ctx, chanCap, connectionID, portID)
+
+	return item.Id, nil
+}
+
+// cancelOutboxItem removes the outbox item with the provided identifier from the outbox of the provided connection
+// and controller port. Outbox items which are in flight cannot be cancelled.
+func (k Keeper) cancelOutboxItem(ctx sdk.Context, connectionID, portID string, id uint64) error {
+	item, found := k.GetOutboxItem(ctx, portID, connectionID, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrOutboxItemNotFound, "outbox item %d not found for port %s on connection %s", id, portID, connectionID)
+	}
+
+	if item.IsInFlight() {
+		return sdkerrors.Wrapf(types.ErrOutboxItemInFlight, "outbox item %d is in flight on channel %s with sequence %d", id, item.ChannelId, item.Sequence)
+	}
+
+	k.DeleteOutboxItem(ctx, portID, connectionID, id)
+
+	EmitOutboxItemEvent(ctx, icatypes.EventTypeOutboxItemCancelled, item)
+
+	return nil
+}
+
+// FlushOutboxes resubmits the pending outbox items of all outboxes marked for resubmission upon the opening of a new
+// active channel. It is called at the end of every block, as the channel is only set to OPEN after the
+// OnChanOpenAck callback has returned.
+func (k Keeper) FlushOutboxes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.OutboxFlushKeyPrefix))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	iterator.Close()
+
+	for _, key := range keys {
+		portID, connectionID, err := parseOutboxFlushKey(key)
+		if err != nil {
+			panic(err)
+		}
+
+		store.Delete(key)
+
+		k.flushOutbox(ctx, nil, connectionID, portID)
+	}
+}
+
+// flushOutbox sends the pending outbox items of the provided connection and controller port over the active channel
+// in order of submission. Submission stops at the first item which cannot be sent, such that the order of the
+// packets is preserved. Pending items remain queued if no active channel is open.
+func (k Keeper) flushOutbox(ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string) {
+	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
+	if !found {
+		return
+	}
+
+	// the channel capability is owned by the authentication module, it is retrieved from the channel keeper
+	// when resubmitting outbox items outside of a call by the authentication module
+	if chanCap == nil {
+		var err error
+		_, chanCap, err = k.channelKeeper.LookupModuleByChannel(ctx, portID, activeChannelID)
+		if err != nil {
+			k.Logger(ctx).Error("failed to resubmit outbox items, channel capability not found", "port-id", portID, "channel-id", activeChannelID, "error", err.Error())
+			return
+		}
+	}
+
+	for _, item := range k.GetOutboxItems(ctx, portID, connectionID) {
+		if item.IsInFlight() {
+			continue
+		}
+
+		// the packet is sent within a cached context, discarding state changes if the packet cannot be sent
+		cacheCtx, writeCache := ctx.CacheContext()
+		sequence, err := k.sendOutboxItem(cacheCtx, chanCap, activeChannelID, item)
+		if err != nil {
+			k.Logger(ctx).Error("failed to send outbox item", "id", item.Id, "port-id", portID, "connection-id", connectionID, "error", err.Error())
+			return
+		}
+
+		// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		writeCache()
+
+		item.ChannelId = activeChannelID
+		item.Sequence = sequence
+		k.SetOutboxItem(ctx, item)
+		k.SetOutboxSequence(ctx, portID, activeChannelID, sequence, item.Id)
+
+		EmitOutboxItemEvent(ctx, icatypes.EventTypeOutboxItemSent, item)
+	}
+}
+
+// sendOutboxItem sends the packet data of the provided outbox item over the provided channel, with a timeout
+// relative to the current block time
+func (k Keeper) sendOutboxItem(ctx sdk.Context, chanCap *capabilitytypes.Capability, channelID string, item types.OutboxItem) (uint64, error) {
+	var icaPacketData icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(item.PacketData, &icaPacketData); err != nil {
+		return 0, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data")
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, item.PortId, channelID)
+	if !found {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelNotFound, channelID)
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + item.RelativeTimeout
+
+	return k.createOutgoingPacket(ctx, item.PortId, channelID, channel.Counterparty.PortId, channel.Counterparty.ChannelId, chanCap, icaPacketData, timeoutTimestamp)
+}
+
+// onOutboxPacketAcknowledged removes the outbox item associated with the provided packet, if any
+func (k Keeper) onOutboxPacketAcknowledged(ctx sdk.Context, packet channeltypes.Packet) {
+	id, found := k.GetOutboxSequence(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	if !found {
+		return
+	}
+
+	connectionID := channel.ConnectionHops[0]
+	if item, found := k.GetOutboxItem(ctx, packet.SourcePort, connectionID, id); found {
+		k.DeleteOutboxItem(ctx, packet.SourcePort, connectionID, id)
+		EmitOutboxItemEvent(ctx, icatypes.EventTypeOutboxItemAcknowledged, item)
+	}
+
+	k.DeleteOutboxSequence(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+}
+
+// onOutboxPacketTimedOut returns the outbox items in flight on the channel of the provided packet with a sequence
+// greater than or equal to the sequence of the timed out packet to the pending state. As the channel is ORDERED,
+// it is closed upon timeout and none of these packets may be received. The pending items are resubmitted once a
+// new active channel is opened. The timeout proves that the packets with a lower sequence were received by the
+// host chain, however their acknowledgements can no longer be processed on the closed channel. These items are
+// removed from the outbox without being resubmitted, such that they are never executed twice.
+func (k Keeper) onOutboxPacketTimedOut(ctx sdk.Context, packet channeltypes.Packet) {
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	if !found {
+		return
+	}
+
+	connectionID := channel.ConnectionHops[0]
+	for _, item := range k.GetOutboxItems(ctx, packet.SourcePort, connectionID) {
+		if item.ChannelId != packet.SourceChannel {
+			continue
+		}
+
+		k.DeleteOutboxSequence(ctx, item.PortId, item.ChannelId, item.Sequence)
+
+		if item.Sequence < packet.Sequence {
+			k.DeleteOutboxItem(ctx, item.PortId, connectionID, item.Id)
+			EmitOutboxItemEvent(ctx, icatypes.EventTypeOutboxItemAckUnknown, item)
+			continue
+		}
+
+		item.ChannelId = ""
+		item.Sequence = 0
+		k.SetOutboxItem(ctx, item)
+
+		EmitOutboxItemEvent(ctx, icatypes.EventTypeOutboxItemRequeued, item)
+	}
+}
@@ -0,0 +1,325 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+var testRelativeTimeout = uint64(time.Hour.Nanoseconds())
+
+func (suite *KeeperTestSuite) newOutboxPacketData(path *ibctesting.Path) icatypes.InterchainAccountPacketData {
+	interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg})
+	suite.Require().NoError(err)
+
+	return icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitTx() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+	packetData := suite.newOutboxPacketData(path)
+
+	// the outbox item is sent immediately over the active channel
+	id, err := controllerKeeper.SubmitTx(suite.chainA.GetContext(), nil, ibctesting.FirstConnectionID, TestPortID, packetData, testRelativeTimeout)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), id)
+
+	item, found := controllerKeeper.GetOutboxItem(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID, id)
+	suite.Require().True(found)
+	suite.Require().Equal(path.EndpointA.ChannelID, item.ChannelId)
+	suite.Require().Equal(uint64(1), item.Sequence)
+	suite.Require().Equal(packetData.GetBytes(), item.PacketData)
+
+	packet := channeltypes.NewPacket(item.PacketData, item.Sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), 0)
+
+	// the outbox item is removed upon acknowledgement
+	err = controllerKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+	suite.Require().NoError(err)
+
+	_, found = controllerKeeper.GetOutboxItem(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID, id)
+	suite.Require().False(found)
+
+	_, found = controllerKeeper.GetOutboxSequence(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	suite.Require().False(found)
+
+	// invalid packet data and relative timeouts are rejected
+	_, err = controllerKeeper.SubmitTx(suite.chainA.GetContext(), nil, ibctesting.FirstConnectionID, TestPortID, icatypes.InterchainAccountPacketData{}, testRelativeTimeout)
+	suite.Require().Error(err)
+
+	_, err = controllerKeeper.SubmitTx(suite.chainA.GetContext(), nil, ibctesting.FirstConnectionID, TestPortID, packetData, 0)
+	suite.Require().ErrorIs(err, types.ErrInvalidRelativeTimeout)
+}
+
+func (suite *KeeperTestSuite) TestOutboxResubmission() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+	packetData := suite.newOutboxPacketData(path)
+
+	timedOutID, err := controllerKeeper.SubmitTx(suite.chainA.GetContext(), nil, ibctesting.FirstConnectionID, TestPortID, packetData, testRelativeTimeout)
+	suite.Require().NoError(err)
+
+	inFlightID, err := controllerKeeper.SubmitTx(suite.chainA.GetContext(), nil, ibctesting.FirstConnectionID, TestPortID, packetData, testRelativeTimeout)
+	suite.Require().NoError(err)
+
+	// the timeout of a packet returns all outbox items in flight on the channel to the pending state
+	timedOutItem, found := controllerKeeper.GetOutboxItem(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID, timedOutID)
+	suite.Require().True(found)
+
+	packet := channeltypes.NewPacket(timedOutItem.PacketData, timedOutItem.Sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), 0)
+
+	err = controllerKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet)
+	suite.Require().NoError(err)
+
+	for _, item := range controllerKeeper.GetOutboxItems(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID) {
+		suite.Require().False(item.IsInFlight())
+	}
+
+	err = path.EndpointA.SetChannelClosed()
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.SetChannelClosed()
+	suite.Require().NoError(err)
+
+	// outbox items remain pending while no active channel is open
+	pendingID, err := controllerKeeper.SubmitTx(suite.chainA.GetContext(), nil, ibctesting.FirstConnectionID, TestPortID, packetData, testRelativeTimeout)
+	suite.Require().NoError(err)
+
+	pendingItem, found := controllerKeeper.GetOutboxItem(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID, pendingID)
+	suite.Require().True(found)
+	suite.Require().False(pendingItem.IsInFlight())
+
+	// reopen a new active channel, the pending outbox items are resubmitted in order at the end of the block
+	path.EndpointA.ChannelID = ""
+	path.EndpointB.ChannelID = ""
+	path.EndpointA.ChannelConfig.PortID = icatypes.PortID
+	path.EndpointB.ChannelConfig.PortID = icatypes.PortID
+	path.EndpointA.ChannelConfig.Version = TestVersion
+	path.EndpointB.ChannelConfig.Version = TestVersion
+
+	err = SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+	suite.Require().Equal("channel-1", path.EndpointA.ChannelID)
+
+	for i, id := range []uint64{timedOutID, inFlightID, pendingID} {
+		item, found := controllerKeeper.GetOutboxItem(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID, id)
+		suite.Require().True(found)
+		suite.Require().Equal(path.EndpointA.ChannelID, item.ChannelId)
+		suite.Require().Equal(uint64(i+1), item.Sequence)
+
+		storedID, found := controllerKeeper.GetOutboxSequence(suite.chainA.GetContext(), TestPortID, path.EndpointA.ChannelID, item.Sequence)
+		suite.Require().True(found)
+		suite.Require().Equal(id, storedID)
+	}
+}
+
+// TestOutboxTimeoutAfterDelivery relays two outbox items over the active channel, the first packet is received by the
+// host chain and the second packet times out. Only the timed out outbox item is resubmitted on the new active channel.
+func (suite *KeeperTestSuite) TestOutboxTimeoutAfterDelivery() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	// fund the interchain account and allow the execution of bank sends on the host chain
+	_, err = suite.chainB.SendMsgs(&banktypes.MsgSend{
+		FromAddress: suite.chainB.SenderAccount.GetAddress().String(),
+		ToAddress:   interchainAccountAddr,
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))),
+	})
+	suite.Require().NoError(err)
+
+	hostParams := hosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, 0, 0, hosttypes.DefaultGasQuotaEpochLength, nil)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), hostParams)
+
+	controllerKeeper := suite.chainA.GetSimApp().ICAControllerKeeper
+	packetData := suite.newOutboxPacketData(path)
+	sendTime := uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+
+	deliveredID, err := controllerKeeper.SubmitTx(suite.chainA.GetContext(), nil, ibctesting.FirstConnectionID, TestPortID, packetData, testRelativeTimeout)
+	suite.Require().NoError(err)
+
+	timedOutID, err := controllerKeeper.SubmitTx(suite.chainA.GetContext(), nil, ibctesting.FirstConnectionID, TestPortID, packetData, uint64(time.Minute.Nanoseconds()))
+	suite.Require().NoError(err)
+
+	deliveredPacket := channeltypes.NewPacket(packetData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), sendTime+testRelativeTimeout)
+	timedOutPacket := channeltypes.NewPacket(packetData.GetBytes(), 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), sendTime+uint64(time.Minute.Nanoseconds()))
+
+	// the first packet is received and executed on the host chain, its acknowledgement is not relayed
+	suite.coordinator.CommitBlock(suite.chainA)
+	err = path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.RecvPacket(deliveredPacket)
+	suite.Require().NoError(err)
+
+	// the second packet times out, closing the channel
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	proof, proofHeight := path.EndpointB.QueryProof(host.NextSequenceRecvKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+	_, err = suite.chainA.SendMsgs(channeltypes.NewMsgTimeout(timedOutPacket, 2, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+
+	// the delivered outbox item is removed and never resubmitted
+	_, found = controllerKeeper.GetOutboxItem(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID, deliveredID)
+	suite.Require().False(found)
+
+	_, found = controllerKeeper.GetOutboxSequence(suite.chainA.GetContext(), TestPortID, path.EndpointA.ChannelID, deliveredPacket.Sequence)
+	suite.Require().False(found)
+
+	timedOutItem, found := controllerKeeper.GetOutboxItem(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID, timedOutID)
+	suite.Require().True(found)
+	suite.Require().False(timedOutItem.IsInFlight())
+
+	// reopen a new active channel, only the timed out outbox item is resubmitted
+	err = path.EndpointB.SetChannelClosed()
+	suite.Require().NoError(err)
+
+	path.EndpointA.ChannelID = ""
+	path.EndpointB.ChannelID = ""
+	path.EndpointA.ChannelConfig.PortID = icatypes.PortID
+	path.EndpointB.ChannelConfig.PortID = icatypes.PortID
+	path.EndpointA.ChannelConfig.Version = TestVersion
+	path.EndpointB.ChannelConfig.Version = TestVersion
+
+	err = SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	items := controllerKeeper.GetOutboxItems(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID)
+	suite.Require().Len(items, 1)
+	suite.Require().Equal(timedOutID, items[0].Id)
+	suite.Require().Equal(path.EndpointA.ChannelID, items[0].ChannelId)
+	suite.Require().Equal(uint64(1), items[0].Sequence)
+
+	// the bank send of the delivered outbox item was executed exactly once
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(900), suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestCancelOutboxItem() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgCancelOutboxItem
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"outbox item not found",
+			func() {
+				msg.Id = 100
+			},
+			types.ErrOutboxItemNotFound,
+		},
+		{
+			"outbox item of another owner",
+			func() {
+				msg.Owner = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			types.ErrOutboxItemNotFound,
+		},
+		{
+			"outbox item in flight",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.State = channeltypes.OPEN
+				path.EndpointA.SetChannel(channel)
+
+				id, err := suite.chainA.GetSimApp().ICAControllerKeeper.SubmitTx(suite.chainA.GetContext(), nil, ibctesting.FirstConnectionID, TestPortID, suite.newOutboxPacketData(path), testRelativeTimeout)
+				suite.Require().NoError(err)
+
+				msg.Id = id
+			},
+			types.ErrOutboxItemInFlight,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			// close the active channel such that the outbox item remains pending
+			err = path.EndpointA.SetChannelClosed()
+			suite.Require().NoError(err)
+
+			id, err := suite.chainA.GetSimApp().ICAControllerKeeper.SubmitTx(suite.chainA.GetContext(), nil, ibctesting.FirstConnectionID, TestPortID, suite.newOutboxPacketData(path), testRelativeTimeout)
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgCancelOutboxItem(TestOwnerAddress, ibctesting.FirstConnectionID, id)
+
+			tc.malleate()
+
+			_, err = suite.chainA.GetSimApp().ICAControllerKeeper.CancelOutboxItem(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				_, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetOutboxItem(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID, id)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
@@ -74,8 +75,34 @@ func (k Keeper) createOutgoingPacket(
 	return packet.Sequence, nil
 }
 
+// OnAcknowledgementPacket removes the outbox item associated with the provided packet, if any, as the packet has
+// been executed on the host chain. The acknowledgement is decoded and the result is stored and emitted as a typed event.
+// Only the latest MaxAcknowledgementResultsPerChannel results of the channel are kept.
+// Acknowledgements which cannot be decoded are not stored, such that the acknowledgement of the packet is not blocked.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	k.onOutboxPacketAcknowledged(ctx, packet)
+
+	result, err := types.NewAcknowledgementResult(packet, acknowledgement)
+	if err != nil {
+		k.Logger(ctx).Error("failed to decode acknowledgement result", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence, "error", err.Error())
+		return nil
+	}
+
+	k.SetAcknowledgementResult(ctx, result)
+	k.pruneAcknowledgementResults(ctx, packet.SourcePort, packet.SourceChannel)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAcknowledgementResult{Result: result}); err != nil {
+		k.Logger(ctx).Error("failed to emit acknowledgement result event", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence, "error", err.Error())
+	}
+
+	return nil
+}
+
 // OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
-// due to the semantics of ORDERED channels
+// due to the semantics of ORDERED channels. Outbox items in flight on the channel which were not received by the host
+// chain are returned to the pending state.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	k.onOutboxPacketTimedOut(ctx, packet)
+
 	return nil
 }
@@ -2,9 +2,13 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/gogo/protobuf/proto"
 
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
@@ -182,6 +186,116 @@ func (suite *KeeperTestSuite) TestSendTx() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	var (
+		path      *ibctesting.Path
+		ack       []byte
+		expResult *types.AcknowledgementResult
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success - result acknowledgement",
+			func() {
+				msgResponse, err := proto.Marshal(&govtypes.MsgSubmitProposalResponse{ProposalId: 1})
+				suite.Require().NoError(err)
+
+				txMsgData, err := proto.Marshal(&sdk.TxMsgData{
+					Data: []*sdk.MsgData{
+						{
+							MsgType: sdk.MsgTypeURL(&govtypes.MsgSubmitProposal{}),
+							Data:    msgResponse,
+						},
+					},
+				})
+				suite.Require().NoError(err)
+
+				ack = channeltypes.NewResultAcknowledgement(txMsgData).Acknowledgement()
+				expResult.Success = true
+				expResult.MsgResults = []types.MsgResult{
+					{
+						MsgTypeUrl:      sdk.MsgTypeURL(&govtypes.MsgSubmitProposal{}),
+						ResponseTypeUrl: "/" + proto.MessageName(&govtypes.MsgSubmitProposalResponse{}),
+						Response:        msgResponse,
+					},
+				}
+			},
+		},
+		{
+			"success - error acknowledgement",
+			func() {
+				ack = channeltypes.NewErrorAcknowledgement(sdkerrors.ErrInsufficientFunds).Acknowledgement()
+				expResult.ErrorCode = sdkerrors.ErrInsufficientFunds.ABCICode()
+			},
+		},
+		{
+			"acknowledgement cannot be decoded",
+			func() {
+				ack = []byte("invalid acknowledgement")
+				expResult = nil
+			},
+		},
+		{
+			"acknowledgement result cannot be decoded",
+			func() {
+				ack = channeltypes.NewResultAcknowledgement([]byte("invalid tx msg data")).Acknowledgement()
+				expResult = nil
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(
+				[]byte{},
+				1,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			expResult = &types.AcknowledgementResult{
+				PortId:    packet.SourcePort,
+				ChannelId: packet.SourceChannel,
+				Sequence:  packet.Sequence,
+			}
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			err = suite.chainA.GetSimApp().ICAControllerKeeper.OnAcknowledgementPacket(ctx, packet, ack)
+			suite.Require().NoError(err)
+
+			result, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetAcknowledgementResult(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+			if expResult != nil {
+				suite.Require().True(found)
+				suite.Require().Equal(*expResult, result)
+
+				expEvent, err := sdk.TypedEventToEvent(&types.EventAcknowledgementResult{Result: *expResult})
+				suite.Require().NoError(err)
+				suite.Require().Contains(ctx.EventManager().Events(), expEvent)
+			} else {
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 	var path *ibctesting.Path
 
@@ -230,3 +344,30 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacketPrunesResults() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	ack := channeltypes.NewErrorAcknowledgement(sdkerrors.ErrInsufficientFunds).Acknowledgement()
+
+	ctx := suite.chainA.GetContext()
+	for sequence := uint64(1); sequence <= types.MaxAcknowledgementResultsPerChannel+1; sequence++ {
+		packet := channeltypes.NewPacket([]byte{}, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+		err = suite.chainA.GetSimApp().ICAControllerKeeper.OnAcknowledgementPacket(ctx, packet, ack)
+		suite.Require().NoError(err)
+	}
+
+	results := suite.chainA.GetSimApp().ICAControllerKeeper.GetAllAcknowledgementResults(ctx)
+	suite.Require().Len(results, types.MaxAcknowledgementResultsPerChannel)
+	suite.Require().Equal(uint64(2), results[0].Sequence)
+
+	_, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetAcknowledgementResult(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+	suite.Require().False(found)
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+const (
+	// msgResponseTypeURLSuffix is the suffix appended to the type URL of a message to obtain the type URL of its response
+	msgResponseTypeURLSuffix = "Response"
+
+	// MaxAcknowledgementResultsPerChannel defines the maximum number of acknowledgement results stored per channel,
+	// the results of the oldest packets are pruned once it is exceeded
+	MaxAcknowledgementResultsPerChannel = 100
+)
+
+// NewAcknowledgementResult decodes the provided acknowledgement of the provided interchain account packet.
+// The result of a successful acknowledgement is expected to contain the protobuf encoded TxMsgData of the executed
+// messages, whose responses are returned as raw bytes along with their type URL, such that responses whose types are
+// not registered in the interface registry can be stored and marshalled to JSON. The error of a failed acknowledgement is reduced to its
+// deterministic ABCI code, as written by channeltypes.NewErrorAcknowledgement.
+func NewAcknowledgementResult(packet channeltypes.Packet, acknowledgement []byte) (AcknowledgementResult, error) {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return AcknowledgementResult{}, sdkerrors.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal ICS-27 packet acknowledgement: %v", err)
+	}
+
+	result := AcknowledgementResult{
+		PortId:    packet.GetSourcePort(),
+		ChannelId: packet.GetSourceChannel(),
+		Sequence:  packet.GetSequence(),
+		Success:   ack.Success(),
+	}
+
+	if !ack.Success() {
+		result.ErrorCode = parseErrorCode(ack.GetError())
+		return result, nil
+	}
+
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(ack.GetResult(), &txMsgData); err != nil {
+		return AcknowledgementResult{}, sdkerrors.Wrapf(ErrInvalidAcknowledgement, "cannot unmarshal ICS-27 tx message data: %v", err)
+	}
+
+	for _, msgData := range txMsgData.Data {
+		result.MsgResults = append(result.MsgResults, MsgResult{
+			MsgTypeUrl:      msgData.MsgType,
+			ResponseTypeUrl: msgData.MsgType + msgResponseTypeURLSuffix,
+			Response:        msgData.Data,
+		})
+	}
+
+	return result, nil
+}
+
+// parseErrorCode returns the ABCI code contained in the provided acknowledgement error, or zero if the error was not
+// written by channeltypes.NewErrorAcknowledgement
+func parseErrorCode(ackErr string) uint32 {
+	var code uint32
+	if _, err := fmt.Sscanf(ackErr, "ABCI code: %d:", &code); err != nil {
+		return 0
+	}
+
+	return code
+}
+
+// Validate performs a stateless validation of the acknowledgement result
+func (result AcknowledgementResult) Validate() error {
+	if err := host.PortIdentifierValidator(result.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(result.ChannelId); err != nil {
+		return err
+	}
+
+	if result.Sequence == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidSequence, "packet sequence cannot be zero")
+	}
+
+	if result.Success {
+		if result.ErrorCode != 0 {
+			return sdkerrors.Wrap(ErrInvalidAcknowledgement, "error code of a successful acknowledgement result must be zero")
+		}
+	} else if len(result.MsgResults) != 0 {
+		return sdkerrors.Wrap(ErrInvalidAcknowledgement, "message results of a failed acknowledgement result must be empty")
+	}
+
+	for _, msgResult := range result.MsgResults {
+		if msgResult.MsgTypeUrl == "" {
+			return sdkerrors.Wrap(ErrInvalidAcknowledgement, "message type URL cannot be empty")
+		}
+	}
+
+	return nil
+}
@@ -7,4 +7,8 @@ import (
 // ICA Controller sentinel errors
 var (
 	ErrControllerSubModuleDisabled = sdkerrors.Register(SubModuleName, 2, "controller submodule is disabled")
+	ErrOutboxItemNotFound          = sdkerrors.Register(SubModuleName, 3, "outbox item not found")
+	ErrOutboxItemInFlight          = sdkerrors.Register(SubModuleName, 4, "outbox item is in flight")
+	ErrInvalidRelativeTimeout      = sdkerrors.Register(SubModuleName, 5, "invalid relative timeout")
+	ErrInvalidAcknowledgement      = sdkerrors.Register(SubModuleName, 6, "invalid acknowledgement")
 )
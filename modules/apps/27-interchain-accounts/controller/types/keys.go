@@ -1,9 +1,66 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// SubModuleName defines the interchain accounts controller module name
 	SubModuleName = "icacontroller"
 
 	// StoreKey is the store key string for the interchain accounts controller module
 	StoreKey = SubModuleName
+
+	// RouterKey is the message route for the interchain accounts controller module
+	RouterKey = SubModuleName
+
+	// OutboxKeyPrefix defines the key prefix used to store outbox items
+	OutboxKeyPrefix = "outbox"
+
+	// OutboxSequenceKeyPrefix defines the key prefix used to store the outbox item identifier of a packet in flight
+	OutboxSequenceKeyPrefix = "outboxSequence"
+
+	// OutboxFlushKeyPrefix defines the key prefix used to store the outboxes pending resubmission
+	OutboxFlushKeyPrefix = "outboxFlush"
+
+	// AcknowledgementResultKeyPrefix defines the key prefix used to store decoded acknowledgement results
+	AcknowledgementResultKeyPrefix = "ackResult"
+
+	// NextOutboxItemIDKey defines the key used to store the next outbox item identifier
+	NextOutboxItemIDKey = "nextOutboxItemID"
 )
+
+// KeyOutboxPrefix returns the key prefix under which the outbox items of the provided port and connection are stored
+func KeyOutboxPrefix(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", OutboxKeyPrefix, portID, connectionID))
+}
+
+// KeyOutboxItem returns the key under which the outbox item with the provided identifier is stored
+func KeyOutboxItem(portID, connectionID string, id uint64) []byte {
+	return append(KeyOutboxPrefix(portID, connectionID), sdk.Uint64ToBigEndian(id)...)
+}
+
+// KeyOutboxSequence returns the key under which the outbox item identifier of the packet with the provided
+// port, channel and sequence is stored
+func KeyOutboxSequence(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", OutboxSequenceKeyPrefix, portID, channelID, sequence))
+}
+
+// KeyOutboxFlush returns the key used to mark the outbox of the provided port and connection for resubmission
+func KeyOutboxFlush(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", OutboxFlushKeyPrefix, portID, connectionID))
+}
+
+// KeyAcknowledgementResultPrefix returns the key prefix under which the acknowledgement results of the provided port
+// and channel are stored
+func KeyAcknowledgementResultPrefix(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", AcknowledgementResultKeyPrefix, portID, channelID))
+}
+
+// KeyAcknowledgementResult returns the key under which the acknowledgement result of the packet with the provided
+// port, channel and sequence is stored
+func KeyAcknowledgementResult(portID, channelID string, sequence uint64) []byte {
+	return append(KeyAcknowledgementResultPrefix(portID, channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// msg types
+const (
+	TypeMsgCancelOutboxItem = "cancelOutboxItem"
+)
+
+var _ sdk.Msg = &MsgCancelOutboxItem{}
+
+// NewMsgCancelOutboxItem creates a new instance of MsgCancelOutboxItem
+func NewMsgCancelOutboxItem(owner, connectionID string, id uint64) *MsgCancelOutboxItem {
+	return &MsgCancelOutboxItem{
+		Owner:        owner,
+		ConnectionId: connectionID,
+		Id:           id,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgCancelOutboxItem) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return sdkerrors.Wrap(err, "failed to create sdk.AccAddress from owner address")
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCancelOutboxItem) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
+
+// Route implements sdk.Msg
+func (msg MsgCancelOutboxItem) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgCancelOutboxItem) Type() string {
+	return TypeMsgCancelOutboxItem
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgCancelOutboxItem) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+const testOwnerAddress = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+
+func TestMsgCancelOutboxItemValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgCancelOutboxItem
+		expPass bool
+	}{
+		{"success", types.NewMsgCancelOutboxItem(testOwnerAddress, ibctesting.FirstConnectionID, 0), true},
+		{"invalid connection ID", types.NewMsgCancelOutboxItem(testOwnerAddress, "invalid|connection", 0), false},
+		{"empty owner", types.NewMsgCancelOutboxItem("", ibctesting.FirstConnectionID, 0), false},
+		{"invalid owner", types.NewMsgCancelOutboxItem("invalid-address", ibctesting.FirstConnectionID, 0), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgCancelOutboxItemGetSigners(t *testing.T) {
+	msg := types.NewMsgCancelOutboxItem(testOwnerAddress, ibctesting.FirstConnectionID, 0)
+
+	signers := msg.GetSigners()
+	require.Len(t, signers, 1)
+	require.Equal(t, testOwnerAddress, signers[0].String())
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// IsInFlight returns true if the packet of the outbox item has been submitted and is awaiting acknowledgement
+func (item OutboxItem) IsInFlight() bool {
+	return item.ChannelId != ""
+}
+
+// Validate performs a stateless validation of the outbox item
+func (item OutboxItem) Validate() error {
+	if err := host.PortIdentifierValidator(item.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ConnectionIdentifierValidator(item.ConnectionId); err != nil {
+		return err
+	}
+
+	if len(item.PacketData) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "packet data cannot be empty")
+	}
+
+	if item.RelativeTimeout == 0 {
+		return sdkerrors.Wrap(ErrInvalidRelativeTimeout, "relative timeout must be greater than zero")
+	}
+
+	if item.IsInFlight() {
+		if err := host.ChannelIdentifierValidator(item.ChannelId); err != nil {
+			return err
+		}
+
+		if item.Sequence == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidSequence, "sequence of an outbox item in flight cannot be zero")
+		}
+	} else if item.Sequence != 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidSequence, "sequence of a pending outbox item must be zero")
+	}
+
+	return nil
+}
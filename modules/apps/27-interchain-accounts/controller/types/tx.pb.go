@@ -0,0 +1,607 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/interchain_accounts/controller/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgCancelOutboxItem defines the request type for the CancelOutboxItem rpc. An outbox item may only be cancelled
+// by its owner while it is pending submission.
+type MsgCancelOutboxItem struct {
+	// the interchain account owner address
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// the controller connection identifier
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// the outbox item identifier
+	Id uint64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelOutboxItem) Reset()         { *m = MsgCancelOutboxItem{} }
+func (m *MsgCancelOutboxItem) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOutboxItem) ProtoMessage()    {}
+func (*MsgCancelOutboxItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{0}
+}
+func (m *MsgCancelOutboxItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelOutboxItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelOutboxItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelOutboxItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOutboxItem.Merge(m, src)
+}
+func (m *MsgCancelOutboxItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelOutboxItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOutboxItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelOutboxItem proto.InternalMessageInfo
+
+// MsgCancelOutboxItemResponse defines the response type for the CancelOutboxItem rpc
+type MsgCancelOutboxItemResponse struct {
+}
+
+func (m *MsgCancelOutboxItemResponse) Reset()         { *m = MsgCancelOutboxItemResponse{} }
+func (m *MsgCancelOutboxItemResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOutboxItemResponse) ProtoMessage()    {}
+func (*MsgCancelOutboxItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{1}
+}
+func (m *MsgCancelOutboxItemResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelOutboxItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelOutboxItemResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelOutboxItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOutboxItemResponse.Merge(m, src)
+}
+func (m *MsgCancelOutboxItemResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelOutboxItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOutboxItemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelOutboxItemResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCancelOutboxItem)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgCancelOutboxItem")
+	proto.RegisterType((*MsgCancelOutboxItemResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgCancelOutboxItemResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_accounts/controller/v1/tx.proto", fileDescriptor_7def041328c84a30)
+}
+
+var fileDescriptor_7def041328c84a30 = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xb1, 0x4a, 0x2b, 0x41,
+	0x14, 0x86, 0x77, 0x92, 0x7b, 0x2f, 0xf7, 0x0e, 0x57, 0x91, 0x35, 0xc5, 0x12, 0x71, 0x13, 0xb6,
+	0x4a, 0x93, 0x19, 0x12, 0x05, 0x21, 0x62, 0x13, 0x0b, 0x49, 0x11, 0x22, 0x5b, 0xda, 0x84, 0xdd,
+	0xd9, 0x61, 0x33, 0xb2, 0x3b, 0x67, 0xd9, 0x99, 0x8d, 0x49, 0x6f, 0x61, 0xe9, 0x23, 0xe4, 0x01,
+	0x2c, 0x7d, 0x08, 0xcb, 0x94, 0x56, 0x22, 0x49, 0x63, 0xed, 0x13, 0xc8, 0x26, 0x48, 0x14, 0xb7,
+	0x11, 0xec, 0xe6, 0x70, 0xf8, 0xce, 0xf9, 0x98, 0xf3, 0xe3, 0x63, 0xe1, 0x33, 0xea, 0x25, 0x49,
+	0x24, 0x98, 0xa7, 0x05, 0x48, 0x45, 0x85, 0xd4, 0x3c, 0x65, 0x23, 0x4f, 0xc8, 0xa1, 0xc7, 0x18,
+	0x64, 0x52, 0x2b, 0xca, 0x40, 0xea, 0x14, 0xa2, 0x88, 0xa7, 0x74, 0xdc, 0xa2, 0x7a, 0x42, 0x92,
+	0x14, 0x34, 0x98, 0x6d, 0xe1, 0x33, 0xf2, 0x11, 0x26, 0x05, 0x30, 0xd9, 0xc0, 0x64, 0xdc, 0xaa,
+	0x56, 0x42, 0x08, 0x61, 0x85, 0xd3, 0xfc, 0xb5, 0x9e, 0xe4, 0x5c, 0x23, 0xbc, 0xdb, 0x57, 0xe1,
+	0xa9, 0x27, 0x19, 0x8f, 0x06, 0x99, 0xf6, 0x61, 0xd2, 0xd3, 0x3c, 0x36, 0x2b, 0xf8, 0x37, 0x5c,
+	0x49, 0x9e, 0x5a, 0xa8, 0x8e, 0x1a, 0xff, 0xdc, 0x75, 0x61, 0x9e, 0xe0, 0x2d, 0x06, 0x52, 0x72,
+	0x96, 0x2f, 0x1d, 0x8a, 0xc0, 0x2a, 0xe5, 0xdd, 0xae, 0xf5, 0xfa, 0x54, 0xab, 0x4c, 0xbd, 0x38,
+	0xea, 0x38, 0x9f, 0xda, 0x8e, 0xfb, 0x7f, 0x53, 0xf7, 0x02, 0x73, 0x1b, 0x97, 0x44, 0x60, 0x95,
+	0xeb, 0xa8, 0xf1, 0xcb, 0x2d, 0x89, 0xa0, 0xf3, 0xf7, 0x66, 0x56, 0x33, 0x5e, 0x66, 0x35, 0xc3,
+	0xd9, 0xc7, 0x7b, 0x05, 0x16, 0x2e, 0x57, 0x09, 0x48, 0xc5, 0xdb, 0xf7, 0x08, 0x97, 0xfb, 0x2a,
+	0x34, 0xef, 0x10, 0xde, 0xf9, 0xa2, 0x7a, 0x46, 0xbe, 0xff, 0x1b, 0xa4, 0x60, 0x5b, 0x75, 0xf0,
+	0x43, 0x83, 0xde, 0xb5, 0xbb, 0x97, 0x0f, 0x0b, 0x1b, 0xcd, 0x17, 0x36, 0x7a, 0x5e, 0xd8, 0xe8,
+	0x76, 0x69, 0x1b, 0xf3, 0xa5, 0x6d, 0x3c, 0x2e, 0x6d, 0xe3, 0xe2, 0x3c, 0x14, 0x7a, 0x94, 0xf9,
+	0x84, 0x41, 0x4c, 0x19, 0xa8, 0x18, 0x14, 0x15, 0x3e, 0x6b, 0x86, 0x40, 0xc7, 0x87, 0x34, 0x86,
+	0x20, 0x8b, 0xb8, 0xca, 0xd3, 0xa1, 0x68, 0xfb, 0xa8, 0xb9, 0x91, 0x68, 0x16, 0x05, 0x43, 0x4f,
+	0x13, 0xae, 0xfc, 0x3f, 0xab, 0x7b, 0x1e, 0xbc, 0x0d, 0x00, 0xaa, 0x0c, 0xc9, 0x69, 0x58, 0x02,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CancelOutboxItem defines a rpc handler method for MsgCancelOutboxItem.
+	CancelOutboxItem(ctx context.Context, in *MsgCancelOutboxItem, opts ...grpc.CallOption) (*MsgCancelOutboxItemResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CancelOutboxItem(ctx context.Context, in *MsgCancelOutboxItem, opts ...grpc.CallOption) (*MsgCancelOutboxItemResponse, error) {
+	out := new(MsgCancelOutboxItemResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/CancelOutboxItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CancelOutboxItem defines a rpc handler method for MsgCancelOutboxItem.
+	CancelOutboxItem(context.Context, *MsgCancelOutboxItem) (*MsgCancelOutboxItemResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CancelOutboxItem(ctx context.Context, req *MsgCancelOutboxItem) (*MsgCancelOutboxItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOutboxItem not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CancelOutboxItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOutboxItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOutboxItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Msg/CancelOutboxItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOutboxItem(ctx, req.(*MsgCancelOutboxItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.interchain_accounts.controller.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CancelOutboxItem",
+			Handler:    _Msg_CancelOutboxItem_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/interchain_accounts/controller/v1/tx.proto",
+}
+
+func (m *MsgCancelOutboxItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOutboxItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOutboxItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOutboxItemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOutboxItemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOutboxItemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCancelOutboxItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelOutboxItemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCancelOutboxItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOutboxItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOutboxItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOutboxItemResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOutboxItemResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOutboxItemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
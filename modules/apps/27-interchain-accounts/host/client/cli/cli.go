@@ -20,3 +20,21 @@ func GetQueryCmd() *cobra.Command {
 
 	return queryCmd
 }
+
+// GetTxCmd returns the transaction commands for the ICA host submodule
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "host",
+		Short:                      "interchain-accounts host transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	txCmd.AddCommand(
+		NewGeneratePacketDataCmd(),
+		NewDecodePacketDataCmd(),
+		NewDecodeAckCmd(),
+	)
+
+	return txCmd
+}
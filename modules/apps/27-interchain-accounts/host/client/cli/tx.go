@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	controllertypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+const (
+	// FlagMemo defines the flag for the memo of the generated interchain account packet data
+	FlagMemo = "memo"
+	// FlagBase64 defines the flag to output the generated interchain account packet data as base64
+	FlagBase64 = "base64"
+)
+
+// NewGeneratePacketDataCmd returns the command to generate interchain account packet data from JSON encoded msgs.
+func NewGeneratePacketDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-packet-data [msgs-json]",
+		Short: "Generate interchain account packet data from JSON encoded messages",
+		Long: `Generate interchain account packet data containing a CosmosTx from a JSON encoded message or a JSON array
+of messages. The messages must be registered in the interface registry of the application. The packet data bytes,
+as sent over the channel, are written to stdout.`,
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%s tx interchain-accounts host generate-packet-data '[{
+	"@type":"/cosmos.bank.v1beta1.MsgSend",
+	"from_address":"cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz",
+	"to_address":"cosmos10h9stc5v6ntgeygf5xf945njqq5h32r53uquvw",
+	"amount": [{"denom": "stake", "amount": "1000"}]
+}]' --memo memo`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(FlagMemo)
+			if err != nil {
+				return err
+			}
+
+			outputBase64, err := cmd.Flags().GetBool(FlagBase64)
+			if err != nil {
+				return err
+			}
+
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			packetData, err := generatePacketData(cdc, []byte(args[0]), memo)
+			if err != nil {
+				return err
+			}
+
+			if outputBase64 {
+				return clientCtx.PrintString(base64.StdEncoding.EncodeToString(packetData.GetBytes()) + "\n")
+			}
+
+			return clientCtx.PrintString(string(packetData.GetBytes()) + "\n")
+		},
+	}
+
+	cmd.Flags().String(FlagMemo, "", "optional memo to be included in the interchain account packet data")
+	cmd.Flags().Bool(FlagBase64, false, "output the packet data bytes encoded as base64")
+
+	return cmd
+}
+
+// NewDecodePacketDataCmd returns the command to decode base64 encoded interchain account packet data.
+func NewDecodePacketDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-packet-data [base64]",
+		Short: "Decode base64 encoded interchain account packet data",
+		Long: `Decode base64 encoded interchain account packet data and print it, including the messages of the CosmosTx
+it contains. The messages must be registered in the interface registry of the application.`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx interchain-accounts host decode-packet-data eyJkYXRhIjoiQ3BZQkNod3ZZMjl6Ylc5ekxtSmhibXN1ZGpGaVpY...", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := base64.StdEncoding.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			out, err := decodePacketData(cdc, bz)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(out)
+		},
+	}
+
+	return cmd
+}
+
+// NewDecodeAckCmd returns the command to decode a base64 encoded interchain account packet acknowledgement.
+func NewDecodeAckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-ack [base64]",
+		Short: "Decode a base64 encoded interchain account packet acknowledgement",
+		Long: `Decode a base64 encoded interchain account packet acknowledgement and print the result, including the
+responses of the executed messages or the ABCI error code returned by the host chain.`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx interchain-accounts host decode-ack eyJyZXN1bHQiOiJFaWdLSmk5amIzTnRiM011WW1GdWF5NTJNV0psZE...", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := base64.StdEncoding.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			out, err := decodeAck(bz)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(out)
+		},
+	}
+
+	return cmd
+}
+
+// generatePacketData unmarshals the provided JSON encoded message, or JSON array of messages, and serializes the
+// messages into interchain account packet data with the provided memo
+func generatePacketData(cdc *codec.ProtoCodec, msgsJSON []byte, memo string) (icatypes.InterchainAccountPacketData, error) {
+	var rawMsgs []json.RawMessage
+	if err := json.Unmarshal(msgsJSON, &rawMsgs); err != nil {
+		// a single message is provided if the input is not a JSON array
+		rawMsgs = []json.RawMessage{msgsJSON}
+	}
+
+	msgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		if err := cdc.UnmarshalInterfaceJSON(rawMsg, &msgs[i]); err != nil {
+			return icatypes.InterchainAccountPacketData{}, err
+		}
+	}
+
+	data, err := icatypes.SerializeCosmosTx(cdc, msgs)
+	if err != nil {
+		return icatypes.InterchainAccountPacketData{}, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	if err := packetData.ValidateBasic(); err != nil {
+		return icatypes.InterchainAccountPacketData{}, err
+	}
+
+	return packetData, nil
+}
+
+// decodePacketData unmarshals the provided interchain account packet data bytes and returns the packet data as JSON,
+// with the data field replaced by the JSON encoded CosmosTx it contains
+func decodePacketData(cdc *codec.ProtoCodec, bz []byte) ([]byte, error) {
+	var packetData icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(bz, &packetData); err != nil {
+		return nil, err
+	}
+
+	var cosmosTx icatypes.CosmosTx
+	if err := cdc.Unmarshal(packetData.Data, &cosmosTx); err != nil {
+		return nil, err
+	}
+
+	cosmosTxJSON, err := cdc.MarshalJSON(&cosmosTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		Type string          `json:"type"`
+		Data json.RawMessage `json:"data"`
+		Memo string          `json:"memo"`
+	}{
+		Type: packetData.Type.String(),
+		Data: cosmosTxJSON,
+		Memo: packetData.Memo,
+	})
+}
+
+// decodeAck decodes the provided interchain account packet acknowledgement bytes and returns the acknowledgement
+// result as JSON. The responses of the executed messages are resolved using the global protobuf registry.
+func decodeAck(bz []byte) ([]byte, error) {
+	result, err := controllertypes.NewAcknowledgementResult(channeltypes.Packet{}, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	type msgResultJSON struct {
+		MsgTypeURL string          `json:"msg_type_url"`
+		Response   json.RawMessage `json:"response"`
+	}
+
+	msgResults := make([]msgResultJSON, len(result.MsgResults))
+	for i, msgResult := range result.MsgResults {
+		response, err := codec.ProtoMarshalJSON(&codectypes.Any{TypeUrl: msgResult.ResponseTypeUrl, Value: msgResult.Response}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		msgResults[i] = msgResultJSON{MsgTypeURL: msgResult.MsgTypeUrl, Response: response}
+	}
+
+	return json.Marshal(struct {
+		Success    bool            `json:"success"`
+		MsgResults []msgResultJSON `json:"msg_results"`
+		ErrorCode  uint32          `json:"error_code"`
+	}{
+		Success:    result.Success,
+		MsgResults: msgResults,
+		ErrorCode:  result.ErrorCode,
+	})
+}
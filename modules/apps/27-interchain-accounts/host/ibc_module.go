@@ -9,9 +9,15 @@ import (
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
 )
 
+var (
+	_ porttypes.IBCModule               = IBCModule{}
+	_ porttypes.RecvPacketStateRetainer = IBCModule{}
+)
+
 // IBCModule implements the ICS26 interface for interchain accounts host chains
 type IBCModule struct {
 	keeper keeper.Keeper
@@ -103,13 +109,13 @@ func (im IBCModule) OnChanCloseConfirm(
 func (im IBCModule) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
-	_ sdk.AccAddress,
+	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
 	if !im.keeper.IsHostEnabled(ctx) {
 		return channeltypes.NewErrorAcknowledgement(types.ErrHostSubModuleDisabled)
 	}
 
-	txResponse, err := im.keeper.OnRecvPacket(ctx, packet)
+	txResponse, err := im.keeper.OnRecvPacket(ctx, packet, relayer)
 	ack := channeltypes.NewResultAcknowledgement(txResponse)
 	if err != nil {
 		ack = channeltypes.NewErrorAcknowledgement(err)
@@ -122,6 +128,13 @@ func (im IBCModule) OnRecvPacket(
 	return ack
 }
 
+// RetainStateOnErrorAcknowledgement implements the RecvPacketStateRetainer interface. The gas usage of
+// the interchain account and the execution fee are committed for failed transactions, the state changes
+// of the transaction itself are reverted by the host keeper.
+func (im IBCModule) RetainStateOnErrorAcknowledgement() bool {
+	return true
+}
+
 // OnAcknowledgementPacket implements the IBCModule interface
 func (im IBCModule) OnAcknowledgementPacket(
 	ctx sdk.Context,
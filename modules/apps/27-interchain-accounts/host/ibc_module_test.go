@@ -152,7 +152,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenTry() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, 0, 0, types.DefaultGasQuotaEpochLength, nil))
 			}, false,
 		},
 		{
@@ -269,7 +269,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenConfirm() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, 0, 0, types.DefaultGasQuotaEpochLength, nil))
 			}, false,
 		},
 		{
@@ -395,7 +395,7 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, 0, 0, types.DefaultGasQuotaEpochLength, nil))
 			}, false,
 		},
 		{
@@ -464,7 +464,7 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 
 			expectedAck := channeltypes.NewResultAcknowledgement(expectedTxResponse)
 
-			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, 0, 0, types.DefaultGasQuotaEpochLength, nil)
 			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 
 			// malleate packetData for test cases
@@ -642,7 +642,7 @@ func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelClose()
 		Data: data,
 	}
 
-	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, 0, 0, types.DefaultGasQuotaEpochLength, nil)
 	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 
 	chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
@@ -693,6 +693,107 @@ func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelClose()
 	suite.Require().True(hasBalance)
 }
 
+// TestOnRecvPacketFailureIsCharged tests that the gas consumed by a failed transaction is recorded and charged
+// to the interchain account when the packet is received through core IBC, which discards the state changes of
+// the application callback for unsuccessful acknowledgements unless the application retains them.
+func (suite *InterchainAccountsTestSuite) TestOnRecvPacketFailureIsCharged() {
+	var (
+		params types.Params
+		amount sdk.Coins
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"failing batch", func() {
+				// the interchain account holds 10000 tokens
+				amount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(20000)))
+			},
+		},
+		{
+			"out of gas batch", func() {
+				params.MaxGasPerPacket = 1000
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+			suite.Require().NoError(err)
+
+			amount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+			params = types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, 0, 2000000, types.DefaultGasQuotaEpochLength, sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 3))))
+
+			tc.malleate()
+
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			suite.coordinator.CommitBlock(suite.chainB)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      amount,
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(ok)
+
+			_, err = suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, icaPacketData, ^uint64(0))
+			suite.Require().NoError(err)
+			suite.coordinator.CommitBlock(suite.chainA)
+
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			icaBalanceBefore := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom)
+
+			packet := channeltypes.NewPacket(icaPacketData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+			res, err := path.EndpointB.RecvPacketWithResult(packet)
+			suite.Require().NoError(err)
+
+			ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			var ack channeltypes.Acknowledgement
+			err = icatypes.ModuleCdc.UnmarshalJSON(ackBz, &ack)
+			suite.Require().NoError(err)
+			suite.Require().False(ack.Success())
+
+			// the msgs are reverted, however the gas consumed is recorded and the execution fee is charged
+			ctx := suite.chainB.GetContext()
+			icaBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, icaAddr, sdk.DefaultBondDenom)
+			suite.Require().True(icaBalance.IsLT(icaBalanceBefore))
+			suite.Require().True(icaBalanceBefore.Sub(icaBalance).Amount.LT(sdk.NewInt(100)))
+			suite.Require().NotZero(suite.chainB.GetSimApp().ICAHostKeeper.GetGasUsage(ctx, interchainAccountAddr))
+		})
+	}
+}
+
 // The safety of including SDK MsgResponses in the acknowledgement rests
 // on the inclusion of the abcitypes.ResponseDeliverTx.Data in the
 // abcitypes.ResposneDeliverTx hash. If the abcitypes.ResponseDeliverTx.Data
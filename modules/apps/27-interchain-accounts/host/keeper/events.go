@@ -29,3 +29,18 @@ func EmitAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, ack expo
 		),
 	)
 }
+
+// EmitExecutionFeeEvent emits an event signalling that an interchain account has been charged an execution fee
+// for the gas consumed executing its messages.
+func EmitExecutionFeeEvent(ctx sdk.Context, interchainAccountAddr string, relayer sdk.AccAddress, gasUsed uint64, fee sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeExecutionFee,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyAccountAddress, interchainAccountAddr),
+			sdk.NewAttribute(icatypes.AttributeKeyRelayer, relayer.String()),
+			sdk.NewAttribute(icatypes.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+			sdk.NewAttribute(icatypes.AttributeKeyExecutionFee, fee.String()),
+		),
+	)
+}
@@ -25,7 +25,8 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				AccountAddress: TestAccAddress.String(),
 			},
 		},
-		Port: icatypes.PortID,
+		Port:   icatypes.PortID,
+		Params: types.NewParams(false, nil, 0, 0, types.DefaultGasQuotaEpochLength, nil),
 	}
 
 	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAHostKeeper, genesisState)
@@ -38,7 +39,7 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().True(found)
 	suite.Require().Equal(TestAccAddress.String(), accountAdrr)
 
-	expParams := types.NewParams(false, nil)
+	expParams := types.NewParams(false, nil, 0, 0, types.DefaultGasQuotaEpochLength, nil)
 	params := suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
 }
@@ -27,6 +27,7 @@ type Keeper struct {
 	channelKeeper icatypes.ChannelKeeper
 	portKeeper    icatypes.PortKeeper
 	accountKeeper icatypes.AccountKeeper
+	bankKeeper    icatypes.BankKeeper
 
 	scopedKeeper capabilitykeeper.ScopedKeeper
 
@@ -37,7 +38,8 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	channelKeeper icatypes.ChannelKeeper, portKeeper icatypes.PortKeeper,
-	accountKeeper icatypes.AccountKeeper, scopedKeeper capabilitykeeper.ScopedKeeper, msgRouter *baseapp.MsgServiceRouter,
+	accountKeeper icatypes.AccountKeeper, bankKeeper icatypes.BankKeeper, scopedKeeper capabilitykeeper.ScopedKeeper,
+	msgRouter *baseapp.MsgServiceRouter,
 ) Keeper {
 	// ensure ibc interchain accounts module account is set
 	if addr := accountKeeper.GetModuleAddress(icatypes.ModuleName); addr == nil {
@@ -56,6 +58,7 @@ func NewKeeper(
 		channelKeeper: channelKeeper,
 		portKeeper:    portKeeper,
 		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
 		scopedKeeper:  scopedKeeper,
 		msgRouter:     msgRouter,
 	}
@@ -190,3 +193,38 @@ func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, connectionID, portI
 	store := ctx.KVStore(k.storeKey)
 	store.Set(icatypes.KeyOwnerAccount(portID, connectionID), []byte(address))
 }
+
+// GetGasUsage retrieves the gas consumed by the provided interchain account address within the current quota epoch.
+// Gas consumed within a previous epoch is disregarded.
+func (k Keeper) GetGasUsage(ctx sdk.Context, address string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyGasUsage(address))
+	if bz == nil {
+		return 0
+	}
+
+	var gasUsage types.GasUsage
+	k.cdc.MustUnmarshal(bz, &gasUsage)
+
+	if gasUsage.Epoch != k.getQuotaEpoch(ctx) {
+		return 0
+	}
+
+	return gasUsage.GasUsed
+}
+
+// SetGasUsage stores the gas consumed by the provided interchain account address within the current quota epoch
+func (k Keeper) SetGasUsage(ctx sdk.Context, address string, gasUsed uint64) {
+	gasUsage := types.GasUsage{
+		Epoch:   k.getQuotaEpoch(ctx),
+		GasUsed: gasUsed,
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyGasUsage(address), k.cdc.MustMarshal(&gasUsage))
+}
+
+// getQuotaEpoch returns the quota epoch of the current block height
+func (k Keeper) getQuotaEpoch(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockHeight()) / k.GetGasQuotaEpochLength(ctx)
+}
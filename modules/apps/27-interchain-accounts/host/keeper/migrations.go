@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// This migration sets the default host gas limit, gas quota and execution fee parameters,
+// preserving the existing host enabled and allow messages parameters.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := types.DefaultParams()
+	params.HostEnabled = m.keeper.IsHostEnabled(ctx)
+	params.AllowMessages = m.keeper.GetAllowMessages(ctx)
+
+	m.keeper.SetParams(ctx, params)
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/keeper"
+	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	suite.SetupTest()
+
+	allowMessages := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	params := types.NewParams(false, allowMessages, 1000, 1000, 1, nil)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	migrator := keeper.NewMigrator(suite.chainB.GetSimApp().ICAHostKeeper)
+	err := migrator.Migrate1to2(suite.chainB.GetContext())
+	suite.Require().NoError(err)
+
+	expParams := types.NewParams(false, allowMessages, types.DefaultMaxGasPerPacket, types.DefaultAccountGasQuota, types.DefaultGasQuotaEpochLength, nil)
+	suite.Require().Equal(expParams, suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext()))
+}
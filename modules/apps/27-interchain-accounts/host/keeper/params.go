@@ -21,9 +21,46 @@ func (k Keeper) GetAllowMessages(ctx sdk.Context) []string {
 	return res
 }
 
+// GetMaxGasPerPacket retrieves the maximum gas which may be consumed executing a single packet from the paramstore.
+// A value of zero indicates that no limit is enforced.
+func (k Keeper) GetMaxGasPerPacket(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyMaxGasPerPacket, &res)
+	return res
+}
+
+// GetAccountGasQuota retrieves the maximum gas which may be consumed by an interchain account within a quota epoch
+// from the paramstore. A value of zero indicates that no quota is enforced.
+func (k Keeper) GetAccountGasQuota(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyAccountGasQuota, &res)
+	return res
+}
+
+// GetGasQuotaEpochLength retrieves the length of a quota epoch in blocks from the paramstore
+func (k Keeper) GetGasQuotaEpochLength(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyGasQuotaEpochLength, &res)
+	return res
+}
+
+// GetExecutionGasPrice retrieves the price per unit of gas charged to interchain accounts from the paramstore
+func (k Keeper) GetExecutionGasPrice(ctx sdk.Context) sdk.DecCoins {
+	var res sdk.DecCoins
+	k.paramSpace.Get(ctx, types.KeyExecutionGasPrice, &res)
+	return res
+}
+
 // GetParams returns the total set of the host submodule parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx))
+	return types.NewParams(
+		k.IsHostEnabled(ctx),
+		k.GetAllowMessages(ctx),
+		k.GetMaxGasPerPacket(ctx),
+		k.GetAccountGasQuota(ctx),
+		k.GetGasQuotaEpochLength(ctx),
+		k.GetExecutionGasPrice(ctx),
+	)
 }
 
 // SetParams sets the total set of the host submodule parameters.
@@ -12,7 +12,8 @@ import (
 
 // OnRecvPacket handles a given interchain accounts packet on a destination host chain.
 // If the transaction is successfully executed, the transaction response bytes will be returned.
-func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+// Any execution fee charged to the interchain account is paid to the provided relayer address.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ([]byte, error) {
 	var data icatypes.InterchainAccountPacketData
 
 	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
@@ -27,7 +28,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 			return nil, err
 		}
 
-		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, relayer, msgs)
 		if err != nil {
 			return nil, err
 		}
@@ -42,7 +43,11 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 // If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
 // into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
 // execution of the transaction is atomic, all state changes are reverted if a single message fails.
-func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
+// The gas consumed by the messages is bounded by the max gas per packet and the remaining gas quota of the
+// interchain account. If an execution gas price is set, the interchain account is charged for the gas consumed
+// and the execution fee is paid to the relayer. The gas consumed is recorded and charged whether or not the
+// transaction succeeds.
+func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, relayer sdk.AccAddress, msgs []sdk.Msg) ([]byte, error) {
 	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
 	if !found {
 		return nil, channeltypes.ErrChannelNotFound
@@ -52,19 +57,125 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 		return nil, err
 	}
 
-	txMsgData := &sdk.TxMsgData{
-		Data: make([]*sdk.MsgData, len(msgs)),
+	// the interchain account address is guaranteed to exist as it has been retrieved during authentication
+	interchainAccountAddr, _ := k.GetInterchainAccountAddress(ctx, channel.ConnectionHops[0], sourcePort)
+
+	gasLimit, gasLimitErr, err := k.getExecutionGasLimit(ctx, interchainAccountAddr)
+	if err != nil {
+		return nil, err
 	}
 
 	// CacheContext returns a new context with the multi-store branched into a cached storage object
-	// writeCache is called only if all msgs succeed, performing state transitions atomically
+	// writeCache is called only if all msgs succeed and the execution fee is paid, performing state transitions atomically
 	cacheCtx, writeCache := ctx.CacheContext()
+	txMsgData, gasUsed, err := k.executeMsgsWithGasLimit(cacheCtx, msgs, gasLimit, gasLimitErr)
+	if err == nil {
+		err = k.chargeExecutionFee(cacheCtx, interchainAccountAddr, relayer, gasUsed)
+	}
+
+	if err == nil {
+		// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		writeCache()
+	} else {
+		// the state changes of the failed transaction are discarded, however the interchain account is still
+		// charged for the gas consumed by the execution
+		k.chargeFailedExecutionFee(ctx, interchainAccountAddr, relayer, gasUsed)
+	}
+
+	if k.GetAccountGasQuota(ctx) != 0 {
+		k.SetGasUsage(ctx, interchainAccountAddr, k.GetGasUsage(ctx, interchainAccountAddr)+gasUsed)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	txResponse, err := proto.Marshal(txMsgData)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal tx data")
+	}
+
+	return txResponse, nil
+}
+
+// getExecutionGasLimit returns the gas limit applicable to the execution of a packet for the provided interchain
+// account along with the error to be returned if the limit is exceeded. A gas limit of zero indicates that the
+// execution is unlimited. An error is returned if the gas quota of the interchain account is exhausted.
+func (k Keeper) getExecutionGasLimit(ctx sdk.Context, interchainAccountAddr string) (uint64, *sdkerrors.Error, error) {
+	gasLimit, gasLimitErr := k.GetMaxGasPerPacket(ctx), sdkerrors.ErrOutOfGas
+
+	gasQuota := k.GetAccountGasQuota(ctx)
+	if gasQuota == 0 {
+		return gasLimit, gasLimitErr, nil
+	}
+
+	gasUsed := k.GetGasUsage(ctx, interchainAccountAddr)
+	if gasUsed >= gasQuota {
+		return 0, nil, sdkerrors.Wrapf(types.ErrGasQuotaExceeded, "interchain account %s has consumed %d of %d gas", interchainAccountAddr, gasUsed, gasQuota)
+	}
+
+	if remaining := gasQuota - gasUsed; gasLimit == 0 || remaining < gasLimit {
+		gasLimit, gasLimitErr = remaining, types.ErrGasQuotaExceeded
+	}
+
+	return gasLimit, gasLimitErr, nil
+}
+
+// executeMsgsWithGasLimit validates and executes the provided msgs, returning the tx msg data and the gas consumed.
+// If the gas limit is exceeded the provided gas limit error is returned. The gas limit is only enforced
+// when it is lower than the gas remaining in the context, otherwise the context gas meter runs out of gas first,
+// aborting the transaction as a whole. This ensures the result of the execution does not depend upon the gas
+// provided by the relayer.
+func (k Keeper) executeMsgsWithGasLimit(ctx sdk.Context, msgs []sdk.Msg, gasLimit uint64, gasLimitErr *sdkerrors.Error) (*sdk.TxMsgData, uint64, error) {
+	parentGasMeter := ctx.GasMeter()
+
+	parentGasLimited := parentGasMeter.Limit() != 0 && parentGasMeter.Limit()-parentGasMeter.GasConsumedToLimit() < gasLimit
+	if gasLimit == 0 || parentGasLimited {
+		gasBefore := parentGasMeter.GasConsumed()
+		txMsgData, err := k.executeMsgs(ctx, msgs)
+
+		return txMsgData, parentGasMeter.GasConsumed() - gasBefore, err
+	}
+
+	gasMeter := sdk.NewGasMeter(gasLimit)
+	txMsgData, err := k.executeMsgsWithGasMeter(ctx.WithGasMeter(gasMeter), msgs, gasLimitErr)
+
+	gasUsed := gasMeter.GasConsumedToLimit()
+	parentGasMeter.ConsumeGas(gasUsed, "interchain account execution")
+
+	return txMsgData, gasUsed, err
+}
+
+// executeMsgsWithGasMeter executes the provided msgs, recovering from any out of gas panic raised by the
+// context gas meter and returning the provided gas limit error in its place.
+func (k Keeper) executeMsgsWithGasMeter(ctx sdk.Context, msgs []sdk.Msg, gasLimitErr *sdkerrors.Error) (txMsgData *sdk.TxMsgData, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			txMsgData, err = nil, sdkerrors.Wrapf(gasLimitErr, "out of gas in location: %s; gas limit: %d", outOfGas.Descriptor, ctx.GasMeter().Limit())
+		}
+	}()
+
+	return k.executeMsgs(ctx, msgs)
+}
+
+// executeMsgs validates and executes the provided msgs, returning the tx msg data containing the msg responses
+func (k Keeper) executeMsgs(ctx sdk.Context, msgs []sdk.Msg) (*sdk.TxMsgData, error) {
+	txMsgData := &sdk.TxMsgData{
+		Data: make([]*sdk.MsgData, len(msgs)),
+	}
+
 	for i, msg := range msgs {
 		if err := msg.ValidateBasic(); err != nil {
 			return nil, err
 		}
 
-		msgResponse, err := k.executeMsg(cacheCtx, msg)
+		msgResponse, err := k.executeMsg(ctx, msg)
 		if err != nil {
 			return nil, err
 		}
@@ -73,19 +184,55 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 			MsgType: sdk.MsgTypeURL(msg),
 			Data:    msgResponse,
 		}
+	}
+
+	return txMsgData, nil
+}
 
+// chargeExecutionFee charges the interchain account for the gas consumed at the execution gas price and pays the
+// execution fee to the relayer. No fee is charged if the execution gas price is not set.
+func (k Keeper) chargeExecutionFee(ctx sdk.Context, interchainAccountAddr string, relayer sdk.AccAddress, gasUsed uint64) error {
+	gasPrice := k.GetExecutionGasPrice(ctx)
+	if gasPrice.IsZero() {
+		return nil
 	}
 
-	// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
-	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
-	writeCache()
+	// the execution fee is the gas price multiplied by the gas consumed, rounded up
+	gasUsedDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed))
+	fee := sdk.NewCoins()
+	for _, price := range gasPrice {
+		fee = fee.Add(sdk.NewCoin(price.Denom, price.Amount.Mul(gasUsedDec).Ceil().RoundInt()))
+	}
 
-	txResponse, err := proto.Marshal(txMsgData)
+	if fee.IsZero() {
+		return nil
+	}
+
+	accAddress, err := sdk.AccAddressFromBech32(interchainAccountAddr)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to marshal tx data")
+		return err
 	}
 
-	return txResponse, nil
+	if err := k.bankKeeper.SendCoins(ctx, accAddress, relayer, fee); err != nil {
+		return sdkerrors.Wrapf(err, "failed to charge execution fee of %s to interchain account %s", fee, interchainAccountAddr)
+	}
+
+	EmitExecutionFeeEvent(ctx, interchainAccountAddr, relayer, gasUsed, fee)
+
+	return nil
+}
+
+// chargeFailedExecutionFee charges the execution fee for the gas consumed by a failed transaction. The fee is
+// charged atomically and is not charged if the interchain account cannot pay it.
+func (k Keeper) chargeFailedExecutionFee(ctx sdk.Context, interchainAccountAddr string, relayer sdk.AccAddress, gasUsed uint64) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.chargeExecutionFee(cacheCtx, interchainAccountAddr, relayer, gasUsed); err != nil {
+		k.Logger(ctx).Info("failed to charge execution fee of failed transaction", "interchain-account", interchainAccountAddr, "error", err.Error())
+		return
+	}
+
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeCache()
 }
 
 // authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
@@ -5,6 +5,7 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -63,7 +64,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{"*"})
+				params := types.NewParams(true, []string{"*"}, 0, 0, types.DefaultGasQuotaEpochLength, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -90,7 +91,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, 0, 0, types.DefaultGasQuotaEpochLength, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -118,7 +119,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, 0, 0, types.DefaultGasQuotaEpochLength, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -152,7 +153,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate), sdk.MsgTypeURL(msgUndelegate)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate), sdk.MsgTypeURL(msgUndelegate)}, 0, 0, types.DefaultGasQuotaEpochLength, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -187,7 +188,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, 0, 0, types.DefaultGasQuotaEpochLength, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -226,7 +227,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, 0, 0, types.DefaultGasQuotaEpochLength, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -252,7 +253,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, 0, 0, types.DefaultGasQuotaEpochLength, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -278,7 +279,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, 0, 0, types.DefaultGasQuotaEpochLength, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -317,7 +318,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, 0, 0, types.DefaultGasQuotaEpochLength, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -415,7 +416,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, 0, 0, types.DefaultGasQuotaEpochLength, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			false,
@@ -463,7 +464,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 				0,
 			)
 
-			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, suite.chainB.SenderAccount.GetAddress())
 
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -476,6 +477,210 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvPacketGasLimits() {
+	var (
+		params  types.Params
+		relayer sdk.AccAddress
+		icaAddr sdk.AccAddress
+		msg     *banktypes.MsgSend
+	)
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		expErr     error
+		expCharged bool // the gas consumed is recorded and charged
+	}{
+		{
+			"success: no gas limits or execution fee", func() {}, nil, true,
+		},
+		{
+			"success: execution within max gas per packet and account gas quota",
+			func() {
+				params.MaxGasPerPacket = 1000000
+				params.AccountGasQuota = 2000000
+			},
+			nil,
+			true,
+		},
+		{
+			"success: execution fee is charged",
+			func() {
+				params.ExecutionGasPrice = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 3)))
+			},
+			nil,
+			true,
+		},
+		{
+			"max gas per packet exceeded",
+			func() {
+				params.MaxGasPerPacket = 1000
+			},
+			sdkerrors.ErrOutOfGas,
+			true,
+		},
+		{
+			"failure: max gas per packet exceeded, gas usage is recorded and execution fee is charged",
+			func() {
+				params.MaxGasPerPacket = 1000
+				params.AccountGasQuota = 2000000
+				params.ExecutionGasPrice = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 3)))
+			},
+			sdkerrors.ErrOutOfGas,
+			true,
+		},
+		{
+			"failure: failing batch, gas usage is recorded and execution fee is charged",
+			func() {
+				params.AccountGasQuota = 2000000
+				params.ExecutionGasPrice = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 3)))
+
+				// the interchain account holds 10000 tokens
+				msg.Amount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(20000)))
+			},
+			sdkerrors.ErrInsufficientFunds,
+			true,
+		},
+		{
+			"account gas quota exceeded during execution",
+			func() {
+				params.AccountGasQuota = 1000
+			},
+			types.ErrGasQuotaExceeded,
+			true,
+		},
+		{
+			"account gas quota exhausted",
+			func() {
+				params.AccountGasQuota = 1000000
+				suite.chainB.GetSimApp().ICAHostKeeper.SetGasUsage(suite.chainB.GetContext(), icaAddr.String(), params.AccountGasQuota)
+			},
+			types.ErrGasQuotaExceeded,
+			false,
+		},
+		{
+			"insufficient funds for execution fee",
+			func() {
+				params.ExecutionGasPrice = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(1000)))
+			},
+			sdkerrors.ErrInsufficientFunds,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			icaAddr, err = sdk.AccAddressFromBech32(interchainAccountAddr)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			relayer = suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+
+			msg = &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			params = types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, 0, 0, types.DefaultGasQuotaEpochLength, nil)
+
+			tc.malleate() // malleate mutates test data
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext()
+			relayerBalanceBefore := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, relayer, sdk.DefaultBondDenom)
+			icaBalanceBefore := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, icaAddr, sdk.DefaultBondDenom)
+			gasUsageBefore := suite.chainB.GetSimApp().ICAHostKeeper.GetGasUsage(ctx, icaAddr.String())
+			gasBefore := ctx.GasMeter().GasConsumed()
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet, relayer)
+
+			relayerBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, relayer, sdk.DefaultBondDenom)
+			icaBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, icaAddr, sdk.DefaultBondDenom)
+			gasUsage := suite.chainB.GetSimApp().ICAHostKeeper.GetGasUsage(ctx, icaAddr.String())
+			fee := relayerBalance.Sub(relayerBalanceBefore)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+				suite.Require().Equal(icaBalanceBefore.Sub(msg.Amount[0]).Sub(fee), icaBalance)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+
+				// the msgs are reverted
+				suite.Require().Equal(icaBalanceBefore.Sub(fee), icaBalance)
+			}
+
+			if tc.expCharged && !params.ExecutionGasPrice.IsZero() {
+				suite.Require().True(fee.IsPositive())
+			} else {
+				suite.Require().True(fee.IsZero())
+			}
+
+			if tc.expCharged && params.AccountGasQuota != 0 {
+				suite.Require().Greater(gasUsage, gasUsageBefore)
+				suite.Require().LessOrEqual(gasUsage-gasUsageBefore, ctx.GasMeter().GasConsumed()-gasBefore)
+			} else {
+				suite.Require().Equal(gasUsageBefore, gasUsage)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGasUsageEpoch() {
+	suite.SetupTest()
+
+	params := types.DefaultParams()
+	params.GasQuotaEpochLength = 10
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	address := suite.chainB.SenderAccount.GetAddress().String()
+	ctx := suite.chainB.GetContext().WithBlockHeight(20)
+
+	suite.Require().Zero(suite.chainB.GetSimApp().ICAHostKeeper.GetGasUsage(ctx, address))
+
+	suite.chainB.GetSimApp().ICAHostKeeper.SetGasUsage(ctx, address, 500)
+	suite.Require().Equal(uint64(500), suite.chainB.GetSimApp().ICAHostKeeper.GetGasUsage(ctx.WithBlockHeight(29), address))
+
+	// gas usage is reset in the following epoch
+	suite.Require().Zero(suite.chainB.GetSimApp().ICAHostKeeper.GetGasUsage(ctx.WithBlockHeight(30), address))
+}
+
 func (suite *KeeperTestSuite) fundICAWallet(ctx sdk.Context, portID string, amount sdk.Coins) {
 	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(ctx, ibctesting.FirstConnectionID, portID)
 	suite.Require().True(found)
@@ -7,4 +7,5 @@ import (
 // ICA Host sentinel errors
 var (
 	ErrHostSubModuleDisabled = sdkerrors.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrGasQuotaExceeded      = sdkerrors.Register(SubModuleName, 3, "interchain account gas quota exceeded")
 )
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -30,6 +32,17 @@ type Params struct {
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+	// max_gas_per_packet defines the maximum amount of gas which may be consumed executing the messages of a single
+	// interchain account packet. A value of zero disables the limit.
+	MaxGasPerPacket uint64 `protobuf:"varint,3,opt,name=max_gas_per_packet,json=maxGasPerPacket,proto3" json:"max_gas_per_packet,omitempty" yaml:"max_gas_per_packet"`
+	// account_gas_quota defines the maximum amount of gas which may be consumed by a single interchain account within
+	// a quota epoch. A value of zero disables the quota.
+	AccountGasQuota uint64 `protobuf:"varint,4,opt,name=account_gas_quota,json=accountGasQuota,proto3" json:"account_gas_quota,omitempty" yaml:"account_gas_quota"`
+	// gas_quota_epoch_length defines the length of a quota epoch in blocks.
+	GasQuotaEpochLength uint64 `protobuf:"varint,5,opt,name=gas_quota_epoch_length,json=gasQuotaEpochLength,proto3" json:"gas_quota_epoch_length,omitempty" yaml:"gas_quota_epoch_length"`
+	// execution_gas_price defines the price per unit of gas charged to an interchain account for the execution of its
+	// messages. The execution fee is paid to the relayer of the packet. An empty price disables the execution fee.
+	ExecutionGasPrice github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=execution_gas_price,json=executionGasPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"execution_gas_price" yaml:"execution_gas_price"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,8 +92,92 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetMaxGasPerPacket() uint64 {
+	if m != nil {
+		return m.MaxGasPerPacket
+	}
+	return 0
+}
+
+func (m *Params) GetAccountGasQuota() uint64 {
+	if m != nil {
+		return m.AccountGasQuota
+	}
+	return 0
+}
+
+func (m *Params) GetGasQuotaEpochLength() uint64 {
+	if m != nil {
+		return m.GasQuotaEpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetExecutionGasPrice() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.ExecutionGasPrice
+	}
+	return nil
+}
+
+// GasUsage tracks the gas consumed by an interchain account within a quota epoch.
+type GasUsage struct {
+	// epoch is the quota epoch in which the gas was consumed.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// gas_used is the total gas consumed within the epoch.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used"`
+}
+
+func (m *GasUsage) Reset()         { *m = GasUsage{} }
+func (m *GasUsage) String() string { return proto.CompactTextString(m) }
+func (*GasUsage) ProtoMessage()    {}
+func (*GasUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *GasUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasUsage.Merge(m, src)
+}
+func (m *GasUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasUsage proto.InternalMessageInfo
+
+func (m *GasUsage) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *GasUsage) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*GasUsage)(nil), "ibc.applications.interchain_accounts.host.v1.GasUsage")
 }
 
 func init() {
@@ -88,26 +185,42 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcf, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0xcf, 0xc8, 0x2f, 0x2e, 0xd1, 0x2f, 0x33, 0x04, 0xd3, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x3a, 0x99, 0x49, 0xc9, 0x7a, 0xc8, 0x1a, 0xf5, 0xb0, 0x68, 0xd4, 0x03, 0x6b, 0x28, 0x33,
-	0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x6b, 0xd4, 0x07, 0xb1, 0x20, 0x66, 0x28, 0xb5, 0x31,
-	0x72, 0xb1, 0x05, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0x59, 0x71, 0xf1, 0x80, 0xd4, 0xc6, 0xa7,
-	0xe6, 0x25, 0x26, 0xe5, 0xa4, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x38, 0x89, 0x7f, 0xba,
-	0x27, 0x2f, 0x5c, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0x2c, 0xab, 0x14, 0xc4, 0x0d, 0xe2, 0xba,
-	0x42, 0x78, 0x42, 0x0e, 0x5c, 0x7c, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xf1, 0xb9, 0xa9, 0xc5, 0xc5,
-	0x89, 0xe9, 0xa9, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x92, 0x9f, 0xee, 0xc9, 0x8b,
-	0x42, 0x74, 0xa3, 0xca, 0x2b, 0x05, 0xf1, 0x82, 0x05, 0x7c, 0xa1, 0x7c, 0xa7, 0x94, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xf2, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x4c, 0x4a, 0xd6, 0x4d,
-	0xcf, 0xd7, 0x2f, 0x33, 0xd1, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x06, 0x85, 0x5f, 0xb1,
-	0xbe, 0x91, 0xb9, 0x2e, 0x22, 0x04, 0x74, 0x51, 0x83, 0xae, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0xec, 0x6b, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd5, 0xb2, 0xee, 0x31, 0x74, 0x01,
-	0x00, 0x00,
+	// 550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0xad, 0x1b, 0x25, 0x03, 0xaa, 0xa5, 0x03, 0xb2, 0x6a, 0x4b, 0x4a, 0x4e, 0x95,
+	0xa0, 0xb1, 0x3a, 0x90, 0x26, 0xed, 0x84, 0x0a, 0x53, 0xd1, 0x04, 0x52, 0x17, 0x09, 0x0e, 0x5c,
+	0x22, 0xc7, 0xb1, 0x52, 0x6b, 0x49, 0x1c, 0x62, 0xa7, 0x74, 0xdf, 0x82, 0x13, 0x57, 0xee, 0x7c,
+	0x92, 0x1d, 0x77, 0xe4, 0x14, 0x50, 0xfb, 0x0d, 0xf2, 0x09, 0x90, 0xed, 0xb0, 0x17, 0xd6, 0x53,
+	0xf2, 0xfc, 0x9f, 0xe7, 0xf9, 0xd9, 0x7e, 0x5e, 0xf4, 0x43, 0x12, 0x20, 0x00, 0xb3, 0x2c, 0x26,
+	0x08, 0x72, 0x42, 0x53, 0x06, 0x48, 0xca, 0x71, 0x8e, 0xa6, 0x90, 0xa4, 0x3e, 0x44, 0x88, 0x16,
+	0x29, 0x67, 0x60, 0x4a, 0x19, 0x07, 0xb3, 0xa1, 0xfc, 0xba, 0x59, 0x4e, 0x39, 0x35, 0x5e, 0x90,
+	0x00, 0xb9, 0x37, 0x13, 0xdd, 0x15, 0x89, 0xae, 0x4c, 0x98, 0x0d, 0xbb, 0x3b, 0x11, 0x8d, 0xa8,
+	0x4c, 0x04, 0xe2, 0x4f, 0x31, 0xba, 0x16, 0xa2, 0x2c, 0xa1, 0x0c, 0x04, 0x90, 0x61, 0x30, 0x1b,
+	0x06, 0x98, 0xc3, 0x21, 0x40, 0x94, 0xa4, 0xca, 0xef, 0x7c, 0x6f, 0xea, 0x9b, 0x13, 0x98, 0xc3,
+	0x84, 0x19, 0x47, 0xfa, 0x03, 0xc1, 0xf2, 0x71, 0x0a, 0x83, 0x18, 0x87, 0xa6, 0xd6, 0xd3, 0xfa,
+	0xad, 0xd1, 0xd3, 0xaa, 0xb4, 0x3b, 0xe7, 0x30, 0x89, 0x8f, 0x9c, 0x9b, 0x5e, 0xc7, 0xdb, 0x12,
+	0xe6, 0xb1, 0xb2, 0x8c, 0xd7, 0xfa, 0x23, 0x18, 0xc7, 0xf4, 0xab, 0x9f, 0x60, 0xc6, 0x60, 0x84,
+	0x99, 0xb9, 0xd6, 0x5b, 0xef, 0xdf, 0x1f, 0xed, 0x56, 0xa5, 0xfd, 0x58, 0x65, 0xdf, 0xf6, 0x3b,
+	0xde, 0x43, 0x29, 0x7c, 0xa8, 0x6d, 0xe3, 0x44, 0x37, 0x12, 0x38, 0xf7, 0x23, 0xc8, 0xfc, 0x0c,
+	0xe7, 0x7e, 0x06, 0xd1, 0x19, 0xe6, 0xe6, 0x7a, 0x4f, 0xeb, 0x37, 0x47, 0xfb, 0x55, 0x69, 0xef,
+	0x2a, 0xca, 0xdd, 0x18, 0xc7, 0x6b, 0x27, 0x70, 0x3e, 0x86, 0x6c, 0x82, 0xf3, 0x89, 0x54, 0x8c,
+	0x77, 0xfa, 0x76, 0x5d, 0x1e, 0x19, 0xfb, 0xa5, 0xa0, 0x1c, 0x9a, 0x4d, 0x89, 0xda, 0xab, 0x4a,
+	0xdb, 0xac, 0x2f, 0xf4, 0x7f, 0x88, 0xe3, 0xb5, 0x6b, 0x6d, 0x0c, 0xd9, 0xa9, 0x50, 0x8c, 0x4f,
+	0xfa, 0x93, 0x2b, 0xb7, 0x8f, 0x33, 0x8a, 0xa6, 0x7e, 0x8c, 0xd3, 0x88, 0x4f, 0xcd, 0x0d, 0x89,
+	0x7b, 0x56, 0x95, 0xf6, 0xbe, 0xc2, 0xad, 0x8e, 0x73, 0xbc, 0x4e, 0x54, 0xc3, 0x8e, 0x85, 0xfc,
+	0x5e, 0xaa, 0xc6, 0x0f, 0x4d, 0xef, 0xe0, 0x39, 0x46, 0x85, 0xe8, 0xab, 0x7a, 0x50, 0x4e, 0x10,
+	0x36, 0x37, 0x7b, 0xeb, 0xfd, 0xad, 0x83, 0x3d, 0x57, 0x75, 0xcd, 0x15, 0x5d, 0x73, 0xeb, 0xae,
+	0xb9, 0x6f, 0x31, 0x7a, 0x43, 0x49, 0x3a, 0x3a, 0xbd, 0x28, 0xed, 0x46, 0x55, 0xda, 0x5d, 0x75,
+	0xee, 0x0a, 0x8c, 0xf3, 0xf3, 0xb7, 0xfd, 0x3c, 0x22, 0x7c, 0x5a, 0x04, 0x2e, 0xa2, 0x09, 0xa8,
+	0x67, 0x40, 0x7d, 0x06, 0x2c, 0x3c, 0x03, 0xfc, 0x3c, 0xc3, 0xec, 0x1f, 0x91, 0x79, 0xdb, 0x57,
+	0x10, 0x51, 0x47, 0x89, 0x98, 0xe8, 0xad, 0x31, 0x64, 0x1f, 0x45, 0x73, 0x8c, 0x1d, 0x7d, 0x43,
+	0xbe, 0x49, 0x8e, 0x44, 0xd3, 0x53, 0x86, 0xe1, 0xea, 0x2d, 0x71, 0x62, 0xc1, 0x70, 0x68, 0xae,
+	0xc9, 0x6a, 0x74, 0xaa, 0xd2, 0x6e, 0x5f, 0x57, 0x43, 0x78, 0x1c, 0xef, 0x5e, 0x24, 0x30, 0x38,
+	0x1c, 0x85, 0x17, 0x0b, 0x4b, 0xbb, 0x5c, 0x58, 0xda, 0x9f, 0x85, 0xa5, 0x7d, 0x5b, 0x5a, 0x8d,
+	0xcb, 0xa5, 0xd5, 0xf8, 0xb5, 0xb4, 0x1a, 0x9f, 0x4f, 0xee, 0xde, 0x95, 0x04, 0x68, 0x10, 0x51,
+	0x30, 0x7b, 0x05, 0x12, 0x1a, 0x16, 0x31, 0x66, 0x62, 0x83, 0x18, 0x38, 0x38, 0x1c, 0x5c, 0xef,
+	0xc0, 0xe0, 0xf6, 0xf2, 0xc8, 0x37, 0x05, 0x9b, 0x72, 0xae, 0x5f, 0xfe, 0x1d, 0x00, 0x41, 0x12,
+	0xdc, 0xea, 0x76, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -130,6 +243,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExecutionGasPrice) > 0 {
+		for iNdEx := len(m.ExecutionGasPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionGasPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.GasQuotaEpochLength != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.GasQuotaEpochLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AccountGasQuota != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.AccountGasQuota))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxGasPerPacket != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxGasPerPacket))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -152,6 +294,39 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHost(v)
 	base := offset
@@ -178,6 +353,36 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovHost(uint64(l))
 		}
 	}
+	if m.MaxGasPerPacket != 0 {
+		n += 1 + sovHost(uint64(m.MaxGasPerPacket))
+	}
+	if m.AccountGasQuota != 0 {
+		n += 1 + sovHost(uint64(m.AccountGasQuota))
+	}
+	if m.GasQuotaEpochLength != 0 {
+		n += 1 + sovHost(uint64(m.GasQuotaEpochLength))
+	}
+	if len(m.ExecutionGasPrice) > 0 {
+		for _, e := range m.ExecutionGasPrice {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovHost(uint64(m.Epoch))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovHost(uint64(m.GasUsed))
+	}
 	return n
 }
 
@@ -268,6 +473,185 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerPacket", wireType)
+			}
+			m.MaxGasPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerPacket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountGasQuota", wireType)
+			}
+			m.AccountGasQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountGasQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasQuotaEpochLength", wireType)
+			}
+			m.GasQuotaEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasQuotaEpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionGasPrice = append(m.ExecutionGasPrice, types.DecCoin{})
+			if err := m.ExecutionGasPrice[len(m.ExecutionGasPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
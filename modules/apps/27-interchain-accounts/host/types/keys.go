@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,8 +12,16 @@ const (
 
 	// StoreKey is the store key string for the interchain accounts host module
 	StoreKey = SubModuleName
+
+	// GasUsageKeyPrefix defines the key prefix used to store the gas consumed by interchain accounts within a quota epoch
+	GasUsageKeyPrefix = "gasUsage"
 )
 
+// KeyGasUsage creates and returns a new key used for the gas usage of the provided interchain account address
+func KeyGasUsage(address string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", GasUsageKeyPrefix, address))
+}
+
 // ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
 func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
 	// check that wildcard * option for allowing all message types is the only string in the array, if so, return true
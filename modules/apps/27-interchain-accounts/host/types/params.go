@@ -4,12 +4,19 @@ import (
 	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 const (
 	// DefaultHostEnabled is the default value for the host param (set to true)
 	DefaultHostEnabled = true
+	// DefaultMaxGasPerPacket is the default value for the max gas per packet param (set to unlimited)
+	DefaultMaxGasPerPacket = 0
+	// DefaultAccountGasQuota is the default value for the account gas quota param (set to unlimited)
+	DefaultAccountGasQuota = 0
+	// DefaultGasQuotaEpochLength is the default value for the gas quota epoch length param (set to 14400 blocks)
+	DefaultGasQuotaEpochLength = 14400
 )
 
 var (
@@ -17,6 +24,14 @@ var (
 	KeyHostEnabled = []byte("HostEnabled")
 	// KeyAllowMessages is the store key for the AllowMessages Params
 	KeyAllowMessages = []byte("AllowMessages")
+	// KeyMaxGasPerPacket is the store key for the MaxGasPerPacket Params
+	KeyMaxGasPerPacket = []byte("MaxGasPerPacket")
+	// KeyAccountGasQuota is the store key for the AccountGasQuota Params
+	KeyAccountGasQuota = []byte("AccountGasQuota")
+	// KeyGasQuotaEpochLength is the store key for the GasQuotaEpochLength Params
+	KeyGasQuotaEpochLength = []byte("GasQuotaEpochLength")
+	// KeyExecutionGasPrice is the store key for the ExecutionGasPrice Params
+	KeyExecutionGasPrice = []byte("ExecutionGasPrice")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -25,16 +40,20 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the host submodule
-func NewParams(enableHost bool, allowMsgs []string) Params {
+func NewParams(enableHost bool, allowMsgs []string, maxGasPerPacket, accountGasQuota, gasQuotaEpochLength uint64, executionGasPrice sdk.DecCoins) Params {
 	return Params{
-		HostEnabled:   enableHost,
-		AllowMessages: allowMsgs,
+		HostEnabled:         enableHost,
+		AllowMessages:       allowMsgs,
+		MaxGasPerPacket:     maxGasPerPacket,
+		AccountGasQuota:     accountGasQuota,
+		GasQuotaEpochLength: gasQuotaEpochLength,
+		ExecutionGasPrice:   executionGasPrice,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the host submodule
 func DefaultParams() Params {
-	return NewParams(DefaultHostEnabled, nil)
+	return NewParams(DefaultHostEnabled, nil, DefaultMaxGasPerPacket, DefaultAccountGasQuota, DefaultGasQuotaEpochLength, nil)
 }
 
 // Validate validates all host submodule parameters
@@ -47,6 +66,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateGasAmount(p.MaxGasPerPacket); err != nil {
+		return err
+	}
+
+	if err := validateGasAmount(p.AccountGasQuota); err != nil {
+		return err
+	}
+
+	if err := validateEpochLength(p.GasQuotaEpochLength); err != nil {
+		return err
+	}
+
+	if err := validateGasPrice(p.ExecutionGasPrice); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -55,6 +90,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyHostEnabled, p.HostEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyAllowMessages, p.AllowMessages, validateAllowlist),
+		paramtypes.NewParamSetPair(KeyMaxGasPerPacket, p.MaxGasPerPacket, validateGasAmount),
+		paramtypes.NewParamSetPair(KeyAccountGasQuota, p.AccountGasQuota, validateGasAmount),
+		paramtypes.NewParamSetPair(KeyGasQuotaEpochLength, p.GasQuotaEpochLength, validateEpochLength),
+		paramtypes.NewParamSetPair(KeyExecutionGasPrice, p.ExecutionGasPrice, validateGasPrice),
 	}
 }
 
@@ -81,3 +120,38 @@ func validateAllowlist(i interface{}) error {
 
 	return nil
 }
+
+func validateGasAmount(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateEpochLength(i interface{}) error {
+	epochLength, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if epochLength == 0 {
+		return fmt.Errorf("gas quota epoch length must be greater than zero")
+	}
+
+	return nil
+}
+
+func validateGasPrice(i interface{}) error {
+	gasPrice, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := gasPrice.Validate(); err != nil {
+		return fmt.Errorf("invalid execution gas price: %w", err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
@@ -10,5 +11,9 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
-	require.NoError(t, types.NewParams(false, []string{}).Validate())
+	require.NoError(t, types.NewParams(false, []string{}, 0, 0, 1, nil).Validate())
+	require.NoError(t, types.NewParams(true, []string{"*"}, 200000, 1000000, 100, sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(25, 3)))).Validate())
+	require.Error(t, types.NewParams(true, []string{""}, 0, 0, types.DefaultGasQuotaEpochLength, nil).Validate())
+	require.Error(t, types.NewParams(true, []string{"*"}, 0, 0, 0, nil).Validate())
+	require.Error(t, types.NewParams(true, []string{"*"}, 0, 0, types.DefaultGasQuotaEpochLength, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(-1)}}).Validate())
 }
@@ -2,10 +2,26 @@ package types
 
 // ICS27 Interchain Accounts events
 const (
-	EventTypePacket = "ics27_packet"
+	EventTypePacket       = "ics27_packet"
+	EventTypeExecutionFee = "ics27_execution_fee"
+
+	EventTypeOutboxItemQueued       = "ics27_outbox_item_queued"
+	EventTypeOutboxItemSent         = "ics27_outbox_item_sent"
+	EventTypeOutboxItemAcknowledged = "ics27_outbox_item_acknowledged"
+	EventTypeOutboxItemRequeued     = "ics27_outbox_item_requeued"
+	EventTypeOutboxItemCancelled    = "ics27_outbox_item_cancelled"
+	EventTypeOutboxItemAckUnknown   = "ics27_outbox_item_ack_unknown"
 
 	AttributeKeyAckError            = "error"
 	AttributeKeyHostChannelID       = "host_channel_id"
 	AttributeKeyControllerChannelID = "controller_channel_id"
 	AttributeKeyAckSuccess          = "success"
+	AttributeKeyAccountAddress      = "account_address"
+	AttributeKeyRelayer             = "relayer"
+	AttributeKeyGasUsed             = "gas_used"
+	AttributeKeyExecutionFee        = "execution_fee"
+	AttributeKeyOutboxItemID        = "outbox_item_id"
+	AttributeKeyPortID              = "port_id"
+	AttributeKeyConnectionID        = "connection_id"
+	AttributeKeySequence            = "sequence"
 )
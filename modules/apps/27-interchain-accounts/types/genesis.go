@@ -1,6 +1,8 @@
 package types
 
 import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
 	controllertypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/types"
 	hosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
@@ -43,12 +45,18 @@ func DefaultControllerGenesis() ControllerGenesisState {
 }
 
 // NewControllerGenesisState creates a returns a new ControllerGenesisState instance
-func NewControllerGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, ports []string, controllerParams controllertypes.Params) ControllerGenesisState {
+func NewControllerGenesisState(
+	channels []ActiveChannel, accounts []RegisteredInterchainAccount, ports []string, controllerParams controllertypes.Params,
+	outboxItems []controllertypes.OutboxItem, nextOutboxItemID uint64, ackResults []controllertypes.AcknowledgementResult,
+) ControllerGenesisState {
 	return ControllerGenesisState{
-		ActiveChannels:     channels,
-		InterchainAccounts: accounts,
-		Ports:              ports,
-		Params:             controllerParams,
+		ActiveChannels:         channels,
+		InterchainAccounts:     accounts,
+		Ports:                  ports,
+		Params:                 controllerParams,
+		OutboxItems:            outboxItems,
+		NextOutboxItemId:       nextOutboxItemID,
+		AcknowledgementResults: ackResults,
 	}
 }
 
@@ -84,6 +92,29 @@ func (gs ControllerGenesisState) Validate() error {
 		return err
 	}
 
+	seenOutboxItemIDs := make(map[uint64]bool)
+	for _, item := range gs.OutboxItems {
+		if err := item.Validate(); err != nil {
+			return err
+		}
+
+		if item.Id >= gs.NextOutboxItemId {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "outbox item ID %d must be less than the next outbox item ID %d", item.Id, gs.NextOutboxItemId)
+		}
+
+		if seenOutboxItemIDs[item.Id] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate outbox item ID %d", item.Id)
+		}
+
+		seenOutboxItemIDs[item.Id] = true
+	}
+
+	for _, result := range gs.AcknowledgementResults {
+		if err := result.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
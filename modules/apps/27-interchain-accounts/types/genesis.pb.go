@@ -80,10 +80,13 @@ func (m *GenesisState) GetHostGenesisState() HostGenesisState {
 
 // ControllerGenesisState defines the interchain accounts controller genesis state
 type ControllerGenesisState struct {
-	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
-	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
-	Ports              []string                      `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
-	Params             types.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	ActiveChannels         []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
+	InterchainAccounts     []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
+	Ports                  []string                      `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
+	Params                 types.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	OutboxItems            []types.OutboxItem            `protobuf:"bytes,5,rep,name=outbox_items,json=outboxItems,proto3" json:"outbox_items" yaml:"outbox_items"`
+	NextOutboxItemId       uint64                        `protobuf:"varint,6,opt,name=next_outbox_item_id,json=nextOutboxItemId,proto3" json:"next_outbox_item_id,omitempty" yaml:"next_outbox_item_id"`
+	AcknowledgementResults []types.AcknowledgementResult `protobuf:"bytes,7,rep,name=acknowledgement_results,json=acknowledgementResults,proto3" json:"acknowledgement_results" yaml:"acknowledgement_results"`
 }
 
 func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{} }
@@ -147,6 +150,27 @@ func (m *ControllerGenesisState) GetParams() types.Params {
 	return types.Params{}
 }
 
+func (m *ControllerGenesisState) GetOutboxItems() []types.OutboxItem {
+	if m != nil {
+		return m.OutboxItems
+	}
+	return nil
+}
+
+func (m *ControllerGenesisState) GetNextOutboxItemId() uint64 {
+	if m != nil {
+		return m.NextOutboxItemId
+	}
+	return 0
+}
+
+func (m *ControllerGenesisState) GetAcknowledgementResults() []types.AcknowledgementResult {
+	if m != nil {
+		return m.AcknowledgementResults
+	}
+	return nil
+}
+
 // HostGenesisState defines the interchain accounts host genesis state
 type HostGenesisState struct {
 	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels" yaml:"active_channels"`
@@ -351,48 +375,56 @@ func init() {
 }
 
 var fileDescriptor_629b3ced0911516b = []byte{
-	// 643 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0x3f, 0x6f, 0x13, 0x31,
-	0x18, 0xc6, 0xe3, 0xa4, 0x14, 0xc5, 0xfd, 0x43, 0x31, 0xa5, 0x3a, 0x82, 0x74, 0x09, 0x5e, 0x1a,
-	0x09, 0xf5, 0x4e, 0x2d, 0x85, 0x8a, 0x4a, 0x08, 0xf5, 0x02, 0x82, 0x6c, 0xc8, 0x2c, 0x88, 0xe5,
-	0xe4, 0xf8, 0xac, 0xc4, 0x52, 0x72, 0x8e, 0xce, 0x6e, 0xa4, 0x4e, 0xec, 0x4c, 0x6c, 0x88, 0x15,
-	0x89, 0x0f, 0xc0, 0x37, 0x60, 0xec, 0x84, 0x3a, 0x32, 0x45, 0xa8, 0xfd, 0x06, 0xf9, 0x04, 0xc8,
-	0xbe, 0x53, 0x92, 0x1e, 0x69, 0x75, 0x13, 0x13, 0x53, 0xec, 0x7b, 0xfd, 0x3c, 0xef, 0xef, 0xf5,
-	0xeb, 0xd8, 0xf0, 0xb1, 0xe8, 0x30, 0x9f, 0x0e, 0x87, 0x7d, 0xc1, 0xa8, 0x16, 0x32, 0x56, 0xbe,
-	0x88, 0x35, 0x4f, 0x58, 0x8f, 0x8a, 0x38, 0xa4, 0x8c, 0xc9, 0xe3, 0x58, 0x2b, 0x7f, 0xb4, 0xeb,
-	0x77, 0x79, 0xcc, 0x95, 0x50, 0xde, 0x30, 0x91, 0x5a, 0xa2, 0x6d, 0xd1, 0x61, 0xde, 0xbc, 0xcc,
-	0x5b, 0x20, 0xf3, 0x46, 0xbb, 0xb5, 0xcd, 0xae, 0xec, 0x4a, 0xab, 0xf1, 0xcd, 0x28, 0x95, 0xd7,
-	0x5a, 0x85, 0xb2, 0x32, 0x19, 0xeb, 0x44, 0xf6, 0xfb, 0x3c, 0x31, 0x00, 0xb3, 0x59, 0x66, 0x72,
-	0x50, 0xc8, 0xa4, 0x27, 0x95, 0x36, 0x72, 0xf3, 0x9b, 0x0a, 0xf1, 0x8f, 0x32, 0x5c, 0x7d, 0x95,
-	0x96, 0xf3, 0x56, 0x53, 0xcd, 0xd1, 0x57, 0x00, 0x9d, 0x99, 0x7d, 0x98, 0x95, 0x1a, 0x2a, 0x13,
-	0x74, 0x40, 0x03, 0x34, 0x57, 0xf6, 0x9e, 0x7b, 0x05, 0x2b, 0xf6, 0x5a, 0x53, 0xa3, 0xf9, 0x1c,
-	0xc1, 0xf6, 0xe9, 0xb8, 0x5e, 0x9a, 0x8c, 0xeb, 0xf5, 0x13, 0x3a, 0xe8, 0x1f, 0xe2, 0xab, 0xd2,
-	0x61, 0xb2, 0xc5, 0x16, 0x1a, 0xa0, 0x8f, 0x00, 0x22, 0x53, 0x44, 0x0e, 0xaf, 0x6c, 0xf1, 0x9e,
-	0x16, 0xc6, 0x7b, 0x2d, 0x95, 0xbe, 0x04, 0xf6, 0x20, 0x03, 0xbb, 0x97, 0x82, 0xfd, 0x9d, 0x02,
-	0x93, 0x8d, 0x5e, 0x4e, 0x84, 0xbf, 0x55, 0xe0, 0xd6, 0xe2, 0x42, 0xd1, 0x07, 0x78, 0x8b, 0x32,
-	0x2d, 0x46, 0x3c, 0x64, 0x3d, 0x1a, 0xc7, 0xbc, 0xaf, 0x1c, 0xd0, 0xa8, 0x34, 0x57, 0xf6, 0x9e,
-	0x14, 0x66, 0x3c, 0xb2, 0xfa, 0x56, 0x2a, 0x0f, 0xdc, 0x0c, 0x70, 0x2b, 0x05, 0xcc, 0x99, 0x63,
-	0xb2, 0x4e, 0xe7, 0x97, 0x2b, 0xf4, 0x05, 0xc0, 0x3b, 0x0b, 0x8c, 0x9d, 0xb2, 0xa5, 0x78, 0x51,
-	0x98, 0x82, 0xf0, 0xae, 0x50, 0x9a, 0x27, 0x3c, 0x6a, 0x4f, 0x17, 0x1c, 0xa5, 0xf1, 0x00, 0x67,
-	0x4c, 0xb5, 0x94, 0x69, 0x81, 0x03, 0x26, 0x48, 0xe4, 0x65, 0x0a, 0x6d, 0xc2, 0x1b, 0x43, 0x99,
-	0x68, 0xe5, 0x54, 0x1a, 0x95, 0x66, 0x95, 0xa4, 0x13, 0xf4, 0x0e, 0x2e, 0x0f, 0x69, 0x42, 0x07,
-	0xca, 0x59, 0xb2, 0xdd, 0x3c, 0x2c, 0xc6, 0x38, 0xf7, 0x8f, 0x18, 0xed, 0x7a, 0x6f, 0xac, 0x43,
-	0xb0, 0x64, 0xc8, 0x48, 0xe6, 0x87, 0x3f, 0x57, 0xe0, 0x46, 0xbe, 0xe3, 0xff, 0x3b, 0x74, 0x5d,
-	0x87, 0x10, 0x5c, 0x32, 0x4d, 0x71, 0x2a, 0x0d, 0xd0, 0xac, 0x12, 0x3b, 0x46, 0x24, 0xd7, 0x9f,
-	0xfd, 0x62, 0x84, 0xf6, 0xca, 0xb9, 0xaa, 0x33, 0xdf, 0x01, 0x5c, 0xbb, 0xb4, 0x8b, 0xe8, 0x19,
-	0x5c, 0x63, 0x32, 0x8e, 0x39, 0x33, 0x8e, 0xa1, 0x88, 0xec, 0xcd, 0x53, 0x0d, 0x9c, 0xc9, 0xb8,
-	0xbe, 0x39, 0xbd, 0x34, 0x66, 0x61, 0x4c, 0x56, 0x67, 0xf3, 0x76, 0x84, 0x1e, 0xc2, 0x9b, 0x06,
-	0xd6, 0x08, 0xcb, 0x56, 0x88, 0x26, 0xe3, 0xfa, 0x7a, 0x2a, 0xcc, 0x02, 0x98, 0x2c, 0x9b, 0x51,
-	0x3b, 0x42, 0xfb, 0x10, 0x66, 0xed, 0x31, 0xeb, 0x6d, 0xad, 0xc1, 0xdd, 0xc9, 0xb8, 0x7e, 0x3b,
-	0x4b, 0x34, 0x8d, 0x61, 0x52, 0xcd, 0x26, 0xed, 0x08, 0xff, 0x04, 0xf0, 0xfe, 0x35, 0x7b, 0xfe,
-	0x4f, 0x2b, 0x68, 0x99, 0x43, 0x6c, 0xd3, 0x86, 0x34, 0x8a, 0x12, 0xae, 0x54, 0x56, 0x46, 0x6d,
-	0xfe, 0x20, 0x5e, 0x5a, 0x60, 0x0f, 0xa2, 0xfd, 0x72, 0x94, 0x7e, 0x08, 0xc2, 0xd3, 0x73, 0x17,
-	0x9c, 0x9d, 0xbb, 0xe0, 0xf7, 0xb9, 0x0b, 0x3e, 0x5d, 0xb8, 0xa5, 0xb3, 0x0b, 0xb7, 0xf4, 0xeb,
-	0xc2, 0x2d, 0xbd, 0x7f, 0xd9, 0x15, 0xba, 0x77, 0xdc, 0xf1, 0x98, 0x1c, 0xf8, 0x4c, 0xaa, 0x81,
-	0x54, 0xbe, 0xe8, 0xb0, 0x9d, 0xae, 0xf4, 0x47, 0xfb, 0xfe, 0x40, 0x46, 0xc7, 0x7d, 0xae, 0xcc,
-	0xe3, 0xa3, 0xfc, 0xbd, 0x83, 0x9d, 0x59, 0xf3, 0x77, 0xa6, 0xef, 0x8e, 0x3e, 0x19, 0x72, 0xd5,
-	0x59, 0xb6, 0x2f, 0xce, 0xa3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xd8, 0xa4, 0x04, 0x67,
-	0x07, 0x00, 0x00,
+	// 769 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0x3d, 0x6f, 0xdb, 0x46,
+	0x18, 0x16, 0x25, 0x59, 0x86, 0xce, 0x1f, 0x75, 0xcf, 0xae, 0xca, 0xca, 0x00, 0xa5, 0xde, 0x50,
+	0x0b, 0x28, 0x4c, 0xc2, 0xae, 0x5b, 0xa3, 0x06, 0xda, 0x42, 0x54, 0x8b, 0x56, 0x43, 0xd1, 0xe2,
+	0xba, 0x14, 0x5d, 0x08, 0xea, 0x78, 0x90, 0x88, 0x92, 0x3c, 0x81, 0x77, 0x52, 0xed, 0xa5, 0xd9,
+	0x33, 0x65, 0x0b, 0x32, 0x26, 0x63, 0xb6, 0xfc, 0x83, 0x8c, 0x9e, 0x02, 0x8f, 0x99, 0x84, 0xc0,
+	0xfe, 0x07, 0xfa, 0x05, 0xc1, 0x1d, 0x19, 0x7d, 0x85, 0x36, 0x08, 0x0f, 0x99, 0x32, 0x91, 0x77,
+	0xf7, 0x3e, 0xcf, 0xfb, 0x3c, 0x77, 0xef, 0x7b, 0x07, 0xbe, 0xf5, 0x7b, 0xc4, 0x72, 0x87, 0xc3,
+	0xc0, 0x27, 0xae, 0xf0, 0x59, 0xc4, 0x2d, 0x3f, 0x12, 0x34, 0x26, 0x03, 0xd7, 0x8f, 0x1c, 0x97,
+	0x10, 0x36, 0x8a, 0x04, 0xb7, 0xc6, 0x47, 0x56, 0x9f, 0x46, 0x94, 0xfb, 0xdc, 0x1c, 0xc6, 0x4c,
+	0x30, 0x78, 0xe0, 0xf7, 0x88, 0xb9, 0x08, 0x33, 0x33, 0x60, 0xe6, 0xf8, 0xa8, 0xbe, 0xd7, 0x67,
+	0x7d, 0xa6, 0x30, 0x96, 0xfc, 0x4b, 0xe0, 0xf5, 0x4e, 0xae, 0xac, 0x84, 0x45, 0x22, 0x66, 0x41,
+	0x40, 0x63, 0x29, 0x60, 0x3e, 0x4a, 0x49, 0x4e, 0x73, 0x91, 0x0c, 0x18, 0x17, 0x12, 0x2e, 0xbf,
+	0x09, 0x10, 0xbd, 0x2c, 0x82, 0xcd, 0x5f, 0x13, 0x3b, 0x7f, 0x09, 0x57, 0x50, 0xf8, 0x4c, 0x03,
+	0xfa, 0x9c, 0xde, 0x49, 0xad, 0x3a, 0x5c, 0x2e, 0xea, 0x5a, 0x53, 0x6b, 0x6d, 0x1c, 0xff, 0x64,
+	0xe6, 0x74, 0x6c, 0x76, 0x66, 0x44, 0x8b, 0x39, 0xec, 0x83, 0xcb, 0x49, 0xa3, 0x30, 0x9d, 0x34,
+	0x1a, 0x17, 0x6e, 0x18, 0x9c, 0xa1, 0xdb, 0xd2, 0x21, 0x5c, 0x23, 0x99, 0x04, 0xf0, 0xa1, 0x06,
+	0xa0, 0x34, 0xb1, 0x22, 0xaf, 0xa8, 0xe4, 0x7d, 0x9f, 0x5b, 0xde, 0x6f, 0x8c, 0x8b, 0x25, 0x61,
+	0x5f, 0xa6, 0xc2, 0xbe, 0x48, 0x84, 0xbd, 0x9f, 0x02, 0xe1, 0x9d, 0xc1, 0x0a, 0x08, 0x3d, 0xad,
+	0x80, 0x5a, 0xb6, 0x51, 0xf8, 0x00, 0x7c, 0xe2, 0x12, 0xe1, 0x8f, 0xa9, 0x43, 0x06, 0x6e, 0x14,
+	0xd1, 0x80, 0xeb, 0x5a, 0xb3, 0xd4, 0xda, 0x38, 0xfe, 0x2e, 0xb7, 0xc6, 0xb6, 0xc2, 0x77, 0x12,
+	0xb8, 0x6d, 0xa4, 0x02, 0x6b, 0x89, 0xc0, 0x15, 0x72, 0x84, 0xb7, 0xdd, 0xc5, 0x70, 0x0e, 0x9f,
+	0x68, 0x60, 0x37, 0x83, 0x58, 0x2f, 0x2a, 0x15, 0x3f, 0xe7, 0x56, 0x81, 0x69, 0xdf, 0xe7, 0x82,
+	0xc6, 0xd4, 0xeb, 0xce, 0x02, 0xda, 0xc9, 0xba, 0x8d, 0x52, 0x4d, 0xf5, 0x44, 0x53, 0x06, 0x03,
+	0xc2, 0xd0, 0x5f, 0x85, 0x71, 0xb8, 0x07, 0xd6, 0x86, 0x2c, 0x16, 0x5c, 0x2f, 0x35, 0x4b, 0xad,
+	0x2a, 0x4e, 0x06, 0xf0, 0x6f, 0x50, 0x19, 0xba, 0xb1, 0x1b, 0x72, 0xbd, 0xac, 0x4e, 0xf3, 0x2c,
+	0x9f, 0xc6, 0x85, 0x8e, 0x18, 0x1f, 0x99, 0x7f, 0x2a, 0x06, 0xbb, 0x2c, 0x95, 0xe1, 0x94, 0x0f,
+	0xfe, 0x0f, 0x36, 0xd9, 0x48, 0xf4, 0xd8, 0xb9, 0xe3, 0x0b, 0x1a, 0x72, 0x7d, 0x4d, 0xed, 0xc1,
+	0x8f, 0xf7, 0xe1, 0xff, 0x43, 0xf1, 0x74, 0x05, 0x0d, 0xed, 0xfd, 0xd4, 0xfd, 0x6e, 0xe2, 0x7e,
+	0x31, 0x03, 0xc2, 0x1b, 0x6c, 0x16, 0xc8, 0xe1, 0xef, 0x60, 0x37, 0xa2, 0xe7, 0xc2, 0x59, 0x08,
+	0x71, 0x7c, 0x4f, 0xaf, 0x34, 0xb5, 0x56, 0xd9, 0x36, 0xe6, 0x1b, 0x98, 0x11, 0x84, 0xf0, 0x8e,
+	0x9c, 0x9d, 0xa7, 0xed, 0x7a, 0xf0, 0xb9, 0x06, 0x3e, 0x77, 0xc9, 0xbf, 0x11, 0xfb, 0x2f, 0xa0,
+	0x5e, 0x9f, 0x86, 0x34, 0x12, 0x4e, 0x4c, 0xf9, 0x28, 0x10, 0x5c, 0x5f, 0x57, 0xd6, 0xba, 0xf7,
+	0xb1, 0xd6, 0x5e, 0xa6, 0xc4, 0x8a, 0xd1, 0xfe, 0x2a, 0x75, 0x69, 0xbc, 0xab, 0xbb, 0xcc, 0xbc,
+	0x08, 0xd7, 0xdc, 0x2c, 0x38, 0x47, 0x8f, 0x4b, 0x60, 0x67, 0xb5, 0xdb, 0x3e, 0x76, 0xc7, 0x5d,
+	0xdd, 0x01, 0x41, 0x59, 0x36, 0x84, 0x5e, 0x6a, 0x6a, 0xad, 0x2a, 0x56, 0xff, 0x10, 0xaf, 0xf4,
+	0xc6, 0x49, 0x3e, 0x85, 0xea, 0xba, 0xbf, 0xa5, 0x2b, 0xd0, 0x0b, 0x0d, 0x6c, 0x2d, 0xed, 0x22,
+	0xfc, 0x01, 0x6c, 0x11, 0x16, 0x45, 0x94, 0x48, 0x46, 0x59, 0xa1, 0xf2, 0xd6, 0xaf, 0xda, 0xfa,
+	0x74, 0xd2, 0xd8, 0x9b, 0x5d, 0xd8, 0xf3, 0x65, 0x84, 0x37, 0xe7, 0xe3, 0xae, 0x07, 0xbf, 0x06,
+	0xeb, 0x52, 0xac, 0x04, 0x16, 0x15, 0x10, 0x4e, 0x27, 0x8d, 0xed, 0x04, 0x98, 0x2e, 0x20, 0x5c,
+	0x91, 0x7f, 0x5d, 0x0f, 0x9e, 0x00, 0x90, 0x1e, 0x8f, 0x8c, 0x57, 0x5e, 0xed, 0xcf, 0xa6, 0x93,
+	0xc6, 0xa7, 0x69, 0xa2, 0xd9, 0x1a, 0xc2, 0xd5, 0x74, 0xd0, 0xf5, 0xd0, 0x2b, 0x0d, 0xec, 0xdf,
+	0xb1, 0xe7, 0x1f, 0xd4, 0x41, 0x47, 0x16, 0xb1, 0x4a, 0xeb, 0xb8, 0x9e, 0x17, 0x53, 0xce, 0x53,
+	0x1b, 0xf5, 0xc5, 0x42, 0x5c, 0x0a, 0x50, 0x85, 0xa8, 0x66, 0xda, 0xc9, 0x84, 0xed, 0x5c, 0x5e,
+	0x1b, 0xda, 0xd5, 0xb5, 0xa1, 0xbd, 0xb9, 0x36, 0xb4, 0x47, 0x37, 0x46, 0xe1, 0xea, 0xc6, 0x28,
+	0xbc, 0xbe, 0x31, 0x0a, 0xff, 0xfc, 0xd2, 0xf7, 0xc5, 0x60, 0xd4, 0x33, 0x09, 0x0b, 0x2d, 0xc2,
+	0x78, 0xc8, 0xb8, 0xe5, 0xf7, 0xc8, 0x61, 0x9f, 0x59, 0xe3, 0x13, 0x2b, 0x64, 0xde, 0x28, 0xa0,
+	0x5c, 0x3e, 0xfc, 0xdc, 0x3a, 0x3e, 0x3d, 0x9c, 0x1f, 0xfe, 0xe1, 0xec, 0xcd, 0x17, 0x17, 0x43,
+	0xca, 0x7b, 0x15, 0xf5, 0xda, 0x7f, 0xf3, 0x76, 0x00, 0x9b, 0x15, 0xe4, 0x66, 0xe3, 0x08, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -458,6 +490,39 @@ func (m *ControllerGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.AcknowledgementResults) > 0 {
+		for iNdEx := len(m.AcknowledgementResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcknowledgementResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NextOutboxItemId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextOutboxItemId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OutboxItems) > 0 {
+		for iNdEx := len(m.OutboxItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutboxItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -714,6 +779,21 @@ func (m *ControllerGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.OutboxItems) > 0 {
+		for _, e := range m.OutboxItems {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextOutboxItemId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextOutboxItemId))
+	}
+	if len(m.AcknowledgementResults) > 0 {
+		for _, e := range m.AcknowledgementResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1070,6 +1150,93 @@ func (m *ControllerGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboxItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboxItems = append(m.OutboxItems, types.OutboxItem{})
+			if err := m.OutboxItems[len(m.OutboxItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOutboxItemId", wireType)
+			}
+			m.NextOutboxItemId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextOutboxItemId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgementResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcknowledgementResults = append(m.AcknowledgementResults, types.AcknowledgementResult{})
+			if err := m.AcknowledgementResults[len(m.AcknowledgementResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
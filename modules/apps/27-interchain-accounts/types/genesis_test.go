@@ -83,7 +83,7 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil, 0, nil)
 			},
 			false,
 		},
@@ -97,7 +97,7 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil, 0, nil)
 			},
 			false,
 		},
@@ -118,7 +118,7 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams(), nil, 0, nil)
 			},
 			false,
 		},
@@ -139,7 +139,7 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams(), nil, 0, nil)
 			},
 			false,
 		},
@@ -160,7 +160,130 @@ func (suite *TypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{"invalid|port"}, controllertypes.DefaultParams())
+				genesisState = types.NewControllerGenesisState(activeChannels, registeredAccounts, []string{"invalid|port"}, controllertypes.DefaultParams(), nil, 0, nil)
+			},
+			false,
+		},
+		{
+			"success - outbox items",
+			func() {
+				outboxItems := []controllertypes.OutboxItem{
+					{
+						Id:              0,
+						PortId:          TestPortID,
+						ConnectionId:    ibctesting.FirstConnectionID,
+						PacketData:      []byte("packet data"),
+						RelativeTimeout: 1,
+					},
+					{
+						Id:              1,
+						PortId:          TestPortID,
+						ConnectionId:    ibctesting.FirstConnectionID,
+						PacketData:      []byte("packet data"),
+						RelativeTimeout: 1,
+						ChannelId:       ibctesting.FirstChannelID,
+						Sequence:        1,
+					},
+				}
+
+				genesisState = types.NewControllerGenesisState([]types.ActiveChannel{}, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), outboxItems, 2, nil)
+			},
+			true,
+		},
+		{
+			"failed to validate outbox items - invalid outbox item",
+			func() {
+				outboxItems := []controllertypes.OutboxItem{
+					{
+						Id:              0,
+						PortId:          TestPortID,
+						ConnectionId:    ibctesting.FirstConnectionID,
+						PacketData:      []byte("packet data"),
+						RelativeTimeout: 0,
+					},
+				}
+
+				genesisState = types.NewControllerGenesisState([]types.ActiveChannel{}, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), outboxItems, 1, nil)
+			},
+			false,
+		},
+		{
+			"failed to validate outbox items - ID not less than next outbox item ID",
+			func() {
+				outboxItems := []controllertypes.OutboxItem{
+					{
+						Id:              1,
+						PortId:          TestPortID,
+						ConnectionId:    ibctesting.FirstConnectionID,
+						PacketData:      []byte("packet data"),
+						RelativeTimeout: 1,
+					},
+				}
+
+				genesisState = types.NewControllerGenesisState([]types.ActiveChannel{}, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), outboxItems, 1, nil)
+			},
+			false,
+		},
+		{
+			"success - acknowledgement results",
+			func() {
+				ackResults := []controllertypes.AcknowledgementResult{
+					{
+						PortId:    TestPortID,
+						ChannelId: ibctesting.FirstChannelID,
+						Sequence:  1,
+						ErrorCode: 5,
+					},
+				}
+
+				genesisState = types.NewControllerGenesisState([]types.ActiveChannel{}, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil, 0, ackResults)
+			},
+			true,
+		},
+		{
+			"failed to validate acknowledgement results - zero sequence",
+			func() {
+				ackResults := []controllertypes.AcknowledgementResult{
+					{
+						PortId:    TestPortID,
+						ChannelId: ibctesting.FirstChannelID,
+						Success:   true,
+					},
+				}
+
+				genesisState = types.NewControllerGenesisState([]types.ActiveChannel{}, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil, 0, ackResults)
+			},
+			false,
+		},
+		{
+			"failed to validate acknowledgement results - error code of successful result",
+			func() {
+				ackResults := []controllertypes.AcknowledgementResult{
+					{
+						PortId:    TestPortID,
+						ChannelId: ibctesting.FirstChannelID,
+						Sequence:  1,
+						Success:   true,
+						ErrorCode: 5,
+					},
+				}
+
+				genesisState = types.NewControllerGenesisState([]types.ActiveChannel{}, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil, 0, ackResults)
+			},
+			false,
+		},
+		{
+			"failed to validate outbox items - duplicate ID",
+			func() {
+				item := controllertypes.OutboxItem{
+					Id:              0,
+					PortId:          TestPortID,
+					ConnectionId:    ibctesting.FirstConnectionID,
+					PacketData:      []byte("packet data"),
+					RelativeTimeout: 1,
+				}
+
+				genesisState = types.NewControllerGenesisState([]types.ActiveChannel{}, []types.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), []controllertypes.OutboxItem{item, item}, 1, nil)
 			},
 			false,
 		},
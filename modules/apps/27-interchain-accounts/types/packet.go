@@ -1,9 +1,19 @@
 package types
 
 import (
+	"encoding/json"
+	"strings"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+var (
+	_ ibcexported.PacketData         = (*InterchainAccountPacketData)(nil)
+	_ ibcexported.PacketDataProvider = (*InterchainAccountPacketData)(nil)
 )
 
 // MaxMemoCharLength defines the maximum length for the InterchainAccountPacketData memo field
@@ -32,6 +42,41 @@ func (iapd InterchainAccountPacketData) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&iapd))
 }
 
+// GetPacketSender returns the sender address of the interchain accounts packet data. It is obtained from the source port ID.
+// Under normal operations, the sender address is the owner address used to create the controller port ID.
+// An empty string is returned if the source port ID is not a controller port ID.
+//
+// NOTE:
+//   - The sender address must only be used by modules on the sending chain.
+func (iapd InterchainAccountPacketData) GetPacketSender(sourcePortID string) string {
+	if !strings.HasPrefix(sourcePortID, PortPrefix) {
+		return ""
+	}
+
+	return strings.TrimPrefix(sourcePortID, PortPrefix)
+}
+
+// GetCustomPacketData interprets the memo field of the packet data as a JSON object
+// and returns the value associated with the given key.
+// If the key is missing or the memo is not properly formatted, then nil is returned.
+func (iapd InterchainAccountPacketData) GetCustomPacketData(key string) interface{} {
+	if len(iapd.Memo) == 0 {
+		return nil
+	}
+
+	jsonObject := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(iapd.Memo), &jsonObject); err != nil {
+		return nil
+	}
+
+	memoData, found := jsonObject[key]
+	if !found {
+		return nil
+	}
+
+	return memoData
+}
+
 // GetBytes returns the JSON marshalled interchain account CosmosTx.
 func (ct CosmosTx) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ct))
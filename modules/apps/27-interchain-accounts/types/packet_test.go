@@ -73,3 +73,36 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestGetPacketSender() {
+	packetData := types.InterchainAccountPacketData{
+		Type: types.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+
+	suite.Require().Equal(TestOwnerAddress, packetData.GetPacketSender(TestPortID))
+	suite.Require().Equal("", packetData.GetPacketSender(types.PortID))
+}
+
+func (suite *TypesTestSuite) TestGetCustomPacketData() {
+	testCases := []struct {
+		name    string
+		memo    string
+		expData interface{}
+	}{
+		{"key found", `{"callback": {"address": "addr"}}`, map[string]interface{}{"address": "addr"}},
+		{"key not found", `{"other": {"address": "addr"}}`, nil},
+		{"empty memo", "", nil},
+		{"memo is not json", "memo", nil},
+	}
+
+	for _, tc := range testCases {
+		packetData := types.InterchainAccountPacketData{
+			Type: types.EXECUTE_TX,
+			Data: []byte("data"),
+			Memo: tc.memo,
+		}
+
+		suite.Require().Equal(tc.expData, packetData.GetCustomPacketData("callback"), tc.name)
+	}
+}
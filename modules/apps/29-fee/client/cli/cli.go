@@ -25,6 +25,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdCounterpartyPayee(),
 		GetCmdFeeEnabledChannel(),
 		GetCmdFeeEnabledChannels(),
+		GetCmdParams(),
+		GetCmdSponsorshipPools(),
+		GetCmdSponsorshipPool(),
+		GetCmdEscrowDiscrepancy(),
 	)
 
 	return queryCmd
@@ -44,6 +48,10 @@ func NewTxCmd() *cobra.Command {
 		NewRegisterPayeeCmd(),
 		NewRegisterCounterpartyPayeeCmd(),
 		NewPayPacketFeeAsyncTxCmd(),
+		NewCancelPacketFeeTxCmd(),
+		NewIncreasePacketFeeTxCmd(),
+		NewFundSponsorshipPoolTxCmd(),
+		NewWithdrawSponsorshipPoolTxCmd(),
 	)
 
 	return txCmd
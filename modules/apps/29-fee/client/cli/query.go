@@ -435,3 +435,145 @@ func GetCmdIncentivizedPacketsForChannel() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdParams returns the command handler for the fee middleware parameter querying.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current fee middleware parameters",
+		Long:    "Query the current fee middleware parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee params", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSponsorshipPools returns the command handler for the Query/SponsorshipPools rpc.
+func GetCmdSponsorshipPools() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sponsorship-pools [port-id] [channel-id]",
+		Short:   "Query for all of the sponsorship pools on a given channel",
+		Long:    "Query for all of the sponsorship pools on a given channel",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee sponsorship-pools transfer channel-5", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySponsorshipPoolsRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SponsorshipPools(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sponsorship-pools")
+
+	return cmd
+}
+
+// GetCmdSponsorshipPool returns the command handler for the Query/SponsorshipPool rpc.
+func GetCmdSponsorshipPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sponsorship-pool [port-id] [channel-id] [sponsor]",
+		Short:   "Query the sponsorship pool of a sponsor on a given channel",
+		Long:    "Query the sponsorship pool of a sponsor on a given channel",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query ibc-fee sponsorship-pool transfer channel-5 cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[2]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySponsorshipPoolRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sponsor:   args[2],
+			}
+
+			res, err := queryClient.SponsorshipPool(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdEscrowDiscrepancy returns the command handler for the Query/EscrowDiscrepancy rpc.
+func GetCmdEscrowDiscrepancy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-discrepancy",
+		Short:   "Query the discrepancy between the total escrowed and the escrow balance of the fee middleware",
+		Long:    "Query the discrepancy between the total escrowed and the escrow balance of the fee middleware. The shortfall is reconciled by an unlock-fee-module proposal.",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee escrow-discrepancy", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EscrowDiscrepancy(cmd.Context(), &types.QueryEscrowDiscrepancyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
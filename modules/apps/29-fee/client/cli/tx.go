@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
@@ -20,6 +22,8 @@ const (
 	flagRecvFee    = "recv-fee"
 	flagAckFee     = "ack-fee"
 	flagTimeoutFee = "timeout-fee"
+
+	flagFundFromCommunityPool = "fund-from-community-pool"
 )
 
 // NewRegisterPayeeCmd returns the command to create a MsgRegisterPayee
@@ -97,44 +101,130 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 
 			packetID := channeltypes.NewPacketId(args[0], args[1], seq)
 
-			recvFeeStr, err := cmd.Flags().GetString(flagRecvFee)
+			fee, err := parseFeeFlags(cmd)
 			if err != nil {
 				return err
 			}
 
-			recvFee, err := sdk.ParseCoinsNormalized(recvFeeStr)
+			packetFee := types.NewPacketFee(fee, sender, relayers)
+			msg := types.NewMsgPayPacketFeeAsync(packetID, packetFee)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagRecvFee, "", "Fee paid to a relayer for relaying a packet receive.")
+	cmd.Flags().String(flagAckFee, "", "Fee paid to a relayer for relaying a packet acknowledgement.")
+	cmd.Flags().String(flagTimeoutFee, "", "Fee paid to a relayer for relaying a packet timeout.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelPacketFeeTxCmd returns the command to create a MsgCancelPacketFee
+func NewCancelPacketFeeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-packet-fee [src-port] [src-channel] [sequence]",
+		Short: "Cancel the fees escrowed to incentivize an existing IBC packet",
+		Long: strings.TrimSpace(`Cancel the fees escrowed by the sender to incentivize an existing IBC packet.
+The fees are refunded to the sender provided they have been held in escrow for at least the minimum fee lock time.`),
+		Example: fmt.Sprintf("%s tx ibc-fee cancel-packet-fee transfer channel-0 1", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			ackFeeStr, err := cmd.Flags().GetString(flagAckFee)
+			seq, err := strconv.ParseUint(args[2], 10, 64)
 			if err != nil {
 				return err
 			}
 
-			ackFee, err := sdk.ParseCoinsNormalized(ackFeeStr)
+			packetID := channeltypes.NewPacketId(args[0], args[1], seq)
+			msg := types.NewMsgCancelPacketFee(packetID, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewIncreasePacketFeeTxCmd returns the command to create a MsgIncreasePacketFee
+func NewIncreasePacketFeeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "increase-packet-fee [src-port] [src-channel] [sequence] [fee-index]",
+		Short: "Increase a fee escrowed to incentivize an existing IBC packet",
+		Long: strings.TrimSpace(`Increase a fee escrowed by the sender to incentivize an existing IBC packet.
+The fee is identified by its index in the list of fees returned by the incentivized packet query.`),
+		Example: fmt.Sprintf("%s tx ibc-fee increase-packet-fee transfer channel-0 1 0 --recv-fee 10stake --ack-fee 10stake --timeout-fee 10stake", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			timeoutFeeStr, err := cmd.Flags().GetString(flagTimeoutFee)
+			seq, err := strconv.ParseUint(args[2], 10, 64)
 			if err != nil {
 				return err
 			}
 
-			timeoutFee, err := sdk.ParseCoinsNormalized(timeoutFeeStr)
+			feeIndex, err := strconv.ParseUint(args[3], 10, 64)
 			if err != nil {
 				return err
 			}
 
-			fee := types.Fee{
-				RecvFee:    recvFee,
-				AckFee:     ackFee,
-				TimeoutFee: timeoutFee,
+			fee, err := parseFeeFlags(cmd)
+			if err != nil {
+				return err
 			}
 
-			packetFee := types.NewPacketFee(fee, sender, relayers)
-			msg := types.NewMsgPayPacketFeeAsync(packetID, packetFee)
+			packetID := channeltypes.NewPacketId(args[0], args[1], seq)
+			msg := types.NewMsgIncreasePacketFee(packetID, feeIndex, fee, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagRecvFee, "", "Additional fee paid to a relayer for relaying a packet receive.")
+	cmd.Flags().String(flagAckFee, "", "Additional fee paid to a relayer for relaying a packet acknowledgement.")
+	cmd.Flags().String(flagTimeoutFee, "", "Additional fee paid to a relayer for relaying a packet timeout.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewFundSponsorshipPoolTxCmd returns the command to create a MsgFundSponsorshipPool
+func NewFundSponsorshipPoolTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-sponsorship-pool [src-port] [src-channel] [amount]",
+		Short: "Fund a sponsorship pool which incentivizes all packets sent on a channel",
+		Long: strings.TrimSpace(`Fund the sponsorship pool of the sender on a fee enabled channel.
+The fee provided by the fee flags is escrowed from the pool for each packet sent on the channel until the pool is exhausted.
+Funding an existing pool adds the amount to its balance and replaces its fee.`),
+		Example: fmt.Sprintf("%s tx ibc-fee fund-sponsorship-pool transfer channel-0 1000stake --recv-fee 10stake --ack-fee 10stake --timeout-fee 10stake", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			fee, err := parseFeeFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundSponsorshipPool(args[0], args[1], fee, amount, clientCtx.GetFromAddress().String())
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -147,3 +237,129 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewWithdrawSponsorshipPoolTxCmd returns the command to create a MsgWithdrawSponsorshipPool
+func NewWithdrawSponsorshipPoolTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-sponsorship-pool [src-port] [src-channel]",
+		Short:   "Withdraw the remaining balance of a sponsorship pool and close the pool",
+		Long:    "Withdraw the remaining balance of the sponsorship pool of the sender on the given channel and close the pool.",
+		Example: fmt.Sprintf("%s tx ibc-fee withdraw-sponsorship-pool transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawSponsorshipPool(args[0], args[1], clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitUnlockFeeModuleProposal implements a command handler for submitting a fee middleware unlock proposal transaction.
+func NewCmdSubmitUnlockFeeModuleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock-fee-module",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to unlock the IBC fee middleware",
+		Long: "Submit a proposal to unlock the IBC fee middleware along with an initial deposit.\n" +
+			"The escrow shortfall of the fee middleware is reconciled before it is unlocked. The shortfall is funded from the\n" +
+			"community pool if the --fund-from-community-pool flag is set, otherwise all escrowed packet fees and sponsorship\n" +
+			"pool balances are reduced pro rata. The shortfall may be queried with the escrow-discrepancy query.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal unlock-fee-module --fund-from-community-pool", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			fundFromCommunityPool, err := cmd.Flags().GetBool(flagFundFromCommunityPool)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUnlockFeeModuleProposal(title, description, fundFromCommunityPool)
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(flagFundFromCommunityPool, false, "fund the escrow shortfall from the community pool instead of reducing the escrowed amounts pro rata")
+
+	return cmd
+}
+
+// parseFeeFlags parses the receive, acknowledgement and timeout fee flags into a Fee
+func parseFeeFlags(cmd *cobra.Command) (types.Fee, error) {
+	recvFeeStr, err := cmd.Flags().GetString(flagRecvFee)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	recvFee, err := sdk.ParseCoinsNormalized(recvFeeStr)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	ackFeeStr, err := cmd.Flags().GetString(flagAckFee)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	ackFee, err := sdk.ParseCoinsNormalized(ackFeeStr)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	timeoutFeeStr, err := cmd.Flags().GetString(flagTimeoutFee)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	timeoutFee, err := sdk.ParseCoinsNormalized(timeoutFeeStr)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	return types.NewFee(recvFee, ackFee, timeoutFee), nil
+}
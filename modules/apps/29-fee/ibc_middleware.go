@@ -14,7 +14,10 @@ import (
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 )
 
-var _ porttypes.Middleware = &IBCMiddleware{}
+var (
+	_ porttypes.Middleware              = &IBCMiddleware{}
+	_ porttypes.RecvPacketStateRetainer = &IBCMiddleware{}
+)
 
 // IBCMiddleware implements the ICS26 callbacks for the fee middleware given the
 // fee keeper and the underlying application.
@@ -356,3 +359,11 @@ func (im IBCMiddleware) WriteAcknowledgement(
 func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
 	return im.keeper.GetAppVersion(ctx, portID, channelID)
 }
+
+// RetainStateOnErrorAcknowledgement implements the optional RecvPacketStateRetainer interface by
+// forwarding the call to the underlying application. The fee middleware does not write any state
+// for unsuccessful synchronous acknowledgements.
+func (im IBCMiddleware) RetainStateOnErrorAcknowledgement() bool {
+	retainer, ok := im.app.(porttypes.RecvPacketStateRetainer)
+	return ok && retainer.RetainStateOnErrorAcknowledgement()
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	icahost "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host"
 	fee "github.com/cosmos/ibc-go/v4/modules/apps/29-fee"
 	"github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
@@ -1092,3 +1093,14 @@ func (suite *FeeTestSuite) TestGetAppVersion() {
 		})
 	}
 }
+
+func (suite *FeeTestSuite) TestRetainStateOnErrorAcknowledgement() {
+	feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+
+	// the interchain accounts host retains the state of unsuccessful acknowledgements
+	feeModule := fee.NewIBCMiddleware(icahost.NewIBCModule(suite.chainA.GetSimApp().ICAHostKeeper), feeKeeper)
+	suite.Require().True(feeModule.RetainStateOnErrorAcknowledgement())
+
+	feeModule = fee.NewIBCMiddleware(ibcmock.NewIBCModule(&ibcmock.AppModule{}, &ibcmock.MockIBCApp{}), feeKeeper)
+	suite.Require().False(feeModule.RetainStateOnErrorAcknowledgement())
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -29,6 +30,17 @@ func (k Keeper) escrowPacketFee(ctx sdk.Context, packetID channeltypes.PacketId,
 		return err
 	}
 
+	k.addPacketFeeInEscrow(ctx, packetID, packetFee)
+
+	return nil
+}
+
+// addPacketFeeInEscrow adds the given packet fee, whose funds are held by the 29-fee module account, to the fees
+// escrowed for the given packetID
+func (k Keeper) addPacketFeeInEscrow(ctx sdk.Context, packetID channeltypes.PacketId, packetFee types.PacketFee) {
+	// record the time at which the fee is escrowed, the fee may only be cancelled after the minimum fee lock time
+	packetFee.EscrowTimestamp = uint64(ctx.BlockTime().UnixNano())
+
 	// multiple fees may be escrowed for a single packet, firstly create a slice containing the new fee
 	// retrieve any previous fees stored in escrow for the packet and append them to the list
 	fees := []types.PacketFee{packetFee}
@@ -40,6 +52,106 @@ func (k Keeper) escrowPacketFee(ctx sdk.Context, packetID channeltypes.PacketId,
 	k.SetFeesInEscrow(ctx, packetID, packetFees)
 
 	EmitIncentivizedPacketEvent(ctx, packetID, packetFees)
+}
+
+// cancelPacketFees refunds all fees escrowed for the given packetID by the given refund address which have been
+// held in escrow for at least the minimum fee lock time. The fees escrowed by other refund addresses and the fees
+// which are still locked remain in escrow.
+func (k Keeper) cancelPacketFees(ctx sdk.Context, packetID channeltypes.PacketId, refundAddress string) error {
+	feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrFeeNotFound, "channel: %s, port: %s, sequence: %d", packetID.ChannelId, packetID.PortId, packetID.Sequence)
+	}
+
+	refundAddr, err := sdk.AccAddressFromBech32(refundAddress)
+	if err != nil {
+		return err
+	}
+
+	var (
+		refundedFees  sdk.Coins
+		remainingFees []types.PacketFee
+		hasLockedFees bool
+	)
+
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	minFeeLockTime := k.GetMinFeeLockTime(ctx)
+
+	for _, packetFee := range feesInEscrow.PacketFees {
+		if packetFee.RefundAddress != refundAddress {
+			remainingFees = append(remainingFees, packetFee)
+			continue
+		}
+
+		// the subtraction avoids an overflow for large minimum fee lock times
+		if blockTime < packetFee.EscrowTimestamp || blockTime-packetFee.EscrowTimestamp < minFeeLockTime {
+			hasLockedFees = true
+			remainingFees = append(remainingFees, packetFee)
+			continue
+		}
+
+		refundedFees = refundedFees.Add(packetFee.Fee.Total()...)
+	}
+
+	if len(remainingFees) == len(feesInEscrow.PacketFees) {
+		if hasLockedFees {
+			return sdkerrors.Wrapf(types.ErrFeeStillLocked, "minimum fee lock time: %s", time.Duration(minFeeLockTime))
+		}
+
+		return sdkerrors.Wrapf(types.ErrFeeNotFound, "no fees escrowed by refund address %s", refundAddress)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, refundAddr, refundedFees); err != nil {
+		return err
+	}
+
+	packetFees := types.NewPacketFees(remainingFees)
+	if len(remainingFees) == 0 {
+		k.DeleteFeesInEscrow(ctx, packetID)
+	} else {
+		k.SetFeesInEscrow(ctx, packetID, packetFees)
+	}
+
+	EmitCancelPacketFeeEvent(ctx, packetID, refundAddress, refundedFees)
+	EmitIncentivizedPacketEvent(ctx, packetID, packetFees)
+
+	return nil
+}
+
+// increasePacketFee escrows the given additional fee for the packet fee at the given index in the list of fees
+// escrowed for the given packetID. The packet fee must belong to the given refund address. The escrow timestamp
+// of the packet fee is reset, thus the minimum fee lock time applies to the total increased fee.
+func (k Keeper) increasePacketFee(ctx sdk.Context, packetID channeltypes.PacketId, feeIndex uint64, fee types.Fee, refundAddress string) error {
+	feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrFeeNotFound, "channel: %s, port: %s, sequence: %d", packetID.ChannelId, packetID.PortId, packetID.Sequence)
+	}
+
+	if feeIndex >= uint64(len(feesInEscrow.PacketFees)) {
+		return sdkerrors.Wrapf(types.ErrInvalidFeeIndex, "fee index %d is out of range, %d fees escrowed for packet", feeIndex, len(feesInEscrow.PacketFees))
+	}
+
+	packetFee := feesInEscrow.PacketFees[feeIndex]
+	if packetFee.RefundAddress != refundAddress {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected refund address %s, got %s", packetFee.RefundAddress, refundAddress)
+	}
+
+	refundAddr, err := sdk.AccAddressFromBech32(refundAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, fee.Total()); err != nil {
+		return err
+	}
+
+	packetFee.Fee = packetFee.Fee.Add(fee)
+	packetFee.EscrowTimestamp = uint64(ctx.BlockTime().UnixNano())
+	feesInEscrow.PacketFees[feeIndex] = packetFee
+
+	k.SetFeesInEscrow(ctx, packetID, feesInEscrow)
+
+	EmitIncentivizedPacketEvent(ctx, packetID, feesInEscrow)
 
 	return nil
 }
@@ -75,3 +75,92 @@ func EmitRegisterCounterpartyPayeeEvent(ctx sdk.Context, relayer, counterpartyPa
 		),
 	})
 }
+
+// EmitCancelPacketFeeEvent emits an event containing information on the fees refunded to a refund address upon
+// the cancellation of its fees escrowed for a specific packet
+func EmitCancelPacketFeeEvent(ctx sdk.Context, packetID channeltypes.PacketId, refundAddress string, refundedFees sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelPacketFee,
+			sdk.NewAttribute(channeltypes.AttributeKeyPortID, packetID.PortId),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, packetID.ChannelId),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprint(packetID.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyRefundAddress, refundAddress),
+			sdk.NewAttribute(types.AttributeKeyRefundedFee, refundedFees.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitFundSponsorshipPoolEvent emits an event containing information on the funds deposited into a sponsorship pool
+func EmitFundSponsorshipPoolEvent(ctx sdk.Context, pool types.SponsorshipPool, amount sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFundSponsorshipPool,
+			sdk.NewAttribute(channeltypes.AttributeKeyPortID, pool.PortId),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, pool.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySponsor, pool.Sponsor),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRecvFee, pool.Fee.RecvFee.String()),
+			sdk.NewAttribute(types.AttributeKeyAckFee, pool.Fee.AckFee.String()),
+			sdk.NewAttribute(types.AttributeKeyTimeoutFee, pool.Fee.TimeoutFee.String()),
+			sdk.NewAttribute(types.AttributeKeyPoolBalance, pool.Balance.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitWithdrawSponsorshipPoolEvent emits an event containing information on the balance refunded to the sponsor
+// upon the closure of a sponsorship pool
+func EmitWithdrawSponsorshipPoolEvent(ctx sdk.Context, pool types.SponsorshipPool) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawSponsorshipPool,
+			sdk.NewAttribute(channeltypes.AttributeKeyPortID, pool.PortId),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, pool.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySponsor, pool.Sponsor),
+			sdk.NewAttribute(types.AttributeKeyRefundedFee, pool.Balance.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitSponsoredPacketFeeEvent emits an event containing information on the fee escrowed from a sponsorship pool
+// for a specific packet
+func EmitSponsoredPacketFeeEvent(ctx sdk.Context, packetID channeltypes.PacketId, pool types.SponsorshipPool) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSponsoredPacketFee,
+			sdk.NewAttribute(channeltypes.AttributeKeyPortID, packetID.PortId),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, packetID.ChannelId),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprint(packetID.Sequence)),
+			sdk.NewAttribute(types.AttributeKeySponsor, pool.Sponsor),
+			sdk.NewAttribute(types.AttributeKeyPoolBalance, pool.Balance.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitUnlockFeeModuleEvent emits an event containing information on the reconciliation of the escrow shortfall
+// upon the unlocking of the fee module
+func EmitUnlockFeeModuleEvent(ctx sdk.Context, shortfall sdk.Coins, reconciliation string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnlockFeeModule,
+			sdk.NewAttribute(types.AttributeKeyShortfall, shortfall.String()),
+			sdk.NewAttribute(types.AttributeKeyReconciliation, reconciliation),
+		),
+	)
+}
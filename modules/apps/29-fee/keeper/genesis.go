@@ -8,6 +8,8 @@ import (
 
 // InitGenesis initializes the fee middleware application state from a provided genesis state
 func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	k.SetParams(ctx, state.Params)
+
 	for _, identifiedFees := range state.IdentifiedFees {
 		k.SetFeesInEscrow(ctx, identifiedFees.PacketId, types.NewPacketFees(identifiedFees.PacketFees))
 	}
@@ -27,6 +29,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, enabledChan := range state.FeeEnabledChannels {
 		k.SetFeeEnabled(ctx, enabledChan.PortId, enabledChan.ChannelId)
 	}
+
+	for _, pool := range state.SponsorshipPools {
+		k.SetSponsorshipPool(ctx, pool)
+	}
 }
 
 // ExportGenesis returns the fee middleware application exported genesis
@@ -37,5 +43,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		RegisteredPayees:             k.GetAllPayees(ctx),
 		RegisteredCounterpartyPayees: k.GetAllCounterpartyPayees(ctx),
 		ForwardRelayers:              k.GetAllForwardRelayerAddresses(ctx),
+		Params:                       k.GetParams(ctx),
+		SponsorshipPools:             k.GetAllSponsorshipPools(ctx),
 	}
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
@@ -42,6 +44,16 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				ChannelId:         ibctesting.FirstChannelID,
 			},
 		},
+		Params: types.NewParams(uint64(time.Hour), types.DefaultMaxSponsorshipPoolsPerChannel),
+		SponsorshipPools: []types.SponsorshipPool{
+			types.NewSponsorshipPool(
+				ibctesting.MockFeePort,
+				ibctesting.FirstChannelID,
+				suite.chainA.SenderAccount.GetAddress().String(),
+				types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee),
+				defaultRecvFee,
+			),
+		},
 	}
 
 	suite.chainA.GetSimApp().IBCFeeKeeper.InitGenesis(suite.chainA.GetContext(), genesisState)
@@ -64,6 +76,14 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	counterpartyPayeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
 	suite.Require().True(found)
 	suite.Require().Equal(genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee, counterpartyPayeeAddr)
+
+	// check params
+	suite.Require().Equal(genesisState.Params, suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext()))
+
+	// check sponsorship pools
+	pool, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetSponsorshipPool(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.SponsorshipPools[0], pool)
 }
 
 func (suite *KeeperTestSuite) TestExportGenesis() {
@@ -97,6 +117,10 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	// set forward relayer address
 	suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerAddressForAsyncAck(suite.chainA.GetContext(), packetID, suite.chainA.SenderAccount.GetAddress().String())
 
+	// set sponsorship pool
+	pool := types.NewSponsorshipPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, refundAcc.String(), fee, fee.Total())
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetSponsorshipPool(suite.chainA.GetContext(), pool)
+
 	// export genesis
 	genesisState := suite.chainA.GetSimApp().IBCFeeKeeper.ExportGenesis(suite.chainA.GetContext())
 
@@ -123,4 +147,10 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].Relayer)
 	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee)
 	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.RegisteredCounterpartyPayees[0].ChannelId)
+
+	// check params
+	suite.Require().Equal(types.DefaultParams(), genesisState.Params)
+
+	// check sponsorship pools
+	suite.Require().Equal([]types.SponsorshipPool{pool}, genesisState.SponsorshipPools)
 }
@@ -256,3 +256,81 @@ func (k Keeper) FeeEnabledChannel(goCtx context.Context, req *types.QueryFeeEnab
 		FeeEnabled: isFeeEnabled,
 	}, nil
 }
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{
+		Params: k.GetParams(ctx),
+	}, nil
+}
+
+// SponsorshipPools implements the Query/SponsorshipPools gRPC method
+func (k Keeper) SponsorshipPools(goCtx context.Context, req *types.QuerySponsorshipPoolsRequest) (*types.QuerySponsorshipPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var pools []types.SponsorshipPool
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeySponsorshipPoolChannelPrefix(req.PortId, req.ChannelId))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pool types.SponsorshipPool
+		if err := k.cdc.Unmarshal(value, &pool); err != nil {
+			return err
+		}
+
+		pools = append(pools, pool)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySponsorshipPoolsResponse{
+		SponsorshipPools: pools,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// SponsorshipPool implements the Query/SponsorshipPool gRPC method
+func (k Keeper) SponsorshipPool(goCtx context.Context, req *types.QuerySponsorshipPoolRequest) (*types.QuerySponsorshipPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, found := k.GetSponsorshipPool(ctx, req.PortId, req.ChannelId, req.Sponsor)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrSponsorshipPoolNotFound, "port ID (%s) channel ID (%s) sponsor (%s)", req.PortId, req.ChannelId, req.Sponsor).Error(),
+		)
+	}
+
+	return &types.QuerySponsorshipPoolResponse{
+		SponsorshipPool: pool,
+	}, nil
+}
+
+// EscrowDiscrepancy implements the Query/EscrowDiscrepancy gRPC method
+func (k Keeper) EscrowDiscrepancy(goCtx context.Context, req *types.QueryEscrowDiscrepancyRequest) (*types.QueryEscrowDiscrepancyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	totalEscrowed, escrowBalance, shortfall := k.getEscrowDiscrepancy(ctx)
+
+	return &types.QueryEscrowDiscrepancyResponse{
+		Locked:                       k.IsLocked(ctx),
+		TotalEscrowed:                totalEscrowed,
+		EscrowBalance:                escrowBalance,
+		Shortfall:                    shortfall,
+		CommunityPoolCoversShortfall: k.communityPoolCoversShortfall(ctx, shortfall),
+	}, nil
+}
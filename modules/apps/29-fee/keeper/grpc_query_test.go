@@ -690,3 +690,234 @@ func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	expParams := types.DefaultParams()
+	res, _ := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().Equal(expParams, res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQuerySponsorshipPools() {
+	var (
+		req      *types.QuerySponsorshipPoolsRequest
+		expPools []types.SponsorshipPool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: empty pagination",
+			func() {
+				req.Pagination = nil
+			},
+			true,
+		},
+		{
+			"success: with multiple sponsorship pools",
+			func() {
+				fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+				sponsor := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+
+				pool := types.NewSponsorshipPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sponsor, fee, fee.Total())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetSponsorshipPool(suite.chainA.GetContext(), pool)
+
+				expPools = append(expPools, pool)
+
+				suite.chainA.NextBlock()
+			},
+			true,
+		},
+		{
+			"empty response: no sponsorship pools on channel",
+			func() {
+				req.ChannelId = "channel-10"
+				expPools = nil
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.coordinator.Setup(suite.path)
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			pool := types.NewSponsorshipPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String(), fee, fee.Total())
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetSponsorshipPool(suite.chainA.GetContext(), pool)
+
+			expPools = []types.SponsorshipPool{pool}
+
+			suite.chainA.NextBlock()
+
+			req = &types.QuerySponsorshipPoolsRequest{
+				PortId:    suite.path.EndpointA.ChannelConfig.PortID,
+				ChannelId: suite.path.EndpointA.ChannelID,
+				Pagination: &query.PageRequest{
+					Limit:      5,
+					CountTotal: false,
+				},
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.queryClient.SponsorshipPools(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().ElementsMatch(expPools, res.SponsorshipPools)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerySponsorshipPool() {
+	var (
+		req     *types.QuerySponsorshipPoolRequest
+		expPool types.SponsorshipPool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"sponsorship pool not found",
+			func() {
+				req.Sponsor = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.coordinator.Setup(suite.path)
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			expPool = types.NewSponsorshipPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String(), fee, fee.Total())
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetSponsorshipPool(suite.chainA.GetContext(), expPool)
+
+			suite.chainA.NextBlock()
+
+			req = &types.QuerySponsorshipPoolRequest{
+				PortId:    suite.path.EndpointA.ChannelConfig.PortID,
+				ChannelId: suite.path.EndpointA.ChannelID,
+				Sponsor:   suite.chainA.SenderAccount.GetAddress().String(),
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.queryClient.SponsorshipPool(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPool, res.SponsorshipPool)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryEscrowDiscrepancy() {
+	var (
+		fee    types.Fee
+		expRes *types.QueryEscrowDiscrepancyResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: no discrepancy",
+			func() {},
+		},
+		{
+			"success: locked fee module with escrow shortfall",
+			func() {
+				packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+				packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee, packetFee}))
+
+				lockFeeModule(suite.chainA)
+
+				expRes.Locked = true
+				expRes.TotalEscrowed = fee.Total().Add(fee.Total()...)
+				expRes.Shortfall = fee.Total()
+			},
+		},
+		{
+			"success: community pool does not cover the shortfall",
+			func() {
+				packetID := channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+				packetFee := types.NewPacketFee(types.NewFee(sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(100))), nil, nil), suite.chainA.SenderAccount.GetAddress().String(), nil)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+
+				expRes.TotalEscrowed = packetFee.Fee.Total()
+				expRes.Shortfall = packetFee.Fee.Total()
+				expRes.CommunityPoolCoversShortfall = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.coordinator.Setup(suite.path)
+
+			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total())
+			suite.Require().NoError(err)
+
+			err = suite.chainA.GetSimApp().DistrKeeper.FundCommunityPool(suite.chainA.GetContext(), fee.Total(), suite.chainA.SenderAccount.GetAddress())
+			suite.Require().NoError(err)
+
+			expRes = &types.QueryEscrowDiscrepancyResponse{
+				Locked:                       false,
+				TotalEscrowed:                sdk.NewCoins(),
+				EscrowBalance:                fee.Total(),
+				Shortfall:                    sdk.NewCoins(),
+				CommunityPoolCoversShortfall: true,
+			}
+
+			tc.malleate()
+
+			suite.chainA.NextBlock()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.queryClient.EscrowDiscrepancy(ctx, &types.QueryEscrowDiscrepancyRequest{})
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(expRes.Locked, res.Locked)
+			suite.Require().True(expRes.TotalEscrowed.IsEqual(res.TotalEscrowed))
+			suite.Require().True(expRes.EscrowBalance.IsEqual(res.EscrowBalance))
+			suite.Require().True(expRes.Shortfall.IsEqual(res.Shortfall))
+			suite.Require().Equal(expRes.CommunityPoolCoversShortfall, res.CommunityPoolCoversShortfall)
+		})
+	}
+}
@@ -21,29 +21,39 @@ var (
 
 // Keeper defines the IBC fungible transfer keeper
 type Keeper struct {
-	storeKey sdk.StoreKey
-	cdc      codec.BinaryCodec
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
 
 	authKeeper    types.AccountKeeper
 	ics4Wrapper   types.ICS4Wrapper
 	channelKeeper types.ChannelKeeper
 	portKeeper    types.PortKeeper
 	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
 }
 
 // NewKeeper creates a new 29-fee Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		cdc:           cdc,
 		storeKey:      key,
+		paramSpace:    paramSpace,
 		ics4Wrapper:   ics4Wrapper,
 		channelKeeper: channelKeeper,
 		portKeeper:    portKeeper,
 		authKeeper:    authKeeper,
 		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
 	}
 }
 
@@ -97,6 +107,13 @@ func (k Keeper) lockFeeModule(ctx sdk.Context) {
 	store.Set(types.KeyLocked(), []byte{1})
 }
 
+// unlockFeeModule removes the flag which locks the fee module.
+// Please see ADR 004 for more information.
+func (k Keeper) unlockFeeModule(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyLocked())
+}
+
 // IsLocked indicates if the fee module is locked
 // Please see ADR 004 for more information.
 func (k Keeper) IsLocked(ctx sdk.Context) bool {
@@ -367,6 +384,65 @@ func (k Keeper) GetAllIdentifiedPacketFees(ctx sdk.Context) []types.IdentifiedPa
 	return identifiedFees
 }
 
+// GetSponsorshipPool returns the sponsorship pool of the given sponsor on the given channel
+func (k Keeper) GetSponsorshipPool(ctx sdk.Context, portID, channelID, sponsor string) (types.SponsorshipPool, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeySponsorshipPool(portID, channelID, sponsor))
+	if bz == nil {
+		return types.SponsorshipPool{}, false
+	}
+
+	var pool types.SponsorshipPool
+	k.cdc.MustUnmarshal(bz, &pool)
+
+	return pool, true
+}
+
+// SetSponsorshipPool stores the given sponsorship pool keyed by its channel and sponsor
+func (k Keeper) SetSponsorshipPool(ctx sdk.Context, pool types.SponsorshipPool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pool)
+	store.Set(types.KeySponsorshipPool(pool.PortId, pool.ChannelId, pool.Sponsor), bz)
+}
+
+// DeleteSponsorshipPool deletes the sponsorship pool of the given sponsor on the given channel
+func (k Keeper) DeleteSponsorshipPool(ctx sdk.Context, portID, channelID, sponsor string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeySponsorshipPool(portID, channelID, sponsor))
+}
+
+// GetSponsorshipPoolsForChannel returns all the sponsorship pools of the given channel
+func (k Keeper) GetSponsorshipPoolsForChannel(ctx sdk.Context, portID, channelID string) []types.SponsorshipPool {
+	return k.getSponsorshipPools(ctx, types.KeySponsorshipPoolChannelPrefix(portID, channelID), 0)
+}
+
+// GetAllSponsorshipPools returns a list of all the sponsorship pools that are stored in state
+func (k Keeper) GetAllSponsorshipPools(ctx sdk.Context) []types.SponsorshipPool {
+	return k.getSponsorshipPools(ctx, []byte(types.SponsorshipPoolPrefix), 0)
+}
+
+// getSponsorshipPools returns the sponsorship pools stored under the given key prefix, up to the given limit.
+// All the pools are returned if the limit is zero.
+func (k Keeper) getSponsorshipPools(ctx sdk.Context, prefix []byte, limit uint64) []types.SponsorshipPool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var pools []types.SponsorshipPool
+	for ; iterator.Valid(); iterator.Next() {
+		if limit != 0 && uint64(len(pools)) >= limit {
+			break
+		}
+
+		var pool types.SponsorshipPool
+		k.cdc.MustUnmarshal(iterator.Value(), &pool)
+
+		pools = append(pools, pool)
+	}
+
+	return pools
+}
+
 // MustMarshalFees attempts to encode a Fee object and returns the
 // raw encoded bytes. It panics on error.
 func (k Keeper) MustMarshalFees(fees types.PacketFees) []byte {
@@ -258,6 +258,27 @@ func (suite *KeeperTestSuite) TestGetAllFeeEnabledChannels() {
 	suite.Require().Equal(ch, expectedCh)
 }
 
+func (suite *KeeperTestSuite) TestGetAllSponsorshipPools() {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	sponsor := suite.chainA.SenderAccount.GetAddress().String()
+
+	var expectedPools []types.SponsorshipPool
+	for i := 0; i < 3; i++ {
+		channelID := channeltypes.FormatChannelIdentifier(uint64(i))
+		pool := types.NewSponsorshipPool(ibctesting.MockFeePort, channelID, sponsor, fee, fee.Total())
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetSponsorshipPool(suite.chainA.GetContext(), pool)
+
+		expectedPools = append(expectedPools, pool)
+	}
+
+	pools := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllSponsorshipPools(suite.chainA.GetContext())
+	suite.Require().Len(pools, len(expectedPools))
+	suite.Require().Equal(expectedPools, pools)
+
+	channelPools := suite.chainA.GetSimApp().IBCFeeKeeper.GetSponsorshipPoolsForChannel(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	suite.Require().Equal(expectedPools[:1], channelPools)
+}
+
 func (suite *KeeperTestSuite) TestGetAllPayees() {
 	var expectedPayees []types.RegisteredPayee
 
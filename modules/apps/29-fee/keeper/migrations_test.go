@@ -0,0 +1,20 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v4/modules/apps/29-fee/keeper"
+	"github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	ctx := suite.chainA.GetContext()
+	feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+
+	// the params are set by the migration
+	feeKeeper.SetParams(ctx, types.NewParams(0, 0))
+
+	migrator := keeper.NewMigrator(feeKeeper)
+	err := migrator.Migrate1to2(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(types.DefaultParams(), feeKeeper.GetParams(ctx))
+}
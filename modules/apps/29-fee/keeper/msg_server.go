@@ -131,3 +131,85 @@ func (k Keeper) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacket
 
 	return &types.MsgPayPacketFeeAsyncResponse{}, nil
 }
+
+// CancelPacketFee defines a rpc handler method for MsgCancelPacketFee
+// CancelPacketFee refunds all fees escrowed by the signer for a packet which has not completed the packet life cycle,
+// provided the fees have been held in escrow for at least the minimum fee lock time.
+func (k Keeper) CancelPacketFee(goCtx context.Context, msg *types.MsgCancelPacketFee) (*types.MsgCancelPacketFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	if err := k.cancelPacketFees(ctx, msg.PacketId, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("packet fees cancelled", "refund address", msg.Signer, "port", msg.PacketId.PortId, "channel", msg.PacketId.ChannelId, "sequence", msg.PacketId.Sequence)
+
+	return &types.MsgCancelPacketFeeResponse{}, nil
+}
+
+// IncreasePacketFee defines a rpc handler method for MsgIncreasePacketFee
+// IncreasePacketFee escrows additional funds for a single fee escrowed by the signer for a packet which has not
+// completed the packet life cycle. The fee to be increased is identified by its index in the list of fees escrowed
+// for the packet, as returned by the IncentivizedPacket query.
+func (k Keeper) IncreasePacketFee(goCtx context.Context, msg *types.MsgIncreasePacketFee) (*types.MsgIncreasePacketFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	if err := k.increasePacketFee(ctx, msg.PacketId, msg.FeeIndex, msg.Fee, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgIncreasePacketFeeResponse{}, nil
+}
+
+// FundSponsorshipPool defines a rpc handler method for MsgFundSponsorshipPool
+// FundSponsorshipPool deposits funds into the sponsorship pool of the signer on a fee enabled channel. The fee of the
+// pool is escrowed from the pool for each packet sent on the channel until the pool is exhausted.
+func (k Keeper) FundSponsorshipPool(goCtx context.Context, msg *types.MsgFundSponsorshipPool) (*types.MsgFundSponsorshipPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId); !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	if !k.IsFeeEnabled(ctx, msg.PortId, msg.ChannelId) {
+		return nil, types.ErrFeeNotEnabled
+	}
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	if err := k.fundSponsorshipPool(ctx, msg.PortId, msg.ChannelId, msg.Signer, msg.Fee, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("sponsorship pool funded", "sponsor", msg.Signer, "port", msg.PortId, "channel", msg.ChannelId, "amount", msg.Amount)
+
+	return &types.MsgFundSponsorshipPoolResponse{}, nil
+}
+
+// WithdrawSponsorshipPool defines a rpc handler method for MsgWithdrawSponsorshipPool
+// WithdrawSponsorshipPool refunds the remaining balance of the sponsorship pool of the signer and removes the pool.
+func (k Keeper) WithdrawSponsorshipPool(goCtx context.Context, msg *types.MsgWithdrawSponsorshipPool) (*types.MsgWithdrawSponsorshipPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	if err := k.withdrawSponsorshipPool(ctx, msg.PortId, msg.ChannelId, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("sponsorship pool withdrawn", "sponsor", msg.Signer, "port", msg.PortId, "channel", msg.ChannelId)
+
+	return &types.MsgWithdrawSponsorshipPoolResponse{}, nil
+}
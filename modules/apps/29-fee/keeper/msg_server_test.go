@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
   
@@ -189,6 +191,7 @@ func (suite *KeeperTestSuite) TestPayPacketFee() {
 			func() {
 				msg.Signer = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(disttypes.ModuleName).String()
 				expPacketFee := types.NewPacketFee(fee, msg.Signer, nil)
+				expPacketFee.EscrowTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
 				expFeesInEscrow = []types.PacketFee{expPacketFee}
 			},
 			true,
@@ -263,6 +266,7 @@ func (suite *KeeperTestSuite) TestPayPacketFee() {
 
 			expEscrowBalance = fee.Total()
 			expPacketFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+			expPacketFee.EscrowTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
 			expFeesInEscrow = []types.PacketFee{expPacketFee}
 
 			tc.malleate()
@@ -441,9 +445,12 @@ func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 			packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
 
 			expEscrowBalance = fee.Total()
-			expFeesInEscrow = []types.PacketFee{packetFee}
 			msg = types.NewMsgPayPacketFeeAsync(packetID, packetFee)
 
+			// the escrow timestamp is set upon escrow
+			packetFee.EscrowTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+			expFeesInEscrow = []types.PacketFee{packetFee}
+
 			tc.malleate()
 
 			_, err = suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFeeAsync(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
@@ -466,3 +473,461 @@ func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCancelPacketFee() {
+	var (
+		packetID         channeltypes.PacketId
+		expRefund        sdk.Coins
+		expFeesInEscrow  []types.PacketFee
+		msg              *types.MsgCancelPacketFee
+		fee              types.Fee
+		otherRefundAddr  sdk.AccAddress
+		minFeeLockTime   time.Duration
+		payPacketFeeFunc func(refundAddr sdk.AccAddress) types.PacketFee
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with fees of another refund address remaining in escrow",
+			func() {
+				expFeesInEscrow = []types.PacketFee{payPacketFeeFunc(otherRefundAddr)}
+			},
+			true,
+		},
+		{
+			"success with locked fees remaining in escrow",
+			func() {
+				suite.coordinator.IncrementTimeBy(minFeeLockTime)
+
+				// the escrowed fee which has not been held in escrow for the minimum fee lock time remains in escrow
+				expFeesInEscrow = []types.PacketFee{payPacketFeeFunc(suite.chainA.SenderAccount.GetAddress())}
+			},
+			true,
+		},
+		{
+			"fee has not been held in escrow for the minimum fee lock time",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(uint64(2*minFeeLockTime), types.DefaultMaxSponsorshipPoolsPerChannel))
+			},
+			false,
+		},
+		{
+			"no fees escrowed for the refund address",
+			func() {
+				msg.Signer = otherRefundAddr.String()
+			},
+			false,
+		},
+		{
+			"no fees escrowed for the packet",
+			func() {
+				msg.PacketId.Sequence = 2
+			},
+			false,
+		},
+		{
+			"fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path) // setup channel
+
+			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			otherRefundAddr = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			minFeeLockTime = time.Duration(suite.chainA.GetSimApp().IBCFeeKeeper.GetMinFeeLockTime(suite.chainA.GetContext()))
+
+			// send a packet to incentivize
+			packetID = channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, packetID.Sequence, packetID.PortId, packetID.ChannelId, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, clienttypes.NewHeight(clienttypes.ParseChainID(suite.chainB.ChainID), 100), 0)
+			err := suite.path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			payPacketFeeFunc = func(refundAddr sdk.AccAddress) types.PacketFee {
+				packetFee := types.NewPacketFee(fee, refundAddr.String(), nil)
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFeeAsync(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgPayPacketFeeAsync(packetID, packetFee))
+				suite.Require().NoError(err)
+
+				packetFee.EscrowTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+				return packetFee
+			}
+
+			payPacketFeeFunc(suite.chainA.SenderAccount.GetAddress())
+			suite.coordinator.IncrementTimeBy(minFeeLockTime)
+
+			expRefund = fee.Total()
+			expFeesInEscrow = nil
+			msg = types.NewMsgCancelPacketFee(packetID, suite.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			_, err = suite.chainA.GetSimApp().IBCFeeKeeper.CancelPacketFee(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err) // message committed
+
+				feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().Equal(len(expFeesInEscrow) != 0, found)
+				suite.Require().Equal(expFeesInEscrow, feesInEscrow.PacketFees)
+
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(balanceBefore.Add(sdk.NewCoin(sdk.DefaultBondDenom, expRefund.AmountOf(sdk.DefaultBondDenom))), balance)
+			} else {
+				suite.Require().Error(err)
+
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(balanceBefore, balance)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestIncreasePacketFee() {
+	var (
+		packetID          channeltypes.PacketId
+		expEscrowBalance  sdk.Coins
+		expFeesInEscrow   []types.PacketFee
+		msg               *types.MsgIncreasePacketFee
+		fee               types.Fee
+		existingPacketFee types.PacketFee
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with multiple fees in escrow",
+			func() {
+				packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), nil)
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFeeAsync(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgPayPacketFeeAsync(packetID, packetFee))
+				suite.Require().NoError(err)
+
+				packetFee.EscrowTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+				existingPacketFee.Fee = fee.Add(fee)
+
+				// the most recently escrowed fee is the first in the list of fees
+				msg.FeeIndex = 1
+				expEscrowBalance = expEscrowBalance.Add(fee.Total()...)
+				expFeesInEscrow = []types.PacketFee{packetFee, existingPacketFee}
+			},
+			true,
+		},
+		{
+			"fee index out of range",
+			func() {
+				msg.FeeIndex = 1
+			},
+			false,
+		},
+		{
+			"signer is not the refund address of the fee",
+			func() {
+				msg.Signer = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"no fees escrowed for the packet",
+			func() {
+				msg.PacketId.Sequence = 2
+			},
+			false,
+		},
+		{
+			"insufficient funds to escrow the additional fee",
+			func() {
+				msg.Fee.RecvFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewIntWithDecimal(1, 30)))
+			},
+			false,
+		},
+		{
+			"fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path) // setup channel
+
+			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+			// send a packet to incentivize
+			packetID = channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, packetID.Sequence, packetID.PortId, packetID.ChannelId, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, clienttypes.NewHeight(clienttypes.ParseChainID(suite.chainB.ChainID), 100), 0)
+			err := suite.path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+			_, err = suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFeeAsync(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgPayPacketFeeAsync(packetID, packetFee))
+			suite.Require().NoError(err)
+
+			suite.coordinator.IncrementTime()
+
+			// the escrow timestamp of the increased fee is reset
+			existingPacketFee = types.NewPacketFee(fee.Add(fee), packetFee.RefundAddress, nil)
+			existingPacketFee.EscrowTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+
+			expEscrowBalance = fee.Total().Add(fee.Total()...)
+			expFeesInEscrow = []types.PacketFee{existingPacketFee}
+			msg = types.NewMsgIncreasePacketFee(packetID, 0, fee, suite.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			escrowBalanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+
+			_, err = suite.chainA.GetSimApp().IBCFeeKeeper.IncreasePacketFee(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+
+			if tc.expPass {
+				suite.Require().NoError(err) // message committed
+
+				feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().True(found)
+				suite.Require().Equal(expFeesInEscrow, feesInEscrow.PacketFees)
+
+				suite.Require().Equal(expEscrowBalance.AmountOf(sdk.DefaultBondDenom), escrowBalance.Amount)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(escrowBalanceBefore, escrowBalance)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFundSponsorshipPool() {
+	var (
+		msg     *types.MsgFundSponsorshipPool
+		fee     types.Fee
+		expPool types.SponsorshipPool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: existing pool is topped up and its fee replaced",
+			func() {
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.FundSponsorshipPool(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+				suite.Require().NoError(err)
+
+				msg.Fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultAckFee)
+				expPool.Fee = msg.Fee
+				expPool.Balance = expPool.Balance.Add(msg.Amount...)
+			},
+			true,
+		},
+		{
+			"success: existing pool is topped up when the maximum number of pools is reached",
+			func() {
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.FundSponsorshipPool(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+				suite.Require().NoError(err)
+
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(types.DefaultMinFeeLockTime, 1))
+				expPool.Balance = expPool.Balance.Add(msg.Amount...)
+			},
+			true,
+		},
+		{
+			"maximum number of pools reached",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(types.DefaultMinFeeLockTime, 1))
+
+				otherSponsor := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+				pool := types.NewSponsorshipPool(msg.PortId, msg.ChannelId, otherSponsor, fee, fee.Total())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetSponsorshipPool(suite.chainA.GetContext(), pool)
+			},
+			false,
+		},
+		{
+			"sponsorship pools are disabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(types.DefaultMinFeeLockTime, 0))
+			},
+			false,
+		},
+		{
+			"channel does not exist",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			false,
+		},
+		{
+			"channel is not fee enabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			},
+			false,
+		},
+		{
+			"fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			false,
+		},
+		{
+			"pool balance does not cover the fee of a single packet",
+			func() {
+				msg.Fee = types.NewFee(msg.Amount.Add(msg.Amount...), defaultAckFee, defaultTimeoutFee)
+			},
+			false,
+		},
+		{
+			"insufficient funds",
+			func() {
+				msg.Amount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewIntWithDecimal(1, 30)))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path) // setup channel
+
+			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			amount := fee.Total().Add(fee.Total()...)
+			sponsor := suite.chainA.SenderAccount.GetAddress().String()
+
+			msg = types.NewMsgFundSponsorshipPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, fee, amount, sponsor)
+			expPool = types.NewSponsorshipPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sponsor, fee, amount)
+
+			tc.malleate()
+
+			escrowBalanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+
+			_, err := suite.chainA.GetSimApp().IBCFeeKeeper.FundSponsorshipPool(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+
+			if tc.expPass {
+				suite.Require().NoError(err) // message committed
+
+				pool, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetSponsorshipPool(suite.chainA.GetContext(), msg.PortId, msg.ChannelId, msg.Signer)
+				suite.Require().True(found)
+				suite.Require().Equal(expPool, pool)
+
+				suite.Require().Equal(escrowBalanceBefore.Add(sdk.NewCoin(sdk.DefaultBondDenom, msg.Amount.AmountOf(sdk.DefaultBondDenom))), escrowBalance)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(escrowBalanceBefore, escrowBalance)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWithdrawSponsorshipPool() {
+	var (
+		msg       *types.MsgWithdrawSponsorshipPool
+		expRefund sdk.Coins
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"sponsorship pool not found",
+			func() {
+				msg.Signer = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path) // setup channel
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			expRefund = fee.Total().Add(fee.Total()...)
+
+			fundMsg := types.NewMsgFundSponsorshipPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, fee, expRefund, suite.chainA.SenderAccount.GetAddress().String())
+			_, err := suite.chainA.GetSimApp().IBCFeeKeeper.FundSponsorshipPool(sdk.WrapSDKContext(suite.chainA.GetContext()), fundMsg)
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgWithdrawSponsorshipPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			_, err = suite.chainA.GetSimApp().IBCFeeKeeper.WithdrawSponsorshipPool(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			if tc.expPass {
+				suite.Require().NoError(err) // message committed
+
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetSponsorshipPool(suite.chainA.GetContext(), msg.PortId, msg.ChannelId, msg.Signer)
+				suite.Require().False(found)
+
+				suite.Require().Equal(balanceBefore.Add(sdk.NewCoin(sdk.DefaultBondDenom, expRefund.AmountOf(sdk.DefaultBondDenom))), balance)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(balanceBefore, balance)
+			}
+		})
+	}
+}
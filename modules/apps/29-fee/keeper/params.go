@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+)
+
+// GetMinFeeLockTime retrieves the minimum fee lock time from the paramstore
+func (k Keeper) GetMinFeeLockTime(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyMinFeeLockTime, &res)
+	return res
+}
+
+// GetMaxSponsorshipPoolsPerChannel retrieves the maximum number of sponsorship pools per channel from the paramstore
+func (k Keeper) GetMaxSponsorshipPoolsPerChannel(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyMaxSponsorshipPoolsPerChannel, &res)
+	return res
+}
+
+// GetParams returns the total set of fee middleware parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.GetMinFeeLockTime(ctx), k.GetMaxSponsorshipPoolsPerChannel(ctx))
+}
+
+// SetParams sets the total set of fee middleware parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
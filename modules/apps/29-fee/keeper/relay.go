@@ -13,8 +13,18 @@ import (
 )
 
 // SendPacket wraps IBC ChannelKeeper's SendPacket function
+// If the source channel is fee enabled, the fees of the sponsorship pools of the channel are escrowed for the packet
 func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
-	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+	if err := k.ics4Wrapper.SendPacket(ctx, chanCap, packet); err != nil {
+		return err
+	}
+
+	if k.IsFeeEnabled(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) && !k.IsLocked(ctx) {
+		packetID := channeltypes.NewPacketId(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		k.escrowPacketFeesFromSponsorshipPools(ctx, packetID)
+	}
+
+	return nil
 }
 
 // WriteAcknowledgement wraps IBC ChannelKeeper's WriteAcknowledgement function
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
@@ -8,6 +10,146 @@ import (
 	ibcmock "github.com/cosmos/ibc-go/v4/testing/mock"
 )
 
+func (suite *KeeperTestSuite) TestSendPacketSponsorshipPools() {
+	var (
+		fee             types.Fee
+		sponsor         sdk.AccAddress
+		otherSponsor    sdk.AccAddress
+		expFeesInEscrow []types.PacketFee
+		expPools        []types.SponsorshipPool
+		expRefund       sdk.Coins
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: fee escrowed from pool",
+			func() {},
+		},
+		{
+			"success: fees escrowed from multiple pools",
+			func() {
+				pool := types.NewSponsorshipPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, otherSponsor.String(), fee, fee.Total().Add(fee.Total()...))
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetSponsorshipPool(suite.chainA.GetContext(), pool)
+
+				pool.Balance = fee.Total()
+				expPools = append(expPools, pool)
+
+				expFeesInEscrow = append(expFeesInEscrow, types.NewPacketFee(fee, otherSponsor.String(), nil))
+			},
+		},
+		{
+			"success: fees escrowed from at most the maximum number of pools",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(types.DefaultMinFeeLockTime, 1))
+
+				otherPool := types.NewSponsorshipPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, otherSponsor.String(), fee, fee.Total().Add(fee.Total()...))
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetSponsorshipPool(suite.chainA.GetContext(), otherPool)
+
+				// only the first pool in store order is used
+				if otherSponsor.String() < sponsor.String() {
+					expPools[0].Balance = expPools[0].Balance.Add(fee.Total()...)
+					otherPool.Balance = fee.Total()
+					expFeesInEscrow = []types.PacketFee{types.NewPacketFee(fee, otherSponsor.String(), nil)}
+				}
+
+				expPools = append(expPools, otherPool)
+			},
+		},
+		{
+			"no fees escrowed: sponsorship pools are disabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(types.DefaultMinFeeLockTime, 0))
+
+				expFeesInEscrow = nil
+				expPools[0].Balance = expPools[0].Balance.Add(fee.Total()...)
+			},
+		},
+		{
+			"success: exhausted pool is closed and the remaining balance refunded",
+			func() {
+				remaining := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50)))
+				pool := types.NewSponsorshipPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sponsor.String(), fee, fee.Total().Add(remaining...))
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetSponsorshipPool(suite.chainA.GetContext(), pool)
+
+				expPools = nil
+				expRefund = remaining
+			},
+		},
+		{
+			"no fees escrowed: fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+
+				expFeesInEscrow = nil
+				expPools[0].Balance = expPools[0].Balance.Add(fee.Total()...)
+			},
+		},
+		{
+			"no fees escrowed: channel is not fee enabled",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+
+				expFeesInEscrow = nil
+				expPools[0].Balance = expPools[0].Balance.Add(fee.Total()...)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path)
+
+			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			sponsor = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			otherSponsor = suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+
+			// the funds of the pools are held by the fee module account
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total().Add(fee.Total()...).Add(fee.Total()...).Add(fee.Total()...))
+			suite.Require().NoError(err)
+
+			pool := types.NewSponsorshipPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sponsor.String(), fee, fee.Total().Add(fee.Total()...))
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetSponsorshipPool(suite.chainA.GetContext(), pool)
+
+			pool.Balance = fee.Total()
+			expPools = []types.SponsorshipPool{pool}
+			expFeesInEscrow = []types.PacketFee{types.NewPacketFee(fee, sponsor.String(), nil)}
+			expRefund = sdk.NewCoins()
+
+			tc.malleate()
+
+			sponsorBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sponsor, sdk.DefaultBondDenom)
+
+			packet := channeltypes.NewPacket(ibcmock.MockPacketData, 1, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, clienttypes.NewHeight(clienttypes.ParseChainID(suite.chainB.ChainID), 100), 0)
+			chanCap := suite.chainA.GetChannelCapability(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+
+			err = suite.chainA.GetSimApp().IBCFeeKeeper.SendPacket(suite.chainA.GetContext(), chanCap, packet)
+			suite.Require().NoError(err)
+
+			packetID := channeltypes.NewPacketId(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+			suite.Require().Equal(len(expFeesInEscrow) != 0, found)
+			suite.Require().Len(feesInEscrow.PacketFees, len(expFeesInEscrow))
+
+			for _, expPacketFee := range expFeesInEscrow {
+				expPacketFee.EscrowTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+				suite.Require().Contains(feesInEscrow.PacketFees, expPacketFee)
+			}
+
+			pools := suite.chainA.GetSimApp().IBCFeeKeeper.GetSponsorshipPoolsForChannel(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			suite.Require().ElementsMatch(expPools, pools)
+
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sponsor, sdk.DefaultBondDenom)
+			suite.Require().Equal(sponsorBalance.Amount.Add(expRefund.AmountOf(sdk.DefaultBondDenom)), balance.Amount)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestWriteAcknowledgementAsync() {
 	testCases := []struct {
 		name     string
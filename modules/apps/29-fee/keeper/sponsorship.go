@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// fundSponsorshipPool deposits the given amount from the sponsor into its sponsorship pool on the given channel,
+// creating the pool if it does not exist and the channel has fewer pools than the MaxSponsorshipPoolsPerChannel
+// param. The fee of the pool is replaced by the given fee. The balance of the pool must cover the fee of at least
+// one packet.
+func (k Keeper) fundSponsorshipPool(ctx sdk.Context, portID, channelID, sponsor string, fee types.Fee, amount sdk.Coins) error {
+	sponsorAddr, err := sdk.AccAddressFromBech32(sponsor)
+	if err != nil {
+		return err
+	}
+
+	pool, found := k.GetSponsorshipPool(ctx, portID, channelID, sponsor)
+	if !found {
+		maxPools := k.GetMaxSponsorshipPoolsPerChannel(ctx)
+		pools := k.getSponsorshipPools(ctx, types.KeySponsorshipPoolChannelPrefix(portID, channelID), maxPools)
+		if uint64(len(pools)) >= maxPools {
+			return sdkerrors.Wrapf(types.ErrMaxSponsorshipPools, "port ID (%s) channel ID (%s) maximum (%d)", portID, channelID, maxPools)
+		}
+
+		pool = types.NewSponsorshipPool(portID, channelID, sponsor, fee, sdk.NewCoins())
+	}
+
+	pool.Fee = fee
+	pool.Balance = pool.Balance.Add(amount...)
+
+	if !pool.CanIncentivizePacket() {
+		return sdkerrors.Wrapf(types.ErrInsufficientPoolBalance, "pool balance %s is less than the packet fee %s", pool.Balance, fee.Total())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sponsorAddr, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	k.SetSponsorshipPool(ctx, pool)
+
+	EmitFundSponsorshipPoolEvent(ctx, pool, amount)
+
+	return nil
+}
+
+// withdrawSponsorshipPool refunds the remaining balance of the sponsorship pool of the sponsor on the given
+// channel to the sponsor and deletes the pool.
+func (k Keeper) withdrawSponsorshipPool(ctx sdk.Context, portID, channelID, sponsor string) error {
+	pool, found := k.GetSponsorshipPool(ctx, portID, channelID, sponsor)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrSponsorshipPoolNotFound, "port ID (%s) channel ID (%s) sponsor (%s)", portID, channelID, sponsor)
+	}
+
+	return k.closeSponsorshipPool(ctx, pool)
+}
+
+// closeSponsorshipPool refunds the remaining balance of the given sponsorship pool to its sponsor and deletes the pool.
+func (k Keeper) closeSponsorshipPool(ctx sdk.Context, pool types.SponsorshipPool) error {
+	sponsorAddr, err := sdk.AccAddressFromBech32(pool.Sponsor)
+	if err != nil {
+		return err
+	}
+
+	if !pool.Balance.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sponsorAddr, pool.Balance); err != nil {
+			return err
+		}
+	}
+
+	k.DeleteSponsorshipPool(ctx, pool.PortId, pool.ChannelId, pool.Sponsor)
+
+	EmitWithdrawSponsorshipPoolEvent(ctx, pool)
+
+	return nil
+}
+
+// escrowPacketFeesFromSponsorshipPools escrows the fee of each sponsorship pool of the packet's source channel for
+// the given packetID. The funds of the pools are already held by the 29-fee module account. The sponsor is the refund
+// address of the escrowed fees. A pool whose remaining balance no longer covers its fee is closed and its remaining
+// balance is refunded to the sponsor. At most MaxSponsorshipPoolsPerChannel pools are used, such that the work done
+// per packet is bounded, pools in excess of a lowered maximum are ignored until they are withdrawn.
+func (k Keeper) escrowPacketFeesFromSponsorshipPools(ctx sdk.Context, packetID channeltypes.PacketId) {
+	maxPools := k.GetMaxSponsorshipPoolsPerChannel(ctx)
+	if maxPools == 0 {
+		return
+	}
+
+	for _, pool := range k.getSponsorshipPools(ctx, types.KeySponsorshipPoolChannelPrefix(packetID.PortId, packetID.ChannelId), maxPools) {
+		if pool.CanIncentivizePacket() {
+			pool.Balance = pool.Balance.Sub(pool.Fee.Total())
+			k.addPacketFeeInEscrow(ctx, packetID, types.NewPacketFee(pool.Fee, pool.Sponsor, nil))
+
+			EmitSponsoredPacketFeeEvent(ctx, packetID, pool)
+		}
+
+		if pool.CanIncentivizePacket() {
+			k.SetSponsorshipPool(ctx, pool)
+			continue
+		}
+
+		// the pool is exhausted, the refund is attempted in a cached context so that a failed refund
+		// does not affect the sending of the packet, in which case the pool remains in state
+		cacheCtx, writeFn := ctx.CacheContext()
+		if err := k.closeSponsorshipPool(cacheCtx, pool); err != nil {
+			k.Logger(ctx).Error("failed to close exhausted sponsorship pool", "port", pool.PortId, "channel", pool.ChannelId, "sponsor", pool.Sponsor, "error", err.Error())
+
+			k.SetSponsorshipPool(ctx, pool)
+			continue
+		}
+
+		writeFn()
+
+		// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+)
+
+// HandleUnlockFeeModuleProposal reconciles the escrow accounting of the fee module and unlocks the fee module.
+// The total escrowed is the sum of all escrowed packet fees and sponsorship pool balances. Any amount by which the
+// balance of the fee module account falls short of the total escrowed is either funded from the community pool or
+// reconciled by reducing all escrowed amounts pro rata to match the balance of the fee module account.
+// Please see ADR 004 for more information.
+func (k Keeper) HandleUnlockFeeModuleProposal(ctx sdk.Context, p *types.UnlockFeeModuleProposal) error {
+	if !k.IsLocked(ctx) {
+		return types.ErrFeeModuleNotLocked
+	}
+
+	totalEscrowed, escrowBalance, shortfall := k.getEscrowDiscrepancy(ctx)
+
+	reconciliation := types.ReconciliationProRata
+	if p.FundFromCommunityPool {
+		reconciliation = types.ReconciliationCommunityPool
+	}
+
+	if !shortfall.IsZero() {
+		if p.FundFromCommunityPool {
+			if err := k.fundShortfallFromCommunityPool(ctx, shortfall); err != nil {
+				return err
+			}
+		} else {
+			if err := k.reduceEscrowedAmountsProRata(ctx, totalEscrowed, escrowBalance, shortfall); err != nil {
+				return err
+			}
+		}
+	}
+
+	k.unlockFeeModule(ctx)
+
+	EmitUnlockFeeModuleEvent(ctx, shortfall, reconciliation)
+
+	k.Logger(ctx).Info("fee module unlocked", "shortfall", shortfall, "reconciliation", reconciliation)
+
+	return nil
+}
+
+// getTotalEscrowed returns the sum of all escrowed packet fees and sponsorship pool balances
+func (k Keeper) getTotalEscrowed(ctx sdk.Context) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, identifiedFees := range k.GetAllIdentifiedPacketFees(ctx) {
+		for _, packetFee := range identifiedFees.PacketFees {
+			total = total.Add(packetFee.Fee.Total()...)
+		}
+	}
+
+	for _, pool := range k.GetAllSponsorshipPools(ctx) {
+		total = total.Add(pool.Balance...)
+	}
+
+	return total
+}
+
+// getEscrowDiscrepancy returns the total escrowed, the balance of the fee module account and the amount by which
+// the balance falls short of the total escrowed
+func (k Keeper) getEscrowDiscrepancy(ctx sdk.Context) (totalEscrowed, escrowBalance, shortfall sdk.Coins) {
+	totalEscrowed = k.getTotalEscrowed(ctx)
+	escrowBalance = k.bankKeeper.GetAllBalances(ctx, k.GetFeeModuleAddress())
+
+	shortfall = sdk.NewCoins()
+	for _, coin := range totalEscrowed {
+		if balance := escrowBalance.AmountOf(coin.Denom); balance.LT(coin.Amount) {
+			shortfall = shortfall.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(balance)))
+		}
+	}
+
+	return totalEscrowed, escrowBalance, shortfall
+}
+
+// communityPoolCoversShortfall returns true if the community pool holds sufficient funds to cover the given shortfall
+func (k Keeper) communityPoolCoversShortfall(ctx sdk.Context, shortfall sdk.Coins) bool {
+	_, hasNeg := k.distrKeeper.GetFeePool(ctx).CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(shortfall...))
+	return !hasNeg
+}
+
+// fundShortfallFromCommunityPool transfers the given shortfall from the community pool to the fee module account
+func (k Keeper) fundShortfallFromCommunityPool(ctx sdk.Context, shortfall sdk.Coins) error {
+	feePool := k.distrKeeper.GetFeePool(ctx)
+
+	communityPool, hasNeg := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(shortfall...))
+	if hasNeg {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "community pool %s is insufficient to cover the escrow shortfall %s", feePool.CommunityPool, shortfall)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, distrtypes.ModuleName, types.ModuleName, shortfall); err != nil {
+		return err
+	}
+
+	feePool.CommunityPool = communityPool
+	k.distrKeeper.SetFeePool(ctx, feePool)
+
+	return nil
+}
+
+// reduceEscrowedAmountsProRata reduces every escrowed packet fee and sponsorship pool balance in each denomination of the
+// shortfall by the ratio of the escrow balance to the total escrowed. Packet fees which are reduced to zero are removed,
+// sponsorship pools which can no longer incentivize a packet are closed and their remaining balance refunded.
+func (k Keeper) reduceEscrowedAmountsProRata(ctx sdk.Context, totalEscrowed, escrowBalance, shortfall sdk.Coins) error {
+	ratios := make(map[string]sdk.Dec)
+	for _, coin := range shortfall {
+		ratios[coin.Denom] = escrowBalance.AmountOf(coin.Denom).ToDec().QuoInt(totalEscrowed.AmountOf(coin.Denom))
+	}
+
+	for _, identifiedFees := range k.GetAllIdentifiedPacketFees(ctx) {
+		var packetFees []types.PacketFee
+		for _, packetFee := range identifiedFees.PacketFees {
+			packetFee.Fee = types.NewFee(
+				reduceCoins(packetFee.Fee.RecvFee, ratios),
+				reduceCoins(packetFee.Fee.AckFee, ratios),
+				reduceCoins(packetFee.Fee.TimeoutFee, ratios),
+			)
+
+			if !packetFee.Fee.Total().IsZero() {
+				packetFees = append(packetFees, packetFee)
+			}
+		}
+
+		if len(packetFees) == 0 {
+			k.DeleteFeesInEscrow(ctx, identifiedFees.PacketId)
+		} else {
+			k.SetFeesInEscrow(ctx, identifiedFees.PacketId, types.NewPacketFees(packetFees))
+		}
+
+		EmitIncentivizedPacketEvent(ctx, identifiedFees.PacketId, types.NewPacketFees(packetFees))
+	}
+
+	for _, pool := range k.GetAllSponsorshipPools(ctx) {
+		pool.Balance = reduceCoins(pool.Balance, ratios)
+
+		if !pool.CanIncentivizePacket() {
+			if err := k.closeSponsorshipPool(ctx, pool); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		k.SetSponsorshipPool(ctx, pool)
+	}
+
+	return nil
+}
+
+// reduceCoins multiplies the amount of each coin by the ratio of its denomination, if any, truncating the result.
+// Coins which are reduced to zero are removed.
+func reduceCoins(coins sdk.Coins, ratios map[string]sdk.Dec) sdk.Coins {
+	reduced := sdk.NewCoins()
+	for _, coin := range coins {
+		if ratio, ok := ratios[coin.Denom]; ok {
+			coin.Amount = ratio.MulInt(coin.Amount).TruncateInt()
+		}
+
+		reduced = reduced.Add(coin)
+	}
+
+	return reduced
+}
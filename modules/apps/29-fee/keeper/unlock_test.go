@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func (suite *KeeperTestSuite) TestHandleUnlockFeeModuleProposal() {
+	var (
+		proposal         *types.UnlockFeeModuleProposal
+		packetID         channeltypes.PacketId
+		fee              types.Fee
+		expFeesInEscrow  []types.PacketFee
+		expPool          *types.SponsorshipPool
+		expEscrowBalance sdk.Coins
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: shortfall funded from the community pool",
+			func() {
+				err := suite.chainA.GetSimApp().DistrKeeper.FundCommunityPool(suite.chainA.GetContext(), fee.Total(), suite.chainA.SenderAccount.GetAddress())
+				suite.Require().NoError(err)
+
+				expEscrowBalance = fee.Total().Add(fee.Total()...)
+			},
+			true,
+		},
+		{
+			"success: no shortfall",
+			func() {
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total())
+				suite.Require().NoError(err)
+
+				expEscrowBalance = fee.Total().Add(fee.Total()...)
+			},
+			true,
+		},
+		{
+			"success: escrowed amounts reduced pro rata",
+			func() {
+				proposal.FundFromCommunityPool = false
+
+				// the escrow balance covers half of the total escrowed
+				halfFee := types.NewFee(
+					sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, defaultRecvFee.AmountOf(sdk.DefaultBondDenom).QuoRaw(2))),
+					sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, defaultAckFee.AmountOf(sdk.DefaultBondDenom).QuoRaw(2))),
+					sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, defaultTimeoutFee.AmountOf(sdk.DefaultBondDenom).QuoRaw(2))),
+				)
+
+				halfPacketFee := types.NewPacketFee(halfFee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+				expFeesInEscrow = []types.PacketFee{halfPacketFee, halfPacketFee}
+				expEscrowBalance = fee.Total()
+			},
+			true,
+		},
+		{
+			"success: sponsorship pool which can no longer incentivize a packet is closed",
+			func() {
+				proposal.FundFromCommunityPool = false
+
+				pool := types.NewSponsorshipPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), fee, fee.Total())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetSponsorshipPool(suite.chainA.GetContext(), pool)
+
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total())
+				suite.Require().NoError(err)
+
+				// the escrow balance covers two thirds of the total escrowed, the reduced pool balance of 399stake is refunded to the sponsor
+				// NOTE: the reduced amounts are truncated, such that the escrow balance always covers the reduced total escrowed
+				reducedFee := types.NewFee(
+					sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(66))),
+					sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(133))),
+					sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(199))),
+				)
+				expFeesInEscrow[0].Fee = reducedFee
+				expFeesInEscrow[1].Fee = reducedFee
+				expEscrowBalance = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(801)))
+			},
+			true,
+		},
+		{
+			"success: sponsorship pool reduced pro rata",
+			func() {
+				proposal.FundFromCommunityPool = false
+
+				pool := types.NewSponsorshipPool(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), fee, fee.Total().Add(fee.Total()...).Add(fee.Total()...))
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetSponsorshipPool(suite.chainA.GetContext(), pool)
+
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total())
+				suite.Require().NoError(err)
+
+				// the escrow balance covers two fifths of the total escrowed
+				reducedFee := types.NewFee(
+					sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(40))),
+					sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(80))),
+					sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(120))),
+				)
+				expFeesInEscrow[0].Fee = reducedFee
+				expFeesInEscrow[1].Fee = reducedFee
+
+				pool.Balance = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(720)))
+				expPool = &pool
+				expEscrowBalance = fee.Total().Add(fee.Total()...)
+			},
+			true,
+		},
+		{
+			"community pool is insufficient to cover the shortfall",
+			func() {
+				suite.chainA.GetSimApp().DistrKeeper.SetFeePool(suite.chainA.GetContext(), distrtypes.InitialFeePool())
+			},
+			false,
+		},
+		{
+			"fee module is not locked",
+			func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.ModuleName))
+				store.Delete(types.KeyLocked())
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path) // setup channel
+
+			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			packetID = channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+
+			// escrow two packet fees, while only a single packet fee is held by the fee module account
+			packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee, packetFee}))
+
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total())
+			suite.Require().NoError(err)
+
+			lockFeeModule(suite.chainA)
+
+			expFeesInEscrow = []types.PacketFee{packetFee, packetFee}
+			expPool = nil
+			proposal = types.NewUnlockFeeModuleProposal(ibctesting.Title, ibctesting.Description, true).(*types.UnlockFeeModuleProposal)
+
+			tc.malleate()
+
+			err = suite.chainA.GetSimApp().IBCFeeKeeper.HandleUnlockFeeModuleProposal(suite.chainA.GetContext(), proposal)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+
+				feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().True(found)
+				suite.Require().Equal(expFeesInEscrow, feesInEscrow.PacketFees)
+
+				pools := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllSponsorshipPools(suite.chainA.GetContext())
+				if expPool != nil {
+					suite.Require().Equal([]types.SponsorshipPool{*expPool}, pools)
+				} else {
+					suite.Require().Empty(pools)
+				}
+
+				escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress())
+				suite.Require().Equal(expEscrowBalance, escrowBalance)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/ibc 29-fee interfaces and concrete types
@@ -14,6 +15,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPayPacketFeeAsync{}, "cosmos-sdk/MsgPayPacketFeeAsync", nil)
 	cdc.RegisterConcrete(&MsgRegisterPayee{}, "cosmos-sdk/MsgRegisterPayee", nil)
 	cdc.RegisterConcrete(&MsgRegisterCounterpartyPayee{}, "cosmos-sdk/MsgRegisterCounterpartyPayee", nil)
+	cdc.RegisterConcrete(&MsgCancelPacketFee{}, "cosmos-sdk/MsgCancelPacketFee", nil)
+	cdc.RegisterConcrete(&MsgIncreasePacketFee{}, "cosmos-sdk/MsgIncreasePacketFee", nil)
+	cdc.RegisterConcrete(&MsgFundSponsorshipPool{}, "cosmos-sdk/MsgFundSponsorshipPool", nil)
+	cdc.RegisterConcrete(&MsgWithdrawSponsorshipPool{}, "cosmos-sdk/MsgWithdrawSponsorshipPool", nil)
 }
 
 // RegisterInterfaces register the 29-fee module interfaces to protobuf
@@ -25,6 +30,14 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgPayPacketFeeAsync{},
 		&MsgRegisterPayee{},
 		&MsgRegisterCounterpartyPayee{},
+		&MsgCancelPacketFee{},
+		&MsgIncreasePacketFee{},
+		&MsgFundSponsorshipPool{},
+		&MsgWithdrawSponsorshipPool{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&UnlockFeeModuleProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -16,4 +16,10 @@ var (
 	ErrFeeNotEnabled                 = sdkerrors.Register(ModuleName, 9, "fee module is not enabled for this channel. If this error occurs after channel setup, fee module may not be enabled")
 	ErrRelayerNotFoundForAsyncAck    = sdkerrors.Register(ModuleName, 10, "relayer address must be stored for async WriteAcknowledgement")
 	ErrFeeModuleLocked               = sdkerrors.Register(ModuleName, 11, "the fee module is currently locked, a severe bug has been detected")
+	ErrFeeStillLocked                = sdkerrors.Register(ModuleName, 12, "packet fee has not been held in escrow for the minimum fee lock time")
+	ErrInvalidFeeIndex               = sdkerrors.Register(ModuleName, 13, "invalid packet fee index")
+	ErrSponsorshipPoolNotFound       = sdkerrors.Register(ModuleName, 14, "sponsorship pool not found")
+	ErrInsufficientPoolBalance       = sdkerrors.Register(ModuleName, 15, "sponsorship pool balance is insufficient to incentivize a packet")
+	ErrFeeModuleNotLocked            = sdkerrors.Register(ModuleName, 16, "the fee module is not locked")
+	ErrMaxSponsorshipPools           = sdkerrors.Register(ModuleName, 17, "maximum number of sponsorship pools reached for channel")
 )
@@ -5,6 +5,11 @@ const (
 	EventTypeIncentivizedPacket        = "incentivized_ibc_packet"
 	EventTypeRegisterPayee             = "register_payee"
 	EventTypeRegisterCounterpartyPayee = "register_counterparty_payee"
+	EventTypeCancelPacketFee           = "cancel_packet_fee"
+	EventTypeFundSponsorshipPool       = "fund_sponsorship_pool"
+	EventTypeWithdrawSponsorshipPool   = "withdraw_sponsorship_pool"
+	EventTypeSponsoredPacketFee        = "sponsored_packet_fee"
+	EventTypeUnlockFeeModule           = "unlock_fee_module"
 
 	AttributeKeyRecvFee           = "recv_fee"
 	AttributeKeyAckFee            = "ack_fee"
@@ -13,4 +18,11 @@ const (
 	AttributeKeyRelayer           = "relayer"
 	AttributeKeyPayee             = "payee"
 	AttributeKeyCounterpartyPayee = "counterparty_payee"
+	AttributeKeyRefundAddress     = "refund_address"
+	AttributeKeyRefundedFee       = "refunded_fee"
+	AttributeKeySponsor           = "sponsor"
+	AttributeKeyAmount            = "amount"
+	AttributeKeyPoolBalance       = "pool_balance"
+	AttributeKeyShortfall         = "shortfall"
+	AttributeKeyReconciliation    = "reconciliation"
 )
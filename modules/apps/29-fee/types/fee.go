@@ -7,6 +7,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
 // NewPacketFee creates and returns a new PacketFee struct including the incentivization fees, refund addres and relayers
@@ -66,6 +67,11 @@ func (f Fee) Total() sdk.Coins {
 	return f.RecvFee.Add(f.AckFee...).Add(f.TimeoutFee...)
 }
 
+// Add returns the sum of the given Fee and the other Fee
+func (f Fee) Add(other Fee) Fee {
+	return NewFee(f.RecvFee.Add(other.RecvFee...), f.AckFee.Add(other.AckFee...), f.TimeoutFee.Add(other.TimeoutFee...))
+}
+
 // Validate asserts that each Fee is valid and all three Fees are not empty or zero
 func (fee Fee) Validate() error {
 	var errFees []string
@@ -90,3 +96,44 @@ func (fee Fee) Validate() error {
 
 	return nil
 }
+
+// NewSponsorshipPool creates and returns a new SponsorshipPool struct for the given sponsor on the given channel
+func NewSponsorshipPool(portID, channelID, sponsor string, fee Fee, balance sdk.Coins) SponsorshipPool {
+	return SponsorshipPool{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sponsor:   sponsor,
+		Fee:       fee,
+		Balance:   balance,
+	}
+}
+
+// Validate performs basic stateless validation of the associated SponsorshipPool
+func (p SponsorshipPool) Validate() error {
+	if err := host.PortIdentifierValidator(p.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Sponsor); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert Sponsor into sdk.AccAddress")
+	}
+
+	if err := p.Fee.Validate(); err != nil {
+		return err
+	}
+
+	if !p.Balance.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid sponsorship pool balance: %s", p.Balance)
+	}
+
+	return nil
+}
+
+// CanIncentivizePacket returns true if the balance of the pool covers the fee of the pool
+func (p SponsorshipPool) CanIncentivizePacket() bool {
+	return p.Balance.IsAllGTE(p.Fee.Total())
+}
@@ -26,6 +26,63 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Params defines the set of ICS29 fee middleware parameters.
+type Params struct {
+	// min_fee_lock_time is the minimum time in nanoseconds a packet fee must remain in escrow
+	// before it may be cancelled by its refund address
+	MinFeeLockTime uint64 `protobuf:"varint,1,opt,name=min_fee_lock_time,json=minFeeLockTime,proto3" json:"min_fee_lock_time,omitempty" yaml:"min_fee_lock_time"`
+	// max_sponsorship_pools_per_channel is the maximum number of sponsorship pools funded on a channel, zero disables
+	// sponsorship pools
+	MaxSponsorshipPoolsPerChannel uint64 `protobuf:"varint,2,opt,name=max_sponsorship_pools_per_channel,json=maxSponsorshipPoolsPerChannel,proto3" json:"max_sponsorship_pools_per_channel,omitempty" yaml:"max_sponsorship_pools_per_channel"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinFeeLockTime() uint64 {
+	if m != nil {
+		return m.MinFeeLockTime
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSponsorshipPoolsPerChannel() uint64 {
+	if m != nil {
+		return m.MaxSponsorshipPoolsPerChannel
+	}
+	return 0
+}
+
 // Fee defines the ICS29 receive, acknowledgement and timeout fees
 type Fee struct {
 	// the packet receive fee
@@ -40,7 +97,7 @@ func (m *Fee) Reset()         { *m = Fee{} }
 func (m *Fee) String() string { return proto.CompactTextString(m) }
 func (*Fee) ProtoMessage()    {}
 func (*Fee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb3319f1af2a53e5, []int{0}
+	return fileDescriptor_cb3319f1af2a53e5, []int{1}
 }
 func (m *Fee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -98,13 +155,15 @@ type PacketFee struct {
 	RefundAddress string `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty" yaml:"refund_address"`
 	// optional list of relayers permitted to receive fees
 	Relayers []string `protobuf:"bytes,3,rep,name=relayers,proto3" json:"relayers,omitempty"`
+	// the block time in unix nanoseconds at which the fee was last escrowed, set by the fee middleware
+	EscrowTimestamp uint64 `protobuf:"varint,4,opt,name=escrow_timestamp,json=escrowTimestamp,proto3" json:"escrow_timestamp,omitempty" yaml:"escrow_timestamp"`
 }
 
 func (m *PacketFee) Reset()         { *m = PacketFee{} }
 func (m *PacketFee) String() string { return proto.CompactTextString(m) }
 func (*PacketFee) ProtoMessage()    {}
 func (*PacketFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb3319f1af2a53e5, []int{1}
+	return fileDescriptor_cb3319f1af2a53e5, []int{2}
 }
 func (m *PacketFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -154,6 +213,13 @@ func (m *PacketFee) GetRelayers() []string {
 	return nil
 }
 
+func (m *PacketFee) GetEscrowTimestamp() uint64 {
+	if m != nil {
+		return m.EscrowTimestamp
+	}
+	return 0
+}
+
 // PacketFees contains a list of type PacketFee
 type PacketFees struct {
 	// list of packet fees
@@ -164,7 +230,7 @@ func (m *PacketFees) Reset()         { *m = PacketFees{} }
 func (m *PacketFees) String() string { return proto.CompactTextString(m) }
 func (*PacketFees) ProtoMessage()    {}
 func (*PacketFees) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb3319f1af2a53e5, []int{2}
+	return fileDescriptor_cb3319f1af2a53e5, []int{3}
 }
 func (m *PacketFees) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,7 +278,7 @@ func (m *IdentifiedPacketFees) Reset()         { *m = IdentifiedPacketFees{} }
 func (m *IdentifiedPacketFees) String() string { return proto.CompactTextString(m) }
 func (*IdentifiedPacketFees) ProtoMessage()    {}
 func (*IdentifiedPacketFees) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb3319f1af2a53e5, []int{3}
+	return fileDescriptor_cb3319f1af2a53e5, []int{4}
 }
 func (m *IdentifiedPacketFees) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -255,50 +321,233 @@ func (m *IdentifiedPacketFees) GetPacketFees() []PacketFee {
 	return nil
 }
 
+// SponsorshipPool defines the funds deposited by a sponsor to incentivize all the packets sent on a channel
+type SponsorshipPool struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// the sponsor address, which is the refund address of the packet fees escrowed from the pool
+	Sponsor string `protobuf:"bytes,3,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// the fee escrowed from the pool for each packet sent on the channel
+	Fee Fee `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee"`
+	// the remaining balance of the pool
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *SponsorshipPool) Reset()         { *m = SponsorshipPool{} }
+func (m *SponsorshipPool) String() string { return proto.CompactTextString(m) }
+func (*SponsorshipPool) ProtoMessage()    {}
+func (*SponsorshipPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{5}
+}
+func (m *SponsorshipPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorshipPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorshipPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsorshipPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorshipPool.Merge(m, src)
+}
+func (m *SponsorshipPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorshipPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorshipPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorshipPool proto.InternalMessageInfo
+
+func (m *SponsorshipPool) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *SponsorshipPool) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *SponsorshipPool) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *SponsorshipPool) GetFee() Fee {
+	if m != nil {
+		return m.Fee
+	}
+	return Fee{}
+}
+
+func (m *SponsorshipPool) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+// UnlockFeeModuleProposal is a gov Content type for unlocking the fee middleware once it has been locked
+// due to an insufficient escrow balance. The escrow shortfall is reconciled before the fee middleware is unlocked.
+type UnlockFeeModuleProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// if true, the escrow shortfall is funded from the community pool, otherwise the escrowed packet fees and
+	// sponsorship pool balances are reduced pro rata to match the escrow balance
+	FundFromCommunityPool bool `protobuf:"varint,3,opt,name=fund_from_community_pool,json=fundFromCommunityPool,proto3" json:"fund_from_community_pool,omitempty" yaml:"fund_from_community_pool"`
+}
+
+func (m *UnlockFeeModuleProposal) Reset()         { *m = UnlockFeeModuleProposal{} }
+func (m *UnlockFeeModuleProposal) String() string { return proto.CompactTextString(m) }
+func (*UnlockFeeModuleProposal) ProtoMessage()    {}
+func (*UnlockFeeModuleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{6}
+}
+func (m *UnlockFeeModuleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlockFeeModuleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockFeeModuleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnlockFeeModuleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockFeeModuleProposal.Merge(m, src)
+}
+func (m *UnlockFeeModuleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlockFeeModuleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockFeeModuleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockFeeModuleProposal proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterType((*Params)(nil), "ibc.applications.fee.v1.Params")
 	proto.RegisterType((*Fee)(nil), "ibc.applications.fee.v1.Fee")
 	proto.RegisterType((*PacketFee)(nil), "ibc.applications.fee.v1.PacketFee")
 	proto.RegisterType((*PacketFees)(nil), "ibc.applications.fee.v1.PacketFees")
 	proto.RegisterType((*IdentifiedPacketFees)(nil), "ibc.applications.fee.v1.IdentifiedPacketFees")
+	proto.RegisterType((*SponsorshipPool)(nil), "ibc.applications.fee.v1.SponsorshipPool")
+	proto.RegisterType((*UnlockFeeModuleProposal)(nil), "ibc.applications.fee.v1.UnlockFeeModuleProposal")
 }
 
 func init() { proto.RegisterFile("ibc/applications/fee/v1/fee.proto", fileDescriptor_cb3319f1af2a53e5) }
 
 var fileDescriptor_cb3319f1af2a53e5 = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x31, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0x73, 0x09, 0x6a, 0x1b, 0x47, 0x14, 0x74, 0x2a, 0x22, 0x8d, 0xe0, 0x52, 0x3c, 0x65,
-	0x89, 0xad, 0x84, 0x32, 0xc0, 0x04, 0x57, 0x29, 0x52, 0x27, 0xd0, 0x89, 0x89, 0x25, 0xf2, 0xd9,
-	0x2f, 0xa9, 0x95, 0xdc, 0xf9, 0x74, 0xbe, 0x44, 0xca, 0xca, 0x27, 0xe0, 0x1b, 0xb0, 0xf3, 0x49,
-	0xba, 0x20, 0x75, 0x64, 0x0a, 0x28, 0xf9, 0x06, 0xdd, 0x91, 0x90, 0x7d, 0x4e, 0x94, 0x82, 0xaa,
-	0xaa, 0x12, 0xd3, 0xf9, 0xd9, 0xef, 0xef, 0xdf, 0xb3, 0xdf, 0xff, 0x8c, 0x5e, 0xc8, 0x98, 0x53,
-	0x96, 0x65, 0x53, 0xc9, 0x59, 0x21, 0x55, 0xaa, 0xe9, 0x08, 0x80, 0xce, 0x7b, 0xe6, 0x43, 0xb2,
-	0x5c, 0x15, 0xca, 0x7f, 0x2a, 0x63, 0x4e, 0x76, 0x53, 0x88, 0x59, 0x9b, 0xf7, 0x5a, 0x01, 0x57,
-	0x3a, 0x51, 0x9a, 0xc6, 0x4c, 0x1b, 0x49, 0x0c, 0x05, 0xeb, 0x51, 0xae, 0x64, 0x5a, 0x0a, 0x5b,
-	0x47, 0x63, 0x35, 0x56, 0x76, 0x48, 0xcd, 0xc8, 0xcd, 0x5a, 0x22, 0x57, 0x39, 0x50, 0x7e, 0xc1,
-	0xd2, 0x14, 0xa6, 0x86, 0xe6, 0x86, 0x65, 0x0a, 0xfe, 0x5d, 0x45, 0xb5, 0x01, 0x80, 0xbf, 0x40,
-	0x07, 0x39, 0xf0, 0xf9, 0x70, 0x04, 0xd0, 0xf4, 0x4e, 0x6a, 0x9d, 0x46, 0xff, 0x98, 0x94, 0x4c,
-	0x62, 0x98, 0xc4, 0x31, 0xc9, 0x99, 0x92, 0x69, 0x78, 0x76, 0xb9, 0x6c, 0x57, 0xae, 0x97, 0xed,
-	0x47, 0x0b, 0x96, 0x4c, 0xdf, 0xe0, 0x8d, 0x10, 0x7f, 0xfb, 0xd9, 0xee, 0x8c, 0x65, 0x71, 0x31,
-	0x8b, 0x09, 0x57, 0x09, 0x75, 0x35, 0x97, 0x9f, 0xae, 0x16, 0x13, 0x5a, 0x2c, 0x32, 0xd0, 0x76,
-	0x0f, 0x1d, 0xed, 0x1b, 0x99, 0x41, 0xcf, 0xd1, 0x3e, 0xe3, 0x13, 0x4b, 0xae, 0xde, 0x45, 0x0e,
-	0x1d, 0xf9, 0xb0, 0x24, 0x3b, 0xdd, 0xfd, 0xc0, 0x7b, 0x8c, 0x4f, 0x0c, 0xf7, 0xb3, 0x87, 0x1a,
-	0x85, 0x4c, 0x40, 0xcd, 0x0a, 0x0b, 0xaf, 0xdd, 0x05, 0x1f, 0x38, 0xb8, 0x5f, 0xc2, 0x77, 0xb4,
-	0xf7, 0x2b, 0x00, 0x39, 0xe5, 0x00, 0x00, 0x7f, 0xf5, 0x50, 0xfd, 0x03, 0xe3, 0x13, 0x30, 0x91,
-	0x7f, 0x8a, 0x6a, 0x65, 0x03, 0xbc, 0x4e, 0xa3, 0xff, 0x8c, 0xdc, 0xe2, 0x06, 0x32, 0x00, 0x08,
-	0x1f, 0x98, 0x62, 0x22, 0x93, 0xee, 0xbf, 0x45, 0x87, 0x39, 0x8c, 0x66, 0xa9, 0x18, 0x32, 0x21,
-	0x72, 0xd0, 0xba, 0x59, 0x3d, 0xf1, 0x3a, 0xf5, 0xf0, 0xf8, 0x7a, 0xd9, 0x7e, 0xb2, 0x69, 0xd1,
-	0xee, 0x3a, 0x8e, 0x1e, 0x96, 0x13, 0xef, 0xca, 0xd8, 0x6f, 0x99, 0xee, 0x4f, 0xd9, 0x02, 0x72,
-	0x6d, 0xaf, 0xa1, 0x1e, 0x6d, 0x63, 0x9c, 0x20, 0xb4, 0x2d, 0x50, 0xfb, 0x43, 0xd4, 0xc8, 0x6c,
-	0x64, 0x8e, 0xad, 0x9d, 0x55, 0xf0, 0xad, 0x95, 0x6e, 0x95, 0x61, 0xeb, 0xe6, 0xe5, 0xed, 0x6c,
-	0x82, 0x23, 0x94, 0x6d, 0x01, 0xf8, 0xbb, 0x87, 0x8e, 0xce, 0x05, 0xa4, 0x85, 0x1c, 0x49, 0x10,
-	0x3b, 0xe4, 0x8f, 0xa8, 0xee, 0x44, 0x52, 0xb8, 0x1b, 0x7a, 0x6e, 0xb9, 0xc6, 0xe0, 0x64, 0xe3,
-	0xea, 0x2d, 0xf3, 0x5c, 0x84, 0x4d, 0x87, 0x7c, 0x7c, 0x03, 0x29, 0x05, 0x8e, 0x0e, 0x32, 0x97,
-	0xf3, 0xf7, 0x79, 0xaa, 0xff, 0xfb, 0x3c, 0xe1, 0xfb, 0xcb, 0x55, 0xe0, 0x5d, 0xad, 0x02, 0xef,
-	0xd7, 0x2a, 0xf0, 0xbe, 0xac, 0x83, 0xca, 0xd5, 0x3a, 0xa8, 0xfc, 0x58, 0x07, 0x95, 0x4f, 0xaf,
-	0xfe, 0x35, 0x8c, 0x8c, 0x79, 0x77, 0xac, 0xe8, 0xfc, 0x94, 0x26, 0x4a, 0xcc, 0xa6, 0xa0, 0xcd,
-	0x7b, 0xa1, 0x69, 0xff, 0x75, 0xd7, 0x3c, 0x15, 0xd6, 0x43, 0xf1, 0x9e, 0xfd, 0x71, 0x5f, 0xfe,
-	0x09, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x01, 0xeb, 0x42, 0x4f, 0x04, 0x00, 0x00,
+	// 848 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0x6c, 0xb3, 0x99, 0x88, 0x76, 0x77, 0xd4, 0xaa, 0xde, 0xb2, 0x1b, 0x77, 0xcd,
+	0x25, 0x12, 0xd4, 0xa6, 0xa5, 0x1c, 0xd8, 0x13, 0xb8, 0x52, 0x50, 0x25, 0x10, 0x91, 0x29, 0x17,
+	0x84, 0x64, 0x4d, 0xc6, 0x2f, 0xed, 0x28, 0x1e, 0x8f, 0xe5, 0x71, 0xc2, 0xe6, 0xca, 0x89, 0x23,
+	0x7f, 0x02, 0x67, 0x38, 0xf3, 0x3f, 0x2c, 0x07, 0xa4, 0x3d, 0x72, 0x0a, 0xa8, 0x3d, 0x70, 0xcf,
+	0x1d, 0x09, 0xcd, 0x8f, 0xa4, 0xd9, 0xae, 0xaa, 0xaa, 0x12, 0x27, 0xcf, 0x9b, 0xf7, 0xbe, 0xf9,
+	0xe6, 0x7d, 0xef, 0xbd, 0x31, 0x7a, 0xce, 0x86, 0x34, 0x24, 0x45, 0x91, 0x31, 0x4a, 0x2a, 0x26,
+	0x72, 0x19, 0x8e, 0x00, 0xc2, 0xe9, 0xa1, 0xfa, 0x04, 0x45, 0x29, 0x2a, 0x81, 0x77, 0xd9, 0x90,
+	0x06, 0xeb, 0x21, 0x81, 0xf2, 0x4d, 0x0f, 0xf7, 0xba, 0x54, 0x48, 0x2e, 0x64, 0x38, 0x24, 0x52,
+	0x41, 0x86, 0x50, 0x91, 0xc3, 0x90, 0x0a, 0x96, 0x1b, 0xe0, 0xde, 0xf6, 0xb9, 0x38, 0x17, 0x7a,
+	0x19, 0xaa, 0x95, 0xdd, 0xd5, 0x8c, 0x54, 0x94, 0x10, 0xd2, 0x0b, 0x92, 0xe7, 0x90, 0x29, 0x36,
+	0xbb, 0x34, 0x21, 0xfe, 0xef, 0x0e, 0xda, 0x18, 0x90, 0x92, 0x70, 0x89, 0x3f, 0x47, 0x8f, 0x39,
+	0xcb, 0x93, 0x11, 0x40, 0x92, 0x09, 0x3a, 0x4e, 0x2a, 0xc6, 0xc1, 0x75, 0xf6, 0x9d, 0x5e, 0x33,
+	0x7a, 0xba, 0x98, 0x7b, 0xee, 0x8c, 0xf0, 0xec, 0x85, 0xff, 0x56, 0x88, 0x1f, 0x6f, 0x72, 0x96,
+	0xf7, 0x01, 0xbe, 0x10, 0x74, 0x7c, 0xc6, 0x38, 0xe0, 0x29, 0x7a, 0xce, 0xc9, 0xcb, 0x44, 0x16,
+	0x22, 0x97, 0xa2, 0x94, 0x17, 0xac, 0x48, 0x0a, 0x21, 0x32, 0x99, 0x14, 0x50, 0x26, 0x96, 0xde,
+	0xad, 0xeb, 0x83, 0x3f, 0x58, 0xcc, 0xbd, 0x9e, 0x3d, 0xf8, 0x2e, 0x88, 0x1f, 0x3f, 0xe3, 0xe4,
+	0xe5, 0xd7, 0xd7, 0x21, 0x03, 0x15, 0x31, 0x80, 0xf2, 0xc4, 0xfa, 0xff, 0xad, 0xa3, 0x46, 0x1f,
+	0x00, 0xcf, 0xd0, 0xc3, 0x12, 0xe8, 0x54, 0x5d, 0xd3, 0x75, 0xf6, 0x1b, 0xbd, 0xce, 0xd1, 0x93,
+	0xc0, 0xe8, 0x17, 0x28, 0xfd, 0x02, 0xab, 0x5f, 0x70, 0x22, 0x58, 0x1e, 0x9d, 0xbc, 0x9a, 0x7b,
+	0xb5, 0xc5, 0xdc, 0xdb, 0x32, 0xb7, 0x58, 0x02, 0xfd, 0x5f, 0xfe, 0xf2, 0x7a, 0xe7, 0xac, 0xba,
+	0x98, 0x0c, 0x03, 0x2a, 0x78, 0x68, 0xf5, 0x37, 0x9f, 0x03, 0x99, 0x8e, 0xc3, 0x6a, 0x56, 0x80,
+	0xd4, 0x67, 0xc8, 0xb8, 0xa5, 0x60, 0x8a, 0x7a, 0x8a, 0x5a, 0x84, 0x8e, 0x35, 0x73, 0xfd, 0x2e,
+	0xe6, 0xc8, 0x32, 0x6f, 0x1a, 0x66, 0x8b, 0xbb, 0x1f, 0xf1, 0x06, 0xa1, 0x63, 0xc5, 0xfb, 0x83,
+	0x83, 0x3a, 0xaa, 0x18, 0x62, 0x52, 0x69, 0xf2, 0xc6, 0x5d, 0xe4, 0x7d, 0x4b, 0x8e, 0x0d, 0xf9,
+	0x1a, 0xf6, 0x7e, 0x17, 0x40, 0x16, 0xd9, 0x07, 0xf0, 0xff, 0x71, 0x50, 0x7b, 0x40, 0xe8, 0x18,
+	0x94, 0x85, 0x8f, 0x51, 0xc3, 0x14, 0xc0, 0xe9, 0x75, 0x8e, 0x9e, 0x06, 0xb7, 0x74, 0x76, 0xd0,
+	0x07, 0x88, 0x9a, 0xea, 0x32, 0xb1, 0x0a, 0xc7, 0x9f, 0xa2, 0xcd, 0x12, 0x46, 0x93, 0x3c, 0x4d,
+	0x48, 0x9a, 0x96, 0x20, 0xa5, 0x6e, 0x94, 0x76, 0xf4, 0x64, 0x31, 0xf7, 0x76, 0x96, 0x25, 0x5a,
+	0xf7, 0xfb, 0xf1, 0x3b, 0x66, 0xe3, 0x33, 0x63, 0xe3, 0x3d, 0x55, 0xfd, 0x8c, 0xcc, 0xa0, 0x94,
+	0x5a, 0x86, 0x76, 0xbc, 0xb2, 0x71, 0x1f, 0x3d, 0x02, 0x49, 0x4b, 0xf1, 0xbd, 0xee, 0x5c, 0x59,
+	0x11, 0x5e, 0xb8, 0x4d, 0xdd, 0x88, 0xef, 0x2e, 0xe6, 0xde, 0xae, 0x39, 0xff, 0x66, 0x84, 0x1f,
+	0x6f, 0x99, 0xad, 0xb3, 0xd5, 0x0e, 0x47, 0x68, 0x95, 0xa8, 0xc4, 0x09, 0xea, 0x14, 0xda, 0x52,
+	0xf2, 0x49, 0xdb, 0x72, 0xfe, 0xad, 0x19, 0xaf, 0x90, 0xd1, 0xde, 0x9b, 0x45, 0x58, 0x3b, 0xc4,
+	0x8f, 0x51, 0xb1, 0x22, 0xf0, 0xff, 0x70, 0xd0, 0xf6, 0x69, 0x0a, 0x79, 0xc5, 0x46, 0x0c, 0xd2,
+	0x35, 0xe6, 0x33, 0xd4, 0xb6, 0x20, 0x96, 0x5a, 0xa5, 0x9f, 0x69, 0x5e, 0x35, 0xf4, 0xc1, 0x72,
+	0xd2, 0x57, 0x9c, 0xa7, 0x69, 0xe4, 0x5a, 0xca, 0x47, 0x6f, 0x50, 0xb2, 0xd4, 0x8f, 0x1f, 0x16,
+	0x36, 0xe6, 0x66, 0x3e, 0xf5, 0xff, 0x3d, 0x9f, 0x5f, 0xeb, 0x68, 0xeb, 0xc6, 0x1c, 0xe3, 0xf7,
+	0x51, 0xab, 0x10, 0xe5, 0x2a, 0x91, 0x76, 0x84, 0xaf, 0x47, 0xc3, 0x3a, 0xfc, 0x78, 0x43, 0xad,
+	0x4e, 0x53, 0x7c, 0x8c, 0x90, 0x4d, 0x4e, 0xc5, 0x9b, 0x0e, 0xd9, 0x59, 0xcc, 0xbd, 0xc7, 0x26,
+	0xfe, 0xda, 0xe7, 0xc7, 0x6d, 0x6b, 0x9c, 0xa6, 0xd8, 0x45, 0x2d, 0xfb, 0xc0, 0xb8, 0x0d, 0x05,
+	0x89, 0x97, 0xe6, 0xb2, 0x57, 0x9b, 0xf7, 0xeb, 0x55, 0x40, 0xad, 0x21, 0xc9, 0x48, 0x4e, 0xc1,
+	0x7d, 0x70, 0xd7, 0xbc, 0x7d, 0xa8, 0x60, 0xf7, 0x7b, 0x53, 0xec, 0xd9, 0xfe, 0x6f, 0x0e, 0xda,
+	0xfd, 0x26, 0x57, 0xef, 0x6d, 0x1f, 0xe0, 0x4b, 0x91, 0x4e, 0x32, 0x18, 0x94, 0xa2, 0x10, 0x92,
+	0x64, 0x78, 0x1b, 0x3d, 0xa8, 0x58, 0x95, 0x99, 0x31, 0x6b, 0xc7, 0xc6, 0xc0, 0xfb, 0xa8, 0x93,
+	0xaa, 0x96, 0x65, 0x85, 0xba, 0xbe, 0xd1, 0x27, 0x5e, 0xdf, 0xc2, 0xdf, 0x21, 0x57, 0x0f, 0xd1,
+	0xa8, 0x14, 0x3c, 0xa1, 0x82, 0xf3, 0x49, 0xce, 0xaa, 0x99, 0x7e, 0x73, 0xb5, 0x36, 0x0f, 0xa3,
+	0xf7, 0x16, 0x73, 0xcf, 0x33, 0x72, 0xde, 0x16, 0xe9, 0xc7, 0x3b, 0xca, 0xd5, 0x2f, 0x05, 0x3f,
+	0x59, 0x3a, 0x54, 0x2d, 0x5f, 0x34, 0x7f, 0xfc, 0xd9, 0xab, 0x45, 0x5f, 0xbd, 0xba, 0xec, 0x3a,
+	0xaf, 0x2f, 0xbb, 0xce, 0xdf, 0x97, 0x5d, 0xe7, 0xa7, 0xab, 0x6e, 0xed, 0xf5, 0x55, 0xb7, 0xf6,
+	0xe7, 0x55, 0xb7, 0xf6, 0xed, 0xc7, 0x6f, 0x8b, 0xc0, 0x86, 0xf4, 0xe0, 0x5c, 0x84, 0xd3, 0xe3,
+	0x90, 0xeb, 0x0c, 0xa5, 0xfa, 0x53, 0xca, 0xf0, 0xe8, 0x93, 0x03, 0xf5, 0x93, 0xd4, 0xba, 0x0c,
+	0x37, 0xf4, 0x2f, 0xeb, 0xa3, 0xff, 0x06, 0x00, 0x09, 0x15, 0x9d, 0x46, 0x49, 0x07, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSponsorshipPoolsPerChannel != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.MaxSponsorshipPoolsPerChannel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinFeeLockTime != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.MinFeeLockTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *Fee) Marshal() (dAtA []byte, err error) {
@@ -386,6 +635,11 @@ func (m *PacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EscrowTimestamp != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.EscrowTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Relayers) > 0 {
 		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Relayers[iNdEx])
@@ -499,6 +753,121 @@ func (m *IdentifiedPacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SponsorshipPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsorshipPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsorshipPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnlockFeeModuleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockFeeModuleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnlockFeeModuleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FundFromCommunityPool {
+		i--
+		if m.FundFromCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFee(v)
 	base := offset
@@ -510,6 +879,21 @@ func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinFeeLockTime != 0 {
+		n += 1 + sovFee(uint64(m.MinFeeLockTime))
+	}
+	if m.MaxSponsorshipPoolsPerChannel != 0 {
+		n += 1 + sovFee(uint64(m.MaxSponsorshipPoolsPerChannel))
+	}
+	return n
+}
+
 func (m *Fee) Size() (n int) {
 	if m == nil {
 		return 0
@@ -555,6 +939,9 @@ func (m *PacketFee) Size() (n int) {
 			n += 1 + l + sovFee(uint64(l))
 		}
 	}
+	if m.EscrowTimestamp != 0 {
+		n += 1 + sovFee(uint64(m.EscrowTimestamp))
+	}
 	return n
 }
 
@@ -590,21 +977,158 @@ func (m *IdentifiedPacketFees) Size() (n int) {
 	return n
 }
 
-func sovFee(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFee(x uint64) (n int) {
-	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *SponsorshipPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovFee(uint64(l))
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
 }
-func (m *Fee) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFee
+
+func (m *UnlockFeeModuleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.FundFromCommunityPool {
+		n += 2
+	}
+	return n
+}
+
+func sovFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFee(x uint64) (n int) {
+	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeeLockTime", wireType)
+			}
+			m.MinFeeLockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinFeeLockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSponsorshipPoolsPerChannel", wireType)
+			}
+			m.MaxSponsorshipPoolsPerChannel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSponsorshipPoolsPerChannel |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -874,6 +1398,25 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 			}
 			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowTimestamp", wireType)
+			}
+			m.EscrowTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFee(dAtA[iNdEx:])
@@ -1096,6 +1639,353 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SponsorshipPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsorshipPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsorshipPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnlockFeeModuleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockFeeModuleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockFeeModuleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundFromCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FundFromCommunityPool = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFee(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
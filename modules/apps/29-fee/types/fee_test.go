@@ -8,6 +8,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
 
 var (
@@ -34,6 +35,15 @@ func TestFeeTotal(t *testing.T) {
 	require.Equal(t, sdk.NewInt(600), total.AmountOf(sdk.DefaultBondDenom))
 }
 
+func TestFeeAdd(t *testing.T) {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	sum := fee.Add(types.NewFee(defaultRecvFee, nil, defaultTimeoutFee))
+	require.Equal(t, sdk.NewInt(200), sum.RecvFee.AmountOf(sdk.DefaultBondDenom))
+	require.Equal(t, sdk.NewInt(200), sum.AckFee.AmountOf(sdk.DefaultBondDenom))
+	require.Equal(t, sdk.NewInt(600), sum.TimeoutFee.AmountOf(sdk.DefaultBondDenom))
+}
+
 func TestPacketFeeValidation(t *testing.T) {
 	var packetFee types.PacketFee
 
@@ -119,3 +129,86 @@ func TestPacketFeeValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestSponsorshipPoolValidation(t *testing.T) {
+	var pool types.SponsorshipPool
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with empty balance",
+			func() {
+				pool.Balance = sdk.Coins{}
+			},
+			true,
+		},
+		{
+			"invalid port ID",
+			func() {
+				pool.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				pool.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid sponsor address",
+			func() {
+				pool.Sponsor = "invalid-addr"
+			},
+			false,
+		},
+		{
+			"should fail if all fees are empty",
+			func() {
+				pool.Fee = types.NewFee(sdk.Coins{}, sdk.Coins{}, sdk.Coins{})
+			},
+			false,
+		},
+		{
+			"invalid balance",
+			func() {
+				pool.Balance = invalidFee
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+		pool = types.NewSponsorshipPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, fee, fee.Total())
+
+		tc.malleate() // malleate mutates test data
+
+		err := pool.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSponsorshipPoolCanIncentivizePacket(t *testing.T) {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	pool := types.NewSponsorshipPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, fee, fee.Total())
+	require.True(t, pool.CanIncentivizePacket())
+
+	pool.Balance = pool.Balance.Sub(defaultRecvFee)
+	require.False(t, pool.CanIncentivizePacket())
+}
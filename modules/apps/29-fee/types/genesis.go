@@ -16,6 +16,8 @@ func NewGenesisState(
 	registeredPayees []RegisteredPayee,
 	registeredCounterpartyPayees []RegisteredCounterpartyPayee,
 	forwardRelayers []ForwardRelayerAddress,
+	params Params,
+	sponsorshipPools []SponsorshipPool,
 ) *GenesisState {
 	return &GenesisState{
 		IdentifiedFees:               identifiedFees,
@@ -23,6 +25,8 @@ func NewGenesisState(
 		RegisteredPayees:             registeredPayees,
 		RegisteredCounterpartyPayees: registeredCounterpartyPayees,
 		ForwardRelayers:              forwardRelayers,
+		Params:                       params,
+		SponsorshipPools:             sponsorshipPools,
 	}
 }
 
@@ -34,6 +38,8 @@ func DefaultGenesisState() *GenesisState {
 		FeeEnabledChannels:           []FeeEnabledChannel{},
 		RegisteredPayees:             []RegisteredPayee{},
 		RegisteredCounterpartyPayees: []RegisteredCounterpartyPayee{},
+		Params:                       DefaultParams(),
+		SponsorshipPools:             []SponsorshipPool{},
 	}
 }
 
@@ -108,5 +114,12 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
-	return nil
+	// Validate SponsorshipPools
+	for _, pool := range gs.SponsorshipPools {
+		if err := pool.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return gs.Params.Validate()
 }
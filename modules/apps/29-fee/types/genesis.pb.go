@@ -36,6 +36,10 @@ type GenesisState struct {
 	RegisteredCounterpartyPayees []RegisteredCounterpartyPayee `protobuf:"bytes,4,rep,name=registered_counterparty_payees,json=registeredCounterpartyPayees,proto3" json:"registered_counterparty_payees" yaml:"registered_counterparty_payees"`
 	// list of forward relayer addresses
 	ForwardRelayers []ForwardRelayerAddress `protobuf:"bytes,5,rep,name=forward_relayers,json=forwardRelayers,proto3" json:"forward_relayers" yaml:"forward_relayers"`
+	// the fee middleware parameters
+	Params Params `protobuf:"bytes,6,opt,name=params,proto3" json:"params"`
+	// list of sponsorship pools
+	SponsorshipPools []SponsorshipPool `protobuf:"bytes,7,rep,name=sponsorship_pools,json=sponsorshipPools,proto3" json:"sponsorship_pools" yaml:"sponsorship_pools"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +110,20 @@ func (m *GenesisState) GetForwardRelayers() []ForwardRelayerAddress {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetSponsorshipPools() []SponsorshipPool {
+	if m != nil {
+		return m.SponsorshipPools
+	}
+	return nil
+}
+
 // FeeEnabledChannel contains the PortID & ChannelID for a fee enabled channel
 type FeeEnabledChannel struct {
 	// unique port identifier
@@ -358,48 +376,52 @@ func init() {
 }
 
 var fileDescriptor_7191992e856dff95 = []byte{
-	// 649 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4e, 0xd4, 0x4e,
-	0x1c, 0xdf, 0xc2, 0x0f, 0xf8, 0x31, 0x18, 0x60, 0x27, 0x20, 0x15, 0xa4, 0x8b, 0x63, 0x48, 0x88,
-	0x66, 0xdb, 0x80, 0x78, 0xd0, 0x9b, 0x25, 0x62, 0x36, 0x31, 0x91, 0x8c, 0x9e, 0xbc, 0x6c, 0xba,
-	0xed, 0xb7, 0x4b, 0xe3, 0x6e, 0xa7, 0x99, 0x19, 0x96, 0xac, 0x37, 0x4f, 0x5e, 0x7d, 0x0d, 0xe3,
-	0x23, 0xf8, 0x02, 0x1c, 0x39, 0x7a, 0xda, 0x18, 0x78, 0x83, 0x7d, 0x02, 0x33, 0x9d, 0x29, 0x2c,
-	0xbb, 0xd4, 0x70, 0xf0, 0x36, 0xd3, 0xf9, 0xfc, 0x9b, 0x7e, 0x26, 0x5f, 0xb4, 0x9d, 0xb4, 0x42,
-	0x2f, 0xc8, 0xb2, 0x4e, 0x12, 0x06, 0x32, 0x61, 0xa9, 0xf0, 0x62, 0x00, 0xaf, 0xb7, 0xeb, 0xb5,
-	0x21, 0x05, 0x91, 0x08, 0x37, 0xe3, 0x4c, 0x32, 0xbc, 0x96, 0xb4, 0x42, 0x77, 0x14, 0xe6, 0xc6,
-	0x00, 0x6e, 0x6f, 0x77, 0x7d, 0xa5, 0xcd, 0xda, 0x2c, 0xc7, 0x78, 0x6a, 0xa5, 0xe1, 0xeb, 0x8f,
-	0xca, 0x54, 0x15, 0x6b, 0x04, 0x12, 0x32, 0x0e, 0x5e, 0x78, 0x1c, 0xa4, 0x29, 0x74, 0xd4, 0xb1,
-	0x59, 0x6a, 0x08, 0xf9, 0x31, 0x83, 0xee, 0xbd, 0xd1, 0x31, 0xde, 0xcb, 0x40, 0x02, 0xee, 0xa1,
-	0xa5, 0x24, 0x82, 0x54, 0x26, 0x71, 0x02, 0x51, 0x33, 0x06, 0x10, 0xb6, 0xb5, 0x35, 0xbd, 0xb3,
-	0xb0, 0x57, 0x77, 0x4b, 0xf2, 0xb9, 0x8d, 0x2b, 0xfc, 0x51, 0x10, 0x7e, 0x02, 0x79, 0x08, 0x20,
-	0x7c, 0xe7, 0x6c, 0x50, 0xab, 0x0c, 0x07, 0xb5, 0xfb, 0xfd, 0xa0, 0xdb, 0x79, 0x49, 0xc6, 0x34,
-	0x09, 0x5d, 0xbc, 0xfe, 0xa2, 0xf0, 0xf8, 0x8b, 0x85, 0x56, 0x62, 0x80, 0x26, 0xa4, 0x41, 0xab,
-	0x03, 0x51, 0xd3, 0xc4, 0x14, 0xf6, 0x54, 0xee, 0xfe, 0xa4, 0xd4, 0xfd, 0x10, 0xe0, 0xb5, 0xe6,
-	0x1c, 0x68, 0x8a, 0xff, 0xd8, 0x58, 0x6f, 0x68, 0xeb, 0xdb, 0x54, 0x09, 0xc5, 0xf1, 0x38, 0x4f,
-	0xe0, 0x53, 0x54, 0xe5, 0xd0, 0x4e, 0x84, 0x04, 0x0e, 0x51, 0x33, 0x0b, 0xfa, 0xea, 0xf6, 0xd3,
-	0xb9, 0xff, 0x4e, 0xa9, 0x3f, 0xbd, 0x62, 0x1c, 0x29, 0x82, 0xbf, 0x65, 0xdc, 0x6d, 0xed, 0x3e,
-	0x21, 0x48, 0xe8, 0x32, 0xbf, 0x49, 0x11, 0xf8, 0xbb, 0x85, 0x9c, 0x11, 0x60, 0xc8, 0x4e, 0x52,
-	0x09, 0x3c, 0x0b, 0xb8, 0xec, 0x17, 0x31, 0xfe, 0xcb, 0x63, 0xec, 0xdf, 0x21, 0xc6, 0xc1, 0x08,
-	0x5b, 0x47, 0xaa, 0x9b, 0x48, 0xdb, 0x13, 0x91, 0x6e, 0x71, 0x22, 0xf4, 0x21, 0x2f, 0xd7, 0x12,
-	0xf8, 0x33, 0x5a, 0x8e, 0x19, 0x3f, 0x0d, 0x78, 0xd4, 0xe4, 0xd0, 0x09, 0xfa, 0xc0, 0x85, 0x3d,
-	0x93, 0x87, 0x73, 0xcb, 0x3b, 0xd2, 0x04, 0xaa, 0xf1, 0xaf, 0xa2, 0x88, 0x83, 0x10, 0x7e, 0xcd,
-	0xc4, 0x5a, 0x33, 0x3d, 0x8d, 0xa9, 0x12, 0xba, 0x14, 0xdf, 0xe0, 0x09, 0xd2, 0x43, 0xd5, 0x89,
-	0xba, 0xf1, 0x53, 0x34, 0x97, 0x31, 0x2e, 0x9b, 0x49, 0x64, 0x5b, 0x5b, 0xd6, 0xce, 0xbc, 0x8f,
-	0x87, 0x83, 0xda, 0xa2, 0xd6, 0x34, 0x07, 0x84, 0xce, 0xaa, 0x55, 0x23, 0xc2, 0xfb, 0x08, 0x99,
-	0x37, 0xa0, 0xf0, 0x53, 0x39, 0x7e, 0x75, 0x38, 0xa8, 0x55, 0x35, 0xfe, 0xfa, 0x8c, 0xd0, 0x79,
-	0xb3, 0x69, 0x44, 0xe4, 0x14, 0x2d, 0x8d, 0xd5, 0x3c, 0x26, 0x64, 0xdd, 0x4d, 0x08, 0xdb, 0x68,
-	0xce, 0x5c, 0x4f, 0x7b, 0xd3, 0x62, 0x8b, 0x57, 0xd0, 0x4c, 0xfe, 0xff, 0xed, 0xe9, 0xfc, 0xbb,
-	0xde, 0x90, 0x9f, 0x16, 0xda, 0xf8, 0x4b, 0xb3, 0xff, 0x3c, 0xc5, 0x5b, 0x84, 0x27, 0x9f, 0x84,
-	0x8e, 0xe4, 0x6f, 0x0e, 0x07, 0xb5, 0x07, 0x46, 0x77, 0x02, 0x43, 0x68, 0x35, 0x1c, 0x4f, 0x47,
-	0xbe, 0x5a, 0x68, 0xf5, 0xd6, 0xea, 0x55, 0x82, 0x40, 0x2f, 0x75, 0x68, 0x5a, 0x6c, 0xf1, 0x07,
-	0x34, 0x9f, 0xe5, 0x53, 0xa4, 0xe8, 0x67, 0x61, 0x6f, 0x33, 0x7f, 0x57, 0x6a, 0x8e, 0xb9, 0xc5,
-	0xf0, 0xea, 0xed, 0xba, 0x7a, 0xd6, 0x34, 0x22, 0xdf, 0x36, 0xcf, 0x68, 0xd9, 0x54, 0x5e, 0xb0,
-	0x09, 0xfd, 0x3f, 0x2b, 0x30, 0xef, 0xce, 0x2e, 0x1c, 0xeb, 0xfc, 0xc2, 0xb1, 0x7e, 0x5f, 0x38,
-	0xd6, 0xb7, 0x4b, 0xa7, 0x72, 0x7e, 0xe9, 0x54, 0x7e, 0x5d, 0x3a, 0x95, 0x8f, 0xcf, 0xdb, 0x89,
-	0x3c, 0x3e, 0x69, 0xb9, 0x21, 0xeb, 0x7a, 0x21, 0x13, 0x5d, 0x26, 0xbc, 0xa4, 0x15, 0xd6, 0xdb,
-	0xcc, 0xeb, 0xed, 0x7b, 0x5d, 0x16, 0x9d, 0x74, 0x40, 0xa8, 0x31, 0x2b, 0xbc, 0xbd, 0x17, 0x75,
-	0x35, 0x61, 0x65, 0x3f, 0x03, 0xd1, 0x9a, 0xcd, 0xc7, 0xe7, 0xb3, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x3c, 0x18, 0x93, 0x1b, 0xdc, 0x05, 0x00, 0x00,
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x4e, 0xdb, 0x48,
+	0x1c, 0x8f, 0xf9, 0x08, 0xcb, 0xb0, 0x82, 0x64, 0x04, 0x8b, 0x17, 0x16, 0x27, 0x3b, 0x2b, 0xa4,
+	0x68, 0x57, 0xb1, 0x45, 0x96, 0x3d, 0x6c, 0xa5, 0x1e, 0x6a, 0x54, 0xaa, 0x48, 0x95, 0x1a, 0x0d,
+	0x3d, 0xf5, 0x12, 0x39, 0xf6, 0xdf, 0xc1, 0xaa, 0xe3, 0xb1, 0x66, 0x4c, 0x50, 0x7a, 0xeb, 0xa9,
+	0xd7, 0xbe, 0x46, 0x9f, 0xa1, 0x2f, 0xc0, 0x91, 0x63, 0x4f, 0x51, 0x45, 0xde, 0x20, 0x4f, 0x50,
+	0x8d, 0x67, 0x02, 0x21, 0x21, 0x2d, 0x87, 0xde, 0x66, 0x3c, 0xbf, 0x2f, 0xeb, 0xff, 0xd3, 0x0c,
+	0x3a, 0x8c, 0x3a, 0xbe, 0xe3, 0xa5, 0x69, 0x1c, 0xf9, 0x5e, 0x16, 0xb1, 0x44, 0x38, 0x21, 0x80,
+	0xd3, 0x3f, 0x72, 0xba, 0x90, 0x80, 0x88, 0x84, 0x9d, 0x72, 0x96, 0x31, 0xbc, 0x1b, 0x75, 0x7c,
+	0x7b, 0x1a, 0x66, 0x87, 0x00, 0x76, 0xff, 0x68, 0x6f, 0xbb, 0xcb, 0xba, 0x2c, 0xc7, 0x38, 0x72,
+	0xa5, 0xe0, 0x7b, 0x7f, 0x2e, 0x52, 0x95, 0xac, 0x29, 0x88, 0xcf, 0x38, 0x38, 0xfe, 0xb9, 0x97,
+	0x24, 0x10, 0xcb, 0x63, 0xbd, 0x54, 0x10, 0x32, 0x2a, 0xa2, 0x5f, 0x5f, 0xa8, 0x18, 0x67, 0x99,
+	0x97, 0x01, 0xee, 0xa3, 0xad, 0x28, 0x80, 0x24, 0x8b, 0xc2, 0x08, 0x82, 0x76, 0x08, 0x20, 0x4c,
+	0xa3, 0xba, 0x5c, 0xdb, 0x68, 0xd4, 0xed, 0x05, 0xf9, 0xec, 0xe6, 0x2d, 0xbe, 0xe5, 0xf9, 0x6f,
+	0x21, 0x3b, 0x05, 0x10, 0xae, 0x75, 0x35, 0xac, 0x14, 0xc6, 0xc3, 0xca, 0x6f, 0x03, 0xaf, 0x17,
+	0x3f, 0x21, 0x33, 0x9a, 0x84, 0x6e, 0xde, 0x7d, 0x91, 0x78, 0xfc, 0xde, 0x40, 0xdb, 0x21, 0x40,
+	0x1b, 0x12, 0xaf, 0x13, 0x43, 0xd0, 0xd6, 0x31, 0x85, 0xb9, 0x94, 0xbb, 0xff, 0xbd, 0xd0, 0xfd,
+	0x14, 0xe0, 0xb9, 0xe2, 0x9c, 0x28, 0x8a, 0xfb, 0x97, 0xb6, 0xde, 0x57, 0xd6, 0x0f, 0xa9, 0x12,
+	0x8a, 0xc3, 0x59, 0x9e, 0xc0, 0x97, 0xa8, 0xcc, 0xa1, 0x1b, 0x89, 0x0c, 0x38, 0x04, 0xed, 0xd4,
+	0x1b, 0xc8, 0xbf, 0x5f, 0xce, 0xfd, 0x6b, 0x0b, 0xfd, 0xe9, 0x2d, 0xa3, 0x25, 0x09, 0x6e, 0x55,
+	0xbb, 0x9b, 0xca, 0x7d, 0x4e, 0x90, 0xd0, 0x12, 0xbf, 0x4f, 0x11, 0xf8, 0x93, 0x81, 0xac, 0x29,
+	0xa0, 0xcf, 0x2e, 0x92, 0x0c, 0x78, 0xea, 0xf1, 0x6c, 0x30, 0x89, 0xb1, 0x92, 0xc7, 0x38, 0x7e,
+	0x44, 0x8c, 0x93, 0x29, 0xb6, 0x8a, 0x54, 0xd7, 0x91, 0x0e, 0xe7, 0x22, 0x3d, 0xe0, 0x44, 0xe8,
+	0x1f, 0x7c, 0xb1, 0x96, 0xc0, 0xef, 0x50, 0x29, 0x64, 0xfc, 0xd2, 0xe3, 0x41, 0x9b, 0x43, 0xec,
+	0x0d, 0x80, 0x0b, 0x73, 0x35, 0x0f, 0x67, 0x2f, 0x9e, 0x91, 0x22, 0x50, 0x85, 0x7f, 0x16, 0x04,
+	0x1c, 0x84, 0x70, 0x2b, 0x3a, 0xd6, 0xae, 0x9e, 0xd3, 0x8c, 0x2a, 0xa1, 0x5b, 0xe1, 0x3d, 0x9e,
+	0xc0, 0x4f, 0x51, 0x31, 0xf5, 0xb8, 0xd7, 0x13, 0x66, 0xb1, 0x6a, 0xd4, 0x36, 0x1a, 0x95, 0x85,
+	0x8e, 0xad, 0x1c, 0xe6, 0xae, 0x48, 0x0b, 0xaa, 0x49, 0x72, 0xbe, 0x22, 0x65, 0x89, 0x60, 0x5c,
+	0x9c, 0x47, 0x69, 0x3b, 0x65, 0x2c, 0x16, 0xe6, 0xda, 0x0f, 0xe6, 0x7b, 0x76, 0xc7, 0x68, 0x31,
+	0x16, 0xcf, 0xce, 0x77, 0x4e, 0x90, 0xd0, 0x92, 0xb8, 0x4f, 0x11, 0xa4, 0x8f, 0xca, 0x73, 0x35,
+	0xc5, 0xff, 0xa0, 0xb5, 0x94, 0xf1, 0xac, 0x1d, 0x05, 0xa6, 0x51, 0x35, 0x6a, 0xeb, 0x2e, 0x1e,
+	0x0f, 0x2b, 0x9b, 0x4a, 0x55, 0x1f, 0x10, 0x5a, 0x94, 0xab, 0x66, 0x80, 0x8f, 0x11, 0xd2, 0xdd,
+	0x95, 0xf8, 0xa5, 0x1c, 0xbf, 0x33, 0x1e, 0x56, 0xca, 0x0a, 0x7f, 0x77, 0x46, 0xe8, 0xba, 0xde,
+	0x34, 0x03, 0x72, 0x89, 0xb6, 0x66, 0xea, 0x39, 0x23, 0x64, 0x3c, 0x4e, 0x08, 0x9b, 0x68, 0x4d,
+	0x8f, 0x45, 0x79, 0xd3, 0xc9, 0x16, 0x6f, 0xa3, 0xd5, 0xbc, 0x37, 0xe6, 0x72, 0xfe, 0x5d, 0x6d,
+	0xc8, 0x67, 0x03, 0xed, 0x7f, 0xa7, 0x91, 0x3f, 0x3d, 0xc5, 0x4b, 0x84, 0xe7, 0xab, 0xac, 0x22,
+	0xb9, 0x07, 0xe3, 0x61, 0xe5, 0x77, 0xad, 0x3b, 0x87, 0x21, 0xb4, 0xec, 0xcf, 0xa6, 0x23, 0x1f,
+	0x0c, 0xb4, 0xf3, 0x60, 0x65, 0x65, 0x02, 0x4f, 0x2d, 0x55, 0x68, 0x3a, 0xd9, 0xe2, 0xd7, 0x68,
+	0x3d, 0xcd, 0x6f, 0xbf, 0xc9, 0x7c, 0x36, 0x1a, 0x07, 0x79, 0xa7, 0xe4, 0xfd, 0x6b, 0x4f, 0x2e,
+	0xdd, 0xbc, 0x99, 0x12, 0xd5, 0x0c, 0x5c, 0x53, 0x17, 0xa9, 0xa4, 0x47, 0x3e, 0x61, 0x13, 0xfa,
+	0x4b, 0x3a, 0xc1, 0xbc, 0xba, 0xba, 0xb1, 0x8c, 0xeb, 0x1b, 0xcb, 0xf8, 0x7a, 0x63, 0x19, 0x1f,
+	0x47, 0x56, 0xe1, 0x7a, 0x64, 0x15, 0xbe, 0x8c, 0xac, 0xc2, 0x9b, 0xff, 0xba, 0x51, 0x76, 0x7e,
+	0xd1, 0xb1, 0x7d, 0xd6, 0x73, 0x7c, 0x26, 0x7a, 0x4c, 0x38, 0x51, 0xc7, 0xaf, 0x77, 0x99, 0xd3,
+	0x3f, 0x76, 0x7a, 0x2c, 0xb8, 0x88, 0x41, 0xc8, 0xe7, 0x41, 0x38, 0x8d, 0xff, 0xeb, 0xf2, 0x65,
+	0xc8, 0x06, 0x29, 0x88, 0x4e, 0x31, 0xbf, 0xf6, 0xff, 0xfd, 0x36, 0x00, 0xa6, 0x95, 0x3c, 0x86,
+	0x94, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -422,6 +444,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SponsorshipPools) > 0 {
+		for iNdEx := len(m.SponsorshipPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SponsorshipPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.ForwardRelayers) > 0 {
 		for iNdEx := len(m.ForwardRelayers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -707,6 +753,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SponsorshipPools) > 0 {
+		for _, e := range m.SponsorshipPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -989,6 +1043,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsorshipPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsorshipPools = append(m.SponsorshipPools, SponsorshipPool{})
+			if err := m.SponsorshipPools[len(m.SponsorshipPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -128,6 +128,27 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid sponsorship pool: invalid channel ID",
+			func() {
+				genState.SponsorshipPools[0].ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid sponsorship pool: invalid sponsor address",
+			func() {
+				genState.SponsorshipPools[0].Sponsor = ""
+			},
+			false,
+		},
+		{
+			"invalid sponsorship pool: invalid balance",
+			func() {
+				genState.SponsorshipPools[0].Balance = invalidFee
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -164,6 +185,9 @@ func TestValidateGenesis(t *testing.T) {
 					ChannelId: ibctesting.FirstChannelID,
 				},
 			},
+			SponsorshipPools: []types.SponsorshipPool{
+				types.NewSponsorshipPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress, types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), defaultRecvFee),
+			},
 		}
 
 		tc.malleate()
@@ -39,6 +39,9 @@ const (
 
 	// ForwardRelayerPrefix is the key prefix for forward relayer addresses stored in state for async acknowledgements
 	ForwardRelayerPrefix = "forwardRelayer"
+
+	// SponsorshipPoolPrefix is the key prefix for the sponsorship pools stored in state
+	SponsorshipPoolPrefix = "sponsorshipPool"
 )
 
 // KeyLocked returns the key used to lock and unlock the fee module. This key is used
@@ -157,3 +160,13 @@ func ParseKeyFeesInEscrow(key string) (channeltypes.PacketId, error) {
 func KeyFeesInEscrowChannelPrefix(portID, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", FeesInEscrowPrefix, portID, channelID))
 }
+
+// KeySponsorshipPool returns the key for the sponsorship pool of the given sponsor on the given channel
+func KeySponsorshipPool(portID, channelID, sponsor string) []byte {
+	return []byte(fmt.Sprintf("%s%s", KeySponsorshipPoolChannelPrefix(portID, channelID), sponsor))
+}
+
+// KeySponsorshipPoolChannelPrefix returns the key prefix for the sponsorship pools of the given channel
+func KeySponsorshipPoolChannelPrefix(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", SponsorshipPoolPrefix, portID, channelID))
+}
@@ -14,6 +14,11 @@ import (
 const (
 	TypeMsgPayPacketFee      = "payPacketFee"
 	TypeMsgPayPacketFeeAsync = "payPacketFeeAsync"
+	TypeMsgCancelPacketFee   = "cancelPacketFee"
+	TypeMsgIncreasePacketFee = "increasePacketFee"
+
+	TypeMsgFundSponsorshipPool     = "fundSponsorshipPool"
+	TypeMsgWithdrawSponsorshipPool = "withdrawSponsorshipPool"
 )
 
 // NewMsgRegisterPayee creates a new instance of MsgRegisterPayee
@@ -214,3 +219,215 @@ func (msg MsgPayPacketFeeAsync) Type() string {
 func (msg MsgPayPacketFeeAsync) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
 }
+
+// NewMsgCancelPacketFee creates a new instance of MsgCancelPacketFee
+func NewMsgCancelPacketFee(packetID channeltypes.PacketId, signer string) *MsgCancelPacketFee {
+	return &MsgCancelPacketFee{
+		PacketId: packetID,
+		Signer:   signer,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgCancelPacketFee fields
+func (msg MsgCancelPacketFee) ValidateBasic() error {
+	if err := msg.PacketId.Validate(); err != nil {
+		return err
+	}
+
+	// signer check
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert msg.Signer into sdk.AccAddress")
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+// The signer of the cancel message must be the refund address of the packet fees
+func (msg MsgCancelPacketFee) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// Route implements sdk.Msg
+func (msg MsgCancelPacketFee) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgCancelPacketFee) Type() string {
+	return TypeMsgCancelPacketFee
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgCancelPacketFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgIncreasePacketFee creates a new instance of MsgIncreasePacketFee
+func NewMsgIncreasePacketFee(packetID channeltypes.PacketId, feeIndex uint64, fee Fee, signer string) *MsgIncreasePacketFee {
+	return &MsgIncreasePacketFee{
+		PacketId: packetID,
+		FeeIndex: feeIndex,
+		Fee:      fee,
+		Signer:   signer,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgIncreasePacketFee fields
+func (msg MsgIncreasePacketFee) ValidateBasic() error {
+	if err := msg.PacketId.Validate(); err != nil {
+		return err
+	}
+
+	// signer check
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert msg.Signer into sdk.AccAddress")
+	}
+
+	if err := msg.Fee.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+// The signer of the increase message must be the refund address of the packet fee
+func (msg MsgIncreasePacketFee) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// Route implements sdk.Msg
+func (msg MsgIncreasePacketFee) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgIncreasePacketFee) Type() string {
+	return TypeMsgIncreasePacketFee
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgIncreasePacketFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgFundSponsorshipPool creates a new instance of MsgFundSponsorshipPool
+func NewMsgFundSponsorshipPool(portID, channelID string, fee Fee, amount sdk.Coins, signer string) *MsgFundSponsorshipPool {
+	return &MsgFundSponsorshipPool{
+		PortId:    portID,
+		ChannelId: channelID,
+		Fee:       fee,
+		Amount:    amount,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgFundSponsorshipPool fields
+func (msg MsgFundSponsorshipPool) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	// signer check
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert msg.Signer into sdk.AccAddress")
+	}
+
+	if err := msg.Fee.Validate(); err != nil {
+		return err
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid sponsorship pool funding amount: %s", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgFundSponsorshipPool) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// Route implements sdk.Msg
+func (msg MsgFundSponsorshipPool) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgFundSponsorshipPool) Type() string {
+	return TypeMsgFundSponsorshipPool
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgFundSponsorshipPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgWithdrawSponsorshipPool creates a new instance of MsgWithdrawSponsorshipPool
+func NewMsgWithdrawSponsorshipPool(portID, channelID, signer string) *MsgWithdrawSponsorshipPool {
+	return &MsgWithdrawSponsorshipPool{
+		PortId:    portID,
+		ChannelId: channelID,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgWithdrawSponsorshipPool fields
+func (msg MsgWithdrawSponsorshipPool) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	// signer check
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(err, "failed to convert msg.Signer into sdk.AccAddress")
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgWithdrawSponsorshipPool) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// Route implements sdk.Msg
+func (msg MsgWithdrawSponsorshipPool) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgWithdrawSponsorshipPool) Type() string {
+	return TypeMsgWithdrawSponsorshipPool
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgWithdrawSponsorshipPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
@@ -393,3 +393,351 @@ func TestMsgPayPacketFeeAsyncGetSignBytes(t *testing.T) {
 		_ = msg.GetSignBytes()
 	})
 }
+
+func TestMsgCancelPacketFeeValidation(t *testing.T) {
+	var msg *types.MsgCancelPacketFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.PacketId.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PacketId.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid sequence",
+			func() {
+				msg.PacketId.Sequence = 0
+			},
+			false,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = "invalid-addr"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+		msg = types.NewMsgCancelPacketFee(packetID, defaultAccAddress)
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestCancelPacketFeeGetSigners(t *testing.T) {
+	refundAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+
+	msg := types.NewMsgCancelPacketFee(packetID, refundAddr.String())
+
+	require.Equal(t, []sdk.AccAddress{refundAddr}, msg.GetSigners())
+}
+
+func TestMsgCancelPacketFeeGetSignBytes(t *testing.T) {
+	packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+	msg := types.NewMsgCancelPacketFee(packetID, defaultAccAddress)
+
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, "cancelPacketFee", msg.Type())
+	require.NotPanics(t, func() {
+		_ = msg.GetSignBytes()
+	})
+}
+
+func TestMsgIncreasePacketFeeValidation(t *testing.T) {
+	var msg *types.MsgIncreasePacketFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with non zero fee index",
+			func() {
+				msg.FeeIndex = 2
+			},
+			true,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.PacketId.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid sequence",
+			func() {
+				msg.PacketId.Sequence = 0
+			},
+			false,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = "invalid-addr"
+			},
+			false,
+		},
+		{
+			"should fail with single invalid fee",
+			func() {
+				msg.Fee.AckFee = invalidFee
+			},
+			false,
+		},
+		{
+			"should fail if all fees are empty",
+			func() {
+				msg.Fee.AckFee = sdk.Coins{}
+				msg.Fee.RecvFee = sdk.Coins{}
+				msg.Fee.TimeoutFee = sdk.Coins{}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+		msg = types.NewMsgIncreasePacketFee(packetID, 0, fee, defaultAccAddress)
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestIncreasePacketFeeGetSigners(t *testing.T) {
+	refundAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	msg := types.NewMsgIncreasePacketFee(packetID, 0, fee, refundAddr.String())
+
+	require.Equal(t, []sdk.AccAddress{refundAddr}, msg.GetSigners())
+}
+
+func TestMsgIncreasePacketFeeGetSignBytes(t *testing.T) {
+	packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	msg := types.NewMsgIncreasePacketFee(packetID, 0, fee, defaultAccAddress)
+
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, "increasePacketFee", msg.Type())
+	require.NotPanics(t, func() {
+		_ = msg.GetSignBytes()
+	})
+}
+
+func TestMsgFundSponsorshipPoolValidation(t *testing.T) {
+	var msg *types.MsgFundSponsorshipPool
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = "invalid-addr"
+			},
+			false,
+		},
+		{
+			"should fail with single invalid fee",
+			func() {
+				msg.Fee.AckFee = invalidFee
+			},
+			false,
+		},
+		{
+			"should fail if all fees are empty",
+			func() {
+				msg.Fee.AckFee = sdk.Coins{}
+				msg.Fee.RecvFee = sdk.Coins{}
+				msg.Fee.TimeoutFee = sdk.Coins{}
+			},
+			false,
+		},
+		{
+			"invalid amount",
+			func() {
+				msg.Amount = invalidFee
+			},
+			false,
+		},
+		{
+			"empty amount",
+			func() {
+				msg.Amount = sdk.Coins{}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+		msg = types.NewMsgFundSponsorshipPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, fee, fee.Total(), defaultAccAddress)
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestFundSponsorshipPoolGetSigners(t *testing.T) {
+	sponsorAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	msg := types.NewMsgFundSponsorshipPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, fee, fee.Total(), sponsorAddr.String())
+
+	require.Equal(t, []sdk.AccAddress{sponsorAddr}, msg.GetSigners())
+}
+
+func TestMsgFundSponsorshipPoolGetSignBytes(t *testing.T) {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	msg := types.NewMsgFundSponsorshipPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, fee, fee.Total(), defaultAccAddress)
+
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, "fundSponsorshipPool", msg.Type())
+	require.NotPanics(t, func() {
+		_ = msg.GetSignBytes()
+	})
+}
+
+func TestMsgWithdrawSponsorshipPoolValidation(t *testing.T) {
+	var msg *types.MsgWithdrawSponsorshipPool
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = "invalid-addr"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgWithdrawSponsorshipPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress)
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestWithdrawSponsorshipPoolGetSigners(t *testing.T) {
+	sponsorAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	msg := types.NewMsgWithdrawSponsorshipPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, sponsorAddr.String())
+
+	require.Equal(t, []sdk.AccAddress{sponsorAddr}, msg.GetSigners())
+}
+
+func TestMsgWithdrawSponsorshipPoolGetSignBytes(t *testing.T) {
+	msg := types.NewMsgWithdrawSponsorshipPool(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress)
+
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, "withdrawSponsorshipPool", msg.Type())
+	require.NotPanics(t, func() {
+		_ = msg.GetSignBytes()
+	})
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultMinFeeLockTime is the default minimum time a packet fee must remain in escrow
+// before it may be cancelled by its refund address.
+var DefaultMinFeeLockTime = uint64(24 * time.Hour)
+
+// DefaultMaxSponsorshipPoolsPerChannel is the default maximum number of sponsorship pools funded on a channel.
+const DefaultMaxSponsorshipPoolsPerChannel = uint64(10)
+
+var (
+	// KeyMinFeeLockTime is store's key for MinFeeLockTime Params
+	KeyMinFeeLockTime = []byte("MinFeeLockTime")
+	// KeyMaxSponsorshipPoolsPerChannel is store's key for MaxSponsorshipPoolsPerChannel Params
+	KeyMaxSponsorshipPoolsPerChannel = []byte("MaxSponsorshipPoolsPerChannel")
+)
+
+// ParamKeyTable type declaration for parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the fee middleware
+func NewParams(minFeeLockTime, maxSponsorshipPoolsPerChannel uint64) Params {
+	return Params{
+		MinFeeLockTime:                minFeeLockTime,
+		MaxSponsorshipPoolsPerChannel: maxSponsorshipPoolsPerChannel,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the fee middleware
+func DefaultParams() Params {
+	return NewParams(DefaultMinFeeLockTime, DefaultMaxSponsorshipPoolsPerChannel)
+}
+
+// Validate all fee middleware parameters
+func (p Params) Validate() error {
+	if err := validateMinFeeLockTime(p.MinFeeLockTime); err != nil {
+		return err
+	}
+
+	return validateMaxSponsorshipPoolsPerChannel(p.MaxSponsorshipPoolsPerChannel)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinFeeLockTime, p.MinFeeLockTime, validateMinFeeLockTime),
+		paramtypes.NewParamSetPair(KeyMaxSponsorshipPoolsPerChannel, p.MaxSponsorshipPoolsPerChannel, validateMaxSponsorshipPoolsPerChannel),
+	}
+}
+
+func validateMinFeeLockTime(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxSponsorshipPoolsPerChannel(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
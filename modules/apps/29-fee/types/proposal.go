@@ -0,0 +1,47 @@
+package types
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUnlockFeeModule defines the type for a UnlockFeeModuleProposal
+	ProposalTypeUnlockFeeModule = "UnlockFeeModule"
+
+	// ReconciliationCommunityPool denotes that an escrow shortfall is funded from the community pool
+	ReconciliationCommunityPool = "community_pool"
+	// ReconciliationProRata denotes that the escrowed amounts are reduced pro rata to match the escrow balance
+	ReconciliationProRata = "pro_rata"
+)
+
+var _ govtypes.Content = &UnlockFeeModuleProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUnlockFeeModule)
+}
+
+// NewUnlockFeeModuleProposal creates a new fee middleware unlock proposal.
+func NewUnlockFeeModuleProposal(title, description string, fundFromCommunityPool bool) govtypes.Content {
+	return &UnlockFeeModuleProposal{
+		Title:                 title,
+		Description:           description,
+		FundFromCommunityPool: fundFromCommunityPool,
+	}
+}
+
+// GetTitle returns the title of a fee middleware unlock proposal.
+func (ufp *UnlockFeeModuleProposal) GetTitle() string { return ufp.Title }
+
+// GetDescription returns the description of a fee middleware unlock proposal.
+func (ufp *UnlockFeeModuleProposal) GetDescription() string { return ufp.Description }
+
+// ProposalRoute returns the routing key of a fee middleware unlock proposal.
+func (ufp *UnlockFeeModuleProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a fee middleware unlock proposal.
+func (ufp *UnlockFeeModuleProposal) ProposalType() string { return ProposalTypeUnlockFeeModule }
+
+// ValidateBasic runs basic stateless validity checks
+func (ufp *UnlockFeeModuleProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(ufp)
+}
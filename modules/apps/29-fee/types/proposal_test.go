@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func TestUnlockFeeModuleProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal govtypes.Content
+		expPass  bool
+	}{
+		{
+			"success",
+			types.NewUnlockFeeModuleProposal(ibctesting.Title, ibctesting.Description, true),
+			true,
+		},
+		{
+			"success: reduce escrowed amounts pro rata",
+			types.NewUnlockFeeModuleProposal(ibctesting.Title, ibctesting.Description, false),
+			true,
+		},
+		{
+			"fails validate abstract - empty title",
+			types.NewUnlockFeeModuleProposal("", ibctesting.Description, true),
+			false,
+		},
+		{
+			"fails validate abstract - empty description",
+			types.NewUnlockFeeModuleProposal(ibctesting.Title, "", true),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+// tests a fee middleware unlock proposal can be marshaled and unmarshaled
+func TestMarshalUnlockFeeModuleProposal(t *testing.T) {
+	proposal := types.NewUnlockFeeModuleProposal(ibctesting.Title, ibctesting.Description, true)
+
+	// create codec
+	ir := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(ir)
+	govtypes.RegisterInterfaces(ir)
+	cdc := codec.NewProtoCodec(ir)
+
+	// marshal message
+	bz, err := cdc.MarshalJSON(proposal.(*types.UnlockFeeModuleProposal))
+	require.NoError(t, err)
+
+	// unmarshal proposal
+	newProposal := &types.UnlockFeeModuleProposal{}
+	err = cdc.UnmarshalJSON(bz, newProposal)
+	require.NoError(t, err)
+	require.Equal(t, proposal, newProposal)
+}
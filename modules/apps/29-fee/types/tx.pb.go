@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -358,6 +360,330 @@ func (m *MsgPayPacketFeeAsyncResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPayPacketFeeAsyncResponse proto.InternalMessageInfo
 
+// MsgCancelPacketFee defines the request type for the CancelPacketFee rpc
+type MsgCancelPacketFee struct {
+	// unique packet identifier comprised of the channel ID, port ID and sequence
+	PacketId types.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id" yaml:"packet_id"`
+	// the refund address of the packet fees to be cancelled
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgCancelPacketFee) Reset()         { *m = MsgCancelPacketFee{} }
+func (m *MsgCancelPacketFee) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPacketFee) ProtoMessage()    {}
+func (*MsgCancelPacketFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{8}
+}
+func (m *MsgCancelPacketFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPacketFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPacketFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelPacketFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPacketFee.Merge(m, src)
+}
+func (m *MsgCancelPacketFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPacketFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPacketFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPacketFee proto.InternalMessageInfo
+
+// MsgCancelPacketFeeResponse defines the response type for the CancelPacketFee rpc
+type MsgCancelPacketFeeResponse struct {
+}
+
+func (m *MsgCancelPacketFeeResponse) Reset()         { *m = MsgCancelPacketFeeResponse{} }
+func (m *MsgCancelPacketFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPacketFeeResponse) ProtoMessage()    {}
+func (*MsgCancelPacketFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{9}
+}
+func (m *MsgCancelPacketFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPacketFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPacketFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelPacketFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPacketFeeResponse.Merge(m, src)
+}
+func (m *MsgCancelPacketFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPacketFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPacketFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPacketFeeResponse proto.InternalMessageInfo
+
+// MsgIncreasePacketFee defines the request type for the IncreasePacketFee rpc
+type MsgIncreasePacketFee struct {
+	// unique packet identifier comprised of the channel ID, port ID and sequence
+	PacketId types.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id" yaml:"packet_id"`
+	// the index of the packet fee to be increased in the list of fees escrowed for the packet
+	FeeIndex uint64 `protobuf:"varint,2,opt,name=fee_index,json=feeIndex,proto3" json:"fee_index,omitempty" yaml:"fee_index"`
+	// the additional recv, ack and timeout fees to be escrowed
+	Fee Fee `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee"`
+	// the refund address of the packet fee to be increased
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgIncreasePacketFee) Reset()         { *m = MsgIncreasePacketFee{} }
+func (m *MsgIncreasePacketFee) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreasePacketFee) ProtoMessage()    {}
+func (*MsgIncreasePacketFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{10}
+}
+func (m *MsgIncreasePacketFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIncreasePacketFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreasePacketFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIncreasePacketFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreasePacketFee.Merge(m, src)
+}
+func (m *MsgIncreasePacketFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIncreasePacketFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreasePacketFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIncreasePacketFee proto.InternalMessageInfo
+
+// MsgIncreasePacketFeeResponse defines the response type for the IncreasePacketFee rpc
+type MsgIncreasePacketFeeResponse struct {
+}
+
+func (m *MsgIncreasePacketFeeResponse) Reset()         { *m = MsgIncreasePacketFeeResponse{} }
+func (m *MsgIncreasePacketFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreasePacketFeeResponse) ProtoMessage()    {}
+func (*MsgIncreasePacketFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{11}
+}
+func (m *MsgIncreasePacketFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIncreasePacketFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreasePacketFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIncreasePacketFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreasePacketFeeResponse.Merge(m, src)
+}
+func (m *MsgIncreasePacketFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIncreasePacketFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreasePacketFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIncreasePacketFeeResponse proto.InternalMessageInfo
+
+// MsgFundSponsorshipPool defines the request type for the FundSponsorshipPool rpc
+type MsgFundSponsorshipPool struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// the fee to be escrowed from the pool for each packet sent on the channel, replacing any previous fee of the pool
+	Fee Fee `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee"`
+	// the funds to be deposited into the pool
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// the sponsor address
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgFundSponsorshipPool) Reset()         { *m = MsgFundSponsorshipPool{} }
+func (m *MsgFundSponsorshipPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundSponsorshipPool) ProtoMessage()    {}
+func (*MsgFundSponsorshipPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{12}
+}
+func (m *MsgFundSponsorshipPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundSponsorshipPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundSponsorshipPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundSponsorshipPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundSponsorshipPool.Merge(m, src)
+}
+func (m *MsgFundSponsorshipPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundSponsorshipPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundSponsorshipPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundSponsorshipPool proto.InternalMessageInfo
+
+// MsgFundSponsorshipPoolResponse defines the response type for the FundSponsorshipPool rpc
+type MsgFundSponsorshipPoolResponse struct {
+}
+
+func (m *MsgFundSponsorshipPoolResponse) Reset()         { *m = MsgFundSponsorshipPoolResponse{} }
+func (m *MsgFundSponsorshipPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundSponsorshipPoolResponse) ProtoMessage()    {}
+func (*MsgFundSponsorshipPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{13}
+}
+func (m *MsgFundSponsorshipPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundSponsorshipPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundSponsorshipPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundSponsorshipPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundSponsorshipPoolResponse.Merge(m, src)
+}
+func (m *MsgFundSponsorshipPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundSponsorshipPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundSponsorshipPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundSponsorshipPoolResponse proto.InternalMessageInfo
+
+// MsgWithdrawSponsorshipPool defines the request type for the WithdrawSponsorshipPool rpc
+type MsgWithdrawSponsorshipPool struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// the sponsor address
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgWithdrawSponsorshipPool) Reset()         { *m = MsgWithdrawSponsorshipPool{} }
+func (m *MsgWithdrawSponsorshipPool) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSponsorshipPool) ProtoMessage()    {}
+func (*MsgWithdrawSponsorshipPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{14}
+}
+func (m *MsgWithdrawSponsorshipPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSponsorshipPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSponsorshipPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawSponsorshipPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSponsorshipPool.Merge(m, src)
+}
+func (m *MsgWithdrawSponsorshipPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSponsorshipPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSponsorshipPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawSponsorshipPool proto.InternalMessageInfo
+
+// MsgWithdrawSponsorshipPoolResponse defines the response type for the WithdrawSponsorshipPool rpc
+type MsgWithdrawSponsorshipPoolResponse struct {
+}
+
+func (m *MsgWithdrawSponsorshipPoolResponse) Reset()         { *m = MsgWithdrawSponsorshipPoolResponse{} }
+func (m *MsgWithdrawSponsorshipPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSponsorshipPoolResponse) ProtoMessage()    {}
+func (*MsgWithdrawSponsorshipPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{15}
+}
+func (m *MsgWithdrawSponsorshipPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSponsorshipPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSponsorshipPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawSponsorshipPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSponsorshipPoolResponse.Merge(m, src)
+}
+func (m *MsgWithdrawSponsorshipPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSponsorshipPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSponsorshipPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawSponsorshipPoolResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterPayee)(nil), "ibc.applications.fee.v1.MsgRegisterPayee")
 	proto.RegisterType((*MsgRegisterPayeeResponse)(nil), "ibc.applications.fee.v1.MsgRegisterPayeeResponse")
@@ -367,56 +693,82 @@ func init() {
 	proto.RegisterType((*MsgPayPacketFeeResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeResponse")
 	proto.RegisterType((*MsgPayPacketFeeAsync)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsync")
 	proto.RegisterType((*MsgPayPacketFeeAsyncResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsyncResponse")
+	proto.RegisterType((*MsgCancelPacketFee)(nil), "ibc.applications.fee.v1.MsgCancelPacketFee")
+	proto.RegisterType((*MsgCancelPacketFeeResponse)(nil), "ibc.applications.fee.v1.MsgCancelPacketFeeResponse")
+	proto.RegisterType((*MsgIncreasePacketFee)(nil), "ibc.applications.fee.v1.MsgIncreasePacketFee")
+	proto.RegisterType((*MsgIncreasePacketFeeResponse)(nil), "ibc.applications.fee.v1.MsgIncreasePacketFeeResponse")
+	proto.RegisterType((*MsgFundSponsorshipPool)(nil), "ibc.applications.fee.v1.MsgFundSponsorshipPool")
+	proto.RegisterType((*MsgFundSponsorshipPoolResponse)(nil), "ibc.applications.fee.v1.MsgFundSponsorshipPoolResponse")
+	proto.RegisterType((*MsgWithdrawSponsorshipPool)(nil), "ibc.applications.fee.v1.MsgWithdrawSponsorshipPool")
+	proto.RegisterType((*MsgWithdrawSponsorshipPoolResponse)(nil), "ibc.applications.fee.v1.MsgWithdrawSponsorshipPoolResponse")
 }
 
 func init() { proto.RegisterFile("ibc/applications/fee/v1/tx.proto", fileDescriptor_05c93128649f1b96) }
 
 var fileDescriptor_05c93128649f1b96 = []byte{
-	// 698 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x10, 0x8f, 0x9b, 0xfe, 0xcb, 0xb4, 0x5f, 0xdb, 0xac, 0xda, 0xaf, 0x8e, 0xd5, 0xc6, 0xfd, 0xac,
-	0x4f, 0xa8, 0x08, 0xd5, 0x26, 0xa1, 0x3d, 0x50, 0x09, 0x21, 0x5c, 0xa9, 0xa2, 0x12, 0x15, 0x91,
-	0xc5, 0x09, 0x21, 0x55, 0x8e, 0xb3, 0x71, 0x0d, 0x89, 0xd7, 0xf2, 0x3a, 0x11, 0x7e, 0x03, 0x8e,
-	0xe5, 0x0d, 0xfa, 0x06, 0xbc, 0x46, 0x8f, 0x3d, 0x70, 0xe0, 0x64, 0xa1, 0xf6, 0xc2, 0x0d, 0x29,
-	0xbc, 0x00, 0x5a, 0x7b, 0x6d, 0x9c, 0x44, 0xa9, 0x02, 0x27, 0x6e, 0x3b, 0x33, 0xbf, 0xf9, 0xed,
-	0xcc, 0xcf, 0x33, 0x5e, 0xd8, 0x71, 0x9a, 0x96, 0x66, 0x7a, 0x5e, 0xc7, 0xb1, 0xcc, 0xc0, 0x21,
-	0x2e, 0xd5, 0xda, 0x18, 0x6b, 0xfd, 0x9a, 0x16, 0xbc, 0x57, 0x3d, 0x9f, 0x04, 0x04, 0x6d, 0x3a,
-	0x4d, 0x4b, 0xcd, 0x23, 0xd4, 0x36, 0xc6, 0x6a, 0xbf, 0x26, 0xad, 0xdb, 0xc4, 0x26, 0x31, 0x46,
-	0x63, 0xa7, 0x04, 0x2e, 0xfd, 0x37, 0x89, 0x90, 0x65, 0xe5, 0x20, 0x16, 0xf1, 0xb1, 0x66, 0x9d,
-	0x9b, 0xae, 0x8b, 0x3b, 0x2c, 0xcc, 0x8f, 0x09, 0x44, 0xf9, 0x24, 0xc0, 0xda, 0x29, 0xb5, 0x0d,
-	0x6c, 0x3b, 0x34, 0xc0, 0x7e, 0xc3, 0x0c, 0x31, 0x46, 0x0f, 0x60, 0xc1, 0x23, 0x7e, 0x70, 0xe6,
-	0xb4, 0x44, 0x61, 0x47, 0xd8, 0x2d, 0xe9, 0x68, 0x10, 0xc9, 0x2b, 0xa1, 0xd9, 0xed, 0x1c, 0x2a,
-	0x3c, 0xa0, 0x18, 0xf3, 0xec, 0x74, 0xd2, 0x42, 0xfb, 0x00, 0x9c, 0x92, 0xe1, 0x67, 0x62, 0xfc,
-	0xc6, 0x20, 0x92, 0xcb, 0x09, 0xfe, 0x57, 0x4c, 0x31, 0x4a, 0xdc, 0x38, 0x69, 0x21, 0x11, 0x16,
-	0x7c, 0xdc, 0x31, 0x43, 0xec, 0x8b, 0x45, 0x96, 0x62, 0xa4, 0x26, 0x5a, 0x87, 0x39, 0x8f, 0x55,
-	0x21, 0xce, 0xc6, 0xfe, 0xc4, 0x38, 0x5c, 0xfc, 0x70, 0x29, 0x17, 0xbe, 0x5d, 0xca, 0x05, 0x45,
-	0x02, 0x71, 0xb4, 0x60, 0x03, 0x53, 0x8f, 0xb8, 0x14, 0x2b, 0x3f, 0x04, 0xd8, 0xca, 0x05, 0x8f,
-	0x48, 0xcf, 0x0d, 0xb0, 0xef, 0x99, 0x7e, 0x10, 0xfe, 0x05, 0x9d, 0xbd, 0x00, 0x64, 0xe5, 0x2a,
-	0x3a, 0xcb, 0xb5, 0xa9, 0x6f, 0x0f, 0x22, 0xb9, 0xc2, 0x79, 0xc7, 0x30, 0x8a, 0x51, 0xb6, 0x46,
-	0x5b, 0xc9, 0x29, 0x72, 0x0f, 0xfe, 0xbf, 0xab, 0xe9, 0x4c, 0x9d, 0x8b, 0x19, 0x58, 0x3d, 0xa5,
-	0x76, 0xc3, 0x0c, 0x1b, 0xa6, 0xf5, 0x0e, 0x07, 0xc7, 0x18, 0xa3, 0x7d, 0x28, 0xb6, 0x31, 0x8e,
-	0xc5, 0x58, 0xaa, 0x6f, 0xa9, 0x13, 0x46, 0x50, 0x3d, 0xc6, 0x58, 0x9f, 0xbd, 0x8a, 0xe4, 0x82,
-	0xc1, 0xe0, 0xe8, 0x29, 0xac, 0x50, 0xd2, 0xf3, 0x2d, 0x7c, 0x96, 0xaa, 0x99, 0xa8, 0x53, 0x19,
-	0x44, 0xf2, 0x46, 0xd2, 0xc5, 0x70, 0x5c, 0x31, 0x96, 0x13, 0x47, 0x23, 0x91, 0xf6, 0x39, 0x94,
-	0x39, 0x20, 0xa7, 0x70, 0x2c, 0x97, 0xbe, 0x35, 0x88, 0x64, 0x71, 0x88, 0x23, 0x2f, 0xf4, 0x6a,
-	0xe2, 0x3b, 0xca, 0xe4, 0xfe, 0x17, 0xe6, 0xa9, 0x63, 0xbb, 0xd8, 0xe7, 0xf3, 0xc2, 0x2d, 0x24,
-	0xc1, 0x22, 0xd7, 0x9d, 0x8a, 0x73, 0x3b, 0xc5, 0xdd, 0x92, 0x91, 0xd9, 0x39, 0xe9, 0x2a, 0xb0,
-	0x39, 0xa2, 0x48, 0xa6, 0xd6, 0x67, 0x01, 0xd6, 0x47, 0x62, 0xcf, 0x68, 0xe8, 0x5a, 0xe8, 0x15,
-	0x94, 0xbc, 0xd8, 0x93, 0x4e, 0xd1, 0x52, 0x7d, 0x3b, 0x16, 0x8e, 0x6d, 0x9a, 0x9a, 0xae, 0x57,
-	0xbf, 0xa6, 0x26, 0x79, 0x27, 0x2d, 0x5d, 0x64, 0xca, 0x0d, 0x22, 0x79, 0x8d, 0x0f, 0x5a, 0x9a,
-	0xad, 0x18, 0x8b, 0x1e, 0xc7, 0xa0, 0x37, 0x00, 0xdc, 0xcf, 0xbe, 0xc7, 0x4c, 0x4c, 0xab, 0x4c,
-	0xfc, 0x1e, 0x59, 0x49, 0x7a, 0x85, 0x73, 0x97, 0x87, 0xb8, 0xdb, 0x6c, 0x68, 0x78, 0x99, 0xc7,
-	0x43, 0xc3, 0x52, 0x8d, 0x37, 0x64, 0xac, 0xab, 0xb4, 0xed, 0xfa, 0xf7, 0x22, 0x14, 0x4f, 0xa9,
-	0x8d, 0xba, 0xf0, 0xcf, 0xf0, 0x4f, 0xe1, 0xfe, 0xc4, 0x62, 0x46, 0xd7, 0x51, 0xaa, 0x4d, 0x0d,
-	0x4d, 0xaf, 0x45, 0x1f, 0x05, 0xa8, 0x4c, 0x5e, 0xdb, 0x83, 0x69, 0x08, 0xc7, 0xd2, 0xa4, 0x27,
-	0x7f, 0x94, 0x96, 0xd5, 0xf4, 0x16, 0x96, 0x87, 0x76, 0x65, 0xf7, 0x2e, 0xba, 0x3c, 0x52, 0x7a,
-	0x38, 0x2d, 0x32, 0xbb, 0x2b, 0x84, 0xf2, 0xf8, 0xa4, 0xed, 0x4d, 0x4b, 0x13, 0xc3, 0xa5, 0x83,
-	0xdf, 0x82, 0xa7, 0x57, 0xeb, 0x2f, 0xaf, 0x6e, 0xaa, 0xc2, 0xf5, 0x4d, 0x55, 0xf8, 0x7a, 0x53,
-	0x15, 0x2e, 0x6e, 0xab, 0x85, 0xeb, 0xdb, 0x6a, 0xe1, 0xcb, 0x6d, 0xb5, 0xf0, 0xfa, 0xc0, 0x76,
-	0x82, 0xf3, 0x5e, 0x53, 0xb5, 0x48, 0x57, 0xb3, 0x08, 0xed, 0x12, 0xaa, 0x39, 0x4d, 0x6b, 0xcf,
-	0x26, 0x5a, 0x7f, 0x5f, 0xeb, 0x92, 0x56, 0xaf, 0x83, 0x29, 0x7b, 0x82, 0xa8, 0x56, 0x7f, 0xbc,
-	0xc7, 0x5e, 0x9f, 0x20, 0xf4, 0x30, 0x6d, 0xce, 0xc7, 0x4f, 0xcb, 0xa3, 0x9f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xe2, 0x94, 0x3a, 0xbf, 0xf3, 0x06, 0x00, 0x00,
+	// 987 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0xfe, 0xca, 0xeb, 0xb2, 0xdd, 0x98, 0xee, 0x36, 0xb1, 0xb2, 0x71, 0xb0, 0x56,
+	0x28, 0x68, 0x55, 0x7b, 0x93, 0x6d, 0x85, 0x58, 0x84, 0x10, 0xae, 0x54, 0x11, 0x89, 0x88, 0xc8,
+	0x20, 0x21, 0x21, 0xa4, 0xca, 0xb1, 0x27, 0xae, 0xd9, 0xc4, 0x63, 0x79, 0x9c, 0xb2, 0x39, 0x71,
+	0x45, 0x82, 0xc3, 0xf2, 0x1f, 0x2c, 0x57, 0x2e, 0x5c, 0xf9, 0x13, 0xf6, 0xb8, 0x07, 0x0e, 0x9c,
+	0x02, 0x6a, 0x2f, 0x9c, 0x38, 0x84, 0x03, 0x57, 0x34, 0xf6, 0xd8, 0x71, 0xe2, 0x3a, 0x6a, 0x2a,
+	0x15, 0x38, 0x65, 0x7e, 0x7c, 0xef, 0xcd, 0xfb, 0xbe, 0x79, 0xf3, 0x5e, 0x0c, 0x75, 0xbb, 0x67,
+	0x28, 0xba, 0xeb, 0x0e, 0x6c, 0x43, 0xf7, 0x6d, 0xec, 0x10, 0xa5, 0x8f, 0x90, 0x72, 0xd6, 0x54,
+	0xfc, 0x67, 0xb2, 0xeb, 0x61, 0x1f, 0xf3, 0x7b, 0x76, 0xcf, 0x90, 0x93, 0x08, 0xb9, 0x8f, 0x90,
+	0x7c, 0xd6, 0x14, 0x6a, 0x06, 0x26, 0x43, 0x4c, 0x94, 0x9e, 0x4e, 0xa8, 0x45, 0x0f, 0xf9, 0x7a,
+	0x53, 0x31, 0xb0, 0xed, 0x84, 0x86, 0xc2, 0xae, 0x85, 0x2d, 0x1c, 0x0c, 0x15, 0x3a, 0x62, 0xab,
+	0x6f, 0x64, 0x1d, 0x48, 0xbd, 0x26, 0x20, 0x06, 0xf6, 0x90, 0x62, 0x9c, 0xea, 0x8e, 0x83, 0x06,
+	0x74, 0x9b, 0x0d, 0x43, 0x88, 0xf4, 0x13, 0x07, 0x77, 0x3a, 0xc4, 0xd2, 0x90, 0x65, 0x13, 0x1f,
+	0x79, 0x5d, 0x7d, 0x8c, 0x10, 0xff, 0x10, 0x36, 0x5d, 0xec, 0xf9, 0x27, 0xb6, 0x59, 0xe6, 0xea,
+	0x5c, 0xa3, 0xa8, 0xf2, 0xd3, 0x89, 0x78, 0x7b, 0xac, 0x0f, 0x07, 0x4f, 0x24, 0xb6, 0x21, 0x69,
+	0x1b, 0x74, 0xd4, 0x36, 0xf9, 0x03, 0x00, 0xe6, 0x92, 0xe2, 0xf3, 0x01, 0xfe, 0xee, 0x74, 0x22,
+	0x96, 0x42, 0xfc, 0x6c, 0x4f, 0xd2, 0x8a, 0x6c, 0xd2, 0x36, 0xf9, 0x32, 0x6c, 0x7a, 0x68, 0xa0,
+	0x8f, 0x91, 0x57, 0x2e, 0x50, 0x13, 0x2d, 0x9a, 0xf2, 0xbb, 0xb0, 0xee, 0xd2, 0x28, 0xca, 0x6b,
+	0xc1, 0x7a, 0x38, 0x79, 0xb2, 0xf5, 0xcd, 0x0b, 0x31, 0xf7, 0xc7, 0x0b, 0x31, 0x27, 0x09, 0x50,
+	0x5e, 0x0c, 0x58, 0x43, 0xc4, 0xc5, 0x0e, 0x41, 0xd2, 0x5f, 0x1c, 0x54, 0x13, 0x9b, 0x47, 0x78,
+	0xe4, 0xf8, 0xc8, 0x73, 0x75, 0xcf, 0x1f, 0xff, 0x0f, 0x98, 0x7d, 0x04, 0xbc, 0x91, 0x88, 0xe8,
+	0x24, 0x41, 0x53, 0xbd, 0x3f, 0x9d, 0x88, 0x15, 0xe6, 0x37, 0x85, 0x91, 0xb4, 0x92, 0xb1, 0x48,
+	0x25, 0xa1, 0xc8, 0x9b, 0xf0, 0x60, 0x19, 0xe9, 0x58, 0x9d, 0xe7, 0x79, 0xd8, 0xe9, 0x10, 0xab,
+	0xab, 0x8f, 0xbb, 0xba, 0xf1, 0x14, 0xf9, 0xc7, 0x08, 0xf1, 0x07, 0x50, 0xe8, 0x23, 0x14, 0x88,
+	0xb1, 0xdd, 0xaa, 0xca, 0x19, 0x29, 0x2a, 0x1f, 0x23, 0xa4, 0xae, 0xbd, 0x9c, 0x88, 0x39, 0x8d,
+	0xc2, 0xf9, 0xf7, 0xe1, 0x36, 0xc1, 0x23, 0xcf, 0x40, 0x27, 0x91, 0x9a, 0xa1, 0x3a, 0x95, 0xe9,
+	0x44, 0xbc, 0x1b, 0xb2, 0x98, 0xdf, 0x97, 0xb4, 0x5b, 0xe1, 0x42, 0x37, 0x94, 0xf6, 0x43, 0x28,
+	0x31, 0x40, 0x42, 0xe1, 0x40, 0x2e, 0xb5, 0x3a, 0x9d, 0x88, 0xe5, 0x39, 0x1f, 0x49, 0xa1, 0x77,
+	0xc2, 0xb5, 0xa3, 0x58, 0xee, 0x7b, 0xb0, 0x41, 0x6c, 0xcb, 0x41, 0x1e, 0xcb, 0x17, 0x36, 0xe3,
+	0x05, 0xd8, 0x62, 0xba, 0x93, 0xf2, 0x7a, 0xbd, 0xd0, 0x28, 0x6a, 0xf1, 0x3c, 0x21, 0x5d, 0x05,
+	0xf6, 0x16, 0x14, 0x89, 0xd5, 0xfa, 0x85, 0x83, 0xdd, 0x85, 0xbd, 0x0f, 0xc8, 0xd8, 0x31, 0xf8,
+	0x4f, 0xa1, 0xe8, 0x06, 0x2b, 0x51, 0x16, 0x6d, 0xb7, 0xee, 0x07, 0xc2, 0xd1, 0x97, 0x26, 0x47,
+	0xcf, 0xeb, 0xac, 0x29, 0x87, 0x76, 0x6d, 0x53, 0x2d, 0x53, 0xe5, 0xa6, 0x13, 0xf1, 0x0e, 0x4b,
+	0xb4, 0xc8, 0x5a, 0xd2, 0xb6, 0x5c, 0x86, 0xe1, 0xbf, 0x00, 0x60, 0xeb, 0xf4, 0x3e, 0xf2, 0x81,
+	0x5b, 0x29, 0xf3, 0x3e, 0xe2, 0x90, 0xd4, 0x0a, 0xf3, 0x5d, 0x9a, 0xf3, 0xdd, 0xa7, 0x49, 0xc3,
+	0xc2, 0x3c, 0x9e, 0x4b, 0x96, 0x1a, 0x54, 0x2f, 0x63, 0x15, 0xd3, 0xfe, 0x8e, 0x03, 0xbe, 0x43,
+	0xac, 0x23, 0xdd, 0x31, 0xd0, 0x60, 0x96, 0x27, 0x37, 0x43, 0x7a, 0x76, 0x79, 0xf9, 0xe4, 0xe5,
+	0x25, 0xc2, 0xad, 0x82, 0x90, 0x8e, 0x26, 0x0e, 0xf6, 0xef, 0xf0, 0x8e, 0xda, 0x8e, 0xe1, 0x21,
+	0x9d, 0xa0, 0x9b, 0x0e, 0xb7, 0x09, 0xc5, 0x3e, 0x42, 0x27, 0xb6, 0x63, 0xa2, 0x67, 0x41, 0xc4,
+	0x6b, 0xea, 0xee, 0xcc, 0x24, 0xde, 0x92, 0xb4, 0xad, 0x3e, 0x42, 0x6d, 0x3a, 0x8c, 0xde, 0x57,
+	0x61, 0xb5, 0xf7, 0x95, 0x91, 0xd4, 0xa9, 0x6b, 0x4c, 0x11, 0x8f, 0x95, 0xf9, 0x39, 0x0f, 0xf7,
+	0x3a, 0xc4, 0x3a, 0x1e, 0x39, 0xe6, 0x27, 0x74, 0x05, 0x7b, 0xe4, 0xd4, 0x76, 0xbb, 0x18, 0x0f,
+	0xfe, 0x8d, 0x1a, 0x78, 0x3d, 0xd6, 0x06, 0x6c, 0xe8, 0x43, 0x5a, 0xbd, 0xca, 0x6b, 0xf5, 0x42,
+	0x63, 0xbb, 0x55, 0x91, 0xc3, 0xc6, 0x28, 0xd3, 0xc6, 0x28, 0xb3, 0xc6, 0x28, 0x1f, 0x61, 0xdb,
+	0x51, 0x1f, 0x51, 0xab, 0x1f, 0x7f, 0x13, 0x1b, 0x96, 0xed, 0x9f, 0x8e, 0x7a, 0xb2, 0x81, 0x87,
+	0x0a, 0xeb, 0xa2, 0xe1, 0xcf, 0x3e, 0x31, 0x9f, 0x2a, 0xfe, 0xd8, 0x45, 0x24, 0x30, 0x20, 0x1a,
+	0x73, 0x9d, 0x90, 0x76, 0x3d, 0x43, 0xda, 0x3a, 0xd4, 0x2e, 0x57, 0x2e, 0x16, 0xf7, 0x07, 0x2e,
+	0xc8, 0xca, 0xcf, 0x6c, 0xff, 0xd4, 0xf4, 0xf4, 0xaf, 0xfe, 0x03, 0x81, 0x67, 0x2c, 0x0a, 0x19,
+	0x2c, 0x1e, 0x80, 0x94, 0x1d, 0x62, 0xc4, 0xa4, 0xf5, 0xe7, 0x26, 0x14, 0x3a, 0xc4, 0xe2, 0x87,
+	0xf0, 0xda, 0xfc, 0x5f, 0x80, 0xb7, 0x32, 0x2f, 0x6d, 0xb1, 0xf9, 0x0a, 0xcd, 0x2b, 0x43, 0xa3,
+	0x63, 0xf9, 0xef, 0x39, 0xa8, 0x64, 0x37, 0xe9, 0xc3, 0xab, 0x38, 0x4c, 0x99, 0x09, 0xef, 0x5d,
+	0xcb, 0x2c, 0x8e, 0xe9, 0x4b, 0xb8, 0x35, 0xd7, 0x19, 0x1b, 0xcb, 0xdc, 0x25, 0x91, 0xc2, 0xa3,
+	0xab, 0x22, 0xe3, 0xb3, 0xc6, 0x50, 0x4a, 0xf7, 0x95, 0xfd, 0xab, 0xba, 0x09, 0xe0, 0xc2, 0xe1,
+	0x4a, 0xf0, 0xf8, 0x68, 0x02, 0x3b, 0x8b, 0xb5, 0xfd, 0xe1, 0x32, 0x4f, 0x0b, 0x60, 0xe1, 0xf1,
+	0x0a, 0xe0, 0x24, 0xdf, 0x74, 0x8d, 0x5e, 0xca, 0x37, 0x05, 0x17, 0x0e, 0x57, 0x82, 0xc7, 0x47,
+	0x7f, 0x0d, 0xaf, 0x5f, 0x56, 0x04, 0x95, 0x65, 0xde, 0x2e, 0x31, 0x10, 0xde, 0x5e, 0xd1, 0x20,
+	0x0e, 0xe0, 0x5b, 0x0e, 0xf6, 0xb2, 0x2a, 0xc5, 0x52, 0x31, 0x33, 0x8c, 0x84, 0x77, 0xaf, 0x61,
+	0x14, 0x45, 0xa3, 0x7e, 0xfc, 0xf2, 0xbc, 0xc6, 0xbd, 0x3a, 0xaf, 0x71, 0xbf, 0x9f, 0xd7, 0xb8,
+	0xe7, 0x17, 0xb5, 0xdc, 0xab, 0x8b, 0x5a, 0xee, 0xd7, 0x8b, 0x5a, 0xee, 0xf3, 0xc3, 0x74, 0x29,
+	0xb5, 0x7b, 0xc6, 0xbe, 0x85, 0x95, 0xb3, 0x03, 0x65, 0x88, 0xcd, 0xd1, 0x00, 0x11, 0xfa, 0xbd,
+	0x41, 0x94, 0xd6, 0x3b, 0xfb, 0xf4, 0x53, 0x23, 0xa8, 0xae, 0xbd, 0x8d, 0xe0, 0x3b, 0xe2, 0xf1,
+	0x3f, 0x03, 0x00, 0xac, 0x91, 0xc2, 0x1e, 0x00, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -453,6 +805,22 @@ type MsgClient interface {
 	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
 	// incentivize the relaying of a known packet (i.e. at a particular sequence)
 	PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAsync, opts ...grpc.CallOption) (*MsgPayPacketFeeAsyncResponse, error)
+	// CancelPacketFee defines a rpc handler method for MsgCancelPacketFee
+	// CancelPacketFee may be called by the refund address of fees escrowed for a packet which has not completed the
+	// packet life cycle in order to refund all of its fees which have been held in escrow for at least the minimum
+	// fee lock time
+	CancelPacketFee(ctx context.Context, in *MsgCancelPacketFee, opts ...grpc.CallOption) (*MsgCancelPacketFeeResponse, error)
+	// IncreasePacketFee defines a rpc handler method for MsgIncreasePacketFee
+	// IncreasePacketFee may be called by the refund address of a fee escrowed for a packet which has not completed the
+	// packet life cycle in order to escrow additional funds for that fee rather than escrowing a new fee
+	IncreasePacketFee(ctx context.Context, in *MsgIncreasePacketFee, opts ...grpc.CallOption) (*MsgIncreasePacketFeeResponse, error)
+	// FundSponsorshipPool defines a rpc handler method for MsgFundSponsorshipPool
+	// FundSponsorshipPool deposits funds into the sponsorship pool of the signer on a fee enabled channel. The fee
+	// of the pool is escrowed from the pool for each packet sent on the channel until the pool is exhausted
+	FundSponsorshipPool(ctx context.Context, in *MsgFundSponsorshipPool, opts ...grpc.CallOption) (*MsgFundSponsorshipPoolResponse, error)
+	// WithdrawSponsorshipPool defines a rpc handler method for MsgWithdrawSponsorshipPool
+	// WithdrawSponsorshipPool refunds the remaining balance of the sponsorship pool of the signer and removes the pool
+	WithdrawSponsorshipPool(ctx context.Context, in *MsgWithdrawSponsorshipPool, opts ...grpc.CallOption) (*MsgWithdrawSponsorshipPoolResponse, error)
 }
 
 type msgClient struct {
@@ -499,6 +867,42 @@ func (c *msgClient) PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAs
 	return out, nil
 }
 
+func (c *msgClient) CancelPacketFee(ctx context.Context, in *MsgCancelPacketFee, opts ...grpc.CallOption) (*MsgCancelPacketFeeResponse, error) {
+	out := new(MsgCancelPacketFeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/CancelPacketFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) IncreasePacketFee(ctx context.Context, in *MsgIncreasePacketFee, opts ...grpc.CallOption) (*MsgIncreasePacketFeeResponse, error) {
+	out := new(MsgIncreasePacketFeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/IncreasePacketFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundSponsorshipPool(ctx context.Context, in *MsgFundSponsorshipPool, opts ...grpc.CallOption) (*MsgFundSponsorshipPoolResponse, error) {
+	out := new(MsgFundSponsorshipPoolResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/FundSponsorshipPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawSponsorshipPool(ctx context.Context, in *MsgWithdrawSponsorshipPool, opts ...grpc.CallOption) (*MsgWithdrawSponsorshipPoolResponse, error) {
+	out := new(MsgWithdrawSponsorshipPoolResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/WithdrawSponsorshipPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterPayee defines a rpc handler method for MsgRegisterPayee
@@ -523,6 +927,22 @@ type MsgServer interface {
 	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
 	// incentivize the relaying of a known packet (i.e. at a particular sequence)
 	PayPacketFeeAsync(context.Context, *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error)
+	// CancelPacketFee defines a rpc handler method for MsgCancelPacketFee
+	// CancelPacketFee may be called by the refund address of fees escrowed for a packet which has not completed the
+	// packet life cycle in order to refund all of its fees which have been held in escrow for at least the minimum
+	// fee lock time
+	CancelPacketFee(context.Context, *MsgCancelPacketFee) (*MsgCancelPacketFeeResponse, error)
+	// IncreasePacketFee defines a rpc handler method for MsgIncreasePacketFee
+	// IncreasePacketFee may be called by the refund address of a fee escrowed for a packet which has not completed the
+	// packet life cycle in order to escrow additional funds for that fee rather than escrowing a new fee
+	IncreasePacketFee(context.Context, *MsgIncreasePacketFee) (*MsgIncreasePacketFeeResponse, error)
+	// FundSponsorshipPool defines a rpc handler method for MsgFundSponsorshipPool
+	// FundSponsorshipPool deposits funds into the sponsorship pool of the signer on a fee enabled channel. The fee
+	// of the pool is escrowed from the pool for each packet sent on the channel until the pool is exhausted
+	FundSponsorshipPool(context.Context, *MsgFundSponsorshipPool) (*MsgFundSponsorshipPoolResponse, error)
+	// WithdrawSponsorshipPool defines a rpc handler method for MsgWithdrawSponsorshipPool
+	// WithdrawSponsorshipPool refunds the remaining balance of the sponsorship pool of the signer and removes the pool
+	WithdrawSponsorshipPool(context.Context, *MsgWithdrawSponsorshipPool) (*MsgWithdrawSponsorshipPoolResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -541,6 +961,18 @@ func (*UnimplementedMsgServer) PayPacketFee(ctx context.Context, req *MsgPayPack
 func (*UnimplementedMsgServer) PayPacketFeeAsync(ctx context.Context, req *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PayPacketFeeAsync not implemented")
 }
+func (*UnimplementedMsgServer) CancelPacketFee(ctx context.Context, req *MsgCancelPacketFee) (*MsgCancelPacketFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPacketFee not implemented")
+}
+func (*UnimplementedMsgServer) IncreasePacketFee(ctx context.Context, req *MsgIncreasePacketFee) (*MsgIncreasePacketFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreasePacketFee not implemented")
+}
+func (*UnimplementedMsgServer) FundSponsorshipPool(ctx context.Context, req *MsgFundSponsorshipPool) (*MsgFundSponsorshipPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundSponsorshipPool not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawSponsorshipPool(ctx context.Context, req *MsgWithdrawSponsorshipPool) (*MsgWithdrawSponsorshipPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSponsorshipPool not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -618,48 +1050,136 @@ func _Msg_PayPacketFeeAsync_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.applications.fee.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterPayee",
-			Handler:    _Msg_RegisterPayee_Handler,
-		},
-		{
-			MethodName: "RegisterCounterpartyPayee",
-			Handler:    _Msg_RegisterCounterpartyPayee_Handler,
-		},
-		{
-			MethodName: "PayPacketFee",
-			Handler:    _Msg_PayPacketFee_Handler,
-		},
-		{
-			MethodName: "PayPacketFeeAsync",
-			Handler:    _Msg_PayPacketFeeAsync_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/applications/fee/v1/tx.proto",
-}
-
-func (m *MsgRegisterPayee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelPacketFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPacketFee)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRegisterPayee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPacketFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/CancelPacketFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPacketFee(ctx, req.(*MsgCancelPacketFee))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
+func _Msg_IncreasePacketFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIncreasePacketFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IncreasePacketFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/IncreasePacketFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IncreasePacketFee(ctx, req.(*MsgIncreasePacketFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundSponsorshipPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundSponsorshipPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundSponsorshipPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/FundSponsorshipPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundSponsorshipPool(ctx, req.(*MsgFundSponsorshipPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawSponsorshipPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawSponsorshipPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawSponsorshipPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/WithdrawSponsorshipPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawSponsorshipPool(ctx, req.(*MsgWithdrawSponsorshipPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.fee.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterPayee",
+			Handler:    _Msg_RegisterPayee_Handler,
+		},
+		{
+			MethodName: "RegisterCounterpartyPayee",
+			Handler:    _Msg_RegisterCounterpartyPayee_Handler,
+		},
+		{
+			MethodName: "PayPacketFee",
+			Handler:    _Msg_PayPacketFee_Handler,
+		},
+		{
+			MethodName: "PayPacketFeeAsync",
+			Handler:    _Msg_PayPacketFeeAsync_Handler,
+		},
+		{
+			MethodName: "CancelPacketFee",
+			Handler:    _Msg_CancelPacketFee_Handler,
+		},
+		{
+			MethodName: "IncreasePacketFee",
+			Handler:    _Msg_IncreasePacketFee_Handler,
+		},
+		{
+			MethodName: "FundSponsorshipPool",
+			Handler:    _Msg_FundSponsorshipPool_Handler,
+		},
+		{
+			MethodName: "WithdrawSponsorshipPool",
+			Handler:    _Msg_WithdrawSponsorshipPool_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/fee/v1/tx.proto",
+}
+
+func (m *MsgRegisterPayee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPayee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
@@ -943,88 +1463,387 @@ func (m *MsgPayPacketFeeAsyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCancelPacketFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterPayee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCancelPacketFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Relayer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Payee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterPayeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelPacketFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPacketFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPacketFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterCounterpartyPayee) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgIncreasePacketFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIncreasePacketFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreasePacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Relayer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.FeeIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FeeIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.CounterpartyPayee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterCounterpartyPayeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgIncreasePacketFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIncreasePacketFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreasePacketFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPayPacketFee) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFundSponsorshipPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundSponsorshipPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundSponsorshipPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundSponsorshipPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundSponsorshipPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundSponsorshipPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSponsorshipPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSponsorshipPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSponsorshipPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSponsorshipPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSponsorshipPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSponsorshipPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterPayeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterCounterpartyPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CounterpartyPayee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterCounterpartyPayeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPayPacketFee) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1048,47 +1867,885 @@ func (m *MsgPayPacketFee) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgPayPacketFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPayPacketFeeAsync) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PacketFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPayPacketFeeAsyncResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelPacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelPacketFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgIncreasePacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.FeeIndex != 0 {
+		n += 1 + sovTx(uint64(m.FeeIndex))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgIncreasePacketFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundSponsorshipPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundSponsorshipPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawSponsorshipPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawSponsorshipPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPayeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgPayPacketFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgPayPacketFeeAsync) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.PacketId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.PacketFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgPayPacketFeeAsyncResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
+func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1111,17 +2768,17 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPayPacketFeeAsync: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPayPacketFeeAsync: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1131,29 +2788,30 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1163,29 +2821,130 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelPacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelPacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1195,27 +2954,28 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1243,7 +3003,7 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payee = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1266,7 +3026,7 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPacketFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1289,10 +3049,10 @@ func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPayeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPacketFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1316,7 +3076,7 @@ func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
+func (m *MsgIncreasePacketFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1339,17 +3099,17 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIncreasePacketFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIncreasePacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1359,29 +3119,30 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeIndex", wireType)
 			}
-			var stringLen uint64
+			m.FeeIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1391,29 +3152,16 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FeeIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1423,27 +3171,28 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1471,7 +3220,7 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1494,7 +3243,7 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgIncreasePacketFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1517,10 +3266,10 @@ func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIncreasePacketFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIncreasePacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1544,7 +3293,7 @@ func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
+func (m *MsgFundSponsorshipPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1567,17 +3316,17 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundSponsorshipPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundSponsorshipPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1587,28 +3336,27 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1636,13 +3384,13 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourcePortId = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1652,29 +3400,30 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceChannelId = string(dAtA[iNdEx:postIndex])
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1684,27 +3433,29 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1732,7 +3483,7 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1755,7 +3506,7 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundSponsorshipPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1778,10 +3529,10 @@ func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundSponsorshipPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundSponsorshipPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1805,7 +3556,7 @@ func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawSponsorshipPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1828,17 +3579,17 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsync: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawSponsorshipPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsync: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawSponsorshipPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1848,30 +3599,29 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1881,24 +3631,55 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1921,7 +3702,7 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawSponsorshipPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1944,10 +3725,10 @@ func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawSponsorshipPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawSponsorshipPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
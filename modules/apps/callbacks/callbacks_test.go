@@ -0,0 +1,132 @@
+package ibccallbacks_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/callbacks/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+// CallbacksTestSuite defines the needed instances and methods to test callbacks
+type CallbacksTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+// SetupTest creates a coordinator with 2 test chains.
+func (s *CallbacksTestSuite) SetupTest() {
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+// SetupTransferTest sets up a transfer channel between chainA and chainB
+func (s *CallbacksTestSuite) SetupTransferTest() {
+	s.SetupTest()
+
+	s.path = ibctesting.NewPath(s.chainA, s.chainB)
+	s.path.EndpointA.ChannelConfig.PortID = transfertypes.PortID
+	s.path.EndpointB.ChannelConfig.PortID = transfertypes.PortID
+	s.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	s.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	s.coordinator.Setup(s.path)
+}
+
+// SetupICATest sets up an interchain accounts channel between chainA (controller) and chainB (host).
+// The sender account of chainA is used as the interchain account owner.
+func (s *CallbacksTestSuite) SetupICATest() {
+	s.SetupTest()
+
+	icaVersion := icatypes.NewDefaultMetadataString(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+
+	s.path = ibctesting.NewPath(s.chainA, s.chainB)
+	s.path.EndpointA.ChannelConfig.PortID = icatypes.PortID
+	s.path.EndpointB.ChannelConfig.PortID = icatypes.PortID
+	s.path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	s.path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	s.path.EndpointA.ChannelConfig.Version = icaVersion
+	s.path.EndpointB.ChannelConfig.Version = icaVersion
+
+	s.coordinator.SetupConnections(s.path)
+
+	owner := s.chainA.SenderAccount.GetAddress().String()
+	portID, err := icatypes.NewControllerPortID(owner)
+	s.Require().NoError(err)
+
+	channelSequence := s.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(s.chainA.GetContext())
+
+	err = s.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(s.chainA.GetContext(), s.path.EndpointA.ConnectionID, owner, icaVersion)
+	s.Require().NoError(err)
+
+	// commit state changes for proof verification
+	s.chainA.NextBlock()
+
+	s.path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	s.path.EndpointA.ChannelConfig.PortID = portID
+
+	s.Require().NoError(s.path.EndpointB.ChanOpenTry())
+	s.Require().NoError(s.path.EndpointA.ChanOpenAck())
+	s.Require().NoError(s.path.EndpointB.ChanOpenConfirm())
+}
+
+// AssertCallbackCounters asserts the number of committed callbacks of each type on the provided chain.
+func (s *CallbacksTestSuite) AssertCallbackCounters(chain *ibctesting.TestChain, expCounters map[types.CallbackType]uint64) {
+	contractKeeper := chain.GetSimApp().MockContractKeeper
+	for _, callbackType := range []types.CallbackType{
+		types.CallbackTypeSendPacket,
+		types.CallbackTypeAcknowledgementPacket,
+		types.CallbackTypeTimeoutPacket,
+		types.CallbackTypeReceivePacket,
+	} {
+		s.Require().Equal(expCounters[callbackType], contractKeeper.GetCallbackCounter(chain.GetContext(), callbackType), string(callbackType))
+	}
+}
+
+// AssertCallbackEvent asserts that a callback event of the provided type was emitted with the expected result.
+func (s *CallbacksTestSuite) AssertCallbackEvent(events sdk.Events, eventType string, callbackType types.CallbackType, expResult string) {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		attributes := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+
+		if attributes[types.AttributeKeyCallbackType] == string(callbackType) {
+			s.Require().Equal(expResult, attributes[types.AttributeKeyCallbackResult])
+			return
+		}
+	}
+
+	s.Require().Fail(fmt.Sprintf("callback event %s for %s not found", eventType, callbackType))
+}
+
+func TestCallbacksTestSuite(t *testing.T) {
+	suite.Run(t, new(CallbacksTestSuite))
+}
+
+// sourceCallbackMemo returns a memo containing a source callback for the provided address.
+func sourceCallbackMemo(address string) string {
+	return fmt.Sprintf(`{"%s": {"%s": "%s"}}`, types.SourceCallbackKey, types.CallbackAddressKey, address)
+}
+
+// destCallbackMemo returns a memo containing a destination callback for the provided address.
+func destCallbackMemo(address string) string {
+	return fmt.Sprintf(`{"%s": {"%s": "%s"}}`, types.DestinationCallbackKey, types.CallbackAddressKey, address)
+}
@@ -0,0 +1,344 @@
+package ibccallbacks
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+var (
+	_ porttypes.Middleware            = &IBCMiddleware{}
+	_ porttypes.PacketDataUnmarshaler = &IBCMiddleware{}
+)
+
+// IBCMiddleware implements the ICS26 callbacks for the callbacks middleware given the
+// underlying application, the ICS4Wrapper and the contract keeper.
+type IBCMiddleware struct {
+	app         porttypes.IBCModule
+	ics4Wrapper porttypes.ICS4Wrapper
+
+	packetDataUnmarshaler porttypes.PacketDataUnmarshaler
+	contractKeeper        types.ContractKeeper
+
+	// maxCallbackGas defines the maximum amount of gas that a callback actor can ask the
+	// relayer to pay for. If a callback fails due to insufficient gas, the entire tx
+	// is reverted if the relayer hadn't provided the minimum(userDefinedGas, maxCallbackGas).
+	// If the actor hasn't defined a gas limit, then it is assumed to be the maxCallbackGas.
+	maxCallbackGas uint64
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the underlying application, the ICS4Wrapper,
+// the contract keeper and the maximum callback gas. The underlying application must implement
+// the PacketDataUnmarshaler interface.
+func NewIBCMiddleware(
+	app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper,
+	contractKeeper types.ContractKeeper, maxCallbackGas uint64,
+) IBCMiddleware {
+	packetDataUnmarshalerApp, ok := app.(porttypes.PacketDataUnmarshaler)
+	if !ok {
+		panic(fmt.Errorf("underlying application does not implement %T", (*porttypes.PacketDataUnmarshaler)(nil)))
+	}
+
+	if ics4Wrapper == nil {
+		panic(fmt.Errorf("ICS4Wrapper cannot be nil"))
+	}
+
+	if contractKeeper == nil {
+		panic(fmt.Errorf("contract keeper cannot be nil"))
+	}
+
+	if maxCallbackGas == 0 {
+		panic(fmt.Errorf("maximum callback gas must be greater than 0"))
+	}
+
+	return IBCMiddleware{
+		app:                   app,
+		ics4Wrapper:           ics4Wrapper,
+		packetDataUnmarshaler: packetDataUnmarshalerApp,
+		contractKeeper:        contractKeeper,
+		maxCallbackGas:        maxCallbackGas,
+	}
+}
+
+// OnChanOpenInit defers to the underlying application
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry defers to the underlying application
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck defers to the underlying application
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyChannelID,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm defers to the underlying application
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit defers to the underlying application
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm defers to the underlying application
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// SendPacket implements the ICS4Wrapper interface. The packet is sent using the ICS4Wrapper
+// and, if the packet data contains a source callback, the send packet callback is executed.
+// An error returned by the callback reverts the sending of the packet.
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+) error {
+	if err := im.ics4Wrapper.SendPacket(ctx, chanCap, packet); err != nil {
+		return err
+	}
+
+	callbackData, err := types.GetSourceCallbackData(ctx, im.packetDataUnmarshaler, packet, im.maxCallbackGas)
+	// SendPacket is not blocked if the packet does not opt-in to callbacks
+	if err != nil {
+		return nil
+	}
+
+	callbackExecutor := func(cachedCtx sdk.Context) error {
+		return im.contractKeeper.IBCSendPacketCallback(
+			cachedCtx, packet, callbackData.CallbackAddress, callbackData.SenderAddress,
+		)
+	}
+
+	err = processCallback(ctx, types.CallbackTypeSendPacket, callbackData, callbackExecutor)
+	// contract keeper is allowed to reject the packet send.
+	if err != nil {
+		return err
+	}
+
+	types.EmitCallbackEvent(ctx, packet, types.CallbackTypeSendPacket, callbackData, nil)
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. The packet is received by the underlying
+// application and, if the packet data contains a destination callback, the receive packet
+// callback is executed. The acknowledgement returned by the underlying application is not
+// affected by the outcome of the callback.
+//
+// If the underlying application returns a nil acknowledgement, the acknowledgement is written
+// asynchronously and the callback is executed in WriteAcknowledgement.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	// if ack is nil (asynchronous acknowledgements), then the callback will be handled in WriteAcknowledgement
+	if ack == nil {
+		return nil
+	}
+
+	callbackData, err := types.GetDestCallbackData(ctx, im.packetDataUnmarshaler, packet, im.maxCallbackGas)
+	// OnRecvPacket is not blocked if the packet does not opt-in to callbacks
+	if err != nil {
+		return ack
+	}
+
+	callbackExecutor := func(cachedCtx sdk.Context) error {
+		return im.contractKeeper.IBCReceivePacketCallback(cachedCtx, packet, ack, callbackData.CallbackAddress)
+	}
+
+	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
+	err = processCallback(ctx, types.CallbackTypeReceivePacket, callbackData, callbackExecutor)
+	types.EmitCallbackEvent(ctx, packet, types.CallbackTypeReceivePacket, callbackData, err)
+
+	return ack
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface. The acknowledgement is written
+// using the ICS4Wrapper and, if the packet data contains a destination callback, the receive
+// packet callback is executed.
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	if err := im.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack); err != nil {
+		return err
+	}
+
+	callbackData, err := types.GetDestCallbackData(ctx, im.packetDataUnmarshaler, packet, im.maxCallbackGas)
+	// WriteAcknowledgement is not blocked if the packet does not opt-in to callbacks
+	if err != nil {
+		return nil
+	}
+
+	callbackExecutor := func(cachedCtx sdk.Context) error {
+		return im.contractKeeper.IBCReceivePacketCallback(cachedCtx, packet, ack, callbackData.CallbackAddress)
+	}
+
+	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
+	err = processCallback(ctx, types.CallbackTypeReceivePacket, callbackData, callbackExecutor)
+	types.EmitCallbackEvent(ctx, packet, types.CallbackTypeReceivePacket, callbackData, err)
+
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The acknowledgement is processed
+// by the underlying application and, if the packet data contains a source callback, the
+// acknowledgement packet callback is executed. A failing callback does not revert the
+// processing of the acknowledgement.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	// we first call the underlying app to handle the acknowledgement
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	callbackData, err := types.GetSourceCallbackData(ctx, im.packetDataUnmarshaler, packet, im.maxCallbackGas)
+	// OnAcknowledgementPacket is not blocked if the packet does not opt-in to callbacks
+	if err != nil {
+		return nil
+	}
+
+	callbackExecutor := func(cachedCtx sdk.Context) error {
+		return im.contractKeeper.IBCOnAcknowledgementPacketCallback(
+			cachedCtx, packet, acknowledgement, relayer, callbackData.CallbackAddress, callbackData.SenderAddress,
+		)
+	}
+
+	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
+	err = processCallback(ctx, types.CallbackTypeAcknowledgementPacket, callbackData, callbackExecutor)
+	types.EmitCallbackEvent(ctx, packet, types.CallbackTypeAcknowledgementPacket, callbackData, err)
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The timeout is processed by the
+// underlying application and, if the packet data contains a source callback, the timeout
+// packet callback is executed. A failing callback does not revert the processing of the timeout.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	callbackData, err := types.GetSourceCallbackData(ctx, im.packetDataUnmarshaler, packet, im.maxCallbackGas)
+	// OnTimeoutPacket is not blocked if the packet does not opt-in to callbacks
+	if err != nil {
+		return nil
+	}
+
+	callbackExecutor := func(cachedCtx sdk.Context) error {
+		return im.contractKeeper.IBCOnTimeoutPacketCallback(
+			cachedCtx, packet, relayer, callbackData.CallbackAddress, callbackData.SenderAddress,
+		)
+	}
+
+	// callback execution errors are not allowed to block the packet lifecycle, they are only used in event emissions
+	err = processCallback(ctx, types.CallbackTypeTimeoutPacket, callbackData, callbackExecutor)
+	types.EmitCallbackEvent(ctx, packet, types.CallbackTypeTimeoutPacket, callbackData, err)
+
+	return nil
+}
+
+// GetAppVersion implements the ICS4Wrapper interface.
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// UnmarshalPacketData defers to the underlying application. This allows the callbacks
+// middleware to be wrapped by another middleware which requires the packet data.
+func (im IBCMiddleware) UnmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (interface{}, error) {
+	return im.packetDataUnmarshaler.UnmarshalPacketData(ctx, portID, channelID, bz)
+}
+
+// processCallback executes the callbackExecutor and reverts contract changes if the callbackExecutor fails.
+//
+// Error Precedence and Returns:
+//   - oogErr: Takes the highest precedence. If the callback runs out of gas and a retry is allowed,
+//     an out of gas panic is raised, reverting the entire transaction. If a retry is not allowed,
+//     the error is returned.
+//   - panicErr: Takes the second-highest precedence. If a panic occurs and it is not propagated, this error is returned.
+//   - callbackErr: If the callbackExecutor returns an error, it is returned as-is.
+//
+// The gas consumed by the callback is always charged to the provided context, up to the
+// execution gas limit of the callback.
+func processCallback(
+	ctx sdk.Context, callbackType types.CallbackType,
+	callbackData types.CallbackData, callbackExecutor func(sdk.Context) error,
+) (err error) {
+	cachedCtx, writeFn := ctx.CacheContext()
+	cachedCtx = cachedCtx.WithGasMeter(sdk.NewGasMeter(callbackData.ExecutionGasLimit))
+
+	defer func() {
+		// consume the minimum of g.consumed and g.limit
+		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumedToLimit(), fmt.Sprintf("ibc %s callback", callbackType))
+
+		// recover from all panics except during out of gas panics when a retry is allowed
+		if r := recover(); r != nil {
+			if oogError, ok := r.(sdk.ErrorOutOfGas); ok {
+				if callbackData.AllowRetry() {
+					panic(sdk.ErrorOutOfGas{Descriptor: fmt.Sprintf("ibc %s callback out of gas; commitGasLimit: %d", callbackType, callbackData.CommitGasLimit)})
+				}
+
+				err = sdkerrors.Wrapf(types.ErrCallbackOutOfGas, "ibc %s callback out of gas: %s", callbackType, oogError.Descriptor)
+				return
+			}
+
+			err = sdkerrors.Wrapf(types.ErrCallbackPanic, "ibc %s callback panicked with: %v", callbackType, r)
+		}
+	}()
+
+	err = callbackExecutor(cachedCtx)
+	if err == nil {
+		writeFn()
+		// the events emitted in the cached context are not automatically propagated to the parent context
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+	}
+
+	return err
+}
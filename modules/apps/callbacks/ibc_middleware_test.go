@@ -0,0 +1,372 @@
+package ibccallbacks_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	ibccallbacks "github.com/cosmos/ibc-go/v4/modules/apps/callbacks"
+	"github.com/cosmos/ibc-go/v4/modules/apps/callbacks/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+	ibcmock "github.com/cosmos/ibc-go/v4/testing/mock"
+	"github.com/cosmos/ibc-go/v4/testing/simapp"
+)
+
+func (s *CallbacksTestSuite) TestNewIBCMiddleware() {
+	var (
+		app            = transfer.NewIBCModule(s.chainA.GetSimApp().TransferKeeper)
+		ics4Wrapper    = s.chainA.GetSimApp().IBCFeeKeeper
+		contractKeeper = s.chainA.GetSimApp().MockContractKeeper
+	)
+
+	testCases := []struct {
+		name     string
+		instance func() ibccallbacks.IBCMiddleware
+		expPanic bool
+	}{
+		{
+			"success",
+			func() ibccallbacks.IBCMiddleware {
+				return ibccallbacks.NewIBCMiddleware(app, ics4Wrapper, contractKeeper, simapp.MaxCallbackGas)
+			},
+			false,
+		},
+		{
+			"failure: underlying application is not a PacketDataUnmarshaler",
+			func() ibccallbacks.IBCMiddleware {
+				return ibccallbacks.NewIBCMiddleware(s.chainA.GetSimApp().FeeMockModule, ics4Wrapper, contractKeeper, simapp.MaxCallbackGas)
+			},
+			true,
+		},
+		{
+			"failure: nil ICS4Wrapper",
+			func() ibccallbacks.IBCMiddleware {
+				return ibccallbacks.NewIBCMiddleware(app, nil, contractKeeper, simapp.MaxCallbackGas)
+			},
+			true,
+		},
+		{
+			"failure: nil contract keeper",
+			func() ibccallbacks.IBCMiddleware {
+				return ibccallbacks.NewIBCMiddleware(app, ics4Wrapper, nil, simapp.MaxCallbackGas)
+			},
+			true,
+		},
+		{
+			"failure: zero maximum callback gas",
+			func() ibccallbacks.IBCMiddleware {
+				return ibccallbacks.NewIBCMiddleware(app, ics4Wrapper, contractKeeper, 0)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			if tc.expPanic {
+				s.Require().Panics(func() { tc.instance() })
+			} else {
+				s.Require().NotPanics(func() { tc.instance() })
+			}
+		})
+	}
+}
+
+func (s *CallbacksTestSuite) TestTransferCallbacks() {
+	testCases := []struct {
+		name         string
+		memo         string
+		expCountersA map[types.CallbackType]uint64
+		expCountersB map[types.CallbackType]uint64
+		expRecvEvent string
+	}{
+		{
+			"success: no callbacks",
+			"",
+			nil,
+			nil,
+			"",
+		},
+		{
+			"success: memo without callbacks",
+			`{"other_middleware": {"key": "value"}}`,
+			nil,
+			nil,
+			"",
+		},
+		{
+			"success: source callback",
+			sourceCallbackMemo(ibcmock.SuccessContract),
+			map[types.CallbackType]uint64{types.CallbackTypeSendPacket: 1, types.CallbackTypeAcknowledgementPacket: 1},
+			nil,
+			"",
+		},
+		{
+			"success: destination callback",
+			destCallbackMemo(ibcmock.SuccessContract),
+			nil,
+			map[types.CallbackType]uint64{types.CallbackTypeReceivePacket: 1},
+			types.AttributeValueCallbackSuccess,
+		},
+		{
+			"success: source and destination callbacks",
+			`{"src_callback": {"address": "successcontract"}, "dest_callback": {"address": "successcontract", "gas_limit": "500000"}}`,
+			map[types.CallbackType]uint64{types.CallbackTypeSendPacket: 1, types.CallbackTypeAcknowledgementPacket: 1},
+			map[types.CallbackType]uint64{types.CallbackTypeReceivePacket: 1},
+			types.AttributeValueCallbackSuccess,
+		},
+		{
+			"success: destination callback error is isolated",
+			destCallbackMemo(ibcmock.ErrorContract),
+			nil,
+			nil,
+			types.AttributeValueCallbackFailure,
+		},
+		{
+			"success: destination callback panic is isolated",
+			destCallbackMemo(ibcmock.PanicContract),
+			nil,
+			nil,
+			types.AttributeValueCallbackFailure,
+		},
+		{
+			"success: destination callback out of gas is isolated",
+			// the user defined gas limit is provided by the relayer, a retry with more gas is not allowed
+			`{"dest_callback": {"address": "oogcontract", "gas_limit": "100000"}}`,
+			nil,
+			nil,
+			types.AttributeValueCallbackFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTransferTest()
+
+			amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			msg := transfertypes.NewMsgTransfer(
+				s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, amount,
+				s.chainA.SenderAccount.GetAddress().String(), s.chainB.SenderAccount.GetAddress().String(),
+				clienttypes.NewHeight(0, 100), 0, tc.memo,
+			)
+
+			res, err := s.chainA.SendMsgs(msg)
+			s.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			s.Require().NoError(err)
+
+			s.Require().NoError(s.path.EndpointB.UpdateClient())
+			res, err = s.path.EndpointB.RecvPacketWithResult(packet)
+			s.Require().NoError(err)
+
+			ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+			s.Require().NoError(err)
+			s.Require().NoError(s.path.EndpointA.AcknowledgePacket(packet, ack))
+
+			if tc.expRecvEvent != "" {
+				s.AssertCallbackEvent(res.GetEvents(), types.EventTypeDestinationCallback, types.CallbackTypeReceivePacket, tc.expRecvEvent)
+			}
+
+			// the tokens are received regardless of the outcome of the callback
+			voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom)).IBCDenom()
+			balance := s.chainB.GetSimApp().BankKeeper.GetBalance(s.chainB.GetContext(), s.chainB.SenderAccount.GetAddress(), voucherDenom)
+			s.Require().Equal(amount.Amount, balance.Amount)
+
+			s.AssertCallbackCounters(s.chainA, tc.expCountersA)
+			s.AssertCallbackCounters(s.chainB, tc.expCountersB)
+		})
+	}
+}
+
+func (s *CallbacksTestSuite) TestTransferSendPacketCallbackRejected() {
+	for _, contract := range []string{ibcmock.ErrorContract, ibcmock.PanicContract, ibcmock.OutOfGasContract} {
+		s.Run(contract, func() {
+			s.SetupTransferTest()
+
+			ctx := s.chainA.GetContext()
+			sender := s.chainA.SenderAccount.GetAddress()
+			preBalance := s.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+
+			msg := transfertypes.NewMsgTransfer(
+				s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+				sender.String(), s.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 100), 0, sourceCallbackMemo(contract),
+			)
+
+			// the msg is executed in a cached context as it would be in a failing transaction
+			cacheCtx, _ := ctx.CacheContext()
+			_, err := s.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(cacheCtx), msg)
+			s.Require().Error(err)
+
+			s.Require().Equal(preBalance, s.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+			s.AssertCallbackCounters(s.chainA, nil)
+		})
+	}
+}
+
+func (s *CallbacksTestSuite) TestTransferTimeoutCallback() {
+	s.SetupTransferTest()
+
+	sender := s.chainA.SenderAccount.GetAddress()
+	preBalance := s.chainA.GetSimApp().BankKeeper.GetBalance(s.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	timeoutHeight := s.path.EndpointA.GetClientState().GetLatestHeight().Increment().(clienttypes.Height)
+	msg := transfertypes.NewMsgTransfer(
+		s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+		sender.String(), s.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, sourceCallbackMemo(ibcmock.SuccessContract),
+	)
+
+	res, err := s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	// advance chainB past the timeout height
+	s.coordinator.CommitNBlocks(s.chainB, 3)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	s.Require().NoError(s.path.EndpointA.TimeoutPacket(packet))
+
+	// the tokens are refunded
+	s.Require().Equal(preBalance, s.chainA.GetSimApp().BankKeeper.GetBalance(s.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+
+	s.AssertCallbackCounters(s.chainA, map[types.CallbackType]uint64{types.CallbackTypeSendPacket: 1, types.CallbackTypeTimeoutPacket: 1})
+}
+
+func (s *CallbacksTestSuite) TestOnAcknowledgementPacketCallbackIsolation() {
+	testCases := []struct {
+		name       string
+		contract   string
+		gasLimit   uint64
+		expResult  string
+		expCounter uint64
+		expPanic   bool
+	}{
+		{"success", ibcmock.SuccessContract, 0, types.AttributeValueCallbackSuccess, 1, false},
+		{"callback error is isolated", ibcmock.ErrorContract, 0, types.AttributeValueCallbackFailure, 0, false},
+		{"callback panic is isolated", ibcmock.PanicContract, 0, types.AttributeValueCallbackFailure, 0, false},
+		{"callback out of gas is isolated", ibcmock.OutOfGasContract, 0, types.AttributeValueCallbackFailure, 0, false},
+		// the relayer provided less gas than the maximum callback gas, the transaction must be retried with more gas
+		{"callback out of gas with insufficient relayer gas reverts", ibcmock.OutOfGasContract, simapp.MaxCallbackGas / 2, "", 0, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTransferTest()
+
+			packetData := transfertypes.NewFungibleTokenPacketData(
+				sdk.DefaultBondDenom, "100", s.chainA.SenderAccount.GetAddress().String(),
+				s.chainB.SenderAccount.GetAddress().String(), sourceCallbackMemo(tc.contract),
+			)
+			packet := channeltypes.NewPacket(
+				packetData.GetBytes(), 1, s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID,
+				s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0,
+			)
+			ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
+			cbs, ok := s.chainA.App.GetIBCKeeper().Router.GetRoute(transfertypes.ModuleName)
+			s.Require().True(ok)
+
+			ctx := s.chainA.GetContext()
+			if tc.gasLimit != 0 {
+				ctx = ctx.WithGasMeter(sdk.NewGasMeter(tc.gasLimit))
+			}
+
+			if tc.expPanic {
+				s.Require().PanicsWithValue(
+					sdk.ErrorOutOfGas{Descriptor: "ibc acknowledgement_packet callback out of gas; commitGasLimit: 1000000"},
+					func() { _ = cbs.OnAcknowledgementPacket(ctx, packet, ack, s.chainA.SenderAccount.GetAddress()) },
+				)
+				return
+			}
+
+			err := cbs.OnAcknowledgementPacket(ctx, packet, ack, s.chainA.SenderAccount.GetAddress())
+			s.Require().NoError(err)
+
+			s.AssertCallbackEvent(ctx.EventManager().Events(), types.EventTypeSourceCallback, types.CallbackTypeAcknowledgementPacket, tc.expResult)
+			s.Require().Equal(tc.expCounter, s.chainA.GetSimApp().MockContractKeeper.GetCallbackCounter(ctx, types.CallbackTypeAcknowledgementPacket))
+		})
+	}
+}
+
+func (s *CallbacksTestSuite) TestOnTimeoutPacketCallbackIsolation() {
+	s.SetupTransferTest()
+
+	// escrow the tokens refunded on timeout
+	sender := s.chainA.SenderAccount.GetAddress()
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	escrowAddress := transfertypes.GetEscrowAddress(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID)
+	_, err := s.chainA.SendMsgs(banktypes.NewMsgSend(sender, escrowAddress, sdk.NewCoins(amount)))
+	s.Require().NoError(err)
+
+	packetData := transfertypes.NewFungibleTokenPacketData(
+		amount.Denom, amount.Amount.String(), sender.String(),
+		s.chainB.SenderAccount.GetAddress().String(), sourceCallbackMemo(ibcmock.ErrorContract),
+	)
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(), 1, s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID,
+		s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0,
+	)
+
+	cbs, ok := s.chainA.App.GetIBCKeeper().Router.GetRoute(transfertypes.ModuleName)
+	s.Require().True(ok)
+
+	ctx := s.chainA.GetContext()
+	preBalance := s.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+
+	err = cbs.OnTimeoutPacket(ctx, packet, sender)
+	s.Require().NoError(err)
+
+	// the refund is not reverted by the failing callback
+	s.Require().Equal(preBalance.Add(amount), s.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom))
+	s.AssertCallbackEvent(ctx.EventManager().Events(), types.EventTypeSourceCallback, types.CallbackTypeTimeoutPacket, types.AttributeValueCallbackFailure)
+	s.AssertCallbackCounters(s.chainA, nil)
+}
+
+func (s *CallbacksTestSuite) TestICACallbacks() {
+	s.SetupICATest()
+
+	chanCap, ok := s.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(s.chainA.GetContext(), host.ChannelCapabilityPath(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID))
+	s.Require().True(ok)
+
+	icaAddress, found := s.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(s.chainB.GetContext(), s.path.EndpointB.ConnectionID, s.path.EndpointA.ChannelConfig.PortID)
+	s.Require().True(found)
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: icaAddress,
+		ToAddress:   s.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}
+	data, err := icatypes.SerializeCosmosTx(s.chainA.GetSimApp().AppCodec(), []sdk.Msg{msgSend})
+	s.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: sourceCallbackMemo(ibcmock.SuccessContract),
+	}
+
+	timeoutTimestamp := uint64(s.chainB.LastHeader.GetTime().Add(ibctesting.TimeIncrement * 10).UnixNano())
+	seq, err := s.chainA.GetSimApp().ICAControllerKeeper.SendTx(s.chainA.GetContext(), chanCap, s.path.EndpointA.ConnectionID, s.path.EndpointA.ChannelConfig.PortID, packetData, timeoutTimestamp)
+	s.Require().NoError(err)
+	s.chainA.NextBlock()
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(), seq, s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID,
+		s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp,
+	)
+	s.Require().NoError(s.path.RelayPacket(packet))
+
+	s.AssertCallbackCounters(s.chainA, map[types.CallbackType]uint64{types.CallbackTypeSendPacket: 1, types.CallbackTypeAcknowledgementPacket: 1})
+}
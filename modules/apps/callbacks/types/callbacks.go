@@ -0,0 +1,200 @@
+package types
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+// CallbackType defines the type of a callback.
+type CallbackType string
+
+const (
+	CallbackTypeSendPacket            CallbackType = "send_packet"
+	CallbackTypeAcknowledgementPacket CallbackType = "acknowledgement_packet"
+	CallbackTypeTimeoutPacket         CallbackType = "timeout_packet"
+	CallbackTypeReceivePacket         CallbackType = "receive_packet"
+)
+
+// CallbackData is the callback data parsed from the packet.
+type CallbackData struct {
+	// CallbackAddress is the address of the callback actor.
+	CallbackAddress string
+	// ExecutionGasLimit is the gas limit which will be used for the callback execution.
+	ExecutionGasLimit uint64
+	// SenderAddress is the sender of the packet. This is passed to the contract keeper
+	// to verify that the packet sender is the same as the callback address if desired.
+	// This address is empty during destination callback execution.
+	SenderAddress string
+	// CommitGasLimit is the gas needed to commit the callback even if the callback
+	// execution fails due to out of gas.
+	// This parameter is only used in event emissions, or logging.
+	CommitGasLimit uint64
+}
+
+// AllowRetry returns true if the callback execution gas limit is less than the commit gas limit.
+// In this case the callback may be retried with more gas, so running out of gas during its
+// execution reverts the transaction instead of being isolated.
+func (c CallbackData) AllowRetry() bool {
+	return c.ExecutionGasLimit < c.CommitGasLimit
+}
+
+// GetSourceCallbackData parses the packet data and returns the source callback data.
+func GetSourceCallbackData(
+	ctx sdk.Context,
+	packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
+	packet ibcexported.PacketI,
+	maxGas uint64,
+) (CallbackData, error) {
+	packetData, err := packetDataUnmarshaler.UnmarshalPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if err != nil {
+		return CallbackData{}, sdkerrors.Wrap(ErrCannotUnmarshalPacketData, err.Error())
+	}
+
+	return getCallbackData(packetData, packet.GetSourcePort(), remainingGas(ctx), maxGas, SourceCallbackKey)
+}
+
+// GetDestCallbackData parses the packet data and returns the destination callback data.
+func GetDestCallbackData(
+	ctx sdk.Context,
+	packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
+	packet ibcexported.PacketI,
+	maxGas uint64,
+) (CallbackData, error) {
+	packetData, err := packetDataUnmarshaler.UnmarshalPacketData(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetData())
+	if err != nil {
+		return CallbackData{}, sdkerrors.Wrap(ErrCannotUnmarshalPacketData, err.Error())
+	}
+
+	return getCallbackData(packetData, packet.GetSourcePort(), remainingGas(ctx), maxGas, DestinationCallbackKey)
+}
+
+// getCallbackData parses the packet data and returns the callback data stored under the
+// provided callback key. The execution gas limit of the callback is capped by the remaining gas.
+func getCallbackData(
+	packetData interface{},
+	srcPortID string,
+	remainingGas,
+	maxGas uint64,
+	callbackKey string,
+) (CallbackData, error) {
+	packetDataProvider, ok := packetData.(ibcexported.PacketDataProvider)
+	if !ok {
+		return CallbackData{}, ErrNotPacketDataProvider
+	}
+
+	callbackData, ok := packetDataProvider.GetCustomPacketData(callbackKey).(map[string]interface{})
+	if callbackData == nil || !ok {
+		return CallbackData{}, ErrCallbackKeyNotFound
+	}
+
+	// get the callback address from the callback data
+	callbackAddress := getCallbackAddress(callbackData)
+	if strings.TrimSpace(callbackAddress) == "" {
+		return CallbackData{}, ErrCallbackAddressNotFound
+	}
+
+	// retrieve packet sender from packet data if possible and if needed
+	var packetSender string
+	if callbackKey == SourceCallbackKey {
+		packetData, ok := packetData.(ibcexported.PacketData)
+		if ok {
+			packetSender = packetData.GetPacketSender(srcPortID)
+		}
+	}
+
+	// get the gas limit from the callback data
+	userGasLimit, err := getUserDefinedGasLimit(callbackData)
+	if err != nil {
+		return CallbackData{}, err
+	}
+
+	executionGasLimit, commitGasLimit := computeExecAndCommitGasLimit(userGasLimit, remainingGas, maxGas)
+
+	return CallbackData{
+		CallbackAddress:   callbackAddress,
+		ExecutionGasLimit: executionGasLimit,
+		SenderAddress:     packetSender,
+		CommitGasLimit:    commitGasLimit,
+	}, nil
+}
+
+// computeExecAndCommitGasLimit returns the execution and commit gas limits of a callback.
+// The commit gas limit is the user defined gas limit capped by the maximum callback gas,
+// the maximum callback gas is used if no gas limit was defined by the user. The execution
+// gas limit is the commit gas limit capped by the remaining gas of the transaction.
+func computeExecAndCommitGasLimit(userGasLimit, remainingGas, maxGas uint64) (uint64, uint64) {
+	commitGasLimit := maxGas
+	if userGasLimit != 0 && userGasLimit < maxGas {
+		commitGasLimit = userGasLimit
+	}
+
+	executionGasLimit := commitGasLimit
+	if remainingGas < executionGasLimit {
+		executionGasLimit = remainingGas
+	}
+
+	return executionGasLimit, commitGasLimit
+}
+
+// remainingGas returns the gas remaining in the gas meter of the provided context.
+// An infinite gas meter reports a limit of zero, in which case the maximum uint64 is returned.
+func remainingGas(ctx sdk.Context) uint64 {
+	gasMeter := ctx.GasMeter()
+	if gasMeter.Limit() == 0 {
+		return math.MaxUint64
+	}
+
+	return gasMeter.Limit() - gasMeter.GasConsumedToLimit()
+}
+
+// getUserDefinedGasLimit returns the custom gas limit provided for callbacks if it is
+// in the callback data. It is assumed that callback data is not nil.
+// If no gas limit is specified, 0 is returned. An error is returned if the gas
+// limit is provided but cannot be parsed as an unsigned integer.
+//
+// The gas limit is expected to be a string, for example:
+// { "src_callback": { "address": ..., "gas_limit": "100000" } }
+func getUserDefinedGasLimit(callbackData map[string]interface{}) (uint64, error) {
+	gasLimit, found := callbackData[CallbackGasLimitKey]
+	if !found {
+		return 0, nil
+	}
+
+	gasLimitStr, ok := gasLimit.(string)
+	if !ok {
+		return 0, sdkerrors.Wrapf(ErrInvalidCallbackData, "callback gas limit must be a string, got %T", gasLimit)
+	}
+
+	if gasLimitStr == "" {
+		return 0, nil
+	}
+
+	userGasLimit, err := strconv.ParseUint(gasLimitStr, 10, 64)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(ErrInvalidCallbackData, "failed to parse callback gas limit %s: %s", gasLimitStr, err)
+	}
+
+	return userGasLimit, nil
+}
+
+// getCallbackAddress returns the callback address if it is specified in the callback data.
+// It is assumed that callback data is not nil.
+// If no callback address is specified or the address is not a string, an empty string is returned.
+//
+// The memo is expected to specify the callback address in the following format:
+// { "src_callback": { "address": "{contractAddrOnSourceChain}" } }
+func getCallbackAddress(callbackData map[string]interface{}) string {
+	callbackAddress, ok := callbackData[CallbackAddressKey].(string)
+	if !ok {
+		return ""
+	}
+
+	return callbackAddress
+}
@@ -0,0 +1,196 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/callbacks/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+const (
+	callbackAddress = "cosmos1callbackaddress"
+	sender          = "cosmos1sender"
+	receiver        = "cosmos1receiver"
+	maxCallbackGas  = uint64(1_000_000)
+)
+
+// transferUnmarshaler unmarshals ics20-1 packet data for testing purposes.
+type transferUnmarshaler struct{}
+
+func (transferUnmarshaler) UnmarshalPacketData(_ sdk.Context, _, _ string, bz []byte) (interface{}, error) {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(bz, &packetData); err != nil {
+		return nil, err
+	}
+
+	return packetData, nil
+}
+
+// nonProviderUnmarshaler returns packet data which does not implement PacketDataProvider.
+type nonProviderUnmarshaler struct{}
+
+func (nonProviderUnmarshaler) UnmarshalPacketData(_ sdk.Context, _, _ string, bz []byte) (interface{}, error) {
+	return bz, nil
+}
+
+func newTransferPacket(memo string) channeltypes.Packet {
+	packetData := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", sender, receiver, memo)
+	return channeltypes.NewPacket(
+		packetData.GetBytes(), 1, transfertypes.PortID, ibctesting.FirstChannelID,
+		transfertypes.PortID, ibctesting.FirstChannelID, clienttypes.NewHeight(0, 100), 0,
+	)
+}
+
+func TestGetSourceCallbackData(t *testing.T) {
+	testCases := []struct {
+		name            string
+		memo            string
+		gasLimit        uint64
+		unmarshaler     porttypes.PacketDataUnmarshaler
+		expCallbackData types.CallbackData
+		expError        error
+	}{
+		{
+			"success: callback with user defined gas limit",
+			fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "50000"}}`, callbackAddress),
+			2_000_000,
+			transferUnmarshaler{},
+			types.CallbackData{CallbackAddress: callbackAddress, SenderAddress: sender, ExecutionGasLimit: 50_000, CommitGasLimit: 50_000},
+			nil,
+		},
+		{
+			"success: callback without gas limit uses the maximum callback gas",
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, callbackAddress),
+			2_000_000,
+			transferUnmarshaler{},
+			types.CallbackData{CallbackAddress: callbackAddress, SenderAddress: sender, ExecutionGasLimit: maxCallbackGas, CommitGasLimit: maxCallbackGas},
+			nil,
+		},
+		{
+			"success: user defined gas limit is capped by the maximum callback gas",
+			fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "5000000"}}`, callbackAddress),
+			10_000_000,
+			transferUnmarshaler{},
+			types.CallbackData{CallbackAddress: callbackAddress, SenderAddress: sender, ExecutionGasLimit: maxCallbackGas, CommitGasLimit: maxCallbackGas},
+			nil,
+		},
+		{
+			"success: execution gas limit is capped by the remaining gas",
+			fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "500000"}}`, callbackAddress),
+			100_000,
+			transferUnmarshaler{},
+			types.CallbackData{CallbackAddress: callbackAddress, SenderAddress: sender, ExecutionGasLimit: 100_000, CommitGasLimit: 500_000},
+			nil,
+		},
+		{
+			"failure: empty memo",
+			"",
+			2_000_000,
+			transferUnmarshaler{},
+			types.CallbackData{},
+			types.ErrCallbackKeyNotFound,
+		},
+		{
+			"failure: memo is not json",
+			"hello",
+			2_000_000,
+			transferUnmarshaler{},
+			types.CallbackData{},
+			types.ErrCallbackKeyNotFound,
+		},
+		{
+			"failure: only destination callback",
+			fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, callbackAddress),
+			2_000_000,
+			transferUnmarshaler{},
+			types.CallbackData{},
+			types.ErrCallbackKeyNotFound,
+		},
+		{
+			"failure: callback data is not an object",
+			`{"src_callback": "callback"}`,
+			2_000_000,
+			transferUnmarshaler{},
+			types.CallbackData{},
+			types.ErrCallbackKeyNotFound,
+		},
+		{
+			"failure: empty callback address",
+			`{"src_callback": {"address": ""}}`,
+			2_000_000,
+			transferUnmarshaler{},
+			types.CallbackData{},
+			types.ErrCallbackAddressNotFound,
+		},
+		{
+			"failure: gas limit is not a string",
+			fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": 50000}}`, callbackAddress),
+			2_000_000,
+			transferUnmarshaler{},
+			types.CallbackData{},
+			types.ErrInvalidCallbackData,
+		},
+		{
+			"failure: gas limit is not a number",
+			fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "gas"}}`, callbackAddress),
+			2_000_000,
+			transferUnmarshaler{},
+			types.CallbackData{},
+			types.ErrInvalidCallbackData,
+		},
+		{
+			"failure: packet data is not a PacketDataProvider",
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, callbackAddress),
+			2_000_000,
+			nonProviderUnmarshaler{},
+			types.CallbackData{},
+			types.ErrNotPacketDataProvider,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		ctx := sdk.Context{}.WithGasMeter(sdk.NewGasMeter(tc.gasLimit))
+		packet := newTransferPacket(tc.memo)
+
+		callbackData, err := types.GetSourceCallbackData(ctx, tc.unmarshaler, packet, maxCallbackGas)
+		if tc.expError == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expError, tc.name)
+		}
+		require.Equal(t, tc.expCallbackData, callbackData, tc.name)
+	}
+}
+
+func TestGetDestCallbackData(t *testing.T) {
+	ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	packet := newTransferPacket(fmt.Sprintf(`{"dest_callback": {"address": "%s", "gas_limit": "50000"}}`, callbackAddress))
+	callbackData, err := types.GetDestCallbackData(ctx, transferUnmarshaler{}, packet, maxCallbackGas)
+	require.NoError(t, err)
+	// the sender address is not set for destination callbacks
+	require.Equal(t, types.CallbackData{CallbackAddress: callbackAddress, ExecutionGasLimit: 50_000, CommitGasLimit: 50_000}, callbackData)
+
+	packet = newTransferPacket(fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, callbackAddress))
+	_, err = types.GetDestCallbackData(ctx, transferUnmarshaler{}, packet, maxCallbackGas)
+	require.ErrorIs(t, err, types.ErrCallbackKeyNotFound)
+
+	packet.Data = []byte("invalid packet data")
+	_, err = types.GetDestCallbackData(ctx, transferUnmarshaler{}, packet, maxCallbackGas)
+	require.ErrorIs(t, err, types.ErrCannotUnmarshalPacketData)
+}
+
+func TestAllowRetry(t *testing.T) {
+	require.True(t, types.CallbackData{ExecutionGasLimit: 100, CommitGasLimit: 200}.AllowRetry())
+	require.False(t, types.CallbackData{ExecutionGasLimit: 200, CommitGasLimit: 200}.AllowRetry())
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ibccallbacks sentinel errors
+var (
+	ErrNotPacketDataProvider     = sdkerrors.Register(ModuleName, 2, "packet is not a PacketDataProvider")
+	ErrCallbackKeyNotFound       = sdkerrors.Register(ModuleName, 3, "callback key not found in packet data")
+	ErrCallbackAddressNotFound   = sdkerrors.Register(ModuleName, 4, "callback address not found in packet data")
+	ErrInvalidCallbackData       = sdkerrors.Register(ModuleName, 5, "invalid callback data")
+	ErrCannotUnmarshalPacketData = sdkerrors.Register(ModuleName, 6, "cannot unmarshal packet data")
+	ErrCallbackOutOfGas          = sdkerrors.Register(ModuleName, 7, "callback out of gas")
+	ErrCallbackPanic             = sdkerrors.Register(ModuleName, 8, "callback panic")
+)
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+// ibccallbacks events
+const (
+	// EventTypeSourceCallback is the event type for a source callback
+	EventTypeSourceCallback = "ibc_src_callback"
+	// EventTypeDestinationCallback is the event type for a destination callback
+	EventTypeDestinationCallback = "ibc_dest_callback"
+
+	AttributeKeyCallbackType              = "callback_type"
+	AttributeKeyCallbackAddress           = "callback_address"
+	AttributeKeyCallbackExecutionGasLimit = "callback_exec_gas_limit"
+	AttributeKeyCallbackCommitGasLimit    = "callback_commit_gas_limit"
+	AttributeKeyCallbackPortID            = "packet_port"
+	AttributeKeyCallbackChannelID         = "packet_channel"
+	AttributeKeyCallbackSequence          = "packet_sequence"
+	AttributeKeyCallbackResult            = "callback_result"
+	AttributeKeyCallbackError             = "callback_error"
+
+	// AttributeValueCallbackSuccess is the value of the callback result attribute for a successful callback
+	AttributeValueCallbackSuccess = "success"
+	// AttributeValueCallbackFailure is the value of the callback result attribute for a failed callback
+	AttributeValueCallbackFailure = "failure"
+)
+
+// EmitCallbackEvent emits an event for a callback. Source callback events are emitted with
+// the source port and channel of the packet, destination callback events with the destination
+// port and channel of the packet.
+func EmitCallbackEvent(
+	ctx sdk.Context,
+	packet ibcexported.PacketI,
+	callbackType CallbackType,
+	callbackData CallbackData,
+	err error,
+) {
+	eventType := EventTypeSourceCallback
+	portID, channelID := packet.GetSourcePort(), packet.GetSourceChannel()
+	if callbackType == CallbackTypeReceivePacket {
+		eventType = EventTypeDestinationCallback
+		portID, channelID = packet.GetDestPort(), packet.GetDestChannel()
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyCallbackType, string(callbackType)),
+		sdk.NewAttribute(AttributeKeyCallbackAddress, callbackData.CallbackAddress),
+		sdk.NewAttribute(AttributeKeyCallbackExecutionGasLimit, fmt.Sprintf("%d", callbackData.ExecutionGasLimit)),
+		sdk.NewAttribute(AttributeKeyCallbackCommitGasLimit, fmt.Sprintf("%d", callbackData.CommitGasLimit)),
+		sdk.NewAttribute(AttributeKeyCallbackPortID, portID),
+		sdk.NewAttribute(AttributeKeyCallbackChannelID, channelID),
+		sdk.NewAttribute(AttributeKeyCallbackSequence, fmt.Sprintf("%d", packet.GetSequence())),
+	}
+
+	if err == nil {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyCallbackResult, AttributeValueCallbackSuccess))
+	} else {
+		attributes = append(
+			attributes,
+			sdk.NewAttribute(AttributeKeyCallbackResult, AttributeValueCallbackFailure),
+			sdk.NewAttribute(AttributeKeyCallbackError, err.Error()),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			attributes...,
+		),
+	)
+}
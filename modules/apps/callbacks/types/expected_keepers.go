@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+// ContractKeeper defines the entry points exposed to the callbacks middleware by a module
+// which executes contract (or actor) callbacks, such as a smart contract VM.
+//
+// Each callback is executed with a cached context whose gas meter is limited to the
+// execution gas limit of the callback. State changes are only written if the callback
+// returns without error. Apart from the send packet callback, a failing callback does
+// not revert the packet lifecycle.
+type ContractKeeper interface {
+	// IBCSendPacketCallback is called in the source chain when a packet with a source callback
+	// is sent. The packetSenderAddress is the address of the packet sender as reported by the
+	// application and should be used to authenticate the packet sender with the contract.
+	// Returning an error reverts the sending of the packet.
+	IBCSendPacketCallback(
+		cachedCtx sdk.Context,
+		packet ibcexported.PacketI,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+	// IBCOnAcknowledgementPacketCallback is called in the source chain when a packet with a
+	// source callback is acknowledged. The packetSenderAddress is the address of the packet
+	// sender as reported by the application.
+	IBCOnAcknowledgementPacketCallback(
+		cachedCtx sdk.Context,
+		packet channeltypes.Packet,
+		acknowledgement []byte,
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+	// IBCOnTimeoutPacketCallback is called in the source chain when a packet with a source
+	// callback times out. The packetSenderAddress is the address of the packet sender as
+	// reported by the application.
+	IBCOnTimeoutPacketCallback(
+		cachedCtx sdk.Context,
+		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
+		contractAddress,
+		packetSenderAddress string,
+	) error
+	// IBCReceivePacketCallback is called in the destination chain when a packet with a
+	// destination callback is received and its acknowledgement is written.
+	IBCReceivePacketCallback(
+		cachedCtx sdk.Context,
+		packet ibcexported.PacketI,
+		ack ibcexported.Acknowledgement,
+		contractAddress string,
+	) error
+}
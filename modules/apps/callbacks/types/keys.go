@@ -0,0 +1,18 @@
+package types
+
+const (
+	// ModuleName defines the callbacks middleware name
+	ModuleName = "ibccallbacks"
+
+	// SourceCallbackKey is the key used to represent the source callback in the packet data memo
+	SourceCallbackKey = "src_callback"
+
+	// DestinationCallbackKey is the key used to represent the destination callback in the packet data memo
+	DestinationCallbackKey = "dest_callback"
+
+	// CallbackAddressKey is the key used to represent the callback address in the callback data
+	CallbackAddressKey = "address"
+
+	// CallbackGasLimitKey is the key used to represent the user defined gas limit in the callback data
+	CallbackGasLimitKey = "gas_limit"
+)
@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	controllercli "github.com/cosmos/ibc-go/v4/modules/apps/icq/controller/client/cli"
+	hostcli "github.com/cosmos/ibc-go/v4/modules/apps/icq/host/client/cli"
+)
+
+// GetQueryCmd returns the query commands for the interchain-queries module
+func GetQueryCmd() *cobra.Command {
+	icqQueryCmd := &cobra.Command{
+		Use:                        "interchain-queries",
+		Aliases:                    []string{"icq"},
+		Short:                      "interchain-queries subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	icqQueryCmd.AddCommand(
+		controllercli.GetQueryCmd(),
+		hostcli.GetQueryCmd(),
+	)
+
+	return icqQueryCmd
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icqtypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// InitGenesis initializes the interchain queries controller application state from a provided genesis state
+func InitGenesis(ctx sdk.Context, keeper Keeper, state icqtypes.ControllerGenesisState) {
+	if !keeper.IsBound(ctx, state.Port) {
+		cap := keeper.BindPort(ctx, state.Port)
+		if err := keeper.ClaimCapability(ctx, cap, host.PortPath(state.Port)); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+
+	keeper.SetParams(ctx, state.Params)
+}
+
+// ExportGenesis returns the interchain queries controller exported genesis
+func ExportGenesis(ctx sdk.Context, keeper Keeper) icqtypes.ControllerGenesisState {
+	return icqtypes.NewControllerGenesisState(
+		icqtypes.ControllerPortID,
+		keeper.GetParams(ctx),
+	)
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	icqtypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// OnChanOpenInit performs basic validation of channel initialization and claims the channel
+// capability. An empty version defaults to the interchain queries version, which is returned.
+func (k Keeper) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+
+	if portID != icqtypes.ControllerPortID {
+		return "", sdkerrors.Wrapf(icqtypes.ErrInvalidControllerPort, "expected %s, got %s", icqtypes.ControllerPortID, portID)
+	}
+
+	if counterparty.PortId != icqtypes.HostPortID {
+		return "", sdkerrors.Wrapf(icqtypes.ErrInvalidHostPort, "expected %s, got %s", icqtypes.HostPortID, counterparty.PortId)
+	}
+
+	if version == "" {
+		version = icqtypes.Version
+	}
+
+	if version != icqtypes.Version {
+		return "", sdkerrors.Wrapf(icqtypes.ErrInvalidVersion, "expected %s, got %s", icqtypes.Version, version)
+	}
+
+	if err := k.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+
+	return version, nil
+}
+
+// OnChanOpenAck validates the version proposed by the host chain.
+func (k Keeper) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != icqtypes.Version {
+		return sdkerrors.Wrapf(icqtypes.ErrInvalidVersion, "expected counterparty version %s, got %s", icqtypes.Version, counterpartyVersion)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	icqtypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func (suite *KeeperTestSuite) TestOnChanOpenInit() {
+	var (
+		channel *channeltypes.Channel
+		path    *ibctesting.Path
+		chanCap *capabilitytypes.Capability
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: empty channel version defaults to the icq version", func() {
+				channel.Version = ""
+			}, true,
+		},
+		{
+			"invalid order - ORDERED", func() {
+				channel.Ordering = channeltypes.ORDERED
+			}, false,
+		},
+		{
+			"invalid port ID", func() {
+				path.EndpointA.ChannelConfig.PortID = ibctesting.MockPort
+			}, false,
+		},
+		{
+			"invalid counterparty port ID", func() {
+				channel.Counterparty.PortId = ibctesting.MockPort
+			}, false,
+		},
+		{
+			"invalid version", func() {
+				channel.Version = "version"
+			}, false,
+		},
+		{
+			"capability already claimed", func() {
+				err := suite.chainA.GetSimApp().ScopedICQControllerKeeper.ClaimCapability(suite.chainA.GetContext(), chanCap, host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				suite.Require().NoError(err)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICQPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			// mock init interchain queries channel
+			path.EndpointA.ChannelID = ibctesting.FirstChannelID
+
+			counterparty := channeltypes.NewCounterparty(path.EndpointB.ChannelConfig.PortID, "")
+			channel = &channeltypes.Channel{
+				State:          channeltypes.INIT,
+				Ordering:       channeltypes.UNORDERED,
+				Counterparty:   counterparty,
+				ConnectionHops: []string{path.EndpointA.ConnectionID},
+				Version:        icqtypes.Version,
+			}
+
+			var err error
+			chanCap, err = suite.chainA.App.GetScopedIBCKeeper().NewCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(icqtypes.ControllerPortID, path.EndpointA.ChannelID))
+			suite.Require().NoError(err)
+
+			tc.malleate() // malleate mutates test data
+
+			version, err := suite.chainA.GetSimApp().ICQControllerKeeper.OnChanOpenInit(suite.chainA.GetContext(), channel.Ordering, channel.GetConnectionHops(),
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, chanCap, channel.Counterparty, channel.Version,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(icqtypes.Version, version)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Empty(version)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnChanOpenAck() {
+	testCases := []struct {
+		name                string
+		counterpartyVersion string
+		expPass             bool
+	}{
+		{"success", icqtypes.Version, true},
+		{"invalid counterparty version", "version", false},
+		{"empty counterparty version", "", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICQPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := path.EndpointA.ChanOpenInit()
+			suite.Require().NoError(err)
+
+			err = suite.chainA.GetSimApp().ICQControllerKeeper.OnChanOpenAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, tc.counterpartyVersion)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/icq/controller/types"
+	icqtypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// SendQuery sends the provided query requests to the host chain over the given channel on behalf
+// of a requesting module. The query paths must be allowed by the host chain and the queries are
+// executed at the latest height of the host chain. The packet sequence for the outgoing packet is
+// returned as a result, it is passed to the controller hooks together with the query responses
+// once the packet is acknowledged or timed out.
+func (k Keeper) SendQuery(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	requests []abci.RequestQuery,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	if !k.IsControllerEnabled(ctx) {
+		return 0, types.ErrControllerSubModuleDisabled
+	}
+
+	if len(requests) == 0 {
+		return 0, sdkerrors.Wrap(icqtypes.ErrInvalidQuery, "query requests cannot be empty")
+	}
+
+	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	bz, err := icqtypes.SerializeCosmosQuery(requests)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "failed to marshal query requests")
+	}
+
+	packetData := icqtypes.InterchainQueryPacketData{
+		Data: bz,
+	}
+
+	if err := packetData.ValidateBasic(); err != nil {
+		return 0, sdkerrors.Wrap(err, "invalid interchain query packet data")
+	}
+
+	// get the next sequence
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "failed to retrieve next sequence send for channel %s on port %s", sourceChannel, sourcePort)
+	}
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.ics4Wrapper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	return packet.Sequence, nil
+}
+
+// OnAcknowledgementPacket decodes the query responses returned by the host chain and passes them
+// to the controller hooks. The responses are nil if the host chain returned an error acknowledgement.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	requests, err := getPacketRequests(packet)
+	if err != nil {
+		return err
+	}
+
+	var responses []abci.ResponseQuery
+	if resp, ok := ack.Response.(*channeltypes.Acknowledgement_Result); ok {
+		var ackData icqtypes.InterchainQueryPacketAck
+		if err := icqtypes.ModuleCdc.UnmarshalJSON(resp.Result, &ackData); err != nil {
+			return sdkerrors.Wrapf(icqtypes.ErrUnknownDataType, "cannot unmarshal ICQ packet acknowledgement: %s", err)
+		}
+
+		responses, err = icqtypes.DeserializeCosmosResponse(ackData.Data)
+		if err != nil {
+			return sdkerrors.Wrapf(icqtypes.ErrUnknownDataType, "cannot unmarshal ICQ cosmos response: %s", err)
+		}
+	}
+
+	if k.hooks == nil {
+		return nil
+	}
+
+	return k.hooks.OnAcknowledgementPacket(ctx, packet, requests, responses, ack)
+}
+
+// OnTimeoutPacket passes the query requests of the timed out packet to the controller hooks.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	requests, err := getPacketRequests(packet)
+	if err != nil {
+		return err
+	}
+
+	if k.hooks == nil {
+		return nil
+	}
+
+	return k.hooks.OnTimeoutPacket(ctx, packet, requests)
+}
+
+// getPacketRequests returns the query requests sent in the provided packet.
+func getPacketRequests(packet channeltypes.Packet) ([]abci.RequestQuery, error) {
+	var data icqtypes.InterchainQueryPacketData
+	if err := icqtypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil, sdkerrors.Wrapf(icqtypes.ErrUnknownDataType, "cannot unmarshal ICQ packet data: %s", err)
+	}
+
+	requests, err := icqtypes.DeserializeCosmosQuery(data.Data)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(icqtypes.ErrUnknownDataType, "cannot unmarshal ICQ cosmos query: %s", err)
+	}
+
+	return requests, nil
+}
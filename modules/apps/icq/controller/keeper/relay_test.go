@@ -0,0 +1,278 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/icq/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/host/types"
+	icqtypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+const allBalancesPath = "/cosmos.bank.v1beta1.Query/AllBalances"
+
+var _ types.ControllerHooks = (*mockControllerHooks)(nil)
+
+// mockControllerHooks records the arguments of the controller hook calls.
+type mockControllerHooks struct {
+	requests  []abci.RequestQuery
+	responses []abci.ResponseQuery
+	ack       *channeltypes.Acknowledgement
+	timedOut  bool
+	err       error
+}
+
+func (h *mockControllerHooks) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, requests []abci.RequestQuery, responses []abci.ResponseQuery, ack channeltypes.Acknowledgement) error {
+	h.requests = requests
+	h.responses = responses
+	h.ack = &ack
+	return h.err
+}
+
+func (h *mockControllerHooks) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, requests []abci.RequestQuery) error {
+	h.requests = requests
+	h.timedOut = true
+	return h.err
+}
+
+// allBalancesRequest returns a query request for all balances of the provided chain's sender account.
+func allBalancesRequest(chain *ibctesting.TestChain) abci.RequestQuery {
+	return abci.RequestQuery{
+		Path: allBalancesPath,
+		Data: chain.GetSimApp().AppCodec().MustMarshal(&banktypes.QueryAllBalancesRequest{
+			Address: chain.SenderAccount.GetAddress().String(),
+		}),
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendQuery() {
+	var (
+		path     *ibctesting.Path
+		requests []abci.RequestQuery
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"controller submodule disabled", func() {
+				suite.chainA.GetSimApp().ICQControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+			}, false,
+		},
+		{
+			"empty query requests", func() {
+				requests = []abci.RequestQuery{}
+			}, false,
+		},
+		{
+			"channel does not exist", func() {
+				path.EndpointA.ChannelID = "channel-100"
+			}, false,
+		},
+		{
+			"channel capability not found", func() {
+				cap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				err := suite.chainA.GetSimApp().ScopedICQControllerKeeper.ReleaseCapability(suite.chainA.GetContext(), cap)
+				suite.Require().NoError(err)
+			}, false,
+		},
+		{
+			"channel is closed", func() {
+				err := path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICQPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			requests = []abci.RequestQuery{allBalancesRequest(suite.chainB)}
+
+			tc.malleate() // malleate mutates test data
+
+			sequence, err := suite.chainA.GetSimApp().ICQControllerKeeper.SendQuery(
+				suite.chainA.GetContext(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				requests,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), sequence)
+
+				commitment := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().NotNil(commitment)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Zero(sequence)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	var (
+		path  *ibctesting.Path
+		hooks *mockControllerHooks
+	)
+
+	testCases := []struct {
+		msg          string
+		malleate     func()
+		expResultAck bool
+		expPass      bool
+	}{
+		{
+			"success", func() {}, true, true,
+		},
+		{
+			"success: host returns error acknowledgement for a query path which is not allowed", func() {
+				suite.chainB.GetSimApp().ICQHostKeeper.SetParams(suite.chainB.GetContext(), hosttypes.NewParams(true, []string{}))
+			}, false, true,
+		},
+		{
+			"hooks return an error", func() {
+				hooks.err = fmt.Errorf("mock hooks error")
+			}, true, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICQPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			suite.chainB.GetSimApp().ICQHostKeeper.SetParams(suite.chainB.GetContext(), hosttypes.NewParams(true, []string{allBalancesPath}))
+
+			hooks = &mockControllerHooks{}
+
+			tc.malleate() // malleate mutates test data
+
+			requests := []abci.RequestQuery{allBalancesRequest(suite.chainB)}
+			timeoutHeight := clienttypes.NewHeight(0, 100)
+
+			sequence, err := suite.chainA.GetSimApp().ICQControllerKeeper.SendQuery(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, requests, timeoutHeight, 0)
+			suite.Require().NoError(err)
+
+			suite.chainA.NextBlock()
+
+			bz, err := icqtypes.SerializeCosmosQuery(requests)
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(
+				icqtypes.InterchainQueryPacketData{Data: bz}.GetBytes(),
+				sequence,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				timeoutHeight,
+				0,
+			)
+
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			res, err := path.EndpointB.RecvPacketWithResult(packet)
+			suite.Require().NoError(err)
+
+			ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			var ack channeltypes.Acknowledgement
+			err = icqtypes.ModuleCdc.UnmarshalJSON(ackBz, &ack)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expResultAck, ack.Success())
+
+			// set the hooks on a copy of the controller keeper and process the acknowledgement
+			controllerKeeper := suite.chainA.GetSimApp().ICQControllerKeeper
+			controllerKeeper.SetHooks(hooks)
+
+			err = controllerKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, ack)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(requests, hooks.requests)
+				suite.Require().Equal(ack, *hooks.ack)
+
+				if tc.expResultAck {
+					suite.Require().Len(hooks.responses, 1)
+
+					var balances banktypes.QueryAllBalancesResponse
+					err = suite.chainB.GetSimApp().AppCodec().Unmarshal(hooks.responses[0].Value, &balances)
+					suite.Require().NoError(err)
+
+					expBalances := suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress())
+					suite.Require().Equal(expBalances, balances.Balances)
+				} else {
+					suite.Require().Nil(hooks.responses)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+
+			// the acknowledgement is also processed successfully by the controller IBC module
+			err = path.EndpointA.AcknowledgePacket(packet, ackBz)
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
+	path := NewICQPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	requests := []abci.RequestQuery{allBalancesRequest(suite.chainB)}
+	timeoutHeight := clienttypes.NewHeight(0, 100)
+
+	sequence, err := suite.chainA.GetSimApp().ICQControllerKeeper.SendQuery(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, requests, timeoutHeight, 0)
+	suite.Require().NoError(err)
+
+	bz, err := icqtypes.SerializeCosmosQuery(requests)
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(
+		icqtypes.InterchainQueryPacketData{Data: bz}.GetBytes(),
+		sequence,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		timeoutHeight,
+		0,
+	)
+
+	hooks := &mockControllerHooks{}
+	controllerKeeper := suite.chainA.GetSimApp().ICQControllerKeeper
+	controllerKeeper.SetHooks(hooks)
+
+	err = controllerKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet)
+	suite.Require().NoError(err)
+	suite.Require().True(hooks.timedOut)
+	suite.Require().Equal(requests, hooks.requests)
+}
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ICQ Controller sentinel errors
+var (
+	ErrControllerSubModuleDisabled = sdkerrors.Register(SubModuleName, 2, "interchain queries controller submodule is disabled")
+)
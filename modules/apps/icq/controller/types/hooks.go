@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// ControllerHooks defines the hooks which are called by the interchain queries controller keeper
+// once the queries sent by a requesting module are acknowledged or timed out. The packet sequence
+// returned by SendQuery may be used by the requesting module to match the hook calls with the
+// queries it sent.
+//
+// The responses passed to OnAcknowledgementPacket are ordered as the requests they correspond to
+// and are nil if the host chain returned an error acknowledgement. An error returned by the hooks
+// fails the processing of the acknowledgement or timeout and should therefore only be returned
+// for unrecoverable errors.
+type ControllerHooks interface {
+	OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, requests []abci.RequestQuery, responses []abci.ResponseQuery, ack channeltypes.Acknowledgement) error
+	OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, requests []abci.RequestQuery) error
+}
+
+var _ ControllerHooks = MultiControllerHooks{}
+
+// MultiControllerHooks combines multiple controller hooks, all hook functions are run in array sequence.
+type MultiControllerHooks []ControllerHooks
+
+// NewMultiControllerHooks creates a new MultiControllerHooks instance
+func NewMultiControllerHooks(hooks ...ControllerHooks) MultiControllerHooks {
+	return hooks
+}
+
+// OnAcknowledgementPacket implements the ControllerHooks interface.
+func (h MultiControllerHooks) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, requests []abci.RequestQuery, responses []abci.ResponseQuery, ack channeltypes.Acknowledgement) error {
+	for i := range h {
+		if err := h[i].OnAcknowledgementPacket(ctx, packet, requests, responses, ack); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket implements the ControllerHooks interface.
+func (h MultiControllerHooks) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, requests []abci.RequestQuery) error {
+	for i := range h {
+		if err := h[i].OnTimeoutPacket(ctx, packet, requests); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,9 @@
+package types
+
+const (
+	// SubModuleName defines the interchain queries controller module name
+	SubModuleName = "icqcontroller"
+
+	// StoreKey is the store key string for the interchain queries controller module
+	StoreKey = SubModuleName
+)
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	icqtypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// OnChanOpenTry performs basic validation of the ICQ channel and claims
+// the channel capability. The interchain queries version is returned.
+func (k Keeper) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+
+	if portID != icqtypes.HostPortID {
+		return "", sdkerrors.Wrapf(icqtypes.ErrInvalidHostPort, "expected %s, got %s", icqtypes.HostPortID, portID)
+	}
+
+	if counterpartyVersion != icqtypes.Version {
+		return "", sdkerrors.Wrapf(icqtypes.ErrInvalidVersion, "expected %s, got %s", icqtypes.Version, counterpartyVersion)
+	}
+
+	// On the host chain the capability may only be claimed during the OnChanOpenTry
+	// The capability being claimed in OpenInit is for a controller chain (the port is different)
+	if err := k.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", sdkerrors.Wrapf(err, "failed to claim capability for channel %s on port %s", channelID, portID)
+	}
+
+	return icqtypes.Version, nil
+}
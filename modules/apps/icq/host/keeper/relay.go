@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/icq/host/types"
+	icqtypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// OnRecvPacket handles a given interchain queries packet on a destination host chain.
+// If the queries are successfully executed, the acknowledgement bytes containing the
+// query responses will be returned.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+	var data icqtypes.InterchainQueryPacketData
+	if err := icqtypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// UnmarshalJSON errors are indeterminate and therefore are not wrapped and included in failed acks
+		return nil, sdkerrors.Wrapf(icqtypes.ErrUnknownDataType, "cannot unmarshal ICQ packet data")
+	}
+
+	reqs, err := icqtypes.DeserializeCosmosQuery(data.Data)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(icqtypes.ErrUnknownDataType, "cannot unmarshal ICQ cosmos query")
+	}
+
+	resps, err := k.executeQueries(ctx, reqs)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := icqtypes.SerializeCosmosResponse(resps)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal query responses")
+	}
+
+	ack := icqtypes.InterchainQueryPacketAck{
+		Data: bz,
+	}
+
+	return ack.GetBytes(), nil
+}
+
+// executeQueries executes the provided query requests against the store at the current height. Each
+// query path must be allowed by the host params and the queries must not request a specific height or
+// proofs. The queries are executed atomically, an error is returned if a single query fails.
+func (k Keeper) executeQueries(ctx sdk.Context, reqs []abci.RequestQuery) ([]abci.ResponseQuery, error) {
+	if len(reqs) == 0 {
+		return nil, sdkerrors.Wrap(icqtypes.ErrInvalidQuery, "query requests cannot be empty")
+	}
+
+	allowQueries := k.GetAllowQueries(ctx)
+
+	// CacheContext returns a new context with the multi-store branched into a cached storage object.
+	// The cache is never written as queries must not perform state transitions.
+	cacheCtx, _ := ctx.CacheContext()
+
+	resps := make([]abci.ResponseQuery, len(reqs))
+	for i, req := range reqs {
+		if !types.ContainsQueryPath(allowQueries, req.Path) {
+			return nil, sdkerrors.Wrapf(icqtypes.ErrQueryNotAllowed, "query path not allowed: %s", req.Path)
+		}
+
+		if req.Height != 0 || req.Prove {
+			return nil, sdkerrors.Wrap(icqtypes.ErrInvalidQuery, "queries are executed at the current height, query height and proofs are not supported")
+		}
+
+		route := k.querier.Route(req.Path)
+		if route == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no route found for query path %s", req.Path)
+		}
+
+		res, err := route(cacheCtx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		resps[i] = abci.ResponseQuery{
+			Value:  res.Value,
+			Height: ctx.BlockHeight(),
+		}
+	}
+
+	return resps, nil
+}
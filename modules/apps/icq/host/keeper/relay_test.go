@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/icq/host/types"
+	icqtypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+const allBalancesPath = "/cosmos.bank.v1beta1.Query/AllBalances"
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	var (
+		path       *ibctesting.Path
+		requests   []abci.RequestQuery
+		packetData []byte
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success with wildcard allow queries", func() {
+				params := types.NewParams(true, []string{"*"})
+				suite.chainB.GetSimApp().ICQHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			}, true,
+		},
+		{
+			"query path not allowed", func() {
+				params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/Balance"})
+				suite.chainB.GetSimApp().ICQHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			}, false,
+		},
+		{
+			"query height is set", func() {
+				requests[0].Height = 1
+			}, false,
+		},
+		{
+			"query proof is requested", func() {
+				requests[0].Prove = true
+			}, false,
+		},
+		{
+			"no route found for query path", func() {
+				requests[0].Path = "/cosmos.bank.v1beta1.Query/Unknown"
+				params := types.NewParams(true, []string{requests[0].Path})
+				suite.chainB.GetSimApp().ICQHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			}, false,
+		},
+		{
+			"invalid query request data", func() {
+				requests[0].Data = []byte("invalid")
+			}, false,
+		},
+		{
+			"empty query requests", func() {
+				requests = []abci.RequestQuery{}
+			}, false,
+		},
+		{
+			"cannot unmarshal packet data", func() {
+				packetData = []byte("invalid packet data")
+			}, false,
+		},
+		{
+			"cannot unmarshal cosmos query", func() {
+				packetData = icqtypes.InterchainQueryPacketData{Data: []byte("invalid query")}.GetBytes()
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICQPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			params := types.NewParams(true, []string{allBalancesPath})
+			suite.chainB.GetSimApp().ICQHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			queryData := suite.chainB.GetSimApp().AppCodec().MustMarshal(&banktypes.QueryAllBalancesRequest{
+				Address: suite.chainB.SenderAccount.GetAddress().String(),
+			})
+
+			requests = []abci.RequestQuery{
+				{
+					Path: allBalancesPath,
+					Data: queryData,
+				},
+			}
+			packetData = nil
+
+			tc.malleate() // malleate mutates test data
+
+			if packetData == nil {
+				bz, err := icqtypes.SerializeCosmosQuery(requests)
+				suite.Require().NoError(err)
+
+				packetData = icqtypes.InterchainQueryPacketData{Data: bz}.GetBytes()
+			}
+
+			packet := channeltypes.NewPacket(
+				packetData,
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			ackBz, err := suite.chainB.GetSimApp().ICQHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				var ack icqtypes.InterchainQueryPacketAck
+				suite.Require().NoError(icqtypes.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+
+				responses, err := icqtypes.DeserializeCosmosResponse(ack.Data)
+				suite.Require().NoError(err)
+				suite.Require().Len(responses, 1)
+				suite.Require().Equal(suite.chainB.GetContext().BlockHeight(), responses[0].Height)
+
+				var balances banktypes.QueryAllBalancesResponse
+				suite.Require().NoError(suite.chainB.GetSimApp().AppCodec().Unmarshal(responses[0].Value, &balances))
+
+				expBalances := suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress())
+				suite.Require().Equal(expBalances, balances.Balances)
+				suite.Require().False(balances.Balances.IsZero())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(ackBz)
+			}
+		})
+	}
+}
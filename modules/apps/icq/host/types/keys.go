@@ -0,0 +1,25 @@
+package types
+
+const (
+	// SubModuleName defines the interchain queries host module name
+	SubModuleName = "icqhost"
+
+	// StoreKey is the store key string for the interchain queries host module
+	StoreKey = SubModuleName
+)
+
+// ContainsQueryPath returns true if the query path is present in allowQueries, otherwise false
+func ContainsQueryPath(allowQueries []string, path string) bool {
+	// check that wildcard * option for allowing all query paths is the only string in the array, if so, return true
+	if len(allowQueries) == 1 && allowQueries[0] == "*" {
+		return true
+	}
+
+	for _, v := range allowQueries {
+		if v == path {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,29 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/icq/host/types"
+)
+
+func TestContainsQueryPath(t *testing.T) {
+	const path = "/cosmos.bank.v1beta1.Query/AllBalances"
+
+	testCases := []struct {
+		name         string
+		allowQueries []string
+		expContains  bool
+	}{
+		{"path is allowed", []string{"/cosmos.bank.v1beta1.Query/Balance", path}, true},
+		{"wildcard allows all paths", []string{"*"}, true},
+		{"wildcard is not the only entry", []string{"*", "/cosmos.bank.v1beta1.Query/Balance"}, false},
+		{"path is not allowed", []string{"/cosmos.bank.v1beta1.Query/Balance"}, false},
+		{"empty allow queries", []string{}, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expContains, types.ContainsQueryPath(tc.allowQueries, path), tc.name)
+	}
+}
@@ -0,0 +1,16 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/icq/host/types"
+)
+
+func TestValidateParams(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+	require.NoError(t, types.NewParams(false, []string{}).Validate())
+	require.NoError(t, types.NewParams(true, []string{"*"}).Validate())
+	require.Error(t, types.NewParams(true, []string{" "}).Validate())
+}
@@ -0,0 +1,202 @@
+package icq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/icq/client/cli"
+	"github.com/cosmos/ibc-go/v4/modules/apps/icq/controller"
+	controllerkeeper "github.com/cosmos/ibc-go/v4/modules/apps/icq/controller/keeper"
+	controllertypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/controller/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/icq/host"
+	hostkeeper "github.com/cosmos/ibc-go/v4/modules/apps/icq/host/keeper"
+	hosttypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/host/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/icq/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibchost "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+
+	_ porttypes.IBCModule = controller.IBCModule{}
+	_ porttypes.IBCModule = host.IBCModule{}
+)
+
+// AppModuleBasic is the IBC interchain queries AppModuleBasic
+type AppModuleBasic struct{}
+
+// Name implements AppModuleBasic interface
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// RegisterLegacyAminoCodec implements AppModuleBasic.
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
+
+// RegisterInterfaces implements AppModuleBasic.
+func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {}
+
+// DefaultGenesis returns default genesis state as raw bytes for the IBC
+// interchain queries module
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesis())
+}
+
+// ValidateGenesis performs genesis state validation for the IBC interchain queries module
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	var gs types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return gs.Validate()
+}
+
+// RegisterRESTRoutes implements AppModuleBasic interface
+func (AppModuleBasic) RegisterRESTRoutes(ctx client.Context, rtr *mux.Router) {
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the interchain queries module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	controllertypes.RegisterQueryHandlerClient(context.Background(), mux, controllertypes.NewQueryClient(clientCtx))
+	hosttypes.RegisterQueryHandlerClient(context.Background(), mux, hosttypes.NewQueryClient(clientCtx))
+}
+
+// GetTxCmd implements AppModuleBasic interface
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return nil
+}
+
+// GetQueryCmd implements AppModuleBasic interface
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
+// AppModule is the application module for the IBC interchain queries module
+type AppModule struct {
+	AppModuleBasic
+	controllerKeeper *controllerkeeper.Keeper
+	hostKeeper       *hostkeeper.Keeper
+}
+
+// NewAppModule creates a new IBC interchain queries module
+func NewAppModule(controllerKeeper *controllerkeeper.Keeper, hostKeeper *hostkeeper.Keeper) AppModule {
+	return AppModule{
+		controllerKeeper: controllerKeeper,
+		hostKeeper:       hostKeeper,
+	}
+}
+
+// InitModule will initialize the interchain queries module. It should only be
+// called once and as an alternative to InitGenesis.
+func (am AppModule) InitModule(ctx sdk.Context, controllerParams controllertypes.Params, hostParams hosttypes.Params) {
+	if am.controllerKeeper != nil {
+		am.controllerKeeper.SetParams(ctx, controllerParams)
+
+		cap := am.controllerKeeper.BindPort(ctx, types.ControllerPortID)
+		if err := am.controllerKeeper.ClaimCapability(ctx, cap, ibchost.PortPath(types.ControllerPortID)); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+
+	if am.hostKeeper != nil {
+		am.hostKeeper.SetParams(ctx, hostParams)
+
+		cap := am.hostKeeper.BindPort(ctx, types.HostPortID)
+		if err := am.hostKeeper.ClaimCapability(ctx, cap, ibchost.PortPath(types.HostPortID)); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+}
+
+// RegisterInvariants implements the AppModule interface
+func (AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+}
+
+// Route implements the AppModule interface
+func (AppModule) Route() sdk.Route {
+	return sdk.NewRoute(types.RouterKey, nil)
+}
+
+// NewHandler implements the AppModule interface
+func (AppModule) NewHandler() sdk.Handler {
+	return nil
+}
+
+// QuerierRoute implements the AppModule interface
+func (AppModule) QuerierRoute() string {
+	return types.QuerierRoute
+}
+
+// LegacyQuerierHandler implements the AppModule interface
+func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
+	return nil
+}
+
+// RegisterServices registers module services
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	controllertypes.RegisterQueryServer(cfg.QueryServer(), am.controllerKeeper)
+	hosttypes.RegisterQueryServer(cfg.QueryServer(), am.hostKeeper)
+}
+
+// InitGenesis performs genesis initialization for the interchain queries module.
+// It returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState types.GenesisState
+	cdc.MustUnmarshalJSON(data, &genesisState)
+
+	if am.controllerKeeper != nil {
+		controllerkeeper.InitGenesis(ctx, *am.controllerKeeper, genesisState.ControllerGenesisState)
+	}
+
+	if am.hostKeeper != nil {
+		hostkeeper.InitGenesis(ctx, *am.hostKeeper, genesisState.HostGenesisState)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the interchain queries module
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	var (
+		controllerGenesisState = types.DefaultControllerGenesis()
+		hostGenesisState       = types.DefaultHostGenesis()
+	)
+
+	if am.controllerKeeper != nil {
+		controllerGenesisState = controllerkeeper.ExportGenesis(ctx, *am.controllerKeeper)
+	}
+
+	if am.hostKeeper != nil {
+		hostGenesisState = hostkeeper.ExportGenesis(ctx, *am.hostKeeper)
+	}
+
+	gs := types.NewGenesisState(controllerGenesisState, hostGenesisState)
+
+	return cdc.MustMarshalJSON(gs)
+}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+
+// BeginBlock implements the AppModule interface
+func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+}
+
+// EndBlock implements the AppModule interface
+func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// ModuleCdc references the global interchain queries module codec. Note, the codec
+// should ONLY be used in certain instances of tests and for JSON encoding.
+//
+// The actual codec used for serialization should be provided to interchain queries and
+// defined at the application level.
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// SerializeCosmosQuery serializes a slice of query requests using the CosmosQuery type.
+// The proto marshaled CosmosQuery bytes are returned.
+func SerializeCosmosQuery(reqs []abci.RequestQuery) ([]byte, error) {
+	q := &CosmosQuery{
+		Requests: reqs,
+	}
+
+	return q.Marshal()
+}
+
+// DeserializeCosmosQuery unmarshals the proto encoded CosmosQuery bytes into a slice of query requests.
+func DeserializeCosmosQuery(bz []byte) ([]abci.RequestQuery, error) {
+	var q CosmosQuery
+	if err := q.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return q.Requests, nil
+}
+
+// SerializeCosmosResponse serializes a slice of query responses using the CosmosResponse type.
+// The proto marshaled CosmosResponse bytes are returned.
+func SerializeCosmosResponse(resps []abci.ResponseQuery) ([]byte, error) {
+	r := &CosmosResponse{
+		Responses: resps,
+	}
+
+	return r.Marshal()
+}
+
+// DeserializeCosmosResponse unmarshals the proto encoded CosmosResponse bytes into a slice of query responses.
+func DeserializeCosmosResponse(bz []byte) ([]abci.ResponseQuery, error) {
+	var r CosmosResponse
+	if err := r.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return r.Responses, nil
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	ErrUnknownDataType       = sdkerrors.Register(ModuleName, 2, "unknown data type")
+	ErrInvalidChannelFlow    = sdkerrors.Register(ModuleName, 3, "invalid message sent to channel end")
+	ErrInvalidVersion        = sdkerrors.Register(ModuleName, 4, "invalid interchain queries version")
+	ErrInvalidControllerPort = sdkerrors.Register(ModuleName, 5, "invalid controller port")
+	ErrInvalidHostPort       = sdkerrors.Register(ModuleName, 6, "invalid host port")
+	ErrInvalidOutgoingData   = sdkerrors.Register(ModuleName, 7, "invalid outgoing data")
+	ErrInvalidQuery          = sdkerrors.Register(ModuleName, 8, "invalid query")
+	ErrQueryNotAllowed       = sdkerrors.Register(ModuleName, 9, "query path not allowed")
+	ErrUnsupported           = sdkerrors.Register(ModuleName, 10, "interchain queries does not support this action")
+)
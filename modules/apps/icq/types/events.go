@@ -0,0 +1,11 @@
+package types
+
+// Interchain Queries events
+const (
+	EventTypePacket = "icq_packet"
+
+	AttributeKeyAckError   = "error"
+	AttributeKeyAckSuccess = "success"
+	AttributeKeySequence   = "sequence"
+	AttributeKeyChannelID  = "channel_id"
+)
@@ -0,0 +1,94 @@
+package types
+
+import (
+	controllertypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/host/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// DefaultGenesis creates and returns the interchain queries GenesisState
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		ControllerGenesisState: DefaultControllerGenesis(),
+		HostGenesisState:       DefaultHostGenesis(),
+	}
+}
+
+// NewGenesisState creates and returns a new GenesisState instance from the provided controller and host genesis state types
+func NewGenesisState(controllerGenesisState ControllerGenesisState, hostGenesisState HostGenesisState) *GenesisState {
+	return &GenesisState{
+		ControllerGenesisState: controllerGenesisState,
+		HostGenesisState:       hostGenesisState,
+	}
+}
+
+// Validate performs basic validation of the interchain queries GenesisState
+func (gs GenesisState) Validate() error {
+	if err := gs.ControllerGenesisState.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.HostGenesisState.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DefaultControllerGenesis creates and returns the default interchain queries ControllerGenesisState
+func DefaultControllerGenesis() ControllerGenesisState {
+	return ControllerGenesisState{
+		Port:   ControllerPortID,
+		Params: controllertypes.DefaultParams(),
+	}
+}
+
+// NewControllerGenesisState creates a returns a new ControllerGenesisState instance
+func NewControllerGenesisState(port string, controllerParams controllertypes.Params) ControllerGenesisState {
+	return ControllerGenesisState{
+		Port:   port,
+		Params: controllerParams,
+	}
+}
+
+// Validate performs basic validation of the ControllerGenesisState
+func (gs ControllerGenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.Port); err != nil {
+		return err
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DefaultHostGenesis creates and returns the default interchain queries HostGenesisState
+func DefaultHostGenesis() HostGenesisState {
+	return HostGenesisState{
+		Port:   HostPortID,
+		Params: hosttypes.DefaultParams(),
+	}
+}
+
+// NewHostGenesisState creates a returns a new HostGenesisState instance
+func NewHostGenesisState(port string, hostParams hosttypes.Params) HostGenesisState {
+	return HostGenesisState{
+		Port:   port,
+		Params: hostParams,
+	}
+}
+
+// Validate performs basic validation of the HostGenesisState
+func (gs HostGenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.Port); err != nil {
+		return err
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	controllertypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/host/types"
+	"github.com/cosmos/ibc-go/v4/modules/apps/icq/types"
+)
+
+func TestValidateGenesisState(t *testing.T) {
+	testCases := []struct {
+		name         string
+		genesisState types.GenesisState
+		expPass      bool
+	}{
+		{"success", *types.DefaultGenesis(), true},
+		{"success with host allow queries", *types.NewGenesisState(types.DefaultControllerGenesis(), types.NewHostGenesisState(types.HostPortID, hosttypes.NewParams(true, []string{"*"}))), true},
+		{"empty value", types.GenesisState{}, false},
+		{"invalid controller port", *types.NewGenesisState(types.NewControllerGenesisState("invalid|port", controllertypes.DefaultParams()), types.DefaultHostGenesis()), false},
+		{"invalid host port", *types.NewGenesisState(types.DefaultControllerGenesis(), types.NewHostGenesisState("invalid|port", hosttypes.DefaultParams())), false},
+		{"invalid host allow queries", *types.NewGenesisState(types.DefaultControllerGenesis(), types.NewHostGenesisState(types.HostPortID, hosttypes.NewParams(true, []string{""}))), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genesisState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// ModuleName defines the interchain queries module name
+	ModuleName = "interchainquery"
+
+	// HostPortID is the default port id that the interchain queries host submodule binds to
+	HostPortID = "icqhost"
+
+	// ControllerPortID is the default port id that the interchain queries controller submodule binds to
+	ControllerPortID = "icqcontroller"
+
+	// Version defines the current version for interchain queries
+	Version = "icq-1"
+
+	// RouterKey is the message route for interchain queries
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for interchain queries
+	QuerierRoute = ModuleName
+)
+
+var (
+	// PortKeyPrefix defines the key prefix used to store ports
+	PortKeyPrefix = "port"
+)
+
+// KeyPort creates and returns a new key used for port store operations
+func KeyPort(portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", PortKeyPrefix, portID))
+}
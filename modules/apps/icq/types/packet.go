@@ -0,0 +1,60 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+var _ ibcexported.PacketDataProvider = (*InterchainQueryPacketData)(nil)
+
+// MaxMemoCharLength defines the maximum length for the InterchainQueryPacketData memo field
+const MaxMemoCharLength = 256
+
+// ValidateBasic performs basic validation of the interchain query packet data.
+// The memo may be empty.
+func (iqpd InterchainQueryPacketData) ValidateBasic() error {
+	if len(iqpd.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidOutgoingData, "packet data cannot be empty")
+	}
+
+	if len(iqpd.Memo) > MaxMemoCharLength {
+		return sdkerrors.Wrapf(ErrInvalidOutgoingData, "packet data memo cannot be greater than %d characters", MaxMemoCharLength)
+	}
+
+	return nil
+}
+
+// GetBytes returns the JSON marshalled interchain query packet data.
+func (iqpd InterchainQueryPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&iqpd))
+}
+
+// GetCustomPacketData interprets the memo field of the packet data as a JSON object
+// and returns the value associated with the given key.
+// If the key is missing or the memo is not properly formatted, then nil is returned.
+func (iqpd InterchainQueryPacketData) GetCustomPacketData(key string) interface{} {
+	if len(iqpd.Memo) == 0 {
+		return nil
+	}
+
+	jsonObject := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(iqpd.Memo), &jsonObject); err != nil {
+		return nil
+	}
+
+	memoData, found := jsonObject[key]
+	if !found {
+		return nil
+	}
+
+	return memoData
+}
+
+// GetBytes returns the JSON marshalled interchain query packet acknowledgement.
+func (iqpa InterchainQueryPacketAck) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&iqpa))
+}
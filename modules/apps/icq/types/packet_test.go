@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/icq/types"
+)
+
+func TestInterchainQueryPacketDataValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		packetData types.InterchainQueryPacketData
+		expPass    bool
+	}{
+		{"success", types.InterchainQueryPacketData{Data: []byte("data")}, true},
+		{"success with memo", types.InterchainQueryPacketData{Data: []byte("data"), Memo: "memo"}, true},
+		{"empty data", types.InterchainQueryPacketData{Memo: "memo"}, false},
+		{"memo too long", types.InterchainQueryPacketData{Data: []byte("data"), Memo: strings.Repeat("a", types.MaxMemoCharLength+1)}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSerializeCosmosQuery(t *testing.T) {
+	requests := []abci.RequestQuery{
+		{Path: "/cosmos.bank.v1beta1.Query/AllBalances", Data: []byte("request")},
+		{Path: "/cosmos.bank.v1beta1.Query/TotalSupply"},
+	}
+
+	bz, err := types.SerializeCosmosQuery(requests)
+	require.NoError(t, err)
+
+	deserialized, err := types.DeserializeCosmosQuery(bz)
+	require.NoError(t, err)
+	require.Equal(t, requests, deserialized)
+
+	_, err = types.DeserializeCosmosQuery([]byte("invalid"))
+	require.Error(t, err)
+}
+
+func TestSerializeCosmosResponse(t *testing.T) {
+	responses := []abci.ResponseQuery{
+		{Value: []byte("response"), Height: 10},
+	}
+
+	bz, err := types.SerializeCosmosResponse(responses)
+	require.NoError(t, err)
+
+	deserialized, err := types.DeserializeCosmosResponse(bz)
+	require.NoError(t, err)
+	require.Equal(t, responses, deserialized)
+
+	_, err = types.DeserializeCosmosResponse([]byte("invalid"))
+	require.Error(t, err)
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"github.com/cosmos/ibc-go/v4/modules/apps/nft-transfer/types"
+)
+
+// UnmarshalClassTrace attempts to decode and return a ClassTrace object from
+// raw encoded bytes.
+func (k Keeper) UnmarshalClassTrace(bz []byte) (types.ClassTrace, error) {
+	var classTrace types.ClassTrace
+	if err := k.cdc.Unmarshal(bz, &classTrace); err != nil {
+		return types.ClassTrace{}, err
+	}
+
+	return classTrace, nil
+}
+
+// MustUnmarshalClassTrace attempts to decode and return a ClassTrace object from
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalClassTrace(bz []byte) types.ClassTrace {
+	var classTrace types.ClassTrace
+	k.cdc.MustUnmarshal(bz, &classTrace)
+	return classTrace
+}
+
+// MustMarshalClassTrace attempts to encode a ClassTrace object and returns the
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustMarshalClassTrace(classTrace types.ClassTrace) []byte {
+	return k.cdc.MustMarshal(&classTrace)
+}
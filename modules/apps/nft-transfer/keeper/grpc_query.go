@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// ClassTrace implements the Query/ClassTrace gRPC method
+func (q Keeper) ClassTrace(c context.Context, req *types.QueryClassTraceRequest) (*types.QueryClassTraceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := transfertypes.ParseHexHash(strings.TrimPrefix(req.Hash, types.ClassPrefix+"/"))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid class trace hash: %s, error: %s", req.Hash, err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	classTrace, found := q.GetClassTrace(ctx, hash)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrTraceNotFound, req.Hash).Error(),
+		)
+	}
+
+	return &types.QueryClassTraceResponse{
+		ClassTrace: &classTrace,
+	}, nil
+}
+
+// ClassTraces implements the Query/ClassTraces gRPC method
+func (q Keeper) ClassTraces(c context.Context, req *types.QueryClassTracesRequest) (*types.QueryClassTracesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	traces := types.Traces{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.ClassTraceKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		result, err := q.UnmarshalClassTrace(value)
+		if err != nil {
+			return err
+		}
+
+		traces = append(traces, result)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassTracesResponse{
+		ClassTraces: traces.Sort(),
+		Pagination:  pageRes,
+	}, nil
+}
+
+// ClassHash implements the Query/ClassHash gRPC method
+func (q Keeper) ClassHash(c context.Context, req *types.QueryClassHashRequest) (*types.QueryClassHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Convert given request trace path to ClassTrace struct to confirm the path in a valid class trace format
+	classTrace := types.ParseClassTrace(req.Trace)
+	if err := classTrace.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	classHash := classTrace.Hash()
+	if !q.HasClassTrace(ctx, classHash) {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrTraceNotFound, req.Trace).Error(),
+		)
+	}
+
+	return &types.QueryClassHashResponse{
+		Hash: classHash.String(),
+	}, nil
+}
+
+// EscrowAddress implements the EscrowAddress gRPC method
+func (q Keeper) EscrowAddress(c context.Context, req *types.QueryEscrowAddressRequest) (*types.QueryEscrowAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addr := types.GetEscrowAddress(req.PortId, req.ChannelId)
+
+	return &types.QueryEscrowAddressResponse{
+		EscrowAddress: addr.String(),
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: &params,
+	}, nil
+}
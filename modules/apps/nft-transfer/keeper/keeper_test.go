@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/nft-transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+const (
+	classID  = "kitty"
+	classURI = "https://kitty.example/class"
+	tokenID  = "kitty-1"
+	tokenURI = "https://kitty.example/kitty-1"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.GetSimApp().InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.GetSimApp().NFTTransferKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+// NewNFTTransferPath returns a path using the nft-transfer port and version.
+func NewNFTTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = types.PortID
+	path.EndpointB.ChannelConfig.PortID = types.PortID
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Version = types.Version
+
+	return path
+}
+
+// mintNFT creates the class if necessary and mints a token owned by the given address.
+func mintNFT(chain *ibctesting.TestChain, classID, tokenID string, owner sdk.AccAddress) {
+	nftKeeper := chain.GetSimApp().MockNFTKeeper
+	if !nftKeeper.HasClass(chain.GetContext(), classID) {
+		err := nftKeeper.SaveClass(chain.GetContext(), classID, classURI, "")
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if err := nftKeeper.Mint(chain.GetContext(), classID, tokenID, tokenURI, "", owner); err != nil {
+		panic(err)
+	}
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestGetAllClassTraces() {
+	expTraces := types.Traces{
+		types.ClassTrace{BaseClassId: "kitty", Path: ""},
+		types.ClassTrace{BaseClassId: "kitty", Path: "nft-transfer/channel-0"},
+		types.ClassTrace{BaseClassId: "kitty", Path: "nft-transfer/channel-0/nft-transfer/channel-1"},
+	}
+
+	for _, trace := range expTraces {
+		suite.chainA.GetSimApp().NFTTransferKeeper.SetClassTrace(suite.chainA.GetContext(), trace)
+	}
+
+	traces := suite.chainA.GetSimApp().NFTTransferKeeper.GetAllClassTraces(suite.chainA.GetContext())
+	suite.Require().Len(traces, len(expTraces))
+	suite.Require().Equal(expTraces.Sort(), traces)
+}
@@ -0,0 +1,364 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	coretypes "github.com/cosmos/ibc-go/v4/modules/core/types"
+)
+
+// SendTransfer handles non-fungible token transfer sending logic. The source and sink zone
+// semantics follow the fungible token transfer application:
+//
+// 1. Sender chain is acting as the source zone. The non-fungible tokens are transferred
+// to an escrow address (i.e locked) on the sender chain and then transferred
+// to the receiving chain through IBC TAO logic. It is expected that the
+// receiving chain will mint vouchers of the tokens to the receiving address.
+//
+// 2. Sender chain is acting as the sink zone. The non-fungible tokens (vouchers) are burned
+// on the sender chain and then transferred to the receiving chain though IBC
+// TAO logic. It is expected that the receiving chain, which had previously
+// sent the original tokens, will unescrow the non-fungible tokens and send
+// them to the receiving address.
+//
+// The class uri and class data, as well as the uri and data of each token, are sent
+// in the packet so that the receiving chain can create the voucher class and tokens.
+func (k Keeper) SendTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel,
+	classID string,
+	tokenIDs []string,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) error {
+	if !k.GetSendEnabled(ctx) {
+		return types.ErrSendDisabled
+	}
+
+	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	// get the next sequence
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", sourcePort, sourceChannel,
+		)
+	}
+
+	// begin createOutgoingPacket logic
+	// See spec for this logic: https://github.com/cosmos/ibc/tree/master/spec/app/ics-721-nft-transfer#packet-relay
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	class, found := k.nftKeeper.GetClass(ctx, classID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidClassID, "class %s not found", classID)
+	}
+
+	// NOTE: class id and hex hash correctness checked during msg.ValidateBasic
+	fullClassPath := classID
+	if strings.HasPrefix(classID, types.ClassPrefix+"/") {
+		var err error
+		if fullClassPath, err = k.ClassPathFromHash(ctx, classID); err != nil {
+			return err
+		}
+	}
+
+	senderIsSource := types.SenderChainIsSource(sourcePort, sourceChannel, fullClassPath)
+
+	tokenURIs := make([]string, len(tokenIDs))
+	tokenData := make([]string, len(tokenIDs))
+	for i, tokenID := range tokenIDs {
+		nft, found := k.nftKeeper.GetNFT(ctx, classID, tokenID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrInvalidTokenID, "token %s of class %s not found", tokenID, classID)
+		}
+
+		if owner := k.nftKeeper.GetOwner(ctx, classID, tokenID); !sender.Equals(owner) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of token %s of class %s", sender, tokenID, classID)
+		}
+
+		tokenURIs[i] = nft.GetURI()
+		tokenData[i] = nft.GetData()
+
+		if err := k.escrowOrBurnToken(ctx, sourcePort, sourceChannel, senderIsSource, classID, tokenID); err != nil {
+			return err
+		}
+	}
+
+	// NOTE: SendTransfer simply sends the class id as it exists on its own
+	// chain inside the packet data. The receiving chain will perform class
+	// prefixing as necessary.
+	packetData := types.NewNonFungibleTokenPacketData(
+		fullClassPath, class.GetURI(), class.GetData(), tokenIDs, tokenURIs, tokenData, sender.String(), receiver, memo,
+	)
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.ics4Wrapper.SendPacket(ctx, channelCap, packet); err != nil {
+		return err
+	}
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", types.ModuleName, "send"},
+			float32(len(tokenIDs)),
+			[]metrics.Label{
+				telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
+				telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
+				telemetry.NewLabel(coretypes.LabelSource, fmt.Sprintf("%t", senderIsSource)),
+			},
+		)
+	}()
+
+	return nil
+}
+
+// escrowOrBurnToken escrows the non-fungible token if the sender chain is the source of the
+// token class, otherwise the token is burned.
+func (k Keeper) escrowOrBurnToken(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	senderIsSource bool,
+	classID,
+	tokenID string,
+) error {
+	if senderIsSource {
+		// escrow source tokens
+		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+		return k.nftKeeper.Transfer(ctx, classID, tokenID, escrowAddress)
+	}
+
+	return k.nftKeeper.Burn(ctx, classID, tokenID)
+}
+
+// OnRecvPacket processes a cross chain non-fungible token transfer. If the
+// sender chain is the source of the token class then voucher tokens will be minted
+// and sent to the receiving address. The voucher class is created upon the first
+// receipt of the class. Otherwise if the sender chain is sending back tokens this
+// chain originally transferred to it, the tokens are unescrowed and sent to the
+// receiving address.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !k.GetReceiveEnabled(ctx) {
+		return types.ErrReceiveDisabled
+	}
+
+	// decode the receiver address
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	labels := []metrics.Label{
+		telemetry.NewLabel(coretypes.LabelSourcePort, packet.GetSourcePort()),
+		telemetry.NewLabel(coretypes.LabelSourceChannel, packet.GetSourceChannel()),
+	}
+
+	// This is the prefix that would have been prefixed to the class id
+	// on sender chain IF and only if the token originally came from the
+	// receiving chain.
+	//
+	// NOTE: We use SourcePort and SourceChannel here, because the counterparty
+	// chain would have prefixed with DestPort and DestChannel when originally
+	// receiving this class as seen in the "sender chain is the source" condition.
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId) {
+		// sender chain is not the source, unescrow tokens
+
+		// remove prefix added by sender chain
+		voucherPrefix := types.GetClassPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedClassID := data.ClassId[len(voucherPrefix):]
+
+		// The class id used to send the tokens is either the native class id or the hash of the path
+		// if the class is not native.
+		classID := types.ParseClassTrace(unprefixedClassID).IBCClassID()
+
+		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		for _, tokenID := range data.TokenIds {
+			if owner := k.nftKeeper.GetOwner(ctx, classID, tokenID); !escrowAddress.Equals(owner) {
+				// NOTE: this error is only expected to occur given an unexpected bug or a malicious
+				// counterparty module.
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "token %s of class %s is not escrowed, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module", tokenID, classID)
+			}
+
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, receiver); err != nil {
+				return sdkerrors.Wrapf(err, "unable to unescrow token %s of class %s", tokenID, classID)
+			}
+		}
+
+		defer func() {
+			telemetry.IncrCounterWithLabels(
+				[]string{"ibc", types.ModuleName, "receive"},
+				float32(len(data.TokenIds)),
+				append(
+					labels, telemetry.NewLabel(coretypes.LabelSource, "true"),
+				),
+			)
+		}()
+
+		return nil
+	}
+
+	// sender chain is the source, mint vouchers
+
+	// since SendPacket did not prefix the class id, we must prefix the class id here
+	sourcePrefix := types.GetClassPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	// NOTE: sourcePrefix contains the trailing "/"
+	prefixedClassID := sourcePrefix + data.ClassId
+
+	// construct the class trace from the full raw class id
+	classTrace := types.ParseClassTrace(prefixedClassID)
+
+	traceHash := classTrace.Hash()
+	if !k.HasClassTrace(ctx, traceHash) {
+		k.SetClassTrace(ctx, classTrace)
+	}
+
+	voucherClassID := classTrace.IBCClassID()
+	if !k.nftKeeper.HasClass(ctx, voucherClassID) {
+		if err := k.nftKeeper.SaveClass(ctx, voucherClassID, data.ClassUri, data.ClassData); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClassTrace,
+			sdk.NewAttribute(types.AttributeKeyTraceHash, traceHash.String()),
+			sdk.NewAttribute(types.AttributeKeyClassID, voucherClassID),
+		),
+	)
+
+	for i, tokenID := range data.TokenIds {
+		if err := k.nftKeeper.Mint(ctx, voucherClassID, tokenID, data.TokenURIAt(i), data.TokenDataAt(i), receiver); err != nil {
+			return sdkerrors.Wrapf(err, "unable to mint token %s of class %s", tokenID, voucherClassID)
+		}
+	}
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", types.ModuleName, "receive"},
+			float32(len(data.TokenIds)),
+			append(
+				labels, telemetry.NewLabel(coretypes.LabelSource, "false"),
+			),
+		)
+	}()
+
+	return nil
+}
+
+// OnAcknowledgementPacket responds to the the success or failure of a packet
+// acknowledgement written on the receiving chain. If the acknowledgement
+// was a success then nothing occurs. If the acknowledgement failed, then
+// the sender is refunded their tokens using the refundPacketTokens function.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundPacketTokens(ctx, packet, data)
+	default:
+		// the acknowledgement succeeded on the receiving chain so nothing
+		// needs to be refunded
+		return nil
+	}
+}
+
+// OnTimeoutPacket refunds the sender since the original packet sent was
+// never received and has been timed out.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	return k.refundPacketTokens(ctx, packet, data)
+}
+
+// refundPacketTokens will unescrow and send back the tokens back to sender
+// if the sending chain was the source chain. Otherwise, the sent tokens
+// were burnt in the original send so new tokens are minted and sent to
+// the sending address.
+func (k Keeper) refundPacketTokens(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	// NOTE: packet data type already checked in handler.go
+
+	// decode the sender address
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	// the class id used on this chain is either the native class id or the voucher class id
+	classID := types.ParseClassTrace(data.ClassId).IBCClassID()
+
+	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId) {
+		// unescrow tokens back to sender
+		for _, tokenID := range data.TokenIds {
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, sender); err != nil {
+				// NOTE: this error is only expected to occur given an unexpected bug.
+				return sdkerrors.Wrapf(err, "unable to unescrow token %s of class %s", tokenID, classID)
+			}
+		}
+
+		return nil
+	}
+
+	// mint vouchers back to sender
+	for i, tokenID := range data.TokenIds {
+		if err := k.nftKeeper.Mint(ctx, classID, tokenID, data.TokenURIAt(i), data.TokenDataAt(i), sender); err != nil {
+			return sdkerrors.Wrapf(err, "unable to mint token %s of class %s", tokenID, classID)
+		}
+	}
+
+	return nil
+}
+
+// ClassPathFromHash returns the full class path prefix from an ibc class id with a hash
+// component.
+func (k Keeper) ClassPathFromHash(ctx sdk.Context, classID string) (string, error) {
+	// trim the class prefix, by default "ibc/"
+	hexHash := classID[len(types.ClassPrefix+"/"):]
+
+	hash, err := transfertypes.ParseHexHash(hexHash)
+	if err != nil {
+		return "", sdkerrors.Wrap(types.ErrInvalidClassID, err.Error())
+	}
+
+	classTrace, found := k.GetClassTrace(ctx, hash)
+	if !found {
+		return "", sdkerrors.Wrap(types.ErrTraceNotFound, hexHash)
+	}
+
+	return classTrace.GetFullClassPath(), nil
+}
@@ -0,0 +1,326 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/nft-transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+// test sending from chainA to chainB using tokens that originate on
+// chainA and tokens that originate on chainB
+func (suite *KeeperTestSuite) TestSendTransfer() {
+	var (
+		path         *ibctesting.Path
+		sendClassID  string
+		sendTokenIDs []string
+		sender       sdk.AccAddress
+		expEscrowed  bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful transfer from source chain",
+			func() {
+				mintNFT(suite.chainA, classID, tokenID, sender)
+			}, true,
+		},
+		{
+			"successful transfer with voucher from counterparty chain",
+			func() {
+				trace := types.ParseClassTrace(types.GetClassPrefix(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID) + classID)
+				suite.chainA.GetSimApp().NFTTransferKeeper.SetClassTrace(suite.chainA.GetContext(), trace)
+				sendClassID = trace.IBCClassID()
+				mintNFT(suite.chainA, sendClassID, tokenID, sender)
+				expEscrowed = false
+			}, true,
+		},
+		{
+			"send disabled",
+			func() {
+				mintNFT(suite.chainA, classID, tokenID, sender)
+				suite.chainA.GetSimApp().NFTTransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, true))
+			}, false,
+		},
+		{
+			"source channel not found",
+			func() {
+				mintNFT(suite.chainA, classID, tokenID, sender)
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"class not found",
+			func() {}, false,
+		},
+		{
+			"voucher class trace not found",
+			func() {
+				sendClassID = types.ParseClassTrace("nft-transfer/channel-7/kitty").IBCClassID()
+				mintNFT(suite.chainA, sendClassID, tokenID, sender)
+			}, false,
+		},
+		{
+			"token not found",
+			func() {
+				mintNFT(suite.chainA, classID, tokenID, sender)
+				sendTokenIDs = []string{tokenID, "kitty-2"}
+			}, false,
+		},
+		{
+			"sender does not own the token",
+			func() {
+				mintNFT(suite.chainA, classID, tokenID, suite.chainB.SenderAccount.GetAddress())
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = NewNFTTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			sendClassID = classID
+			sendTokenIDs = []string{tokenID}
+			sender = suite.chainA.SenderAccount.GetAddress()
+			expEscrowed = true
+
+			tc.malleate()
+
+			err := suite.chainA.GetSimApp().NFTTransferKeeper.SendTransfer(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sendClassID, sendTokenIDs,
+				sender, suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "",
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				nftKeeper := suite.chainA.GetSimApp().MockNFTKeeper
+				if expEscrowed {
+					escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+					suite.Require().Equal(escrowAddress, nftKeeper.GetOwner(suite.chainA.GetContext(), sendClassID, tokenID))
+				} else {
+					_, found := nftKeeper.GetNFT(suite.chainA.GetContext(), sendClassID, tokenID)
+					suite.Require().False(found, "voucher token was not burned")
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// test receiving tokens on chainB originating from chainA and tokens
+// originating from chainB which are sent back
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	var (
+		path       *ibctesting.Path
+		packetData types.NonFungibleTokenPacketData
+	)
+
+	testCases := []struct {
+		msg          string
+		malleate     func()
+		recvIsSource bool // the receiving chain is the source of the class originally
+		expPass      bool
+	}{
+		{
+			"success receive on sink chain",
+			func() {}, false, true,
+		},
+		{
+			"success receive on source chain",
+			func() {
+				escrowAddress := types.GetEscrowAddress(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				mintNFT(suite.chainB, classID, tokenID, escrowAddress)
+				packetData.ClassId = types.GetClassPrefix(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID) + classID
+			}, true, true,
+		},
+		{
+			"receive disabled",
+			func() {
+				suite.chainB.GetSimApp().NFTTransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false))
+			}, false, false,
+		},
+		{
+			"invalid receiver address",
+			func() {
+				packetData.Receiver = "gaia1scqhwpgsmr6vmztaa7suurfl52my6nd2kmrudl"
+			}, false, false,
+		},
+		{
+			"invalid packet data",
+			func() {
+				packetData.TokenIds = nil
+			}, false, false,
+		},
+		{
+			"token on source chain is not escrowed",
+			func() {
+				mintNFT(suite.chainB, classID, tokenID, suite.chainA.SenderAccount.GetAddress())
+				packetData.ClassId = types.GetClassPrefix(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID) + classID
+			}, true, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = NewNFTTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			receiver := suite.chainB.SenderAccount.GetAddress()
+			packetData = types.NewNonFungibleTokenPacketData(
+				classID, classURI, "", []string{tokenID}, []string{tokenURI}, nil,
+				suite.chainA.SenderAccount.GetAddress().String(), receiver.String(), "",
+			)
+
+			tc.malleate()
+
+			packet := channeltypes.NewPacket(
+				packetData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0,
+			)
+
+			err := suite.chainB.GetSimApp().NFTTransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, packetData)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				nftKeeper := suite.chainB.GetSimApp().MockNFTKeeper
+				if tc.recvIsSource {
+					suite.Require().Equal(receiver, nftKeeper.GetOwner(suite.chainB.GetContext(), classID, tokenID))
+					return
+				}
+
+				voucherTrace := types.ParseClassTrace(types.GetClassPrefix(packet.GetDestPort(), packet.GetDestChannel()) + classID)
+				trace, found := suite.chainB.GetSimApp().NFTTransferKeeper.GetClassTrace(suite.chainB.GetContext(), voucherTrace.Hash())
+				suite.Require().True(found)
+				suite.Require().Equal(voucherTrace, trace)
+
+				class, found := nftKeeper.GetClass(suite.chainB.GetContext(), voucherTrace.IBCClassID())
+				suite.Require().True(found)
+				suite.Require().Equal(classURI, class.GetURI())
+
+				nft, found := nftKeeper.GetNFT(suite.chainB.GetContext(), voucherTrace.IBCClassID(), tokenID)
+				suite.Require().True(found)
+				suite.Require().Equal(tokenURI, nft.GetURI())
+				suite.Require().Equal(receiver, nftKeeper.GetOwner(suite.chainB.GetContext(), voucherTrace.IBCClassID(), tokenID))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestOnAcknowledgementPacket tests that successful acknowledgement is a no-op
+// and failure acknowledment leads to refund when attempting to send from chainA
+// to chainB.
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	var (
+		path     *ibctesting.Path
+		sendFrom string
+	)
+
+	testCases := []struct {
+		msg      string
+		ack      channeltypes.Acknowledgement
+		malleate func()
+		expOwner func() sdk.AccAddress
+	}{
+		{
+			"success ack is a no-op",
+			channeltypes.NewResultAcknowledgement([]byte{byte(1)}),
+			func() {},
+			func() sdk.AccAddress {
+				return types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			},
+		},
+		{
+			"error ack refunds escrowed token",
+			channeltypes.NewErrorAcknowledgement(types.ErrInvalidPacketData),
+			func() {},
+			func() sdk.AccAddress { return suite.chainA.SenderAccount.GetAddress() },
+		},
+		{
+			"error ack mints back burned voucher",
+			channeltypes.NewErrorAcknowledgement(types.ErrInvalidPacketData),
+			func() {
+				trace := types.ParseClassTrace(types.GetClassPrefix(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID) + classID)
+				suite.chainA.GetSimApp().NFTTransferKeeper.SetClassTrace(suite.chainA.GetContext(), trace)
+				sendFrom = trace.IBCClassID()
+			},
+			func() sdk.AccAddress { return suite.chainA.SenderAccount.GetAddress() },
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = NewNFTTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			sendFrom = classID
+
+			tc.malleate()
+
+			packet, packetData := suite.sendNFT(path, sendFrom)
+
+			err := suite.chainA.GetSimApp().NFTTransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, packetData, tc.ack)
+			suite.Require().NoError(err)
+
+			owner := suite.chainA.GetSimApp().MockNFTKeeper.GetOwner(suite.chainA.GetContext(), sendFrom, tokenID)
+			suite.Require().Equal(tc.expOwner(), owner)
+		})
+	}
+}
+
+// TestOnTimeoutPacket tests that the tokens sent are refunded to the sender
+// when the packet times out.
+func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
+	path := NewNFTTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	packet, packetData := suite.sendNFT(path, classID)
+
+	err := suite.chainA.GetSimApp().NFTTransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, packetData)
+	suite.Require().NoError(err)
+
+	owner := suite.chainA.GetSimApp().MockNFTKeeper.GetOwner(suite.chainA.GetContext(), classID, tokenID)
+	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), owner)
+}
+
+// sendNFT mints a token of the given class on chainA and sends it over the path,
+// returning the packet sent and its packet data.
+func (suite *KeeperTestSuite) sendNFT(path *ibctesting.Path, sendClassID string) (channeltypes.Packet, types.NonFungibleTokenPacketData) {
+	sender := suite.chainA.SenderAccount.GetAddress()
+	mintNFT(suite.chainA, sendClassID, tokenID, sender)
+
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sendClassID, []string{tokenID},
+		sender.String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "",
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var packetData types.NonFungibleTokenPacketData
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData))
+
+	return packet, packetData
+}
@@ -0,0 +1,105 @@
+package nfttransfer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/nft-transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+const (
+	classID  = "kitty"
+	classURI = "https://kitty.example/class"
+	tokenID  = "kitty-1"
+	tokenURI = "https://kitty.example/kitty-1"
+)
+
+type TransferTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *TransferTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func NewNFTTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = types.PortID
+	path.EndpointB.ChannelConfig.PortID = types.PortID
+	path.EndpointA.ChannelConfig.Version = types.Version
+	path.EndpointB.ChannelConfig.Version = types.Version
+
+	return path
+}
+
+// constructs a send of a non-fungible token from chainA to chainB on the established
+// channel/connection and sends the same token back from chainB to chainA.
+func (suite *TransferTestSuite) TestHandleMsgTransfer() {
+	path := NewNFTTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	senderA := suite.chainA.SenderAccount.GetAddress()
+	receiverB := suite.chainB.SenderAccount.GetAddress()
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+
+	nftKeeperA := suite.chainA.GetSimApp().MockNFTKeeper
+	suite.Require().NoError(nftKeeperA.SaveClass(suite.chainA.GetContext(), classID, classURI, ""))
+	suite.Require().NoError(nftKeeperA.Mint(suite.chainA.GetContext(), classID, tokenID, tokenURI, "", senderA))
+
+	// send from chainA to chainB
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, classID, []string{tokenID}, senderA.String(), receiverB.String(), timeoutHeight, 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// relay send
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	// check that the token is escrowed on chainA and the voucher exists on chainB
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Equal(escrowAddress, nftKeeperA.GetOwner(suite.chainA.GetContext(), classID, tokenID))
+
+	voucherTrace := types.ParseClassTrace(types.GetClassPrefix(packet.GetDestPort(), packet.GetDestChannel()) + classID)
+	voucherClassID := voucherTrace.IBCClassID()
+
+	nftKeeperB := suite.chainB.GetSimApp().MockNFTKeeper
+	suite.Require().Equal(receiverB, nftKeeperB.GetOwner(suite.chainB.GetContext(), voucherClassID, tokenID))
+
+	voucherClass, found := nftKeeperB.GetClass(suite.chainB.GetContext(), voucherClassID)
+	suite.Require().True(found)
+	suite.Require().Equal(classURI, voucherClass.GetURI())
+
+	// send the voucher back from chainB to chainA
+	msg = types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucherClassID, []string{tokenID}, receiverB.String(), senderA.String(), timeoutHeight, 0, "")
+	res, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	// check that the voucher is burned on chainB and the token is unescrowed on chainA
+	_, found = nftKeeperB.GetNFT(suite.chainB.GetContext(), voucherClassID, tokenID)
+	suite.Require().False(found)
+	suite.Require().Equal(senderA, nftKeeperA.GetOwner(suite.chainA.GetContext(), classID, tokenID))
+}
+
+func TestTransferTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferTestSuite))
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBC nft-transfer sentinel errors
+var (
+	ErrInvalidClassID      = sdkerrors.Register(ModuleName, 2, "invalid class id for cross-chain non-fungible token transfer")
+	ErrInvalidTokenID      = sdkerrors.Register(ModuleName, 3, "invalid non-fungible token id")
+	ErrInvalidPacketData   = sdkerrors.Register(ModuleName, 4, "invalid non-fungible token packet data")
+	ErrInvalidVersion      = sdkerrors.Register(ModuleName, 5, "invalid ICS721 version")
+	ErrTraceNotFound       = sdkerrors.Register(ModuleName, 6, "class trace not found")
+	ErrSendDisabled        = sdkerrors.Register(ModuleName, 7, "non-fungible token transfers from this chain are disabled")
+	ErrReceiveDisabled     = sdkerrors.Register(ModuleName, 8, "non-fungible token transfers to this chain are disabled")
+	ErrMaxTransferChannels = sdkerrors.Register(ModuleName, 9, "max nft-transfer channels")
+)
@@ -0,0 +1,21 @@
+package types
+
+// IBC nft-transfer events
+const (
+	EventTypeTimeout      = "timeout"
+	EventTypePacket       = "non_fungible_token_packet"
+	EventTypeTransfer     = "ibc_nft_transfer"
+	EventTypeChannelClose = "channel_closed"
+	EventTypeClassTrace   = "class_trace"
+
+	AttributeKeyReceiver       = "receiver"
+	AttributeKeyClassID        = "class_id"
+	AttributeKeyTokenIDs       = "token_ids"
+	AttributeKeyRefundReceiver = "refund_receiver"
+	AttributeKeyRefundClassID  = "refund_class_id"
+	AttributeKeyRefundTokenIDs = "refund_token_ids"
+	AttributeKeyAckSuccess     = "success"
+	AttributeKeyAck            = "acknowledgement"
+	AttributeKeyAckError       = "error"
+	AttributeKeyTraceHash      = "trace_hash"
+)
@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+// Class defines the non-fungible token class information read from the NFT keeper.
+type Class interface {
+	GetID() string
+	GetURI() string
+	GetData() string
+}
+
+// NFT defines the non-fungible token information read from the NFT keeper.
+type NFT interface {
+	GetClassID() string
+	GetID() string
+	GetURI() string
+	GetData() string
+}
+
+// NFTKeeper defines the expected NFT keeper. The interface follows the keeper of the SDK
+// x/nft module, which is not part of the SDK version used by ibc-go v4. Chains must provide an
+// implementation for the NFT module they use. Chains using x/nft must provide an adapter
+// converting between the opaque class and token data strings carried in the packet and the
+// x/nft Class and NFT types, no such adapter is provided.
+type NFTKeeper interface {
+	// SaveClass creates a new class with the given id, uri and data.
+	SaveClass(ctx sdk.Context, classID, classURI, classData string) error
+	// Mint creates a new non-fungible token of the class owned by the receiver.
+	Mint(ctx sdk.Context, classID, tokenID, tokenURI, tokenData string, receiver sdk.AccAddress) error
+	// Transfer transfers the ownership of the non-fungible token to the receiver.
+	Transfer(ctx sdk.Context, classID, tokenID string, receiver sdk.AccAddress) error
+	// Burn deletes the non-fungible token.
+	Burn(ctx sdk.Context, classID, tokenID string) error
+
+	GetOwner(ctx sdk.Context, classID, tokenID string) sdk.AccAddress
+	HasClass(ctx sdk.Context, classID string) bool
+	GetClass(ctx sdk.Context, classID string) (Class, bool)
+	GetNFT(ctx sdk.Context, classID, tokenID string) (NFT, bool)
+}
+
+// ICS4Wrapper defines the expected ICS4Wrapper for middleware
+type ICS4Wrapper interface {
+	SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error
+}
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+}
+
+// PortKeeper defines the expected IBC port keeper
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
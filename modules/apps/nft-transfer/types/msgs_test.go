@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+)
+
+// define constants used for testing
+const (
+	validPort      = "testportid"
+	invalidPort    = "(invalidport1)"
+	validChannel   = "testchannel"
+	invalidChannel = "(invalidchannel1)"
+
+	ibcClassID        = "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2"
+	invalidIBCClassID = "ibc/7F1D3FCF4AE79E1554"
+)
+
+var timeoutHeight = clienttypes.NewHeight(0, 10)
+
+// TestMsgTransferRoute tests Route for MsgTransfer
+func TestMsgTransferRoute(t *testing.T) {
+	msg := NewMsgTransfer(validPort, validChannel, "kitty", []string{tokenID}, addr1, addr2, timeoutHeight, 0, "")
+
+	require.Equal(t, RouterKey, msg.Route())
+}
+
+// TestMsgTransferValidation tests ValidateBasic for MsgTransfer
+func TestMsgTransferValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *MsgTransfer
+		expPass bool
+	}{
+		{"valid msg with base class id", NewMsgTransfer(validPort, validChannel, "kitty", []string{tokenID}, addr1, addr2, timeoutHeight, 0, ""), true},
+		{"valid msg with voucher class id", NewMsgTransfer(validPort, validChannel, ibcClassID, []string{tokenID, "kitty-2"}, addr1, addr2, timeoutHeight, 0, ""), true},
+		{"valid msg with memo", NewMsgTransfer(validPort, validChannel, "kitty", []string{tokenID}, addr1, addr2, timeoutHeight, 0, "memo"), true},
+		{"invalid port id", NewMsgTransfer(invalidPort, validChannel, "kitty", []string{tokenID}, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"invalid channel id", NewMsgTransfer(validPort, invalidChannel, "kitty", []string{tokenID}, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"empty class id", NewMsgTransfer(validPort, validChannel, "", []string{tokenID}, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"invalid voucher class id", NewMsgTransfer(validPort, validChannel, invalidIBCClassID, []string{tokenID}, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"empty token ids", NewMsgTransfer(validPort, validChannel, "kitty", nil, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"duplicated token ids", NewMsgTransfer(validPort, validChannel, "kitty", []string{tokenID, tokenID}, addr1, addr2, timeoutHeight, 0, ""), false},
+		{"missing sender address", NewMsgTransfer(validPort, validChannel, "kitty", []string{tokenID}, emptyAddr, addr2, timeoutHeight, 0, ""), false},
+		{"missing recipient address", NewMsgTransfer(validPort, validChannel, "kitty", []string{tokenID}, addr1, "", timeoutHeight, 0, ""), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgTransferGetSigners tests GetSigners for MsgTransfer
+func TestMsgTransferGetSigners(t *testing.T) {
+	msg := NewMsgTransfer(validPort, validChannel, "kitty", []string{tokenID}, addr1, addr2, timeoutHeight, 0, "")
+
+	require.Equal(t, addr1, msg.GetSigners()[0].String())
+}
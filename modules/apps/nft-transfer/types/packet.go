@@ -0,0 +1,159 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+var (
+	_ ibcexported.PacketData         = (*NonFungibleTokenPacketData)(nil)
+	_ ibcexported.PacketDataProvider = (*NonFungibleTokenPacketData)(nil)
+)
+
+var (
+	// DefaultRelativePacketTimeoutHeight is the default packet timeout height (in blocks) relative
+	// to the current block height of the counterparty chain provided by the client state. The
+	// timeout is disabled when set to 0.
+	DefaultRelativePacketTimeoutHeight = "0-1000"
+
+	// DefaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
+	// relative to the current block timestamp of the counterparty chain provided by the client
+	// state. The timeout is disabled when set to 0. The default is currently set to a 10 minute
+	// timeout.
+	DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+)
+
+// MaximumTokenIDsLength is the maximum number of non-fungible tokens which can be transferred in
+// a single packet.
+const MaximumTokenIDsLength = 100
+
+// NewNonFungibleTokenPacketData contructs a new NonFungibleTokenPacketData instance
+func NewNonFungibleTokenPacketData(
+	classID, classURI, classData string,
+	tokenIDs, tokenURIs, tokenData []string,
+	sender, receiver string,
+	memo string,
+) NonFungibleTokenPacketData {
+	return NonFungibleTokenPacketData{
+		ClassId:   classID,
+		ClassUri:  classURI,
+		ClassData: classData,
+		TokenIds:  tokenIDs,
+		TokenUris: tokenURIs,
+		TokenData: tokenData,
+		Sender:    sender,
+		Receiver:  receiver,
+		Memo:      memo,
+	}
+}
+
+// ValidateBasic is used for validating the non-fungible token transfer. The token uris and
+// token data must either be empty or provide a value for each token id.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (nftpd NonFungibleTokenPacketData) ValidateBasic() error {
+	if err := ValidatePrefixedClassID(nftpd.ClassId); err != nil {
+		return err
+	}
+	if err := ValidateTokenIDs(nftpd.TokenIds); err != nil {
+		return err
+	}
+	if len(nftpd.TokenUris) != 0 && len(nftpd.TokenUris) != len(nftpd.TokenIds) {
+		return sdkerrors.Wrapf(ErrInvalidPacketData, "expected %d token uris, got %d", len(nftpd.TokenIds), len(nftpd.TokenUris))
+	}
+	if len(nftpd.TokenData) != 0 && len(nftpd.TokenData) != len(nftpd.TokenIds) {
+		return sdkerrors.Wrapf(ErrInvalidPacketData, "expected %d token data, got %d", len(nftpd.TokenIds), len(nftpd.TokenData))
+	}
+	if strings.TrimSpace(nftpd.Sender) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(nftpd.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	return nil
+}
+
+// TokenURIAt returns the uri of the token at the given position or an empty string
+// if no token uri is provided for that position.
+func (nftpd NonFungibleTokenPacketData) TokenURIAt(i int) string {
+	if i < 0 || i >= len(nftpd.TokenUris) {
+		return ""
+	}
+	return nftpd.TokenUris[i]
+}
+
+// TokenDataAt returns the data of the token at the given position or an empty string
+// if no token data is provided for that position.
+func (nftpd NonFungibleTokenPacketData) TokenDataAt(i int) string {
+	if i < 0 || i >= len(nftpd.TokenData) {
+		return ""
+	}
+	return nftpd.TokenData[i]
+}
+
+// GetBytes is a helper for serialising. Empty fields, such as an unset memo, are omitted.
+func (nftpd NonFungibleTokenPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(mustProtoMarshalJSON(&nftpd))
+}
+
+// GetPacketSender returns the sender address embedded in the packet data.
+//
+// NOTE:
+//   - The sender address is set by the module which requested the packet to be sent,
+//     and this module may not have validated the sender address by a signature check.
+//   - The sender address must only be used by modules on the sending chain.
+//   - sourcePortID is not used in this implementation.
+func (nftpd NonFungibleTokenPacketData) GetPacketSender(sourcePortID string) string {
+	return nftpd.Sender
+}
+
+// GetCustomPacketData interprets the memo field of the packet data as a JSON object
+// and returns the value associated with the given key.
+// If the key is missing or the memo is not properly formatted, then nil is returned.
+func (nftpd NonFungibleTokenPacketData) GetCustomPacketData(key string) interface{} {
+	if len(nftpd.Memo) == 0 {
+		return nil
+	}
+
+	jsonObject := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(nftpd.Memo), &jsonObject); err != nil {
+		return nil
+	}
+
+	memoData, found := jsonObject[key]
+	if !found {
+		return nil
+	}
+
+	return memoData
+}
+
+// ValidateTokenIDs validates that at least one and at most MaximumTokenIDsLength token ids
+// are provided and that the token ids are neither blank nor duplicated.
+func ValidateTokenIDs(tokenIDs []string) error {
+	if len(tokenIDs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTokenID, "token ids cannot be empty")
+	}
+	if len(tokenIDs) > MaximumTokenIDsLength {
+		return sdkerrors.Wrapf(ErrInvalidTokenID, "number of token ids must not exceed %d, got %d", MaximumTokenIDsLength, len(tokenIDs))
+	}
+
+	seenTokenIDs := make(map[string]bool)
+	for i, tokenID := range tokenIDs {
+		if strings.TrimSpace(tokenID) == "" {
+			return sdkerrors.Wrapf(ErrInvalidTokenID, "token id at position %d cannot be blank", i)
+		}
+		if seenTokenIDs[tokenID] {
+			return sdkerrors.Wrapf(ErrInvalidTokenID, "duplicated token id %s", tokenID)
+		}
+		seenTokenIDs[tokenID] = true
+	}
+
+	return nil
+}
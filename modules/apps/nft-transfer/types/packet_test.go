@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	classID  = "nft-transfer/channelToA/kitty"
+	classURI = "https://kitty.example/class"
+	tokenID  = "kitty-1"
+)
+
+var (
+	addr1     = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	addr2     = sdk.AccAddress("testaddr2").String()
+	emptyAddr string
+)
+
+// TestNonFungibleTokenPacketDataValidateBasic tests ValidateBasic for NonFungibleTokenPacketData
+func TestNonFungibleTokenPacketDataValidateBasic(t *testing.T) {
+	tooManyTokenIDs := make([]string, MaximumTokenIDsLength+1)
+	for i := range tooManyTokenIDs {
+		tooManyTokenIDs[i] = fmt.Sprintf("kitty-%d", i)
+	}
+
+	testCases := []struct {
+		name       string
+		packetData NonFungibleTokenPacketData
+		expPass    bool
+	}{
+		{"valid packet", NewNonFungibleTokenPacketData(classID, classURI, "", []string{tokenID}, nil, nil, addr1, addr2, ""), true},
+		{"valid packet with token uris and data", NewNonFungibleTokenPacketData(classID, classURI, "", []string{tokenID, "kitty-2"}, []string{"uri-1", "uri-2"}, []string{"data-1", "data-2"}, addr1, addr2, ""), true},
+		{"invalid class id", NewNonFungibleTokenPacketData("", classURI, "", []string{tokenID}, nil, nil, addr1, addr2, ""), false},
+		{"missing token ids", NewNonFungibleTokenPacketData(classID, classURI, "", nil, nil, nil, addr1, addr2, ""), false},
+		{"blank token id", NewNonFungibleTokenPacketData(classID, classURI, "", []string{" "}, nil, nil, addr1, addr2, ""), false},
+		{"duplicated token ids", NewNonFungibleTokenPacketData(classID, classURI, "", []string{tokenID, tokenID}, nil, nil, addr1, addr2, ""), false},
+		{"too many token ids", NewNonFungibleTokenPacketData(classID, classURI, "", tooManyTokenIDs, nil, nil, addr1, addr2, ""), false},
+		{"token uris length mismatch", NewNonFungibleTokenPacketData(classID, classURI, "", []string{tokenID}, []string{"uri-1", "uri-2"}, nil, addr1, addr2, ""), false},
+		{"token data length mismatch", NewNonFungibleTokenPacketData(classID, classURI, "", []string{tokenID}, nil, []string{"data-1", "data-2"}, addr1, addr2, ""), false},
+		{"missing sender address", NewNonFungibleTokenPacketData(classID, classURI, "", []string{tokenID}, nil, nil, emptyAddr, addr2, ""), false},
+		{"missing recipient address", NewNonFungibleTokenPacketData(classID, classURI, "", []string{tokenID}, nil, nil, addr1, emptyAddr, ""), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestNonFungibleTokenPacketDataTokenAt(t *testing.T) {
+	packetData := NewNonFungibleTokenPacketData(classID, classURI, "", []string{tokenID, "kitty-2"}, []string{"uri-1", "uri-2"}, nil, addr1, addr2, "")
+
+	require.Equal(t, "uri-2", packetData.TokenURIAt(1))
+	require.Equal(t, "", packetData.TokenURIAt(2))
+	require.Equal(t, "", packetData.TokenDataAt(0))
+}
+
+func TestNonFungibleTokenPacketDataGetBytes(t *testing.T) {
+	packetData := NewNonFungibleTokenPacketData(classID, classURI, "", []string{tokenID}, nil, nil, addr1, addr2, "")
+	expected := fmt.Sprintf(`{"classId":"%s","classUri":"%s","receiver":"%s","sender":"%s","tokenIds":["%s"]}`, classID, classURI, addr2, addr1, tokenID)
+
+	require.Equal(t, expected, string(packetData.GetBytes()))
+}
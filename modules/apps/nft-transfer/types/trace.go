@@ -0,0 +1,234 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// ParseClassTrace parses a string with the ibc prefix (class trace) and the base class id
+// into a ClassTrace type.
+//
+// Examples:
+//
+// - "nft-transfer/channelidone/kitty" => ClassTrace{Path: "nft-transfer/channelidone", BaseClassId: "kitty"}
+// - "nft-transfer/channelidone/nft-transfer/channelidtwo/kitty" => ClassTrace{Path: "nft-transfer/channelidone/nft-transfer/channelidtwo", BaseClassId: "kitty"}
+// - "nft-transfer/channelidone/collections/kitty" => ClassTrace{Path: "nft-transfer/channelidone", BaseClassId: "collections/kitty"}
+// - "kitty" => ClassTrace{Path: "", BaseClassId: "kitty"}
+func ParseClassTrace(rawClassID string) ClassTrace {
+	classSplit := strings.Split(rawClassID, "/")
+
+	if classSplit[0] == rawClassID {
+		return ClassTrace{
+			Path:        "",
+			BaseClassId: rawClassID,
+		}
+	}
+
+	path, baseClassID := extractPathAndBaseFromFullClassID(classSplit)
+	return ClassTrace{
+		Path:        path,
+		BaseClassId: baseClassID,
+	}
+}
+
+// Hash returns the hex bytes of the SHA256 hash of the ClassTrace fields using the following formula:
+//
+// hash = sha256(tracePath + "/" + baseClassId)
+func (ct ClassTrace) Hash() tmbytes.HexBytes {
+	hash := sha256.Sum256([]byte(ct.GetFullClassPath()))
+	return hash[:]
+}
+
+// GetPrefix returns the receiving class prefix composed by the trace info and a separator.
+func (ct ClassTrace) GetPrefix() string {
+	return ct.Path + "/"
+}
+
+// IBCClassID returns the class id of an ICS721 voucher class in the format
+// 'ibc/{hash(tracePath + baseClassId)}'. If the trace is empty, it will return the base class id.
+func (ct ClassTrace) IBCClassID() string {
+	if ct.Path != "" {
+		return fmt.Sprintf("%s/%s", ClassPrefix, ct.Hash())
+	}
+	return ct.BaseClassId
+}
+
+// GetFullClassPath returns the full class path according to the ICS721 specification:
+// tracePath + "/" + baseClassId
+// If there exists no trace then the base class id is returned.
+func (ct ClassTrace) GetFullClassPath() string {
+	if ct.Path == "" {
+		return ct.BaseClassId
+	}
+	return ct.GetPrefix() + ct.BaseClassId
+}
+
+// Validate performs a basic validation of the ClassTrace fields.
+func (ct ClassTrace) Validate() error {
+	// empty trace is accepted when the class lives on the original chain
+	switch {
+	case ct.Path == "" && ct.BaseClassId != "":
+		return nil
+	case strings.TrimSpace(ct.BaseClassId) == "":
+		return fmt.Errorf("base class id cannot be blank")
+	}
+
+	identifiers := strings.Split(ct.Path, "/")
+	return validateTraceIdentifiers(identifiers)
+}
+
+// extractPathAndBaseFromFullClassID returns the trace path and the base class id from
+// the elements that constitute the complete class id.
+func extractPathAndBaseFromFullClassID(fullClassIDItems []string) (string, string) {
+	var path []string
+	var baseClassID []string
+	length := len(fullClassIDItems)
+	for i := 0; i < length; i = i + 2 {
+		if i < length-1 && length > 2 && fullClassIDItems[i] == PortID {
+			path = append(path, fullClassIDItems[i], fullClassIDItems[i+1])
+		} else {
+			baseClassID = fullClassIDItems[i:]
+			break
+		}
+	}
+
+	return strings.Join(path, "/"), strings.Join(baseClassID, "/")
+}
+
+func validateTraceIdentifiers(identifiers []string) error {
+	if len(identifiers) == 0 || len(identifiers)%2 != 0 {
+		return fmt.Errorf("trace info must come in pairs of port and channel identifiers '{portID}/{channelID}', got the identifiers: %s", identifiers)
+	}
+
+	// validate correctness of port and channel identifiers
+	for i := 0; i < len(identifiers); i += 2 {
+		if err := host.PortIdentifierValidator(identifiers[i]); err != nil {
+			return sdkerrors.Wrapf(err, "invalid port ID at position %d", i)
+		}
+		if err := host.ChannelIdentifierValidator(identifiers[i+1]); err != nil {
+			return sdkerrors.Wrapf(err, "invalid channel ID at position %d", i)
+		}
+	}
+	return nil
+}
+
+// Traces defines a wrapper type for a slice of ClassTrace.
+type Traces []ClassTrace
+
+// Validate performs a basic validation of each class trace info.
+func (t Traces) Validate() error {
+	seenTraces := make(map[string]bool)
+	for i, trace := range t {
+		hash := trace.Hash().String()
+		if seenTraces[hash] {
+			return fmt.Errorf("duplicated class trace with hash %s", trace.Hash())
+		}
+
+		if err := trace.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "failed class trace %d validation", i)
+		}
+		seenTraces[hash] = true
+	}
+	return nil
+}
+
+var _ sort.Interface = Traces{}
+
+// Len implements sort.Interface for Traces
+func (t Traces) Len() int { return len(t) }
+
+// Less implements sort.Interface for Traces
+func (t Traces) Less(i, j int) bool { return t[i].GetFullClassPath() < t[j].GetFullClassPath() }
+
+// Swap implements sort.Interface for Traces
+func (t Traces) Swap(i, j int) { t[i], t[j] = t[j], t[i] }
+
+// Sort is a helper function to sort the set of class traces in-place
+func (t Traces) Sort() Traces {
+	sort.Sort(t)
+	return t
+}
+
+// ValidatePrefixedClassID checks that the class id of an IBC non-fungible token packet is correctly prefixed.
+// The function will return no error if the given string follows one of the two formats:
+//
+//   - Prefixed class id: 'nft-transfer/{channelIDN}/.../nft-transfer/{channelID0}/baseClassId'
+//   - Unprefixed class id: 'baseClassId'
+//
+// 'baseClassId' may or may not contain '/'s
+func ValidatePrefixedClassID(classID string) error {
+	classSplit := strings.Split(classID, "/")
+	if classSplit[0] == classID && strings.TrimSpace(classID) != "" {
+		return nil
+	}
+
+	if strings.TrimSpace(classSplit[len(classSplit)-1]) == "" {
+		return sdkerrors.Wrap(ErrInvalidClassID, "base class id cannot be blank")
+	}
+
+	path, _ := extractPathAndBaseFromFullClassID(classSplit)
+	if path == "" {
+		// NOTE: base class id contains slashes, so no base class id validation
+		return nil
+	}
+
+	identifiers := strings.Split(path, "/")
+	return validateTraceIdentifiers(identifiers)
+}
+
+// ValidateIBCClassID validates that the given class id is either:
+//
+//   - A valid base class id (eg: 'kitty' or 'collections/kitty')
+//   - A valid non-fungible token class representation (i.e 'ibc/{hash}')
+func ValidateIBCClassID(classID string) error {
+	if strings.TrimSpace(classID) == "" {
+		return sdkerrors.Wrap(ErrInvalidClassID, "class id cannot be blank")
+	}
+
+	classSplit := strings.SplitN(classID, "/", 2)
+
+	switch {
+	case classID == ClassPrefix:
+		return sdkerrors.Wrapf(ErrInvalidClassID, "class id should be prefixed with the format 'ibc/{hash(trace + \"/\" + %s)}'", classID)
+
+	case len(classSplit) == 2 && classSplit[0] == ClassPrefix:
+		if strings.TrimSpace(classSplit[1]) == "" {
+			return sdkerrors.Wrapf(ErrInvalidClassID, "class id should be prefixed with the format 'ibc/{hash(trace + \"/\" + %s)}'", classID)
+		}
+
+		if _, err := transfertypes.ParseHexHash(classSplit[1]); err != nil {
+			return sdkerrors.Wrapf(err, "invalid class trace hash %s", classSplit[1])
+		}
+	}
+
+	return nil
+}
+
+// SenderChainIsSource returns false if the class originally came
+// from the receiving chain and true otherwise.
+func SenderChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	return !ReceiverChainIsSource(sourcePort, sourceChannel, classID)
+}
+
+// ReceiverChainIsSource returns true if the class originally came
+// from the receiving chain and false otherwise.
+func ReceiverChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	// If the receiver chain originally sent the class to the sender chain
+	// the class id will have the sender's SourcePort and SourceChannel as the
+	// prefix.
+	voucherPrefix := GetClassPrefix(sourcePort, sourceChannel)
+	return strings.HasPrefix(classID, voucherPrefix)
+}
+
+// GetClassPrefix returns the receiving class prefix
+func GetClassPrefix(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/", portID, channelID)
+}
@@ -0,0 +1,150 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClassTrace(t *testing.T) {
+	testCases := []struct {
+		name     string
+		classID  string
+		expTrace ClassTrace
+	}{
+		{"empty class id", "", ClassTrace{}},
+		{"base class id", "kitty", ClassTrace{BaseClassId: "kitty"}},
+		{"base class id with single '/'s", "collections/kitty", ClassTrace{BaseClassId: "collections/kitty"}},
+		{"trace info", "nft-transfer/channelToA/kitty", ClassTrace{BaseClassId: "kitty", Path: "nft-transfer/channelToA"}},
+		{"trace info with single '/' in base class id", "nft-transfer/channelToA/collections/kitty", ClassTrace{BaseClassId: "collections/kitty", Path: "nft-transfer/channelToA"}},
+		{"trace info with multiple port/channel pairs", "nft-transfer/channelToA/nft-transfer/channelToB/kitty", ClassTrace{BaseClassId: "kitty", Path: "nft-transfer/channelToA/nft-transfer/channelToB"}},
+		{"incomplete path", "nft-transfer/kitty", ClassTrace{BaseClassId: "nft-transfer/kitty"}},
+		{"fungible transfer port is not a trace", "transfer/channelToA/kitty", ClassTrace{BaseClassId: "transfer/channelToA/kitty"}},
+	}
+
+	for _, tc := range testCases {
+		trace := ParseClassTrace(tc.classID)
+		require.Equal(t, tc.expTrace, trace, tc.name)
+	}
+}
+
+func TestClassTrace_IBCClassID(t *testing.T) {
+	trace := ClassTrace{BaseClassId: "kitty"}
+	require.Equal(t, "kitty", trace.IBCClassID())
+
+	trace = ClassTrace{BaseClassId: "kitty", Path: "nft-transfer/channelToA"}
+	require.Equal(t, "ibc/"+trace.Hash().String(), trace.IBCClassID())
+	require.Equal(t, "nft-transfer/channelToA/kitty", trace.GetFullClassPath())
+	require.Equal(t, trace, ParseClassTrace(trace.GetFullClassPath()))
+}
+
+func TestClassTrace_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		trace    ClassTrace
+		expError bool
+	}{
+		{"base class id only", ClassTrace{BaseClassId: "kitty"}, false},
+		{"empty ClassTrace", ClassTrace{}, true},
+		{"valid single trace info", ClassTrace{BaseClassId: "kitty", Path: "nft-transfer/channelToA"}, false},
+		{"valid multiple trace info", ClassTrace{BaseClassId: "kitty", Path: "nft-transfer/channelToA/nft-transfer/channelToB"}, false},
+		{"single trace identifier", ClassTrace{BaseClassId: "kitty", Path: "nft-transfer"}, true},
+		{"invalid port ID", ClassTrace{BaseClassId: "kitty", Path: "(nft-transfer)/channelToA"}, true},
+		{"invalid channel ID", ClassTrace{BaseClassId: "kitty", Path: "nft-transfer/(channelToA)"}, true},
+		{"empty base class id with trace", ClassTrace{BaseClassId: "", Path: "nft-transfer/channelToA"}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.trace.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+	}
+}
+
+func TestTraces_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		traces   Traces
+		expError bool
+	}{
+		{"empty Traces", Traces{}, false},
+		{"valid multiple trace info", Traces{{BaseClassId: "kitty", Path: "nft-transfer/channelToA/nft-transfer/channelToB"}}, false},
+		{
+			"valid multiple trace info",
+			Traces{
+				{BaseClassId: "kitty", Path: "nft-transfer/channelToA/nft-transfer/channelToB"},
+				{BaseClassId: "kitty", Path: "nft-transfer/channelToA/nft-transfer/channelToB"},
+			},
+			true,
+		},
+		{"empty ClassTrace", Traces{{}}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.traces.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+	}
+}
+
+func TestValidatePrefixedClassID(t *testing.T) {
+	testCases := []struct {
+		name     string
+		classID  string
+		expError bool
+	}{
+		{"prefixed class id", "nft-transfer/channelToA/kitty", false},
+		{"base class id", "kitty", false},
+		{"base class id with slashes", "collections/kitty", false},
+		{"empty class id", "", true},
+		{"empty base class id with trace", "nft-transfer/channelToA/", true},
+		{"single trace identifier", "nft-transfer/", true},
+	}
+
+	for _, tc := range testCases {
+		err := ValidatePrefixedClassID(tc.classID)
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+	}
+}
+
+func TestValidateIBCClassID(t *testing.T) {
+	testCases := []struct {
+		name     string
+		classID  string
+		expError bool
+	}{
+		{"base class id", "kitty", false},
+		{"base class id with slashes", "collections/kitty", false},
+		{"valid voucher class id", "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", false},
+		{"invalid hash", "ibc/7F1D3FCF4AE79E1554", true},
+		{"empty class id", "", true},
+		{"prefix only", "ibc", true},
+		{"prefix with blank hash", "ibc/", true},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateIBCClassID(tc.classID)
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+	}
+}
+
+func TestReceiverChainIsSource(t *testing.T) {
+	require.True(t, ReceiverChainIsSource(PortID, "channel-0", "nft-transfer/channel-0/kitty"))
+	require.False(t, ReceiverChainIsSource(PortID, "channel-0", "kitty"))
+	require.False(t, ReceiverChainIsSource(PortID, "channel-0", "nft-transfer/channel-01/kitty"))
+	require.True(t, SenderChainIsSource(PortID, "channel-0", "nft-transfer/channel-1/kitty"))
+}
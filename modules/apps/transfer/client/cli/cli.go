@@ -18,9 +18,14 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryDenomTrace(),
 		GetCmdQueryDenomTraces(),
+		GetCmdQueryDenomTracesByBaseDenom(),
+		GetCmdQueryDenomTracesByFirstHop(),
+		GetCmdQueryDenomTracesByHop(),
 		GetCmdParams(),
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
+		GetCmdQueryChannelTransferPolicy(),
+		GetCmdQueryChannelTransferPolicies(),
 	)
 
 	return queryCmd
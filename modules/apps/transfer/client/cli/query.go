@@ -82,6 +82,128 @@ func GetCmdQueryDenomTraces() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryDenomTracesByBaseDenom defines the command to query the denomination traces with a
+// given base denomination.
+func GetCmdQueryDenomTracesByBaseDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-traces-by-base-denom [base-denom]",
+		Short:   "Query the trace info for all token denominations with a given base denomination",
+		Long:    "Query the trace info for all token denominations with a given base denomination",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-traces-by-base-denom uusdc", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomTracesByBaseDenomRequest{
+				BaseDenom:  args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomTracesByBaseDenom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations trace by base denom")
+
+	return cmd
+}
+
+// GetCmdQueryDenomTracesByFirstHop defines the command to query the denomination traces whose first
+// hop is a given port and channel.
+func GetCmdQueryDenomTracesByFirstHop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-traces-by-first-hop [port-id] [channel-id]",
+		Short:   "Query the trace info for all token denominations received over a given channel",
+		Long:    "Query the trace info for all token denominations whose first hop is the given port and channel, i.e. all tokens received over the channel",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-traces-by-first-hop transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomTracesByFirstHopRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomTracesByFirstHop(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations trace by first hop")
+
+	return cmd
+}
+
+// GetCmdQueryDenomTracesByHop defines the command to query the denomination traces whose path
+// crosses a given port and channel.
+func GetCmdQueryDenomTracesByHop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-traces-by-hop [port-id] [channel-id]",
+		Short:   "Query the trace info for all token denominations whose path crosses a given channel",
+		Long:    "Query the trace info for all token denominations containing the given port and channel as any hop of their trace",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-traces-by-hop transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomTracesByHopRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomTracesByHop(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations trace by hop")
+
+	return cmd
+}
+
 // GetCmdParams returns the command handler for ibc-transfer parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -167,3 +289,64 @@ func GetCmdQueryDenomHash() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryChannelTransferPolicy defines the command to query the transfer policy of a channel.
+func GetCmdQueryChannelTransferPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-policy [port-id] [channel-id]",
+		Short:   "Query the transfer policy of a channel",
+		Long:    "Query the transfer policy of a channel",
+		Example: fmt.Sprintf("%s query ibc-transfer transfer-policy transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelTransferPolicyRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.ChannelTransferPolicy(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryChannelTransferPolicies defines the command to query the transfer policies of all channels.
+func GetCmdQueryChannelTransferPolicies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-policies",
+		Short:   "Query the transfer policies of all channels",
+		Long:    "Query the transfer policies of all channels",
+		Example: fmt.Sprintf("%s query ibc-transfer transfer-policies", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChannelTransferPolicies(cmd.Context(), &types.QueryChannelTransferPoliciesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
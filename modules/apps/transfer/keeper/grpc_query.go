@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -72,6 +73,110 @@ func (q Keeper) DenomTraces(c context.Context, req *types.QueryDenomTracesReques
 	}, nil
 }
 
+// DenomTracesByBaseDenom implements the Query/DenomTracesByBaseDenom gRPC method
+func (q Keeper) DenomTracesByBaseDenom(c context.Context, req *types.QueryDenomTracesByBaseDenomRequest) (*types.QueryDenomTracesByBaseDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.BaseDenom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "base denomination cannot be blank")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	traces, pageRes, err := q.paginateDenomTraceIndex(ctx, types.DenomTraceBaseDenomIndexPrefix(req.BaseDenom), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomTracesByBaseDenomResponse{
+		DenomTraces: traces,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// DenomTracesByFirstHop implements the Query/DenomTracesByFirstHop gRPC method
+func (q Keeper) DenomTracesByFirstHop(c context.Context, req *types.QueryDenomTracesByFirstHopRequest) (*types.QueryDenomTracesByFirstHopResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validatePortAndChannel(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	traces, pageRes, err := q.paginateDenomTraceIndex(ctx, types.DenomTraceFirstHopIndexPrefix(req.PortId, req.ChannelId), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomTracesByFirstHopResponse{
+		DenomTraces: traces,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// DenomTracesByHop implements the Query/DenomTracesByHop gRPC method
+func (q Keeper) DenomTracesByHop(c context.Context, req *types.QueryDenomTracesByHopRequest) (*types.QueryDenomTracesByHopResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validatePortAndChannel(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	traces, pageRes, err := q.paginateDenomTraceIndex(ctx, types.DenomTraceHopIndexPrefix(req.PortId, req.ChannelId), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomTracesByHopResponse{
+		DenomTraces: traces,
+		Pagination:  pageRes,
+	}, nil
+}
+
+// paginateDenomTraceIndex paginates over the denomination trace hashes indexed under the given
+// key prefix and returns the corresponding denomination traces.
+func (q Keeper) paginateDenomTraceIndex(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) (types.Traces, *query.PageResponse, error) {
+	traces := types.Traces{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), indexPrefix)
+
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		denomTrace, found := q.GetDenomTrace(ctx, value)
+		if !found {
+			return status.Error(codes.NotFound, sdkerrors.Wrapf(types.ErrTraceNotFound, "indexed denomination trace %X", value).Error())
+		}
+
+		traces = append(traces, denomTrace)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return traces.Sort(), pageRes, nil
+}
+
+// validatePortAndChannel validates the port and channel identifiers of a query request.
+func validatePortAndChannel(portID, channelID string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return nil
+}
+
 // Params implements the Query/Params gRPC method
 func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -121,3 +226,36 @@ func (q Keeper) EscrowAddress(c context.Context, req *types.QueryEscrowAddressRe
 		EscrowAddress: addr.String(),
 	}, nil
 }
+
+// ChannelTransferPolicy implements the Query/ChannelTransferPolicy gRPC method
+func (q Keeper) ChannelTransferPolicy(c context.Context, req *types.QueryChannelTransferPolicyRequest) (*types.QueryChannelTransferPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validatePortAndChannel(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	policy, found := q.GetChannelTransferPolicy(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			fmt.Sprintf("transfer policy not found for port ID (%s) channel ID (%s)", req.PortId, req.ChannelId),
+		)
+	}
+
+	return &types.QueryChannelTransferPolicyResponse{
+		Policy: policy,
+	}, nil
+}
+
+// ChannelTransferPolicies implements the Query/ChannelTransferPolicies gRPC method
+func (q Keeper) ChannelTransferPolicies(c context.Context, _ *types.QueryChannelTransferPoliciesRequest) (*types.QueryChannelTransferPoliciesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryChannelTransferPoliciesResponse{
+		Policies: q.GetChannelPolicies(ctx),
+	}, nil
+}
@@ -263,3 +263,350 @@ func (suite *KeeperTestSuite) TestEscrowAddress() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryChannelTransferPolicy() {
+	var (
+		req       *types.QueryChannelTransferPolicyRequest
+		expPolicy = types.NewChannelTransferPolicy(ibctesting.TransferPort, ibctesting.FirstChannelID, true, false, []string{"uusdc"}, nil)
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"transfer policy not found",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryChannelTransferPolicyRequest{
+				PortId:    ibctesting.TransferPort,
+				ChannelId: ibctesting.FirstChannelID,
+			}
+			suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, expPolicy))
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.ChannelTransferPolicy(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expPolicy, res.Policy)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelTransferPolicies() {
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	res, err := suite.queryClient.ChannelTransferPolicies(ctx, &types.QueryChannelTransferPoliciesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Policies)
+
+	expPolicies := []types.ChannelTransferPolicy{
+		types.NewChannelTransferPolicy(ibctesting.TransferPort, ibctesting.FirstChannelID, true, false, nil, []string{"stake"}),
+		types.NewChannelTransferPolicy(ibctesting.TransferPort, "channel-1", false, true, []string{"uusdc"}, nil),
+	}
+	suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, expPolicies...))
+
+	ctx = sdk.WrapSDKContext(suite.chainA.GetContext())
+	res, err = suite.queryClient.ChannelTransferPolicies(ctx, &types.QueryChannelTransferPoliciesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expPolicies, res.Policies)
+}
+
+var (
+	usdcTrace          = types.DenomTrace{Path: "", BaseDenom: "uusdc"}
+	usdcFromATrace     = types.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uusdc"}
+	usdcFromBTrace     = types.DenomTrace{Path: "transfer/channel-1/transfer/channel-0", BaseDenom: "uusdc"}
+	atomFromBTrace     = types.DenomTrace{Path: "transfer/channel-1", BaseDenom: "uatom"}
+	poolFromATrace     = types.DenomTrace{Path: "transfer/channel-0", BaseDenom: "gamm/pool/1"}
+	gammFromATrace     = types.DenomTrace{Path: "transfer/channel-0", BaseDenom: "gamm"}
+	indexedDenomTraces = types.Traces{usdcTrace, usdcFromATrace, usdcFromBTrace, atomFromBTrace, poolFromATrace, gammFromATrace}
+)
+
+func (suite *KeeperTestSuite) TestQueryDenomTracesByBaseDenom() {
+	var (
+		req       *types.QueryDenomTracesByBaseDenomRequest
+		expTraces types.Traces
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				expTraces = types.Traces{usdcTrace, usdcFromATrace, usdcFromBTrace}
+			},
+			true,
+		},
+		{
+			"success: base denom which is a prefix of another base denom",
+			func() {
+				req.BaseDenom = "gamm"
+				expTraces = types.Traces{gammFromATrace}
+			},
+			true,
+		},
+		{
+			"success: base denom with slashes",
+			func() {
+				req.BaseDenom = "gamm/pool/1"
+				expTraces = types.Traces{poolFromATrace}
+			},
+			true,
+		},
+		{
+			"success: paginated",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 1}
+				expTraces = types.Traces{usdcTrace, usdcFromATrace, usdcFromBTrace}
+
+				res, err := suite.queryClient.DenomTracesByBaseDenom(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+				suite.Require().NoError(err)
+				suite.Require().Len(res.DenomTraces, 1)
+				suite.Require().NotNil(res.Pagination.NextKey)
+			},
+			true,
+		},
+		{
+			"success: no denom traces",
+			func() {
+				req.BaseDenom = "stake"
+				expTraces = nil
+			},
+			true,
+		},
+		{
+			"blank base denom",
+			func() {
+				req.BaseDenom = " "
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			for _, trace := range indexedDenomTraces {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), trace)
+			}
+
+			req = &types.QueryDenomTracesByBaseDenomRequest{
+				BaseDenom: "uusdc",
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.DenomTracesByBaseDenom(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				if req.Pagination != nil {
+					suite.Require().Subset(expTraces, res.DenomTraces)
+				} else {
+					suite.Require().Equal(expTraces.Sort(), res.DenomTraces)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomTracesByFirstHop() {
+	var (
+		req       *types.QueryDenomTracesByFirstHopRequest
+		expTraces types.Traces
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				expTraces = types.Traces{usdcFromATrace, poolFromATrace, gammFromATrace}
+			},
+			true,
+		},
+		{
+			"success: multi-hop denom trace",
+			func() {
+				req.ChannelId = "channel-1"
+				expTraces = types.Traces{usdcFromBTrace, atomFromBTrace}
+			},
+			true,
+		},
+		{
+			"success: no denom traces",
+			func() {
+				req.ChannelId = "channel-2"
+				expTraces = nil
+			},
+			true,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			for _, trace := range indexedDenomTraces {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), trace)
+			}
+
+			req = &types.QueryDenomTracesByFirstHopRequest{
+				PortId:    ibctesting.TransferPort,
+				ChannelId: "channel-0",
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.DenomTracesByFirstHop(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expTraces.Sort(), res.DenomTraces)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomTracesByHop() {
+	var (
+		req       *types.QueryDenomTracesByHopRequest
+		expTraces types.Traces
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				expTraces = types.Traces{usdcFromATrace, usdcFromBTrace, poolFromATrace, gammFromATrace}
+			},
+			true,
+		},
+		{
+			"success: first hop only",
+			func() {
+				req.ChannelId = "channel-1"
+				expTraces = types.Traces{usdcFromBTrace, atomFromBTrace}
+			},
+			true,
+		},
+		{
+			"success: no denom traces",
+			func() {
+				req.ChannelId = "channel-2"
+				expTraces = nil
+			},
+			true,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			for _, trace := range indexedDenomTraces {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), trace)
+			}
+
+			req = &types.QueryDenomTracesByHopRequest{
+				PortId:    ibctesting.TransferPort,
+				ChannelId: "channel-0",
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.DenomTracesByHop(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expTraces.Sort(), res.DenomTraces)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// afterRecvTransfer calls the AfterRecvTransfer hook if transfer hooks are set.
+func (k Keeper) afterRecvTransfer(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, localDenom string) error {
+	if k.hooks == nil {
+		return nil
+	}
+
+	return k.hooks.AfterRecvTransfer(ctx, packet, data, localDenom)
+}
+
+// afterAckTransfer calls the AfterAckTransfer hook if transfer hooks are set. The hook is run in a
+// cached context which is only written if the hook succeeds, an error is logged rather than returned
+// such that a failing hook cannot block the refund of the sender.
+func (k Keeper) afterAckTransfer(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) {
+	if k.hooks == nil {
+		return
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.hooks.AfterAckTransfer(cacheCtx, packet, data, types.ParseDenomTrace(data.Denom).IBCDenom(), ack); err != nil {
+		k.Logger(ctx).Error("transfer acknowledgement hook failed", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence, "error", err.Error())
+		return
+	}
+
+	writeFn()
+
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// afterTimeoutTransfer calls the AfterTimeoutTransfer hook if transfer hooks are set. The hook is run
+// in a cached context which is only written if the hook succeeds, an error is logged rather than
+// returned such that a failing hook cannot block the refund of the sender.
+func (k Keeper) afterTimeoutTransfer(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) {
+	if k.hooks == nil {
+		return
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.hooks.AfterTimeoutTransfer(cacheCtx, packet, data, types.ParseDenomTrace(data.Denom).IBCDenom()); err != nil {
+		k.Logger(ctx).Error("transfer timeout hook failed", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence, "error", err.Error())
+		return
+	}
+
+	writeFn()
+
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
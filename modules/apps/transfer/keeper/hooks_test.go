@@ -0,0 +1,219 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+var _ types.TransferHooks = &mockTransferHooks{}
+
+const mockHookEventType = "mock_transfer_hook"
+
+// mockTransferHooks records the calls of the transfer hooks and returns the configured error. The
+// acknowledgement and timeout hooks emit a mockHookEventType event.
+type mockTransferHooks struct {
+	err error
+
+	recvCalls    []string
+	ackCalls     []string
+	timeoutCalls []string
+}
+
+func (h *mockTransferHooks) AfterRecvTransfer(_ sdk.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketData, localDenom string) error {
+	h.recvCalls = append(h.recvCalls, localDenom)
+	return h.err
+}
+
+func (h *mockTransferHooks) AfterAckTransfer(ctx sdk.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketData, localDenom string, _ channeltypes.Acknowledgement) error {
+	h.ackCalls = append(h.ackCalls, localDenom)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(mockHookEventType))
+	return h.err
+}
+
+func (h *mockTransferHooks) AfterTimeoutTransfer(ctx sdk.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketData, localDenom string) error {
+	h.timeoutCalls = append(h.timeoutCalls, localDenom)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(mockHookEventType))
+	return h.err
+}
+
+func (suite *KeeperTestSuite) TestAfterRecvTransferHook() {
+	testCases := []struct {
+		msg     string
+		hookErr error
+		expPass bool
+	}{
+		{"success", nil, true},
+		{"hook fails the receive", errors.New("hook failed"), false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			hooks := &mockTransferHooks{err: tc.hookErr}
+			transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+			transferKeeper.SetHooks(types.NewMultiTransferHooks(hooks))
+
+			data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			err := transferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+			suite.Require().Equal([]string{voucherDenom}, hooks.recvCalls)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAfterRecvTransferHookV2() {
+	path := NewTransferPathV2(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	hooks := &mockTransferHooks{}
+	transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+	transferKeeper.SetHooks(hooks)
+
+	tokens := types.Tokens{
+		types.NewToken(types.NewDenom(sdk.DefaultBondDenom), "100"),
+		types.NewToken(types.NewDenom("gamm/pool/1"), "50"),
+	}
+	data := types.NewFungibleTokenPacketDataV2(tokens, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+	err := transferKeeper.OnRecvPacketV2(suite.chainB.GetContext(), packet, data)
+	suite.Require().NoError(err)
+
+	// the hook is called once for every token
+	expDenoms := make([]string, len(tokens))
+	for i, token := range tokens {
+		expDenoms[i] = types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, token.Denom.Path())).IBCDenom()
+	}
+	suite.Require().Equal(expDenoms, hooks.recvCalls)
+}
+
+func (suite *KeeperTestSuite) TestAfterAckTransferHook() {
+	testCases := []struct {
+		msg       string
+		ack       channeltypes.Acknowledgement
+		hookErr   error
+		expRefund bool
+	}{
+		{"success: result acknowledgement", channeltypes.NewResultAcknowledgement([]byte{byte(1)}), nil, false},
+		{"success: error acknowledgement", channeltypes.NewErrorAcknowledgement(errors.New("failed packet transfer")), nil, true},
+		{"success: hook error does not fail the refund", channeltypes.NewErrorAcknowledgement(errors.New("failed packet transfer")), errors.New("hook failed"), true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// escrow the tokens refunded upon an error acknowledgement
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			err := suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin,
+				suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0,
+			)
+			suite.Require().NoError(err)
+
+			hooks := &mockTransferHooks{err: tc.hookErr}
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			transferKeeper.SetHooks(hooks)
+
+			data := types.NewFungibleTokenPacketData(coin.Denom, coin.Amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 110), 0)
+
+			ctx := suite.chainA.GetContext()
+			balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			err = transferKeeper.OnAcknowledgementPacket(ctx, packet, data, tc.ack)
+			suite.Require().NoError(err)
+			suite.Require().Equal([]string{sdk.DefaultBondDenom}, hooks.ackCalls)
+
+			// the state changes and events of a failed hook are discarded
+			suite.Require().Equal(tc.hookErr == nil, containsEventType(ctx.EventManager().Events(), mockHookEventType))
+
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			if tc.expRefund {
+				suite.Require().Equal(balanceBefore.Add(coin), balance)
+			} else {
+				suite.Require().Equal(balanceBefore, balance)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAfterTimeoutTransferHook() {
+	testCases := []struct {
+		msg     string
+		hookErr error
+	}{
+		{"success", nil},
+		{"success: hook error does not fail the refund", errors.New("hook failed")},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// send a voucher of chainB back to chainB so that it is minted back to the sender upon timeout
+			voucherDenom := types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			ibcDenom := types.ParseDenomTrace(voucherDenom).IBCDenom()
+
+			hooks := &mockTransferHooks{err: tc.hookErr}
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			transferKeeper.SetHooks(hooks)
+
+			data := types.NewFungibleTokenPacketData(voucherDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 110), 0)
+
+			ctx := suite.chainA.GetContext()
+			err := transferKeeper.OnTimeoutPacket(ctx, packet, data)
+			suite.Require().NoError(err)
+			suite.Require().Equal([]string{ibcDenom}, hooks.timeoutCalls)
+			suite.Require().Equal(tc.hookErr == nil, containsEventType(ctx.EventManager().Events(), mockHookEventType))
+
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, suite.chainA.SenderAccount.GetAddress(), ibcDenom)
+			suite.Require().Equal(sdk.NewInt(100), balance.Amount)
+		})
+	}
+}
+
+// containsEventType returns true if an event of the given type is contained in the events
+func containsEventType(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (suite *KeeperTestSuite) TestSetHooks() {
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	transferKeeper.SetHooks(&mockTransferHooks{})
+
+	suite.Require().Panics(func() {
+		transferKeeper.SetHooks(&mockTransferHooks{})
+	})
+}
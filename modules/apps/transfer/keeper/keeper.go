@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -26,6 +29,9 @@ type Keeper struct {
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	scopedKeeper  capabilitykeeper.ScopedKeeper
+
+	denomMetadataHook types.DenomMetadataHook
+	hooks             types.TransferHooks
 }
 
 // NewKeeper creates a new IBC transfer Keeper instance
@@ -54,14 +60,50 @@ func NewKeeper(
 		authKeeper:    authKeeper,
 		bankKeeper:    bankKeeper,
 		scopedKeeper:  scopedKeeper,
+
+		denomMetadataHook: types.DefaultDenomMetadata,
 	}
 }
 
+// SetHooks sets the transfer hooks. It must be called before the keeper is passed to the
+// transfer module and IBC module. The method panics if the hooks have already been set.
+func (k *Keeper) SetHooks(th types.TransferHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set transfer hooks twice")
+	}
+
+	k.hooks = th
+	return k
+}
+
+// SetDenomMetadataHook sets the hook used to construct the bank denomination metadata of
+// vouchers, overriding types.DefaultDenomMetadata. It must be called before the keeper is
+// passed to the transfer module and IBC module. The method panics if the hook is nil.
+func (k *Keeper) SetDenomMetadataHook(hook types.DenomMetadataHook) {
+	if hook == nil {
+		panic("denom metadata hook cannot be nil")
+	}
+
+	k.denomMetadataHook = hook
+}
+
+// SetICS4Wrapper sets the ICS4Wrapper used to send packets. It allows a middleware, which
+// wraps the transfer IBC module, to be set as the ICS4Wrapper after the keeper is constructed.
+// It must be called before the keeper is passed to the transfer module and IBC module.
+func (k *Keeper) SetICS4Wrapper(wrapper types.ICS4Wrapper) {
+	k.ics4Wrapper = wrapper
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
 }
 
+// GetAppVersion returns the ICS20 version negotiated for the provided channel.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
 // IsBound checks if the transfer module is already bound to the desired port
 func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
 	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
@@ -105,11 +147,35 @@ func (k Keeper) HasDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes)
 	return store.Has(denomTraceHash)
 }
 
-// SetDenomTrace sets a new {trace hash -> denom trace} pair to the store.
+// SetDenomTrace sets a new {trace hash -> denom trace} pair to the store. The trace hash is
+// indexed by the base denomination, the first hop and each hop of the denomination trace.
 func (k Keeper) SetDenomTrace(ctx sdk.Context, denomTrace types.DenomTrace) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
 	bz := k.MustMarshalDenomTrace(denomTrace)
-	store.Set(denomTrace.Hash(), bz)
+	traceHash := denomTrace.Hash()
+	store.Set(traceHash, bz)
+
+	k.setDenomTraceIndexes(ctx, denomTrace, traceHash)
+}
+
+// setDenomTraceIndexes stores the secondary indexes of the denomination trace with the given hash.
+func (k Keeper) setDenomTraceIndexes(ctx sdk.Context, denomTrace types.DenomTrace, traceHash tmbytes.HexBytes) {
+	store := ctx.KVStore(k.storeKey)
+	prefix.NewStore(store, types.DenomTraceBaseDenomIndexPrefix(denomTrace.BaseDenom)).Set(traceHash, traceHash)
+
+	if denomTrace.Path == "" {
+		return
+	}
+
+	identifiers := strings.Split(denomTrace.Path, "/")
+	for i := 0; i+1 < len(identifiers); i += 2 {
+		portID, channelID := identifiers[i], identifiers[i+1]
+		if i == 0 {
+			prefix.NewStore(store, types.DenomTraceFirstHopIndexPrefix(portID, channelID)).Set(traceHash, traceHash)
+		}
+
+		prefix.NewStore(store, types.DenomTraceHopIndexPrefix(portID, channelID)).Set(traceHash, traceHash)
+	}
 }
 
 // GetAllDenomTraces returns the trace information for all the denominations.
@@ -139,6 +205,30 @@ func (k Keeper) IterateDenomTraces(ctx sdk.Context, cb func(denomTrace types.Den
 	}
 }
 
+// SetDenomMetadata registers the bank denomination metadata of the voucher represented by the
+// denomination trace using the denom metadata hook. Metadata already registered for the voucher
+// is not overwritten. An error is returned if the metadata returned by the hook is invalid, callers
+// registering metadata on a best-effort basis must not fail on it.
+func (k Keeper) SetDenomMetadata(ctx sdk.Context, denomTrace types.DenomTrace) error {
+	ibcDenom := denomTrace.IBCDenom()
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, ibcDenom); found {
+		return nil
+	}
+
+	metadata := k.denomMetadataHook(ctx, denomTrace)
+	if metadata.Base != ibcDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidDenomForTransfer, "denom metadata base denomination %s does not match voucher denomination %s", metadata.Base, ibcDenom)
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidDenomForTransfer, "invalid denom metadata for %s: %s", ibcDenom, err)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	return nil
+}
+
 // AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
 	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
@@ -4,8 +4,11 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/keeper"
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
@@ -44,6 +47,102 @@ func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
 	return path
 }
 
+// NewTransferPathV2 returns a transfer path using the ics20-2 version.
+func NewTransferPathV2(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := NewTransferPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.Version = types.V2
+	path.EndpointB.ChannelConfig.Version = types.V2
+
+	return path
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+func (suite *KeeperTestSuite) TestSetDenomMetadata() {
+	var (
+		transferKeeper keeper.Keeper
+		denomTrace     types.DenomTrace
+		expMetadata    banktypes.Metadata
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: default denom metadata",
+			func() {},
+			true,
+		},
+		{
+			"success: custom denom metadata hook",
+			func() {
+				transferKeeper.SetDenomMetadataHook(func(ctx sdk.Context, denomTrace types.DenomTrace) banktypes.Metadata {
+					metadata := types.DefaultDenomMetadata(ctx, denomTrace)
+					metadata.Name = "Cosmos Hub Atom"
+					metadata.Symbol = "ATOM"
+					return metadata
+				})
+
+				expMetadata.Name = "Cosmos Hub Atom"
+				expMetadata.Symbol = "ATOM"
+			},
+			true,
+		},
+		{
+			"success: existing denom metadata is not overwritten",
+			func() {
+				expMetadata.Name = "existing metadata"
+				suite.chainA.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainA.GetContext(), expMetadata)
+			},
+			true,
+		},
+		{
+			"failure: hook returns metadata for different base denomination",
+			func() {
+				transferKeeper.SetDenomMetadataHook(func(ctx sdk.Context, denomTrace types.DenomTrace) banktypes.Metadata {
+					return types.DefaultDenomMetadata(ctx, types.ParseDenomTrace("transfer/channel-1/uatom"))
+				})
+			},
+			false,
+		},
+		{
+			"failure: hook returns invalid metadata",
+			func() {
+				transferKeeper.SetDenomMetadataHook(func(ctx sdk.Context, denomTrace types.DenomTrace) banktypes.Metadata {
+					metadata := types.DefaultDenomMetadata(ctx, denomTrace)
+					metadata.Symbol = ""
+					return metadata
+				})
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			transferKeeper = suite.chainA.GetSimApp().TransferKeeper
+			denomTrace = types.ParseDenomTrace("transfer/channel-0/uatom")
+			expMetadata = types.DefaultDenomMetadata(suite.chainA.GetContext(), denomTrace)
+
+			tc.malleate()
+
+			err := transferKeeper.SetDenomMetadata(suite.chainA.GetContext(), denomTrace)
+
+			metadata, found := suite.chainA.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainA.GetContext(), denomTrace.IBCDenom())
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(expMetadata, metadata)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// This migration
+// - indexes all existing denomination traces by base denomination, first hop and hop
+// - registers the bank denomination metadata of the vouchers of all existing denomination
+// traces which do not have denomination metadata, skipping invalid metadata
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	for _, denomTrace := range m.keeper.GetAllDenomTraces(ctx) {
+		m.keeper.setDenomTraceIndexes(ctx, denomTrace, denomTrace.Hash())
+
+		if err := m.keeper.SetDenomMetadata(ctx, denomTrace); err != nil {
+			m.keeper.Logger(ctx).Error("failed to register denomination metadata", "denom", denomTrace.IBCDenom(), "error", err.Error())
+		}
+	}
+
+	return nil
+}
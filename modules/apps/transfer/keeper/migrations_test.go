@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	denomTraces := types.Traces{
+		types.ParseDenomTrace("transfer/channel-0/uatom"),
+		types.ParseDenomTrace("transfer/channel-1/transfer/channel-0/uatom"),
+		types.ParseDenomTrace("transfer/channel-0/gamm/pool/1"),
+		types.ParseDenomTrace("transfer/channel-0/cw20:juno1abc"),
+	}
+
+	// store the denomination traces without their indexes
+	store := prefix.NewStore(ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey)), types.DenomTraceKey)
+	for _, denomTrace := range denomTraces {
+		store.Set(denomTrace.Hash(), transferKeeper.MustMarshalDenomTrace(denomTrace))
+	}
+
+	// metadata already registered for a voucher is not overwritten
+	existingMetadata := types.DefaultDenomMetadata(ctx, denomTraces[0])
+	existingMetadata.Name = "existing metadata"
+	bankKeeper.SetDenomMetaData(ctx, existingMetadata)
+
+	migrator := keeper.NewMigrator(transferKeeper)
+	err := migrator.Migrate1to2(ctx)
+	suite.Require().NoError(err)
+
+	for i, denomTrace := range denomTraces {
+		expMetadata := types.DefaultDenomMetadata(ctx, denomTrace)
+		if i == 0 {
+			expMetadata = existingMetadata
+		}
+
+		metadata, found := bankKeeper.GetDenomMetaData(ctx, denomTrace.IBCDenom())
+		suite.Require().True(found)
+		suite.Require().Equal(expMetadata, metadata)
+	}
+
+	res, err := transferKeeper.DenomTracesByBaseDenom(sdk.WrapSDKContext(ctx), &types.QueryDenomTracesByBaseDenomRequest{BaseDenom: "uatom"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Traces{denomTraces[0], denomTraces[1]}.Sort(), res.DenomTraces)
+
+	firstHopRes, err := transferKeeper.DenomTracesByFirstHop(sdk.WrapSDKContext(ctx), &types.QueryDenomTracesByFirstHopRequest{PortId: "transfer", ChannelId: "channel-0"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Traces{denomTraces[0], denomTraces[2], denomTraces[3]}.Sort(), firstHopRes.DenomTraces)
+
+	hopRes, err := transferKeeper.DenomTracesByHop(sdk.WrapSDKContext(ctx), &types.QueryDenomTracesByHopRequest{PortId: "transfer", ChannelId: "channel-0"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(denomTraces.Sort(), hopRes.DenomTraces)
+}
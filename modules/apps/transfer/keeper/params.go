@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 )
@@ -20,12 +21,72 @@ func (k Keeper) GetReceiveEnabled(ctx sdk.Context) bool {
 	return res
 }
 
+// GetChannelPolicies retrieves the channel transfer policies from the paramstore
+func (k Keeper) GetChannelPolicies(ctx sdk.Context) []types.ChannelTransferPolicy {
+	var res []types.ChannelTransferPolicy
+	// the channel policies may not exist in the paramstore of chains which have not
+	// set them since they were introduced
+	k.paramSpace.GetIfExists(ctx, types.KeyChannelPolicies, &res)
+	return res
+}
+
+// GetChannelTransferPolicy returns the transfer policy of the channel with the given port and
+// channel identifiers. A boolean is returned indicating if a policy is set for the channel.
+func (k Keeper) GetChannelTransferPolicy(ctx sdk.Context, portID, channelID string) (types.ChannelTransferPolicy, bool) {
+	for _, policy := range k.GetChannelPolicies(ctx) {
+		if policy.PortId == portID && policy.ChannelId == channelID {
+			return policy, true
+		}
+	}
+
+	return types.ChannelTransferPolicy{}, false
+}
+
 // GetParams returns the total set of ibc-transfer parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx))
+	return types.NewParams(k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx), k.GetChannelPolicies(ctx)...)
 }
 
 // SetParams sets the total set of ibc-transfer parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
+
+// checkSendEnabled returns an error if fungible token transfers from this chain are disabled
+// either globally or over the channel with the given port and channel identifiers.
+func (k Keeper) checkSendEnabled(ctx sdk.Context, portID, channelID string) error {
+	if !k.GetSendEnabled(ctx) {
+		return types.ErrSendDisabled
+	}
+
+	if policy, found := k.GetChannelTransferPolicy(ctx, portID, channelID); found && !policy.SendEnabled {
+		return sdkerrors.Wrapf(types.ErrSendDisabled, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return nil
+}
+
+// checkReceiveEnabled returns an error if fungible token transfers to this chain are disabled
+// either globally or over the channel with the given port and channel identifiers.
+func (k Keeper) checkReceiveEnabled(ctx sdk.Context, portID, channelID string) error {
+	if !k.GetReceiveEnabled(ctx) {
+		return types.ErrReceiveDisabled
+	}
+
+	if policy, found := k.GetChannelTransferPolicy(ctx, portID, channelID); found && !policy.ReceiveEnabled {
+		return sdkerrors.Wrapf(types.ErrReceiveDisabled, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return nil
+}
+
+// checkDenomAllowed returns an error if the token with the given full denomination path, as
+// represented on this chain, may not be transferred over the channel with the given port and
+// channel identifiers.
+func (k Keeper) checkDenomAllowed(ctx sdk.Context, portID, channelID, fullDenomPath string) error {
+	if policy, found := k.GetChannelTransferPolicy(ctx, portID, channelID); found && !policy.IsDenomAllowed(fullDenomPath) {
+		return sdkerrors.Wrapf(types.ErrDenomNotAllowed, "denomination %s, port ID (%s) channel ID (%s)", fullDenomPath, portID, channelID)
+	}
+
+	return nil
+}
@@ -1,6 +1,11 @@
 package keeper_test
 
-import "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+import (
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+)
 
 func (suite *KeeperTestSuite) TestParams() {
 	expParams := types.DefaultParams()
@@ -13,3 +18,44 @@ func (suite *KeeperTestSuite) TestParams() {
 	params = suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
 }
+
+func (suite *KeeperTestSuite) TestGetChannelTransferPolicy() {
+	policy := types.NewChannelTransferPolicy("transfer", "channel-0", true, false, []string{"uusdc"}, nil)
+
+	_, found := suite.chainA.GetSimApp().TransferKeeper.GetChannelTransferPolicy(suite.chainA.GetContext(), "transfer", "channel-0")
+	suite.Require().False(found)
+
+	suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, policy))
+
+	res, found := suite.chainA.GetSimApp().TransferKeeper.GetChannelTransferPolicy(suite.chainA.GetContext(), "transfer", "channel-0")
+	suite.Require().True(found)
+	suite.Require().Equal(policy, res)
+
+	_, found = suite.chainA.GetSimApp().TransferKeeper.GetChannelTransferPolicy(suite.chainA.GetContext(), "transfer", "channel-1")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestChannelPoliciesParamChangeProposal() {
+	policy := types.NewChannelTransferPolicy("transfer", "channel-0", true, true, []string{"uusdc"}, []string{"stake"})
+
+	value, err := suite.chainA.GetSimApp().LegacyAmino().MarshalJSON([]types.ChannelTransferPolicy{policy})
+	suite.Require().NoError(err)
+
+	proposal := paramsproposal.NewParameterChangeProposal("title", "description", []paramsproposal.ParamChange{
+		paramsproposal.NewParamChange(types.ModuleName, string(types.KeyChannelPolicies), string(value)),
+	})
+
+	handler := params.NewParamChangeProposalHandler(suite.chainA.GetSimApp().ParamsKeeper)
+	err = handler(suite.chainA.GetContext(), proposal)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]types.ChannelTransferPolicy{policy}, suite.chainA.GetSimApp().TransferKeeper.GetChannelPolicies(suite.chainA.GetContext()))
+
+	// duplicate channel policies are rejected
+	value, err = suite.chainA.GetSimApp().LegacyAmino().MarshalJSON([]types.ChannelTransferPolicy{policy, policy})
+	suite.Require().NoError(err)
+
+	proposal.Changes[0].Value = string(value)
+	err = handler(suite.chainA.GetContext(), proposal)
+	suite.Require().Error(err)
+}
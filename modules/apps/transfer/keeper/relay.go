@@ -58,8 +58,25 @@ func (k Keeper) SendTransfer(
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
 ) error {
-	if !k.GetSendEnabled(ctx) {
-		return types.ErrSendDisabled
+	return k.sendTransfer(ctx, sourcePort, sourceChannel, sdk.Coins{token}, sender, receiver, timeoutHeight, timeoutTimestamp, "")
+}
+
+// sendTransfer escrows or burns each of the provided tokens as described in SendTransfer and
+// sends a single packet transferring all of them. Multiple tokens may only be transferred over
+// channels using the ics20-2 version.
+func (k Keeper) sendTransfer(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	tokens sdk.Coins,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) error {
+	if err := k.checkSendEnabled(ctx, sourcePort, sourceChannel); err != nil {
+		return err
 	}
 
 	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
@@ -70,6 +87,15 @@ func (k Keeper) SendTransfer(
 	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
 	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
 
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "app version not found for port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	if appVersion != types.V2 && len(tokens) != 1 {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "cannot transfer %d tokens in a single packet over a channel with version %s", len(tokens), appVersion)
+	}
+
 	// get the next sequence
 	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
 	if !found {
@@ -86,68 +112,52 @@ func (k Keeper) SendTransfer(
 		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
-	// NOTE: denomination and hex hash correctness checked during msg.ValidateBasic
-	fullDenomPath := token.Denom
-
-	var err error
+	labels := []metrics.Label{
+		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
+		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
+	}
 
-	// deconstruct the token denomination into the denomination trace info
-	// to determine if the sender is the source chain
-	if strings.HasPrefix(token.Denom, "ibc/") {
-		fullDenomPath, err = k.DenomPathFromHash(ctx, token.Denom)
+	fullDenomPaths := make([]string, len(tokens))
+	sourceLabels := make([]metrics.Label, len(tokens))
+	for i, token := range tokens {
+		// NOTE: denomination and hex hash correctness checked during msg.ValidateBasic
+		fullDenomPath, err := k.getFullDenomPath(ctx, token.Denom)
 		if err != nil {
 			return err
 		}
-	}
 
-	labels := []metrics.Label{
-		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
-		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
+		if err := k.checkDenomAllowed(ctx, sourcePort, sourceChannel, fullDenomPath); err != nil {
+			return err
+		}
+
+		if err := k.escrowOrBurnToken(ctx, sourcePort, sourceChannel, fullDenomPath, token, sender); err != nil {
+			return err
+		}
+
+		fullDenomPaths[i] = fullDenomPath
+		sourceLabels[i] = telemetry.NewLabel(coretypes.LabelSource, fmt.Sprintf("%t", types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath)))
 	}
 
 	// NOTE: SendTransfer simply sends the denomination as it exists on its own
 	// chain inside the packet data. The receiving chain will perform denom
 	// prefixing as necessary.
 
-	if types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath) {
-		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "true"))
-
-		// create the escrow address for the tokens
-		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
-
-		// escrow source tokens. It fails if balance insufficient.
-		if err := k.bankKeeper.SendCoins(
-			ctx, sender, escrowAddress, sdk.NewCoins(token),
-		); err != nil {
-			return err
+	var packetData []byte
+	if appVersion == types.V2 {
+		packetTokens := make(types.Tokens, len(tokens))
+		for i, token := range tokens {
+			packetTokens[i] = types.NewToken(types.ExtractDenomFromPath(fullDenomPaths[i]), token.Amount.String())
 		}
 
+		packetData = types.NewFungibleTokenPacketDataV2(packetTokens, sender.String(), receiver, memo).GetBytes()
 	} else {
-		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "false"))
-
-		// transfer the coins to the module account and burn them
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(
-			ctx, sender, types.ModuleName, sdk.NewCoins(token),
-		); err != nil {
-			return err
-		}
-
-		if err := k.bankKeeper.BurnCoins(
-			ctx, types.ModuleName, sdk.NewCoins(token),
-		); err != nil {
-			// NOTE: should not happen as the module account was
-			// retrieved on the step above and it has enough balace
-			// to burn.
-			panic(fmt.Sprintf("cannot burn coins after a successful send to a module account: %v", err))
-		}
+		packetData = types.NewFungibleTokenPacketData(
+			fullDenomPaths[0], tokens[0].Amount.String(), sender.String(), receiver, memo,
+		).GetBytes()
 	}
 
-	packetData := types.NewFungibleTokenPacketData(
-		fullDenomPath, token.Amount.String(), sender.String(), receiver,
-	)
-
 	packet := channeltypes.NewPacket(
-		packetData.GetBytes(),
+		packetData,
 		sequence,
 		sourcePort,
 		sourceChannel,
@@ -162,24 +172,80 @@ func (k Keeper) SendTransfer(
 	}
 
 	defer func() {
-		if token.Amount.IsInt64() {
-			telemetry.SetGaugeWithLabels(
-				[]string{"tx", "msg", "ibc", "transfer"},
-				float32(token.Amount.Int64()),
-				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, fullDenomPath)},
+		for i, token := range tokens {
+			if token.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "ibc", "transfer"},
+					float32(token.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, fullDenomPaths[i])},
+				)
+			}
+
+			telemetry.IncrCounterWithLabels(
+				[]string{"ibc", types.ModuleName, "send"},
+				1,
+				append(labels, sourceLabels[i]),
 			)
 		}
-
-		telemetry.IncrCounterWithLabels(
-			[]string{"ibc", types.ModuleName, "send"},
-			1,
-			labels,
-		)
 	}()
 
 	return nil
 }
 
+// getFullDenomPath returns the full denomination path of the provided coin denomination.
+func (k Keeper) getFullDenomPath(ctx sdk.Context, denom string) (string, error) {
+	// deconstruct the token denomination into the denomination trace info
+	// to determine if the sender is the source chain
+	if strings.HasPrefix(denom, "ibc/") {
+		return k.DenomPathFromHash(ctx, denom)
+	}
+
+	return denom, nil
+}
+
+// escrowOrBurnToken escrows the token if the sender chain is the source of the token,
+// otherwise the token is burned.
+func (k Keeper) escrowOrBurnToken(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel,
+	fullDenomPath string,
+	token sdk.Coin,
+	sender sdk.AccAddress,
+) error {
+	if types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath) {
+		// create the escrow address for the tokens
+		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+
+		// escrow source tokens. It fails if balance insufficient.
+		if err := k.bankKeeper.SendCoins(
+			ctx, sender, escrowAddress, sdk.NewCoins(token),
+		); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	// transfer the coins to the module account and burn them
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, sender, types.ModuleName, sdk.NewCoins(token),
+	); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(
+		ctx, types.ModuleName, sdk.NewCoins(token),
+	); err != nil {
+		// NOTE: should not happen as the module account was
+		// retrieved on the step above and it has enough balace
+		// to burn.
+		panic(fmt.Sprintf("cannot burn coins after a successful send to a module account: %v", err))
+	}
+
+	return nil
+}
+
 // OnRecvPacket processes a cross chain fungible token transfer. If the
 // sender chain is the source of minted tokens then vouchers will be minted
 // and sent to the receiving address. Otherwise if the sender chain is sending
@@ -191,8 +257,8 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return err
 	}
 
-	if !k.GetReceiveEnabled(ctx) {
-		return types.ErrReceiveDisabled
+	if err := k.checkReceiveEnabled(ctx, packet.GetDestPort(), packet.GetDestChannel()); err != nil {
+		return err
 	}
 
 	// decode the receiver address
@@ -201,10 +267,57 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return err
 	}
 
+	localDenom, err := k.receiveToken(ctx, packet, data.Denom, data.Amount, receiver)
+	if err != nil {
+		return err
+	}
+
+	return k.afterRecvTransfer(ctx, packet, data, localDenom)
+}
+
+// OnRecvPacketV2 processes a cross chain fungible token transfer of multiple tokens received
+// on an ics20-2 channel. Each token is received as described in OnRecvPacket. An error is
+// returned if any of the tokens cannot be received, in which case the state changes of all
+// tokens are reverted by core IBC as the packet is acknowledged with an error acknowledgement.
+func (k Keeper) OnRecvPacketV2(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := k.checkReceiveEnabled(ctx, packet.GetDestPort(), packet.GetDestChannel()); err != nil {
+		return err
+	}
+
+	// decode the receiver address
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	for _, token := range data.Tokens {
+		localDenom, err := k.receiveToken(ctx, packet, token.Denom.Path(), token.Amount, receiver)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to receive token %s", token)
+		}
+
+		tokenData := types.NewFungibleTokenPacketData(token.Denom.Path(), token.Amount, data.Sender, data.Receiver, data.Memo)
+		if err := k.afterRecvTransfer(ctx, packet, tokenData, localDenom); err != nil {
+			return sdkerrors.Wrapf(err, "failed to receive token %s", token)
+		}
+	}
+
+	return nil
+}
+
+// receiveToken unescrows the token to the receiver if the receiving chain is the source of
+// the token, otherwise vouchers are minted and sent to the receiver. The denomination of the
+// received coin on this chain is returned.
+func (k Keeper) receiveToken(ctx sdk.Context, packet channeltypes.Packet, denom, amount string, receiver sdk.AccAddress) (string, error) {
 	// parse the transfer amount
-	transferAmount, ok := sdk.NewIntFromString(data.Amount)
+	transferAmount, ok := sdk.NewIntFromString(amount)
 	if !ok {
-		return sdkerrors.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+		return "", sdkerrors.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", amount)
 	}
 
 	labels := []metrics.Label{
@@ -220,26 +333,30 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	// chain would have prefixed with DestPort and DestChannel when originally
 	// receiving this coin as seen in the "sender chain is the source" condition.
 
-	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
 		// sender chain is not the source, unescrow tokens
 
 		// remove prefix added by sender chain
 		voucherPrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
-		unprefixedDenom := data.Denom[len(voucherPrefix):]
+		unprefixedDenom := denom[len(voucherPrefix):]
 
 		// coin denomination used in sending from the escrow address
-		denom := unprefixedDenom
+		coinDenom := unprefixedDenom
 
 		// The denomination used to send the coins is either the native denom or the hash of the path
 		// if the denomination is not native.
 		denomTrace := types.ParseDenomTrace(unprefixedDenom)
 		if denomTrace.Path != "" {
-			denom = denomTrace.IBCDenom()
+			coinDenom = denomTrace.IBCDenom()
+		}
+		token := sdk.NewCoin(coinDenom, transferAmount)
+
+		if err := k.checkDenomAllowed(ctx, packet.GetDestPort(), packet.GetDestChannel(), unprefixedDenom); err != nil {
+			return "", err
 		}
-		token := sdk.NewCoin(denom, transferAmount)
 
 		if k.bankKeeper.BlockedAddr(receiver) {
-			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+			return "", sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
 		}
 
 		// unescrow tokens
@@ -249,7 +366,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			// counterparty module. The bug may occur in bank or any part of the code that allows
 			// the escrow address to be drained. A malicious counterparty module could drain the
 			// escrow address by allowing more tokens to be sent back then were escrowed.
-			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
+			return "", sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
 		}
 
 		defer func() {
@@ -270,7 +387,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			)
 		}()
 
-		return nil
+		return coinDenom, nil
 	}
 
 	// sender chain is the source, mint vouchers
@@ -278,7 +395,11 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	// since SendPacket did not prefix the denomination, we must prefix denomination here
 	sourcePrefix := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
 	// NOTE: sourcePrefix contains the trailing "/"
-	prefixedDenom := sourcePrefix + data.Denom
+	prefixedDenom := sourcePrefix + denom
+
+	if err := k.checkDenomAllowed(ctx, packet.GetDestPort(), packet.GetDestChannel(), prefixedDenom); err != nil {
+		return "", err
+	}
 
 	// construct the denomination trace from the full raw denomination
 	denomTrace := types.ParseDenomTrace(prefixedDenom)
@@ -286,6 +407,11 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	traceHash := denomTrace.Hash()
 	if !k.HasDenomTrace(ctx, traceHash) {
 		k.SetDenomTrace(ctx, denomTrace)
+
+		// denomination metadata is registered on a best-effort basis and never fails the receipt
+		if err := k.SetDenomMetadata(ctx, denomTrace); err != nil {
+			k.Logger(ctx).Error("failed to register denomination metadata", "denom", denomTrace.IBCDenom(), "error", err.Error())
+		}
 	}
 
 	voucherDenom := denomTrace.IBCDenom()
@@ -302,14 +428,14 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	if err := k.bankKeeper.MintCoins(
 		ctx, types.ModuleName, sdk.NewCoins(voucher),
 	); err != nil {
-		return err
+		return "", err
 	}
 
 	// send to receiver
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
 		ctx, types.ModuleName, receiver, sdk.NewCoins(voucher),
 	); err != nil {
-		return err
+		return "", err
 	}
 
 	defer func() {
@@ -317,7 +443,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			telemetry.SetGaugeWithLabels(
 				[]string{"ibc", types.ModuleName, "packet", "receive"},
 				float32(transferAmount.Int64()),
-				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, data.Denom)},
+				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, denom)},
 			)
 		}
 
@@ -330,7 +456,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		)
 	}()
 
-	return nil
+	return voucherDenom, nil
 }
 
 // OnAcknowledgementPacket responds to the the success or failure of a packet
@@ -340,18 +466,66 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		if err := k.refundPacketToken(ctx, packet, data); err != nil {
+			return err
+		}
 	default:
 		// the acknowledgement succeeded on the receiving chain so nothing
-		// needs to be executed and no error needs to be returned
-		return nil
+		// needs to be refunded
+	}
+
+	k.afterAckTransfer(ctx, packet, data, ack)
+
+	return nil
+}
+
+// OnAcknowledgementPacketV2 responds to the the success or failure of an ics20-2 packet
+// acknowledgement written on the receiving chain. If the acknowledgement failed, then
+// the sender is refunded all tokens using the refundPacketTokens function.
+func (k Keeper) OnAcknowledgementPacketV2(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, ack channeltypes.Acknowledgement) error {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		if err := k.refundPacketTokens(ctx, packet, data); err != nil {
+			return err
+		}
+	default:
+		// the acknowledgement succeeded on the receiving chain so nothing
+		// needs to be refunded
+	}
+
+	for _, token := range data.Tokens {
+		tokenData := types.NewFungibleTokenPacketData(token.Denom.Path(), token.Amount, data.Sender, data.Receiver, data.Memo)
+		k.afterAckTransfer(ctx, packet, tokenData, ack)
 	}
+
+	return nil
 }
 
 // OnTimeoutPacket refunds the sender since the original packet sent was
 // never received and has been timed out.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+	if err := k.refundPacketToken(ctx, packet, data); err != nil {
+		return err
+	}
+
+	k.afterTimeoutTransfer(ctx, packet, data)
+
+	return nil
+}
+
+// OnTimeoutPacketV2 refunds the sender all tokens since the original ics20-2 packet
+// sent was never received and has been timed out.
+func (k Keeper) OnTimeoutPacketV2(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+	if err := k.refundPacketTokens(ctx, packet, data); err != nil {
+		return err
+	}
+
+	for _, token := range data.Tokens {
+		tokenData := types.NewFungibleTokenPacketData(token.Denom.Path(), token.Amount, data.Sender, data.Receiver, data.Memo)
+		k.afterTimeoutTransfer(ctx, packet, tokenData)
+	}
+
+	return nil
 }
 
 // refundPacketToken will unescrow and send back the tokens back to sender
@@ -361,23 +535,47 @@ func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, dat
 func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
 	// NOTE: packet data type already checked in handler.go
 
-	// parse the denomination from the full denom path
-	trace := types.ParseDenomTrace(data.Denom)
-
-	// parse the transfer amount
-	transferAmount, ok := sdk.NewIntFromString(data.Amount)
-	if !ok {
-		return sdkerrors.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	// decode the sender address
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
 	}
-	token := sdk.NewCoin(trace.IBCDenom(), transferAmount)
 
+	return k.refundToken(ctx, packet, data.Denom, data.Amount, sender)
+}
+
+// refundPacketTokens refunds all tokens of an ics20-2 packet to the sender as described
+// in refundPacketToken.
+func (k Keeper) refundPacketTokens(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
 	// decode the sender address
 	sender, err := sdk.AccAddressFromBech32(data.Sender)
 	if err != nil {
 		return err
 	}
 
-	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+	for _, token := range data.Tokens {
+		if err := k.refundToken(ctx, packet, token.Denom.Path(), token.Amount, sender); err != nil {
+			return sdkerrors.Wrapf(err, "failed to refund token %s", token)
+		}
+	}
+
+	return nil
+}
+
+// refundToken unescrows the token to the sender if the sending chain was the source
+// of the token, otherwise vouchers are minted and sent to the sender.
+func (k Keeper) refundToken(ctx sdk.Context, packet channeltypes.Packet, denom, amount string, sender sdk.AccAddress) error {
+	// parse the denomination from the full denom path
+	trace := types.ParseDenomTrace(denom)
+
+	// parse the transfer amount
+	transferAmount, ok := sdk.NewIntFromString(amount)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", amount)
+	}
+	token := sdk.NewCoin(trace.IBCDenom(), transferAmount)
+
+	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
 		// unescrow tokens back to sender
 		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
 		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, sender, sdk.NewCoins(token)); err != nil {
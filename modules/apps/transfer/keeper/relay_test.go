@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
@@ -85,6 +86,43 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				amount = types.GetTransferCoin(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, " randomdenom", sdk.NewInt(100))
 			}, false, false,
 		},
+		{
+			"send disabled on channel",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				policy := types.NewChannelTransferPolicy(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, false, true, nil, nil)
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, policy))
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			}, true, false,
+		},
+		{
+			"native denom denied on channel",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				policy := types.NewChannelTransferPolicy(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, true, true, nil, []string{sdk.DefaultBondDenom})
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, policy))
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			}, true, false,
+		},
+		{
+			"denom not allowed on channel",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				policy := types.NewChannelTransferPolicy(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, true, true, []string{"uusdc"}, nil)
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, policy))
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			}, true, false,
+		},
+		{
+			"successful transfer of voucher allowed on channel by full denom path",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				fullDenomPath := types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+				policy := types.NewChannelTransferPolicy(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, true, true, []string{fullDenomPath}, nil)
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, policy))
+				amount = types.GetTransferCoin(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, sdk.NewInt(100))
+			}, false, true,
+		},
 		{
 			"channel capability not found",
 			func() {
@@ -111,12 +149,12 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			if !tc.sendFromSource {
 				// send coin from chainB to chainA
 				coinFromBToA := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
-				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0)
+				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "")
 				_, err = suite.chainB.SendMsgs(transferMsg)
 				suite.Require().NoError(err) // message committed
 
 				// receive coin on chainA from chainB
-				fungibleTokenPacket := types.NewFungibleTokenPacketData(coinFromBToA.Denom, coinFromBToA.Amount.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String())
+				fungibleTokenPacket := types.NewFungibleTokenPacketData(coinFromBToA.Denom, coinFromBToA.Amount.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), "")
 				packet := channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 110), 0)
 
 				// get proof of packet commitment from chainB
@@ -191,6 +229,29 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		{"failure: receive on module account on source chain", func() {
 			receiver = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
 		}, true, false},
+
+		// - channel transfer policies on chainB
+		{"success: denom allowed on channel", func() {
+			policy := types.NewChannelTransferPolicy(ibctesting.TransferPort, ibctesting.FirstChannelID, true, true, []string{sdk.DefaultBondDenom}, nil)
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, policy))
+		}, false, true},
+		{"failure: receive disabled on channel", func() {
+			policy := types.NewChannelTransferPolicy(ibctesting.TransferPort, ibctesting.FirstChannelID, true, false, nil, nil)
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, policy))
+		}, false, false},
+		{"failure: denom not allowed on channel", func() {
+			policy := types.NewChannelTransferPolicy(ibctesting.TransferPort, ibctesting.FirstChannelID, true, true, []string{"uusdc"}, nil)
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, policy))
+		}, false, false},
+		{"failure: voucher denom denied on channel", func() {
+			deniedDenom := types.GetPrefixedDenom(ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+			policy := types.NewChannelTransferPolicy(ibctesting.TransferPort, ibctesting.FirstChannelID, true, true, nil, []string{deniedDenom})
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, policy))
+		}, false, false},
+		{"failure: native denom denied on channel", func() {
+			policy := types.NewChannelTransferPolicy(ibctesting.TransferPort, ibctesting.FirstChannelID, true, true, nil, []string{sdk.DefaultBondDenom})
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, policy))
+		}, true, false},
 	}
 
 	for _, tc := range testCases {
@@ -209,7 +270,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			if tc.recvIsSource {
 				// send coin from chainB to chainA, receive them, acknowledge them, and send back to chainB
 				coinFromBToA := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
-				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0)
+				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "")
 				res, err := suite.chainB.SendMsgs(transferMsg)
 				suite.Require().NoError(err) // message committed
 
@@ -228,19 +289,31 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			}
 
 			// send coin from chainA to chainB
-			transferMsg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(trace.IBCDenom(), amount), suite.chainA.SenderAccount.GetAddress().String(), receiver, clienttypes.NewHeight(0, 110), 0)
+			transferMsg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(trace.IBCDenom(), amount), suite.chainA.SenderAccount.GetAddress().String(), receiver, clienttypes.NewHeight(0, 110), 0, "")
 			_, err := suite.chainA.SendMsgs(transferMsg)
 			suite.Require().NoError(err) // message committed
 
 			tc.malleate()
 
-			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver)
+			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver, "")
 			packet := channeltypes.NewPacket(data.GetBytes(), seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
 
 			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				if !tc.recvIsSource {
+					// denom metadata is registered for newly minted vouchers
+					voucherTrace := types.ParseDenomTrace(types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), trace.GetFullDenomPath()))
+					metadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), voucherTrace.IBCDenom())
+					suite.Require().True(found)
+					suite.Require().Equal(types.DefaultDenomMetadata(suite.chainB.GetContext(), voucherTrace), metadata)
+
+					// the registered metadata must pass the bank genesis validation
+					bankGenesis := suite.chainB.GetSimApp().BankKeeper.ExportGenesis(suite.chainB.GetContext())
+					suite.Require().NoError(bankGenesis.Validate())
+				}
 			} else {
 				suite.Require().Error(err)
 			}
@@ -248,6 +321,36 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+// TestOnRecvPacketInvalidDenomMetadata tests that vouchers are received even if their denomination
+// metadata cannot be registered.
+func (suite *KeeperTestSuite) TestOnRecvPacketInvalidDenomMetadata() {
+	path := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+	transferKeeper.SetDenomMetadataHook(func(ctx sdk.Context, denomTrace types.DenomTrace) banktypes.Metadata {
+		metadata := types.DefaultDenomMetadata(ctx, denomTrace)
+		metadata.Symbol = ""
+		return metadata
+	})
+
+	amount := sdk.NewInt(100)
+	receiver := suite.chainB.SenderAccount.GetAddress()
+
+	data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+	err := transferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+	suite.Require().NoError(err)
+
+	voucherTrace := types.ParseDenomTrace(types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherTrace.IBCDenom())
+	suite.Require().Equal(amount, balance.Amount)
+
+	_, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), voucherTrace.IBCDenom())
+	suite.Require().False(found)
+}
+
 // TestOnAcknowledgementPacket tests that successful acknowledgement is a no-op
 // and failure acknowledment leads to refund when attempting to send from chainA
 // to chainB. If sender is source than the denomination being refunded has no
@@ -307,7 +410,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 
 			tc.malleate()
 
-			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String())
+			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
 
 			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
@@ -403,7 +506,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 
 			tc.malleate()
 
-			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), sender, suite.chainB.SenderAccount.GetAddress().String())
+			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), sender, suite.chainB.SenderAccount.GetAddress().String(), "")
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
 
 			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
@@ -422,3 +525,247 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 		})
 	}
 }
+
+// test sending multiple tokens from chainA to chainB in a single packet
+func (suite *KeeperTestSuite) TestSendTransferMultiDenom() {
+	var (
+		path   *ibctesting.Path
+		tokens sdk.Coins
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success with single token",
+			func() {
+				tokens = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+			}, true,
+		},
+		{
+			"multiple tokens over ics20-1 channel",
+			func() {
+				path = NewTransferPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+			}, false,
+		},
+		{
+			"insufficient balance for one of the tokens",
+			func() {
+				tokens = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), sdk.NewCoin("bitcoin", sdk.NewInt(100)))
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPathV2(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			atom := sdk.NewCoin("atom", sdk.NewInt(100))
+			suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(atom)))
+			tokens = sdk.NewCoins(atom, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+
+			tc.malleate()
+
+			msg := types.NewMsgTransferWithTokens(
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, tokens,
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+				clienttypes.NewHeight(0, 110), 0, "",
+			)
+
+			_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), escrow)
+				suite.Require().Equal(tokens, escrowBalance)
+
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+				suite.Require().NotEmpty(commitment)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// test receiving multiple tokens in a single packet sent over an ics20-2 channel
+func (suite *KeeperTestSuite) TestOnRecvPacketV2() {
+	var (
+		path     *ibctesting.Path
+		tokens   types.Tokens
+		receiver string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: vouchers minted for all tokens", func() {}, true,
+		},
+		{
+			"success: tokens unescrowed and vouchers minted",
+			func() {
+				// chainB receives back a token native to chainB
+				escrow := types.GetEscrowAddress(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				suite.Require().NoError(simapp.FundAccount(suite.chainB.GetSimApp(), suite.chainB.GetContext(), escrow, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))))
+
+				denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				tokens = append(tokens, types.NewToken(denom, "100"))
+			}, true,
+		},
+		{
+			"failure: unescrow of one token failed",
+			func() {
+				denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				tokens = append(tokens, types.NewToken(denom, "100"))
+			}, false,
+		},
+		{
+			"failure: empty tokens",
+			func() {
+				tokens = nil
+			}, false,
+		},
+		{
+			"failure: duplicated token denomination",
+			func() {
+				tokens = append(tokens, tokens[0])
+			}, false,
+		},
+		{
+			"failure: invalid receiver address",
+			func() {
+				receiver = "invalid address"
+			}, false,
+		},
+		{
+			"failure: receive disabled",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false))
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPathV2(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			receiver = suite.chainB.SenderAccount.GetAddress().String()
+			tokens = types.Tokens{
+				types.NewToken(types.NewDenom(sdk.DefaultBondDenom), "100"),
+				types.NewToken(types.NewDenom("gamm/pool/1"), "50"),
+			}
+
+			tc.malleate()
+
+			data := types.NewFungibleTokenPacketDataV2(tokens, suite.chainA.SenderAccount.GetAddress().String(), receiver, "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			// the denominations of the tokens on chainB
+			coinDenoms := make([]string, len(tokens))
+			preBalances := make([]sdk.Coin, len(tokens))
+			for i, token := range tokens {
+				if token.Denom.HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel()) {
+					coinDenoms[i] = types.NewDenom(token.Denom.Base, token.Denom.Trace[1:]...).IBCDenom()
+				} else {
+					coinDenoms[i] = types.NewDenom(token.Denom.Base, append([]types.Hop{types.NewHop(packet.GetDestPort(), packet.GetDestChannel())}, token.Denom.Trace...)...).IBCDenom()
+				}
+
+				preBalances[i] = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), coinDenoms[i])
+			}
+
+			err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacketV2(suite.chainB.GetContext(), packet, data)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				for i, token := range tokens {
+					balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), coinDenoms[i])
+					suite.Require().Equal(token.Amount, balance.Sub(preBalances[i]).Amount.String())
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestRefundPacketTokensV2 tests that all tokens of an ics20-2 packet are refunded on a
+// timeout or error acknowledgement.
+func (suite *KeeperTestSuite) TestRefundPacketTokensV2() {
+	testCases := []struct {
+		msg    string
+		refund func(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error
+	}{
+		{
+			"timeout",
+			func(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				return suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacketV2(ctx, packet, data)
+			},
+		},
+		{
+			"error acknowledgement",
+			func(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				ack := channeltypes.NewErrorAcknowledgement(fmt.Errorf("failed packet transfer"))
+				return suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacketV2(ctx, packet, data, ack)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := NewTransferPathV2(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			native := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(native)))
+
+			// the voucher was burned when sent and is minted back to the sender
+			voucherDenom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			tokens := types.Tokens{
+				types.NewToken(types.NewDenom(sdk.DefaultBondDenom), native.Amount.String()),
+				types.NewToken(voucherDenom, "50"),
+			}
+
+			data := types.NewFungibleTokenPacketDataV2(tokens, sender.String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			preBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+			err := tc.refund(suite.chainA.GetContext(), packet, data)
+			suite.Require().NoError(err)
+
+			postBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+			suite.Require().Equal(preBalance.Add(native), postBalance)
+
+			voucherBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, voucherDenom.IBCDenom())
+			suite.Require().Equal(sdk.NewInt(50), voucherBalance.Amount)
+		})
+	}
+}
@@ -27,6 +27,11 @@ func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 			denomTraceB := cdc.MustUnmarshalDenomTrace(kvB.Value)
 			return fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", denomTraceA.IBCDenom(), denomTraceB.IBCDenom())
 
+		case bytes.Equal(kvA.Key[:1], types.DenomTraceBaseDenomIndexKey),
+			bytes.Equal(kvA.Key[:1], types.DenomTraceFirstHopIndexKey),
+			bytes.Equal(kvA.Key[:1], types.DenomTraceHopIndexKey):
+			return fmt.Sprintf("DenomTrace hash A: %X\nDenomTrace hash B: %X", kvA.Value, kvB.Value)
+
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
@@ -31,6 +31,10 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.DenomTraceKey,
 				Value: app.TransferKeeper.MustMarshalDenomTrace(trace),
 			},
+			{
+				Key:   append(types.DenomTraceBaseDenomIndexPrefix(trace.BaseDenom), trace.Hash()...),
+				Value: trace.Hash(),
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -43,6 +47,7 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"PortID", fmt.Sprintf("Port A: %s\nPort B: %s", types.PortID, types.PortID)},
 		{"DenomTrace", fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", trace.IBCDenom(), trace.IBCDenom())},
+		{"DenomTraceIndex", fmt.Sprintf("DenomTrace hash A: %X\nDenomTrace hash B: %X", []byte(trace.Hash()), []byte(trace.Hash()))},
 		{"other", ""},
 	}
 
@@ -1,10 +1,14 @@
 package types
 
 import (
+	"bytes"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/ibc transfer interfaces and concrete types
@@ -39,3 +43,24 @@ func init() {
 	RegisterLegacyAminoCodec(amino)
 	amino.Seal()
 }
+
+// mustProtoMarshalJSON provides an auxiliary function to return Proto3 JSON encoded
+// bytes of a message. It matches codec.ProtoMarshalJSON with the exception that
+// EmitDefaults is set to false, so that unpopulated fields (such as an empty memo)
+// are not marshalled. This keeps the packet data of transfers without a memo identical
+// to the encoding used prior to the introduction of the memo field.
+func mustProtoMarshalJSON(msg proto.Message) []byte {
+	anyResolver := codectypes.NewInterfaceRegistry()
+	jm := &jsonpb.Marshaler{OrigName: true, EmitDefaults: false, AnyResolver: anyResolver}
+
+	if err := codectypes.UnpackInterfaces(msg, codectypes.ProtoJSONPacker{JSONPBMarshaler: jm}); err != nil {
+		panic(err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := jm.Marshal(buf, msg); err != nil {
+		panic(err)
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// TransferHooks defines the hooks which are called by the transfer keeper after a fungible token
+// transfer has been received, acknowledged or timed out. Each hook is called once for every token
+// of a packet, with the packet data of ics20-2 packets converted to FungibleTokenPacketData.
+// The local denomination is the denomination of the transferred coin on this chain, i.e. the
+// denomination received by the receiver or refunded to the sender.
+//
+// An error returned by AfterRecvTransfer fails the receive of the packet, in which case the
+// state changes of the transfer are reverted and an error acknowledgement is written.
+// AfterAckTransfer and AfterTimeoutTransfer are run in a cached context: an error returned by
+// them reverts the state changes of the hook and is logged, but does not fail the processing of
+// the acknowledgement or timeout, such that the refund of the sender cannot be blocked.
+type TransferHooks interface {
+	AfterRecvTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, localDenom string) error
+	AfterAckTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, localDenom string, ack channeltypes.Acknowledgement) error
+	AfterTimeoutTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, localDenom string) error
+}
+
+var _ TransferHooks = MultiTransferHooks{}
+
+// MultiTransferHooks combines multiple transfer hooks, all hook functions are run in array sequence.
+type MultiTransferHooks []TransferHooks
+
+// NewMultiTransferHooks creates a new MultiTransferHooks instance
+func NewMultiTransferHooks(hooks ...TransferHooks) MultiTransferHooks {
+	return hooks
+}
+
+// AfterRecvTransfer implements the TransferHooks interface.
+func (h MultiTransferHooks) AfterRecvTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, localDenom string) error {
+	for i := range h {
+		if err := h[i].AfterRecvTransfer(ctx, packet, data, localDenom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterAckTransfer implements the TransferHooks interface.
+func (h MultiTransferHooks) AfterAckTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, localDenom string, ack channeltypes.Acknowledgement) error {
+	for i := range h {
+		if err := h[i].AfterAckTransfer(ctx, packet, data, localDenom, ack); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterTimeoutTransfer implements the TransferHooks interface.
+func (h MultiTransferHooks) AfterTimeoutTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, localDenom string) error {
+	for i := range h {
+		if err := h[i].AfterTimeoutTransfer(ctx, packet, data, localDenom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
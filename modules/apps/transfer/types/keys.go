@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 )
 
 const (
@@ -15,6 +16,10 @@ const (
 	// module supports
 	Version = "ics20-1"
 
+	// V2 defines the IBC transfer version which supports transferring
+	// multiple tokens in a single packet
+	V2 = "ics20-2"
+
 	// PortID is the default port id that transfer module binds to
 	PortID = "transfer"
 
@@ -32,10 +37,19 @@ const (
 )
 
 var (
+	// SupportedVersions defines the IBC transfer versions the module supports
+	SupportedVersions = []string{V2, Version}
+
 	// PortKey defines the key to store the port ID in store
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
 	DenomTraceKey = []byte{0x02}
+	// DenomTraceBaseDenomIndexKey defines the key prefix of the index of denomination traces by base denomination
+	DenomTraceBaseDenomIndexKey = []byte{0x03}
+	// DenomTraceFirstHopIndexKey defines the key prefix of the index of denomination traces by their first hop
+	DenomTraceFirstHopIndexKey = []byte{0x04}
+	// DenomTraceHopIndexKey defines the key prefix of the index of denomination traces by each hop of their trace
+	DenomTraceHopIndexKey = []byte{0x05}
 )
 
 // GetEscrowAddress returns the escrow address for the specified channel.
@@ -53,3 +67,33 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 	hash := sha256.Sum256(preImage)
 	return hash[:20]
 }
+
+// IsSupportedVersion returns true if the provided version is a supported IBC transfer version.
+func IsSupportedVersion(version string) bool {
+	for _, supportedVersion := range SupportedVersions {
+		if version == supportedVersion {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DenomTraceBaseDenomIndexPrefix returns the store key prefix under which the hashes of the denomination
+// traces with the given base denomination are indexed. The base denomination is hashed since it may
+// contain '/'s.
+func DenomTraceBaseDenomIndexPrefix(baseDenom string) []byte {
+	return append(append([]byte{}, DenomTraceBaseDenomIndexKey...), tmhash.Sum([]byte(baseDenom))...)
+}
+
+// DenomTraceFirstHopIndexPrefix returns the store key prefix under which the hashes of the denomination
+// traces whose first hop is the given port and channel are indexed.
+func DenomTraceFirstHopIndexPrefix(portID, channelID string) []byte {
+	return append(append([]byte{}, DenomTraceFirstHopIndexKey...), GetDenomPrefix(portID, channelID)...)
+}
+
+// DenomTraceHopIndexPrefix returns the store key prefix under which the hashes of the denomination
+// traces containing the given port and channel as any of their hops are indexed.
+func DenomTraceHopIndexPrefix(portID, channelID string) []byte {
+	return append(append([]byte{}, DenomTraceHopIndexKey...), GetDenomPrefix(portID, channelID)...)
+}
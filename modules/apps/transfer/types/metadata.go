@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DenomMetadataHook defines the function used to construct the bank denomination metadata
+// registered for a voucher upon the first receipt of its denomination trace. Chains may set
+// a custom hook on the transfer keeper to override the DefaultDenomMetadata. The returned
+// metadata must use the IBC denomination of the trace as its base denomination.
+type DenomMetadataHook func(ctx sdk.Context, denomTrace DenomTrace) banktypes.Metadata
+
+// DefaultDenomMetadata returns the bank denomination metadata of the voucher represented by the
+// denomination trace. The voucher is named after its full denomination path, which is registered
+// as an alias of the IBC denomination. As the exponent of the voucher is unknown, the IBC denomination
+// is the only denomination unit and is used as the display denomination. The full denomination path
+// is not registered as an alias if it is not a valid denomination, e.g. if the base denomination
+// contains ':' or '.'.
+func DefaultDenomMetadata(_ sdk.Context, denomTrace DenomTrace) banktypes.Metadata {
+	ibcDenom := denomTrace.IBCDenom()
+	fullDenomPath := denomTrace.GetFullDenomPath()
+
+	denomUnit := &banktypes.DenomUnit{
+		Denom:    ibcDenom,
+		Exponent: 0,
+	}
+
+	if err := sdk.ValidateDenom(fullDenomPath); err == nil {
+		denomUnit.Aliases = []string{fullDenomPath}
+	}
+
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("IBC token from %s", fullDenomPath),
+		DenomUnits:  []*banktypes.DenomUnit{denomUnit},
+		Base:        ibcDenom,
+		Display:     ibcDenom,
+		Name:        fmt.Sprintf("%s IBC token", fullDenomPath),
+		Symbol:      strings.ToUpper(denomTrace.BaseDenom),
+	}
+}
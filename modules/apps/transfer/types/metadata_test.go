@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+)
+
+func TestDefaultDenomMetadata(t *testing.T) {
+	longBaseDenom := strings.Repeat("a", 128)
+
+	testCases := []struct {
+		name      string
+		fullPath  string
+		expName   string
+		expSymbol string
+		expAlias  bool // the full denomination path is registered as an alias
+	}{
+		{"single hop", "transfer/channel-0/uatom", "transfer/channel-0/uatom IBC token", "UATOM", true},
+		{"multiple hops", "transfer/channel-1/transfer/channel-0/uatom", "transfer/channel-1/transfer/channel-0/uatom IBC token", "UATOM", true},
+		{"base denom with slashes", "transfer/channel-0/gamm/pool/1", "transfer/channel-0/gamm/pool/1 IBC token", "GAMM/POOL/1", true},
+		{"base denom with colon", "transfer/channel-0/cw20:juno1abc", "transfer/channel-0/cw20:juno1abc IBC token", "CW20:JUNO1ABC", false},
+		{"base denom with dot", "transfer/channel-0/erc20.weth", "transfer/channel-0/erc20.weth IBC token", "ERC20.WETH", false},
+		{"full denom path too long", "transfer/channel-0/" + longBaseDenom, "transfer/channel-0/" + longBaseDenom + " IBC token", strings.ToUpper(longBaseDenom), false},
+	}
+
+	for _, tc := range testCases {
+		denomTrace := types.ParseDenomTrace(tc.fullPath)
+		metadata := types.DefaultDenomMetadata(sdk.Context{}, denomTrace)
+
+		require.NoError(t, metadata.Validate(), tc.name)
+		require.Equal(t, denomTrace.IBCDenom(), metadata.Base, tc.name)
+		require.Equal(t, denomTrace.IBCDenom(), metadata.Display, tc.name)
+		require.Equal(t, tc.expName, metadata.Name, tc.name)
+		require.Equal(t, tc.expSymbol, metadata.Symbol, tc.name)
+
+		if tc.expAlias {
+			require.Equal(t, []string{tc.fullPath}, metadata.DenomUnits[0].Aliases, tc.name)
+		} else {
+			require.Empty(t, metadata.DenomUnits[0].Aliases, tc.name)
+		}
+	}
+}
@@ -21,6 +21,7 @@ func NewMsgTransfer(
 	sourcePort, sourceChannel string,
 	token sdk.Coin, sender, receiver string,
 	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string,
 ) *MsgTransfer {
 	return &MsgTransfer{
 		SourcePort:       sourcePort,
@@ -30,6 +31,28 @@ func NewMsgTransfer(
 		Receiver:         receiver,
 		TimeoutHeight:    timeoutHeight,
 		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// NewMsgTransferWithTokens creates a new MsgTransfer instance transferring multiple tokens.
+// Multiple tokens may only be transferred over ics20-2 channels.
+//nolint:interfacer
+func NewMsgTransferWithTokens(
+	sourcePort, sourceChannel string,
+	tokens sdk.Coins, sender, receiver string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	memo string,
+) *MsgTransfer {
+	return &MsgTransfer{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Tokens:           tokens,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
 	}
 }
 
@@ -54,11 +77,23 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
 		return sdkerrors.Wrap(err, "invalid source channel ID")
 	}
-	if !msg.Token.IsValid() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Token.String())
-	}
-	if !msg.Token.IsPositive() {
-		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, msg.Token.String())
+	if len(msg.Tokens) > 0 {
+		if !msg.Token.IsNil() && !msg.Token.IsZero() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "cannot fill both token and tokens")
+		}
+		if len(msg.Tokens) > MaximumTokensLength {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "number of tokens must not exceed %d, got %d", MaximumTokensLength, len(msg.Tokens))
+		}
+		if err := msg.Tokens.Validate(); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+		}
+	} else {
+		if !msg.Token.IsValid() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Token.String())
+		}
+		if !msg.Token.IsPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, msg.Token.String())
+		}
 	}
 	// NOTE: sender format must be validated as it is required by the GetSigners function.
 	_, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -68,7 +103,21 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if strings.TrimSpace(msg.Receiver) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
 	}
-	return ValidateIBCDenom(msg.Token.Denom)
+	for _, token := range msg.GetCoins() {
+		if err := ValidateIBCDenom(token.Denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCoins returns the tokens to be transferred. The Tokens field is used if it is set,
+// otherwise the single Token is returned.
+func (msg MsgTransfer) GetCoins() sdk.Coins {
+	if len(msg.Tokens) > 0 {
+		return msg.Tokens
+	}
+	return sdk.Coins{msg.Token}
 }
 
 // GetSignBytes implements sdk.Msg.
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -20,15 +21,15 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		packetData FungibleTokenPacketData
 		expPass    bool
 	}{
-		{"valid packet", NewFungibleTokenPacketData(denom, amount, addr1, addr2), true},
-		{"valid packet with large amount", NewFungibleTokenPacketData(denom, largeAmount, addr1, addr2), true},
-		{"invalid denom", NewFungibleTokenPacketData("", amount, addr1, addr2), false},
-		{"invalid empty amount", NewFungibleTokenPacketData(denom, "", addr1, addr2), false},
-		{"invalid zero amount", NewFungibleTokenPacketData(denom, "0", addr1, addr2), false},
-		{"invalid negative amount", NewFungibleTokenPacketData(denom, "-1", addr1, addr2), false},
-		{"invalid large amount", NewFungibleTokenPacketData(denom, invalidLargeAmount, addr1, addr2), false},
-		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr, addr2), false},
-		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1, emptyAddr), false},
+		{"valid packet", NewFungibleTokenPacketData(denom, amount, addr1, addr2, ""), true},
+		{"valid packet with large amount", NewFungibleTokenPacketData(denom, largeAmount, addr1, addr2, ""), true},
+		{"invalid denom", NewFungibleTokenPacketData("", amount, addr1, addr2, ""), false},
+		{"invalid empty amount", NewFungibleTokenPacketData(denom, "", addr1, addr2, ""), false},
+		{"invalid zero amount", NewFungibleTokenPacketData(denom, "0", addr1, addr2, ""), false},
+		{"invalid negative amount", NewFungibleTokenPacketData(denom, "-1", addr1, addr2, ""), false},
+		{"invalid large amount", NewFungibleTokenPacketData(denom, invalidLargeAmount, addr1, addr2, ""), false},
+		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr, addr2, ""), false},
+		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1, emptyAddr, ""), false},
 	}
 
 	for i, tc := range testCases {
@@ -40,3 +41,74 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+// TestFungibleTokenPacketDataV2ValidateBasic tests ValidateBasic for FungibleTokenPacketDataV2
+func TestFungibleTokenPacketDataV2ValidateBasic(t *testing.T) {
+	token := NewToken(ExtractDenomFromPath(denom), amount)
+	otherToken := NewToken(NewDenom("uatom"), amount)
+
+	tooManyTokens := make(Tokens, MaximumTokensLength+1)
+	for i := range tooManyTokens {
+		tooManyTokens[i] = NewToken(NewDenom(fmt.Sprintf("denom%d", i)), amount)
+	}
+
+	testCases := []struct {
+		name       string
+		packetData FungibleTokenPacketDataV2
+		expPass    bool
+	}{
+		{"valid packet", NewFungibleTokenPacketDataV2(Tokens{token}, addr1, addr2, ""), true},
+		{"valid packet with multiple tokens", NewFungibleTokenPacketDataV2(Tokens{token, otherToken}, addr1, addr2, ""), true},
+		{"empty tokens", NewFungibleTokenPacketDataV2(Tokens{}, addr1, addr2, ""), false},
+		{"too many tokens", NewFungibleTokenPacketDataV2(tooManyTokens, addr1, addr2, ""), false},
+		{"duplicate tokens", NewFungibleTokenPacketDataV2(Tokens{token, token}, addr1, addr2, ""), false},
+		{"invalid token", NewFungibleTokenPacketDataV2(Tokens{NewToken(NewDenom("uatom"), "0")}, addr1, addr2, ""), false},
+		{"missing sender address", NewFungibleTokenPacketDataV2(Tokens{token}, emptyAddr, addr2, ""), false},
+		{"missing recipient address", NewFungibleTokenPacketDataV2(Tokens{token}, addr1, emptyAddr, ""), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestFungibleTokenPacketDataGetBytes tests that an empty memo is omitted from the packet data bytes
+func TestFungibleTokenPacketDataGetBytes(t *testing.T) {
+	packetData := NewFungibleTokenPacketData(denom, amount, addr1, addr2, "")
+	expected := fmt.Sprintf(`{"amount":"%s","denom":"%s","receiver":"%s","sender":"%s"}`, amount, denom, addr2, addr1)
+	require.Equal(t, expected, string(packetData.GetBytes()))
+
+	packetData = NewFungibleTokenPacketData(denom, amount, addr1, addr2, "memo")
+	expected = fmt.Sprintf(`{"amount":"%s","denom":"%s","memo":"memo","receiver":"%s","sender":"%s"}`, amount, denom, addr2, addr1)
+	require.Equal(t, expected, string(packetData.GetBytes()))
+}
+
+// TestFungibleTokenPacketDataGetCustomPacketData tests GetCustomPacketData for FungibleTokenPacketData
+func TestFungibleTokenPacketDataGetCustomPacketData(t *testing.T) {
+	testCases := []struct {
+		name    string
+		memo    string
+		expData interface{}
+	}{
+		{"key found", `{"callback": {"address": "addr"}}`, map[string]interface{}{"address": "addr"}},
+		{"key not found", `{"other": {"address": "addr"}}`, nil},
+		{"empty memo", "", nil},
+		{"memo is not json", "memo", nil},
+		{"memo is not a json object", `["callback"]`, nil},
+	}
+
+	for _, tc := range testCases {
+		packetData := NewFungibleTokenPacketData(denom, amount, addr1, addr2, tc.memo)
+		require.Equal(t, tc.expData, packetData.GetCustomPacketData("callback"), tc.name)
+		require.Equal(t, addr1, packetData.GetPacketSender(PortID), tc.name)
+
+		packetDataV2 := NewFungibleTokenPacketDataV2(Tokens{NewToken(NewDenom("uatom"), amount)}, addr1, addr2, tc.memo)
+		require.Equal(t, tc.expData, packetDataV2.GetCustomPacketData("callback"), tc.name)
+		require.Equal(t, addr1, packetDataV2.GetPacketSender(PortID), tc.name)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
 const (
@@ -18,6 +20,8 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyReceiveEnabled is store's key for ReceiveEnabled Params
 	KeyReceiveEnabled = []byte("ReceiveEnabled")
+	// KeyChannelPolicies is store's key for ChannelPolicies Params
+	KeyChannelPolicies = []byte("ChannelPolicies")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -26,10 +30,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the ibc transfer module
-func NewParams(enableSend, enableReceive bool) Params {
+func NewParams(enableSend, enableReceive bool, channelPolicies ...ChannelTransferPolicy) Params {
 	return Params{
-		SendEnabled:    enableSend,
-		ReceiveEnabled: enableReceive,
+		SendEnabled:     enableSend,
+		ReceiveEnabled:  enableReceive,
+		ChannelPolicies: channelPolicies,
 	}
 }
 
@@ -44,7 +49,11 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateEnabled(p.ReceiveEnabled)
+	if err := validateEnabled(p.ReceiveEnabled); err != nil {
+		return err
+	}
+
+	return validateChannelPolicies(p.ChannelPolicies)
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -52,6 +61,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, p.SendEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyReceiveEnabled, p.ReceiveEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyChannelPolicies, p.ChannelPolicies, validateChannelPolicies),
 	}
 }
 
@@ -63,3 +73,25 @@ func validateEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateChannelPolicies(i interface{}) error {
+	policies, ok := i.([]ChannelTransferPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, policy := range policies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+
+		channelPath := host.ChannelPath(policy.PortId, policy.ChannelId)
+		if seen[channelPath] {
+			return fmt.Errorf("duplicate transfer policy for port ID %s channel ID %s", policy.PortId, policy.ChannelId)
+		}
+		seen[channelPath] = true
+	}
+
+	return nil
+}
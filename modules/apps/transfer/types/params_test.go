@@ -7,6 +7,11 @@ import (
 )
 
 func TestValidateParams(t *testing.T) {
+	policy := NewChannelTransferPolicy("transfer", "channel-0", true, false, []string{"uusdc"}, nil)
+
 	require.NoError(t, DefaultParams().Validate())
 	require.NoError(t, NewParams(true, false).Validate())
+	require.NoError(t, NewParams(true, true, policy).Validate())
+	require.Error(t, NewParams(true, true, policy, policy).Validate(), "duplicate channel policies")
+	require.Error(t, NewParams(true, true, NewChannelTransferPolicy("transfer", "", true, true, nil, nil)).Validate(), "invalid channel policy")
 }
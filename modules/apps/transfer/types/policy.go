@@ -0,0 +1,82 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// NewChannelTransferPolicy creates a new ChannelTransferPolicy instance
+func NewChannelTransferPolicy(
+	portID, channelID string,
+	sendEnabled, receiveEnabled bool,
+	allowedDenoms, deniedDenoms []string,
+) ChannelTransferPolicy {
+	return ChannelTransferPolicy{
+		PortId:         portID,
+		ChannelId:      channelID,
+		SendEnabled:    sendEnabled,
+		ReceiveEnabled: receiveEnabled,
+		AllowedDenoms:  allowedDenoms,
+		DeniedDenoms:   deniedDenoms,
+	}
+}
+
+// Validate performs a basic validation of the ChannelTransferPolicy fields.
+func (p ChannelTransferPolicy) Validate() error {
+	if err := host.PortIdentifierValidator(p.PortId); err != nil {
+		return sdkerrors.Wrapf(err, "invalid transfer policy port ID %s", p.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return sdkerrors.Wrapf(err, "invalid transfer policy channel ID %s", p.ChannelId)
+	}
+
+	allowed := make(map[string]bool)
+	for _, denom := range p.AllowedDenoms {
+		if err := ValidatePrefixedDenom(denom); err != nil {
+			return sdkerrors.Wrapf(err, "invalid allowed denomination %s", denom)
+		}
+		if allowed[denom] {
+			return sdkerrors.Wrapf(ErrInvalidDenomForTransfer, "duplicate allowed denomination %s", denom)
+		}
+		allowed[denom] = true
+	}
+
+	denied := make(map[string]bool)
+	for _, denom := range p.DeniedDenoms {
+		if err := ValidatePrefixedDenom(denom); err != nil {
+			return sdkerrors.Wrapf(err, "invalid denied denomination %s", denom)
+		}
+		if denied[denom] {
+			return sdkerrors.Wrapf(ErrInvalidDenomForTransfer, "duplicate denied denomination %s", denom)
+		}
+		if allowed[denom] {
+			return sdkerrors.Wrapf(ErrInvalidDenomForTransfer, "denomination %s cannot be both allowed and denied", denom)
+		}
+		denied[denom] = true
+	}
+
+	return nil
+}
+
+// IsDenomAllowed returns true if the token with the provided full denomination path may be
+// transferred over the channel. The denomination is matched against both the base denomination
+// and the full denomination path. A denomination is allowed if it is not denied and either no
+// allowed denominations are set or it is one of the allowed denominations.
+func (p ChannelTransferPolicy) IsDenomAllowed(fullDenomPath string) bool {
+	baseDenom := ParseDenomTrace(fullDenomPath).BaseDenom
+	matches := func(denoms []string) bool {
+		for _, denom := range denoms {
+			if denom == baseDenom || denom == fullDenomPath {
+				return true
+			}
+		}
+		return false
+	}
+
+	if matches(p.DeniedDenoms) {
+		return false
+	}
+
+	return len(p.AllowedDenoms) == 0 || matches(p.AllowedDenoms)
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelTransferPolicyValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		policy  ChannelTransferPolicy
+		expPass bool
+	}{
+		{"valid policy", NewChannelTransferPolicy(validPort, validChannel, true, true, nil, nil), true},
+		{"valid policy with denoms", NewChannelTransferPolicy(validPort, validChannel, true, true, []string{"uusdc", denom}, []string{"stake"}), true},
+		{"invalid port ID", NewChannelTransferPolicy(invalidPort, validChannel, true, true, nil, nil), false},
+		{"invalid channel ID", NewChannelTransferPolicy(validPort, invalidChannel, true, true, nil, nil), false},
+		{"blank allowed denom", NewChannelTransferPolicy(validPort, validChannel, true, true, []string{" "}, nil), false},
+		{"invalid denied denom", NewChannelTransferPolicy(validPort, validChannel, true, true, nil, []string{"transfer/"}), false},
+		{"duplicate allowed denom", NewChannelTransferPolicy(validPort, validChannel, true, true, []string{"uusdc", "uusdc"}, nil), false},
+		{"duplicate denied denom", NewChannelTransferPolicy(validPort, validChannel, true, true, nil, []string{"stake", "stake"}), false},
+		{"denom allowed and denied", NewChannelTransferPolicy(validPort, validChannel, true, true, []string{"stake"}, []string{"stake"}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.policy.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestChannelTransferPolicyIsDenomAllowed(t *testing.T) {
+	testCases := []struct {
+		name          string
+		policy        ChannelTransferPolicy
+		fullDenomPath string
+		expAllowed    bool
+	}{
+		{"no denoms set", NewChannelTransferPolicy(validPort, validChannel, true, true, nil, nil), denom, true},
+		{"allowed by base denom", NewChannelTransferPolicy(validPort, validChannel, true, true, []string{"atom"}, nil), denom, true},
+		{"allowed by full denom path", NewChannelTransferPolicy(validPort, validChannel, true, true, []string{denom}, nil), denom, true},
+		{"not in allowed denoms", NewChannelTransferPolicy(validPort, validChannel, true, true, []string{"uusdc"}, nil), denom, false},
+		{"allowed base denom from different path", NewChannelTransferPolicy(validPort, validChannel, true, true, []string{"transfer/channel-1/atom"}, nil), denom, false},
+		{"denied by base denom", NewChannelTransferPolicy(validPort, validChannel, true, true, nil, []string{"atom"}), denom, false},
+		{"denied by full denom path", NewChannelTransferPolicy(validPort, validChannel, true, true, nil, []string{denom}), denom, false},
+		{"other denom denied", NewChannelTransferPolicy(validPort, validChannel, true, true, nil, []string{"stake"}), denom, true},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expAllowed, tc.policy.IsDenomAllowed(tc.fullDenomPath), tc.name)
+	}
+}
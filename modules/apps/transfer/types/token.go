@@ -0,0 +1,171 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// NewToken creates a new Token instance
+func NewToken(denom Denom, amount string) Token {
+	return Token{
+		Denom:  denom,
+		Amount: amount,
+	}
+}
+
+// Validate validates a token denomination and amount.
+func (t Token) Validate() error {
+	if err := t.Denom.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "invalid token denom")
+	}
+
+	amount, ok := sdk.NewIntFromString(t.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", t.Amount)
+	}
+	if !amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "amount must be strictly positive: got %d", amount)
+	}
+
+	return nil
+}
+
+// String returns the token amount followed by the full denomination path.
+func (t Token) String() string {
+	return fmt.Sprintf("%s%s", t.Amount, t.Denom.Path())
+}
+
+// Tokens defines a wrapper type for a slice of Token.
+type Tokens []Token
+
+// String returns the comma separated string representation of the tokens.
+func (t Tokens) String() string {
+	if len(t) == 0 {
+		return ""
+	}
+
+	tokens := make([]string, len(t))
+	for i, token := range t {
+		tokens[i] = token.String()
+	}
+	return strings.Join(tokens, ",")
+}
+
+// NewDenom creates a new Denom instance given the base denomination and the trace of hops
+// the denomination was sent through, starting with the most recent hop.
+func NewDenom(base string, trace ...Hop) Denom {
+	return Denom{
+		Base:  base,
+		Trace: trace,
+	}
+}
+
+// ExtractDenomFromPath returns the structured Denom of the provided full denomination path
+// as defined by ParseDenomTrace.
+//
+// Examples:
+//
+// - "transfer/channelidone/uatom" => Denom{Base: "uatom", Trace: [{transfer, channelidone}]}
+// - "transfer/channelidone/gamm/pool/1" => Denom{Base: "gamm/pool/1", Trace: [{transfer, channelidone}]}
+// - "uatom" => Denom{Base: "uatom"}
+func ExtractDenomFromPath(fullDenomPath string) Denom {
+	denomTrace := ParseDenomTrace(fullDenomPath)
+	if denomTrace.Path == "" {
+		return NewDenom(denomTrace.BaseDenom)
+	}
+
+	identifiers := strings.Split(denomTrace.Path, "/")
+	trace := make([]Hop, 0, len(identifiers)/2)
+	for i := 0; i+1 < len(identifiers); i += 2 {
+		trace = append(trace, NewHop(identifiers[i], identifiers[i+1]))
+	}
+
+	return NewDenom(denomTrace.BaseDenom, trace...)
+}
+
+// Validate performs a basic validation of the Denom fields.
+func (d Denom) Validate() error {
+	if strings.TrimSpace(d.Base) == "" {
+		return sdkerrors.Wrap(ErrInvalidDenomForTransfer, "base denomination cannot be blank")
+	}
+
+	for i, hop := range d.Trace {
+		if err := hop.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid hop at position %d", i)
+		}
+	}
+
+	return nil
+}
+
+// IsNative returns true if the denomination has no trace, i.e. the token is native to the chain.
+func (d Denom) IsNative() bool {
+	return len(d.Trace) == 0
+}
+
+// HasPrefix returns true if the most recent hop of the denomination trace matches the provided
+// port and channel identifiers.
+func (d Denom) HasPrefix(portID, channelID string) bool {
+	if d.IsNative() {
+		return false
+	}
+
+	return d.Trace[0].PortId == portID && d.Trace[0].ChannelId == channelID
+}
+
+// Path returns the full denomination path according to the ICS20 specification:
+// tracePath + "/" + baseDenom
+// If there exists no trace then the base denomination is returned.
+func (d Denom) Path() string {
+	return d.DenomTrace().GetFullDenomPath()
+}
+
+// DenomTrace returns the DenomTrace representation of the denomination.
+func (d Denom) DenomTrace() DenomTrace {
+	hops := make([]string, len(d.Trace))
+	for i, hop := range d.Trace {
+		hops[i] = hop.String()
+	}
+
+	return DenomTrace{
+		Path:      strings.Join(hops, "/"),
+		BaseDenom: d.Base,
+	}
+}
+
+// IBCDenom returns the coin denomination of the token on the chain it lives on. If the trace
+// is empty, it will return the base denomination.
+func (d Denom) IBCDenom() string {
+	return d.DenomTrace().IBCDenom()
+}
+
+// NewHop creates a new Hop instance
+func NewHop(portID, channelID string) Hop {
+	return Hop{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs a basic validation of the Hop port and channel identifiers.
+func (h Hop) Validate() error {
+	if err := host.PortIdentifierValidator(h.PortId); err != nil {
+		return sdkerrors.Wrapf(err, "invalid hop source port ID %s", h.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(h.ChannelId); err != nil {
+		return sdkerrors.Wrapf(err, "invalid hop source channel ID %s", h.ChannelId)
+	}
+
+	return nil
+}
+
+// String returns the Hop in the format:
+// <portID>/<channelID>
+func (h Hop) String() string {
+	return fmt.Sprintf("%s/%s", h.PortId, h.ChannelId)
+}
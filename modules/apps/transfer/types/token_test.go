@@ -0,0 +1,83 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractDenomFromPath(t *testing.T) {
+	testCases := []struct {
+		name     string
+		path     string
+		expDenom Denom
+	}{
+		{"native denom", "uatom", NewDenom("uatom")},
+		{"single hop", "transfer/channel-0/uatom", NewDenom("uatom", NewHop("transfer", "channel-0"))},
+		{"multiple hops", "transfer/channel-1/transfer/channel-0/uatom", NewDenom("uatom", NewHop("transfer", "channel-1"), NewHop("transfer", "channel-0"))},
+		{"base denom with slashes", "transfer/channel-0/gamm/pool/1", NewDenom("gamm/pool/1", NewHop("transfer", "channel-0"))},
+	}
+
+	for _, tc := range testCases {
+		denom := ExtractDenomFromPath(tc.path)
+		require.Equal(t, tc.expDenom, denom, tc.name)
+		require.Equal(t, tc.path, denom.Path(), tc.name)
+		require.Equal(t, ParseDenomTrace(tc.path).IBCDenom(), denom.IBCDenom(), tc.name)
+	}
+}
+
+func TestDenomValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		denom   Denom
+		expPass bool
+	}{
+		{"valid native denom", NewDenom("uatom"), true},
+		{"valid denom with trace", NewDenom("uatom", NewHop("transfer", "channel-0")), true},
+		{"empty base denom", NewDenom("", NewHop("transfer", "channel-0")), false},
+		{"invalid hop port", NewDenom("uatom", NewHop("", "channel-0")), false},
+		{"invalid hop channel", NewDenom("uatom", NewHop("transfer", "(channel)")), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.denom.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestDenomHasPrefix(t *testing.T) {
+	denom := NewDenom("uatom", NewHop("transfer", "channel-1"), NewHop("transfer", "channel-0"))
+
+	require.True(t, denom.HasPrefix("transfer", "channel-1"))
+	require.False(t, denom.HasPrefix("transfer", "channel-0"))
+	require.False(t, NewDenom("uatom").HasPrefix("transfer", "channel-1"))
+}
+
+func TestTokenValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		token   Token
+		expPass bool
+	}{
+		{"valid token", NewToken(NewDenom("uatom"), amount), true},
+		{"valid token with large amount", NewToken(NewDenom("uatom"), largeAmount), true},
+		{"invalid denom", NewToken(NewDenom(""), amount), false},
+		{"empty amount", NewToken(NewDenom("uatom"), ""), false},
+		{"zero amount", NewToken(NewDenom("uatom"), "0"), false},
+		{"negative amount", NewToken(NewDenom("uatom"), "-1"), false},
+		{"invalid large amount", NewToken(NewDenom("uatom"), invalidLargeAmount), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.token.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
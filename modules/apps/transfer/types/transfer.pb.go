@@ -91,6 +91,10 @@ type Params struct {
 	// receive_enabled enables or disables all cross-chain token transfers to this
 	// chain.
 	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty" yaml:"receive_enabled"`
+	// channel_policies defines the transfer policies of individual channels. A
+	// channel policy further restricts the transfers enabled by send_enabled and
+	// receive_enabled over the channel it applies to.
+	ChannelPolicies []ChannelTransferPolicy `protobuf:"bytes,3,rep,name=channel_policies,json=channelPolicies,proto3" json:"channel_policies" yaml:"channel_policies"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -140,9 +144,115 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
+func (m *Params) GetChannelPolicies() []ChannelTransferPolicy {
+	if m != nil {
+		return m.ChannelPolicies
+	}
+	return nil
+}
+
+// ChannelTransferPolicy defines the fungible token transfers which are enabled
+// over a single channel. Denominations are matched against either the base
+// denomination or the full denomination path of the token as represented on
+// this chain.
+type ChannelTransferPolicy struct {
+	// the port identifier of the channel the policy applies to
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// the channel identifier of the channel the policy applies to
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// send_enabled enables or disables cross-chain token transfers from this
+	// chain over the channel.
+	SendEnabled bool `protobuf:"varint,3,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty" yaml:"send_enabled"`
+	// receive_enabled enables or disables cross-chain token transfers to this
+	// chain over the channel.
+	ReceiveEnabled bool `protobuf:"varint,4,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty" yaml:"receive_enabled"`
+	// allowed_denoms, if non-empty, restricts the denominations which may be
+	// transferred over the channel to the listed denominations.
+	AllowedDenoms []string `protobuf:"bytes,5,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty" yaml:"allowed_denoms"`
+	// denied_denoms lists the denominations which may not be transferred over the
+	// channel.
+	DeniedDenoms []string `protobuf:"bytes,6,rep,name=denied_denoms,json=deniedDenoms,proto3" json:"denied_denoms,omitempty" yaml:"denied_denoms"`
+}
+
+func (m *ChannelTransferPolicy) Reset()         { *m = ChannelTransferPolicy{} }
+func (m *ChannelTransferPolicy) String() string { return proto.CompactTextString(m) }
+func (*ChannelTransferPolicy) ProtoMessage()    {}
+func (*ChannelTransferPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{2}
+}
+func (m *ChannelTransferPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelTransferPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelTransferPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelTransferPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelTransferPolicy.Merge(m, src)
+}
+func (m *ChannelTransferPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelTransferPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelTransferPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelTransferPolicy proto.InternalMessageInfo
+
+func (m *ChannelTransferPolicy) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelTransferPolicy) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelTransferPolicy) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return false
+}
+
+func (m *ChannelTransferPolicy) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
+}
+
+func (m *ChannelTransferPolicy) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *ChannelTransferPolicy) GetDeniedDenoms() []string {
+	if m != nil {
+		return m.DeniedDenoms
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DenomTrace)(nil), "ibc.applications.transfer.v1.DenomTrace")
 	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
+	proto.RegisterType((*ChannelTransferPolicy)(nil), "ibc.applications.transfer.v1.ChannelTransferPolicy")
 }
 
 func init() {
@@ -150,26 +260,38 @@ func init() {
 }
 
 var fileDescriptor_5041673e96e97901 = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0x2b, 0x31,
-	0x14, 0x86, 0x9b, 0x72, 0x29, 0xb7, 0x51, 0x14, 0xa2, 0x68, 0x29, 0x9a, 0xca, 0xac, 0x04, 0x71,
-	0x42, 0x51, 0x10, 0xba, 0x11, 0xaa, 0xee, 0xb5, 0xb8, 0x72, 0x53, 0x92, 0xcc, 0x71, 0x1a, 0x98,
-	0x4c, 0x86, 0x24, 0x1d, 0xe8, 0x23, 0xb8, 0xf3, 0xb1, 0x5c, 0x76, 0xe9, 0xaa, 0x48, 0xfb, 0x06,
-	0x7d, 0x02, 0x99, 0xb4, 0x94, 0xc1, 0xdd, 0x7f, 0xce, 0xf9, 0xbe, 0xb3, 0xf8, 0xf1, 0x95, 0x12,
-	0x92, 0xf1, 0xa2, 0xc8, 0x94, 0xe4, 0x5e, 0x99, 0xdc, 0x31, 0x6f, 0x79, 0xee, 0xde, 0xc1, 0xb2,
-	0xb2, 0xbf, 0xcb, 0x71, 0x61, 0x8d, 0x37, 0xe4, 0x4c, 0x09, 0x19, 0xd7, 0xe1, 0x78, 0x07, 0x94,
-	0xfd, 0xee, 0x71, 0x6a, 0x52, 0x13, 0x40, 0x56, 0xa5, 0x8d, 0x13, 0xdd, 0x63, 0xfc, 0x08, 0xb9,
-	0xd1, 0xaf, 0x96, 0x4b, 0x20, 0x04, 0xff, 0x2b, 0xb8, 0x9f, 0x74, 0xd0, 0x05, 0xba, 0x6c, 0x8f,
-	0x42, 0x26, 0xe7, 0x18, 0x0b, 0xee, 0x60, 0x9c, 0x54, 0x58, 0xa7, 0x19, 0x2e, 0xed, 0x6a, 0x13,
-	0xbc, 0xe8, 0x03, 0xe1, 0xd6, 0x33, 0xb7, 0x5c, 0x3b, 0x32, 0xc0, 0xfb, 0x0e, 0xf2, 0x64, 0x0c,
-	0x39, 0x17, 0x19, 0x24, 0xe1, 0xcb, 0xff, 0xe1, 0xe9, 0x7a, 0xd1, 0x3b, 0x9a, 0x71, 0x9d, 0x0d,
-	0xa2, 0xfa, 0x35, 0x1a, 0xed, 0x55, 0xe3, 0xd3, 0x66, 0x22, 0x0f, 0xf8, 0xd0, 0x82, 0x04, 0x55,
-	0xc2, 0x4e, 0x6f, 0x06, 0xbd, 0xbb, 0x5e, 0xf4, 0x4e, 0x36, 0xfa, 0x1f, 0x20, 0x1a, 0x1d, 0x6c,
-	0x37, 0xdb, 0x27, 0xc3, 0x97, 0xaf, 0x25, 0x45, 0xf3, 0x25, 0x45, 0x3f, 0x4b, 0x8a, 0x3e, 0x57,
-	0xb4, 0x31, 0x5f, 0xd1, 0xc6, 0xf7, 0x8a, 0x36, 0xde, 0xee, 0x52, 0xe5, 0x27, 0x53, 0x11, 0x4b,
-	0xa3, 0x99, 0x34, 0x4e, 0x1b, 0xc7, 0x94, 0x90, 0xd7, 0xa9, 0x61, 0xe5, 0x2d, 0xd3, 0x26, 0x99,
-	0x66, 0xe0, 0xaa, 0x9e, 0x6b, 0xfd, 0xfa, 0x59, 0x01, 0x4e, 0xb4, 0x42, 0x4d, 0x37, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x71, 0xb6, 0xa1, 0xba, 0x89, 0x01, 0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0x8f, 0x93, 0x12, 0xf0, 0xb5, 0x4d, 0xe1, 0x68, 0xa8, 0xa9, 0xc0, 0x17, 0xdd, 0x14, 0xa9,
+	0xc2, 0x56, 0x69, 0x25, 0xa4, 0x4a, 0x08, 0xe4, 0xc2, 0xd0, 0x2d, 0x58, 0x9d, 0x58, 0xa2, 0xf3,
+	0xf9, 0x48, 0x4e, 0xb2, 0x7d, 0x96, 0xcf, 0x35, 0xca, 0xc4, 0xce, 0xc4, 0xc7, 0xea, 0xd8, 0x91,
+	0xc9, 0x42, 0xc9, 0x37, 0xf0, 0xcc, 0x80, 0x7c, 0xe7, 0x84, 0xa4, 0x20, 0x24, 0xd4, 0xed, 0xbd,
+	0xdf, 0x3f, 0x5b, 0x3f, 0xdd, 0x03, 0x47, 0x3c, 0xa0, 0x2e, 0x49, 0xd3, 0x88, 0x53, 0x92, 0x73,
+	0x91, 0x48, 0x37, 0xcf, 0x48, 0x22, 0x3f, 0xb1, 0xcc, 0x2d, 0x8e, 0x57, 0xb3, 0x93, 0x66, 0x22,
+	0x17, 0xf0, 0x19, 0x0f, 0xa8, 0xb3, 0x2e, 0x76, 0x56, 0x82, 0xe2, 0xf8, 0x70, 0x7f, 0x22, 0x26,
+	0x42, 0x09, 0xdd, 0x7a, 0xd2, 0x1e, 0xfc, 0x06, 0x80, 0x77, 0x2c, 0x11, 0xf1, 0x65, 0x46, 0x28,
+	0x83, 0x10, 0x6c, 0xa5, 0x24, 0x9f, 0x5a, 0xc6, 0xc0, 0x18, 0x9a, 0xbe, 0x9a, 0xe1, 0x73, 0x00,
+	0x02, 0x22, 0xd9, 0x38, 0xac, 0x65, 0x56, 0x5b, 0x31, 0x66, 0x8d, 0x28, 0x1f, 0xfe, 0xda, 0x06,
+	0xdd, 0x11, 0xc9, 0x48, 0x2c, 0xe1, 0x19, 0xd8, 0x91, 0x2c, 0x09, 0xc7, 0x2c, 0x21, 0x41, 0xc4,
+	0x42, 0x95, 0xf2, 0xc0, 0x3b, 0xa8, 0x4a, 0xf4, 0x78, 0x46, 0xe2, 0xe8, 0x0c, 0xaf, 0xb3, 0xd8,
+	0xdf, 0xae, 0xd7, 0xf7, 0x7a, 0x83, 0xe7, 0x60, 0x2f, 0x63, 0x94, 0xf1, 0x82, 0xad, 0xec, 0x6d,
+	0x65, 0x3f, 0xac, 0x4a, 0xf4, 0x44, 0xdb, 0x6f, 0x09, 0xb0, 0xdf, 0x6b, 0x90, 0x65, 0xc8, 0x17,
+	0xf0, 0x90, 0x4e, 0x49, 0x92, 0xb0, 0x68, 0x9c, 0x8a, 0x88, 0x53, 0xce, 0xa4, 0xd5, 0x19, 0x74,
+	0x86, 0xdb, 0x2f, 0x4f, 0x9c, 0x7f, 0x75, 0xe3, 0x9c, 0x6b, 0xd7, 0x65, 0x03, 0x8d, 0x6a, 0xf3,
+	0xcc, 0x43, 0xd7, 0x25, 0x6a, 0x55, 0x25, 0x3a, 0xd0, 0x9f, 0xbf, 0x1d, 0x8d, 0xfd, 0xbd, 0x06,
+	0x1a, 0x2d, 0x91, 0x9f, 0x6d, 0xd0, 0xff, 0x6b, 0x16, 0x3c, 0x02, 0xf7, 0x53, 0x91, 0xe5, 0x63,
+	0xae, 0x6b, 0x31, 0x3d, 0x58, 0x95, 0xa8, 0xa7, 0x83, 0x1b, 0x02, 0xfb, 0xdd, 0x7a, 0xba, 0x08,
+	0xe1, 0x29, 0x00, 0xcb, 0x8f, 0x71, 0xdd, 0x83, 0xe9, 0xf5, 0xab, 0x12, 0x3d, 0xda, 0xfc, 0x91,
+	0xda, 0x62, 0x36, 0xcb, 0x45, 0xf8, 0x47, 0xfd, 0x9d, 0xbb, 0xd5, 0xbf, 0xf5, 0xdf, 0xf5, 0xbf,
+	0x05, 0x3d, 0x12, 0x45, 0xe2, 0x33, 0x0b, 0xf5, 0x63, 0x91, 0xd6, 0xbd, 0x41, 0x67, 0x68, 0x7a,
+	0x4f, 0xab, 0x12, 0xf5, 0x75, 0xc6, 0x26, 0x8f, 0xfd, 0xdd, 0x06, 0x50, 0x6f, 0x49, 0xc2, 0xd7,
+	0x60, 0x37, 0x64, 0x09, 0xff, 0x1d, 0xd0, 0x55, 0x01, 0x56, 0x55, 0xa2, 0x7d, 0x1d, 0xb0, 0x41,
+	0x63, 0x7f, 0x47, 0xef, 0xda, 0xee, 0x7d, 0xb8, 0x9e, 0xdb, 0xc6, 0xcd, 0xdc, 0x36, 0x7e, 0xcc,
+	0x6d, 0xe3, 0xdb, 0xc2, 0x6e, 0xdd, 0x2c, 0xec, 0xd6, 0xf7, 0x85, 0xdd, 0xfa, 0xf8, 0x6a, 0xc2,
+	0xf3, 0xe9, 0x55, 0xe0, 0x50, 0x11, 0xbb, 0x54, 0xc8, 0x58, 0x48, 0x97, 0x07, 0xf4, 0xc5, 0x44,
+	0xb8, 0xc5, 0xa9, 0x1b, 0x8b, 0xf0, 0x2a, 0x62, 0xb2, 0xbe, 0xb3, 0xb5, 0xfb, 0xca, 0x67, 0x29,
+	0x93, 0x41, 0x57, 0x9d, 0xc9, 0xc9, 0xaf, 0x01, 0x00, 0x2b, 0x3b, 0xcc, 0xde, 0x89, 0x03, 0x00,
+	0x00,
 }
 
 func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
@@ -229,6 +351,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChannelPolicies) > 0 {
+		for iNdEx := len(m.ChannelPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.ReceiveEnabled {
 		i--
 		if m.ReceiveEnabled {
@@ -252,6 +388,81 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChannelTransferPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelTransferPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelTransferPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeniedDenoms) > 0 {
+		for iNdEx := len(m.DeniedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedDenoms[iNdEx])
+			copy(dAtA[i:], m.DeniedDenoms[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.DeniedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTransfer(v)
 	base := offset
@@ -292,6 +503,47 @@ func (m *Params) Size() (n int) {
 	if m.ReceiveEnabled {
 		n += 2
 	}
+	if len(m.ChannelPolicies) > 0 {
+		for _, e := range m.ChannelPolicies {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelTransferPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if len(m.DeniedDenoms) > 0 {
+		for _, s := range m.DeniedDenoms {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -484,6 +736,258 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ReceiveEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelPolicies = append(m.ChannelPolicies, ChannelTransferPolicy{})
+			if err := m.ChannelPolicies[len(m.ChannelPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelTransferPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelTransferPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelTransferPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedDenoms = append(m.DeniedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransfer(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -38,7 +39,7 @@ type MsgTransfer struct {
 	SourcePort string `protobuf:"bytes,1,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty" yaml:"source_port"`
 	// the channel by which the packet will be sent
 	SourceChannel string `protobuf:"bytes,2,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty" yaml:"source_channel"`
-	// the tokens to be transferred
+	// the token to be transferred
 	Token types.Coin `protobuf:"bytes,3,opt,name=token,proto3" json:"token"`
 	// the sender address
 	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -50,6 +51,11 @@ type MsgTransfer struct {
 	// Timeout timestamp in absolute nanoseconds since unix epoch.
 	// The timeout is disabled when set to 0.
 	TimeoutTimestamp uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+	// the tokens to be transferred over an ics20-2 channel. Token must be left empty
+	// if tokens are provided.
+	Tokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=tokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens,omitempty"`
+	// optional memo
+	Memo string `protobuf:"bytes,9,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
 func (m *MsgTransfer) Reset()         { *m = MsgTransfer{} }
@@ -132,38 +138,43 @@ func init() {
 }
 
 var fileDescriptor_7401ed9bed2f8e09 = []byte{
-	// 494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x92, 0x86, 0x70, 0x51, 0x2b, 0x30, 0xb4, 0x72, 0xa3, 0x62, 0x47, 0x96, 0x90,
-	0xc2, 0xc0, 0x9d, 0x5c, 0x40, 0x95, 0x3a, 0xa1, 0x74, 0x81, 0xa1, 0x12, 0x58, 0x9d, 0x58, 0x8a,
-	0x7d, 0x3d, 0x9c, 0x13, 0xf1, 0x3d, 0xeb, 0xee, 0x62, 0xd1, 0x6f, 0xc0, 0xc8, 0x47, 0xe8, 0xcc,
-	0x27, 0xe9, 0xd8, 0x91, 0x29, 0x42, 0xc9, 0xc2, 0x9c, 0x4f, 0x80, 0xce, 0xbe, 0x84, 0x64, 0x41,
-	0x4c, 0xf6, 0x7b, 0xff, 0xdf, 0xbb, 0xbf, 0xde, 0xbd, 0x77, 0xe8, 0x19, 0xcf, 0x28, 0x49, 0xcb,
-	0x72, 0xc2, 0x69, 0xaa, 0x39, 0x08, 0x45, 0xb4, 0x4c, 0x85, 0xfa, 0xcc, 0x24, 0xa9, 0x62, 0xa2,
-	0xbf, 0xe2, 0x52, 0x82, 0x06, 0xef, 0x88, 0x67, 0x14, 0x6f, 0x62, 0x78, 0x85, 0xe1, 0x2a, 0xee,
-	0x3f, 0xc9, 0x21, 0x87, 0x1a, 0x24, 0xe6, 0xaf, 0xa9, 0xe9, 0x07, 0x14, 0x54, 0x01, 0x8a, 0x64,
-	0xa9, 0x62, 0xa4, 0x8a, 0x33, 0xa6, 0xd3, 0x98, 0x50, 0xe0, 0xc2, 0xea, 0xa1, 0xb1, 0xa6, 0x20,
-	0x19, 0xa1, 0x13, 0xce, 0x84, 0x36, 0x86, 0xcd, 0x5f, 0x03, 0x44, 0x3f, 0x5a, 0xa8, 0x77, 0xae,
-	0xf2, 0x0b, 0xeb, 0xe4, 0x9d, 0xa0, 0x9e, 0x82, 0xa9, 0xa4, 0xec, 0xb2, 0x04, 0xa9, 0x7d, 0x77,
-	0xe0, 0x0e, 0x1f, 0x8c, 0x0e, 0x96, 0xb3, 0xd0, 0xbb, 0x4e, 0x8b, 0xc9, 0x69, 0xb4, 0x21, 0x46,
-	0x09, 0x6a, 0xa2, 0xf7, 0x20, 0xb5, 0xf7, 0x06, 0xed, 0x59, 0x8d, 0x8e, 0x53, 0x21, 0xd8, 0xc4,
-	0xbf, 0x57, 0xd7, 0x1e, 0x2e, 0x67, 0xe1, 0xfe, 0x56, 0xad, 0xd5, 0xa3, 0x64, 0xb7, 0x49, 0x9c,
-	0x35, 0xb1, 0xf7, 0x1a, 0xed, 0x68, 0xf8, 0xc2, 0x84, 0xdf, 0x1a, 0xb8, 0xc3, 0xde, 0xf1, 0x21,
-	0x6e, 0x7a, 0xc3, 0xa6, 0x37, 0x6c, 0x7b, 0xc3, 0x67, 0xc0, 0xc5, 0xa8, 0x7d, 0x3b, 0x0b, 0x9d,
-	0xa4, 0xa1, 0xbd, 0x03, 0xd4, 0x51, 0x4c, 0x5c, 0x31, 0xe9, 0xb7, 0x8d, 0x61, 0x62, 0x23, 0xaf,
-	0x8f, 0xba, 0x92, 0x51, 0xc6, 0x2b, 0x26, 0xfd, 0x9d, 0x5a, 0x59, 0xc7, 0xde, 0x27, 0xb4, 0xa7,
-	0x79, 0xc1, 0x60, 0xaa, 0x2f, 0xc7, 0x8c, 0xe7, 0x63, 0xed, 0x77, 0x6a, 0xcf, 0x3e, 0x36, 0x33,
-	0x30, 0xf7, 0x85, 0xed, 0x2d, 0x55, 0x31, 0x7e, 0x5b, 0x13, 0xa3, 0xa7, 0xc6, 0xf4, 0x6f, 0x33,
-	0xdb, 0xf5, 0x51, 0xb2, 0x6b, 0x13, 0x0d, 0xed, 0xbd, 0x43, 0x8f, 0x56, 0x84, 0xf9, 0x2a, 0x9d,
-	0x16, 0xa5, 0x7f, 0x7f, 0xe0, 0x0e, 0xdb, 0xa3, 0xa3, 0xe5, 0x2c, 0xf4, 0xb7, 0x0f, 0x59, 0x23,
-	0x51, 0xf2, 0xd0, 0xe6, 0x2e, 0x56, 0xa9, 0xd3, 0xee, 0xb7, 0x9b, 0xd0, 0xf9, 0x7d, 0x13, 0x3a,
-	0xd1, 0x3e, 0x7a, 0xbc, 0x31, 0xab, 0x84, 0xa9, 0x12, 0x84, 0x62, 0xc7, 0x80, 0x5a, 0xe7, 0x2a,
-	0xf7, 0xc6, 0xa8, 0xbb, 0x1e, 0xe3, 0x73, 0xfc, 0xaf, 0x65, 0xc2, 0x1b, 0xa7, 0xf4, 0xe3, 0xff,
-	0x46, 0x57, 0x86, 0xa3, 0x0f, 0xb7, 0xf3, 0xc0, 0xbd, 0x9b, 0x07, 0xee, 0xaf, 0x79, 0xe0, 0x7e,
-	0x5f, 0x04, 0xce, 0xdd, 0x22, 0x70, 0x7e, 0x2e, 0x02, 0xe7, 0xe3, 0x49, 0xce, 0xf5, 0x78, 0x9a,
-	0x61, 0x0a, 0x05, 0xb1, 0xab, 0xc9, 0x33, 0xfa, 0x22, 0x07, 0x52, 0xbd, 0x22, 0x05, 0x5c, 0x4d,
-	0x27, 0x4c, 0x99, 0xa7, 0xb0, 0xf1, 0x04, 0xf4, 0x75, 0xc9, 0x54, 0xd6, 0xa9, 0xd7, 0xf1, 0xe5,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x6e, 0x0e, 0x0d, 0x2c, 0x03, 0x00, 0x00,
+	// 563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xb6, 0xff, 0xa4, 0xf9, 0xd3, 0x8d, 0x1a, 0x95, 0x85, 0x56, 0x6e, 0x54, 0xec, 0xc8, 0x12,
+	0x28, 0x48, 0x74, 0x57, 0x2e, 0xa0, 0x4a, 0x3d, 0xa1, 0x94, 0x03, 0x1c, 0x2a, 0x81, 0xd5, 0x13,
+	0x97, 0x62, 0x6f, 0x16, 0x67, 0xd5, 0xd8, 0x6b, 0x79, 0x37, 0x86, 0xbc, 0x01, 0x47, 0x1e, 0xa1,
+	0x67, 0x9e, 0xa4, 0x12, 0x97, 0x1e, 0x39, 0x19, 0x94, 0x5c, 0x50, 0x8f, 0x79, 0x02, 0xb4, 0xf6,
+	0x26, 0x4d, 0x84, 0x54, 0x71, 0xda, 0x9d, 0xf9, 0xbe, 0x99, 0x6f, 0x67, 0x76, 0x06, 0x3c, 0x62,
+	0x21, 0xc1, 0x41, 0x9a, 0x8e, 0x18, 0x09, 0x24, 0xe3, 0x89, 0xc0, 0x32, 0x0b, 0x12, 0xf1, 0x91,
+	0x66, 0x38, 0xf7, 0xb0, 0xfc, 0x8c, 0xd2, 0x8c, 0x4b, 0x0e, 0xf7, 0x59, 0x48, 0xd0, 0x2a, 0x0d,
+	0x2d, 0x68, 0x28, 0xf7, 0x3a, 0x0f, 0x22, 0x1e, 0xf1, 0x92, 0x88, 0xd5, 0xad, 0x8a, 0xe9, 0xd8,
+	0x84, 0x8b, 0x98, 0x0b, 0x1c, 0x06, 0x82, 0xe2, 0xdc, 0x0b, 0xa9, 0x0c, 0x3c, 0x4c, 0x38, 0x4b,
+	0x34, 0xee, 0x28, 0x69, 0xc2, 0x33, 0x8a, 0xc9, 0x88, 0xd1, 0x44, 0x2a, 0xc1, 0xea, 0x56, 0x11,
+	0xdc, 0xef, 0x75, 0xd0, 0x3a, 0x15, 0xd1, 0x99, 0x56, 0x82, 0x47, 0xa0, 0x25, 0xf8, 0x38, 0x23,
+	0xf4, 0x3c, 0xe5, 0x99, 0xb4, 0xcc, 0xae, 0xd9, 0xdb, 0xec, 0xef, 0xce, 0x0b, 0x07, 0x4e, 0x82,
+	0x78, 0x74, 0xec, 0xae, 0x80, 0xae, 0x0f, 0x2a, 0xeb, 0x2d, 0xcf, 0x24, 0x7c, 0x09, 0xda, 0x1a,
+	0x23, 0xc3, 0x20, 0x49, 0xe8, 0xc8, 0xfa, 0xaf, 0x8c, 0xdd, 0x9b, 0x17, 0xce, 0xce, 0x5a, 0xac,
+	0xc6, 0x5d, 0x7f, 0xab, 0x72, 0x9c, 0x54, 0x36, 0x7c, 0x01, 0x36, 0x24, 0xbf, 0xa0, 0x89, 0x55,
+	0xeb, 0x9a, 0xbd, 0xd6, 0xe1, 0x1e, 0xaa, 0x6a, 0x43, 0xaa, 0x36, 0xa4, 0x6b, 0x43, 0x27, 0x9c,
+	0x25, 0xfd, 0xfa, 0x55, 0xe1, 0x18, 0x7e, 0xc5, 0x86, 0xbb, 0xa0, 0x21, 0x68, 0x32, 0xa0, 0x99,
+	0x55, 0x57, 0x82, 0xbe, 0xb6, 0x60, 0x07, 0x34, 0x33, 0x4a, 0x28, 0xcb, 0x69, 0x66, 0x6d, 0x94,
+	0xc8, 0xd2, 0x86, 0x1f, 0x40, 0x5b, 0xb2, 0x98, 0xf2, 0xb1, 0x3c, 0x1f, 0x52, 0x16, 0x0d, 0xa5,
+	0xd5, 0x28, 0x35, 0x3b, 0x48, 0xfd, 0x81, 0xea, 0x17, 0xd2, 0x5d, 0xca, 0x3d, 0xf4, 0xba, 0x64,
+	0xf4, 0x1f, 0x2a, 0xd1, 0xdb, 0x62, 0xd6, 0xe3, 0x5d, 0x7f, 0x4b, 0x3b, 0x2a, 0x36, 0x7c, 0x03,
+	0xee, 0x2d, 0x18, 0xea, 0x14, 0x32, 0x88, 0x53, 0xeb, 0xff, 0xae, 0xd9, 0xab, 0xf7, 0xf7, 0xe7,
+	0x85, 0x63, 0xad, 0x27, 0x59, 0x52, 0x5c, 0x7f, 0x5b, 0xfb, 0xce, 0x16, 0x2e, 0xf8, 0x09, 0x34,
+	0xca, 0x4a, 0x85, 0xd5, 0xec, 0xd6, 0xee, 0x6e, 0xcc, 0x2b, 0xf5, 0xc6, 0x9b, 0xc2, 0xd9, 0xae,
+	0x02, 0x9e, 0xf2, 0x98, 0x49, 0x1a, 0xa7, 0x72, 0xf2, 0xed, 0xa7, 0xd3, 0x8b, 0x98, 0x1c, 0x8e,
+	0x43, 0x44, 0x78, 0x8c, 0xf5, 0xd4, 0x54, 0xc7, 0x81, 0x18, 0x5c, 0x60, 0x39, 0x49, 0xa9, 0x28,
+	0x93, 0x08, 0x5f, 0xcb, 0xc1, 0xc7, 0xa0, 0x1e, 0xd3, 0x98, 0x5b, 0x9b, 0xe5, 0x47, 0xc2, 0x9b,
+	0xc2, 0x69, 0x2b, 0xfb, 0x36, 0xab, 0x5f, 0xe2, 0xc7, 0xcd, 0x2f, 0x97, 0x8e, 0xf1, 0xfb, 0xd2,
+	0x31, 0xdc, 0x1d, 0x70, 0x7f, 0x65, 0x98, 0x7c, 0x2a, 0x52, 0x9e, 0x08, 0x7a, 0xc8, 0x41, 0xed,
+	0x54, 0x44, 0x70, 0x08, 0x9a, 0xcb, 0x39, 0x7b, 0x82, 0xee, 0x9a, 0x76, 0xb4, 0x92, 0xa5, 0xe3,
+	0xfd, 0x33, 0x75, 0x21, 0xd8, 0x7f, 0x77, 0x35, 0xb5, 0xcd, 0xeb, 0xa9, 0x6d, 0xfe, 0x9a, 0xda,
+	0xe6, 0xd7, 0x99, 0x6d, 0x5c, 0xcf, 0x6c, 0xe3, 0xc7, 0xcc, 0x36, 0xde, 0x1f, 0xfd, 0xdd, 0x05,
+	0x16, 0x92, 0x83, 0x88, 0xe3, 0xfc, 0x39, 0x8e, 0xf9, 0x60, 0x3c, 0xa2, 0x42, 0xed, 0xea, 0xca,
+	0x8e, 0x96, 0xad, 0x09, 0x1b, 0xe5, 0xbe, 0x3c, 0xfb, 0x33, 0x00, 0xfc, 0x39, 0xc0, 0x35, 0xcd,
+	0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -268,6 +279,27 @@ func (m *MsgTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.TimeoutTimestamp != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
 		i--
@@ -387,6 +419,16 @@ func (m *MsgTransfer) Size() (n int) {
 	if m.TimeoutTimestamp != 0 {
 		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
 	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -647,6 +689,72 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, types.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
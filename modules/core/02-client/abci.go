@@ -8,8 +8,11 @@ import (
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 )
 
-// BeginBlocker updates an existing localhost client with the latest block height.
+// BeginBlocker updates an existing localhost client with the latest block height
+// and checks a bounded number of clients for expiry.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.CheckClientExpiry(ctx)
+
 	plan, found := k.GetUpgradePlan(ctx)
 	if found {
 		// Once we are at the last block this chain will commit, set the upgraded consensus state
@@ -21,9 +21,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryClientStates(),
 		GetCmdQueryClientState(),
 		GetCmdQueryClientStatus(),
+		GetCmdQueryClientHealth(),
 		GetCmdQueryConsensusStates(),
 		GetCmdQueryConsensusStateHeights(),
 		GetCmdQueryConsensusState(),
+		GetCmdQueryConsensusStateAtTime(),
+		GetCmdQueryUpdateInfo(),
 		GetCmdQueryHeader(),
 		GetCmdSelfConsensusState(),
 		GetCmdParams(),
@@ -3,6 +3,8 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -122,6 +124,41 @@ func GetCmdQueryClientStatus() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryClientHealth defines the command to query the health of a client with a given id
+func GetCmdQueryClientHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "health [client-id]",
+		Short:   "Query client health",
+		Long:    "Query client status, remaining time until expiry and update statistics",
+		Example: fmt.Sprintf("%s query %s %s health [client-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientID := args[0]
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryClientHealthRequest{
+				ClientId: clientID,
+			}
+
+			res, err := queryClient.ClientHealth(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryConsensusStates defines the command to query all the consensus states from a given
 // client state.
 func GetCmdQueryConsensusStates() *cobra.Command {
@@ -255,6 +292,97 @@ If the '--latest' flag is included, the query returns the latest consensus state
 	return cmd
 }
 
+// GetCmdQueryUpdateInfo defines the command to query the update info recorded for the consensus
+// state of a client at a given height.
+func GetCmdQueryUpdateInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-info [client-id] [height]",
+		Short:   "Query the update info of a client consensus state at a given height",
+		Long:    "Query the submitter address, transaction hash and header hash of the client update which created the consensus state at a given height.",
+		Example: fmt.Sprintf("%s query %s %s update-info [client-id] [height]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientID := args[0]
+
+			height, err := types.ParseHeight(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryUpdateInfoRequest{
+				ClientId:       clientID,
+				RevisionNumber: height.GetRevisionNumber(),
+				RevisionHeight: height.GetRevisionHeight(),
+			}
+
+			res, err := queryClient.UpdateInfo(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConsensusStateAtTime defines the command to query the latest consensus state of a client
+// whose timestamp is at or before a given time.
+func GetCmdQueryConsensusStateAtTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus-state-at-time [client-id] [timestamp]",
+		Short: "Query the latest consensus state of a client at or before a given time",
+		Long: `Query the latest consensus state for a particular light client whose timestamp is at or before the provided time.
+The timestamp may be provided either as a unix timestamp in nanoseconds or in RFC 3339 format.`,
+		Example: fmt.Sprintf("%s query %s %s consensus-state-at-time [client-id] 2022-06-01T15:04:05Z", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientID := args[0]
+
+			timestamp, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				t, err := time.Parse(time.RFC3339Nano, args[1])
+				if err != nil {
+					return fmt.Errorf("timestamp must be a unix timestamp in nanoseconds or in RFC 3339 format: %w", err)
+				}
+
+				timestamp = uint64(t.UnixNano())
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsensusStateAtTimeRequest{
+				ClientId:  clientID,
+				Timestamp: timestamp,
+			}
+
+			res, err := queryClient.ConsensusStateAtTime(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryHeader defines the command to query the latest header on the chain
 func GetCmdQueryHeader() *cobra.Command {
 	cmd := &cobra.Command{
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
@@ -33,6 +34,27 @@ func QueryClientState(
 	return queryClient.ClientState(context.Background(), req)
 }
 
+// QueryClientLatestHeight returns the latest height of the client with the provided identifier using the gRPC
+// query client.
+func QueryClientLatestHeight(clientCtx client.Context, clientID string) (types.Height, error) {
+	res, err := QueryClientState(clientCtx, clientID, false)
+	if err != nil {
+		return types.Height{}, err
+	}
+
+	clientState, err := types.UnpackClientState(res.ClientState)
+	if err != nil {
+		return types.Height{}, err
+	}
+
+	height, ok := clientState.GetLatestHeight().(types.Height)
+	if !ok {
+		return types.Height{}, sdkerrors.Wrapf(types.ErrInvalidHeight, "invalid height type %T", clientState.GetLatestHeight())
+	}
+
+	return height, nil
+}
+
 // QueryClientStateABCI queries the store to get the light client state and a merkle proof.
 func QueryClientStateABCI(
 	clientCtx client.Context, clientID string,
@@ -142,26 +164,58 @@ func QueryTendermintHeader(clientCtx client.Context) (ibctmtypes.Header, int64,
 		return ibctmtypes.Header{}, 0, err
 	}
 
+	protoCommit := commit.SignedHeader.ToProto()
+	protoValset, err := QueryTendermintValidatorSet(clientCtx, height)
+	if err != nil {
+		return ibctmtypes.Header{}, 0, err
+	}
+
+	header := ibctmtypes.Header{
+		SignedHeader: protoCommit,
+		ValidatorSet: protoValset,
+	}
+
+	return header, height, nil
+}
+
+// QueryTendermintValidatorSet takes a client context and returns the tendermint validator set
+// at the provided height
+func QueryTendermintValidatorSet(clientCtx client.Context, height int64) (*tmproto.ValidatorSet, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
 	page := 1
 	count := 10_000
 
 	validators, err := node.Validators(context.Background(), &height, &page, &count)
 	if err != nil {
-		return ibctmtypes.Header{}, 0, err
+		return nil, err
 	}
 
-	protoCommit := commit.SignedHeader.ToProto()
-	protoValset, err := tmtypes.NewValidatorSet(validators.Validators).ToProto()
+	return tmtypes.NewValidatorSet(validators.Validators).ToProto()
+}
+
+// QueryTendermintUpdateHeader takes a client context and returns the tendermint header at the
+// latest height, or at the context height if set, along with the validator set trusted at the
+// provided trusted height, such that it can be used to update a client at the trusted height.
+func QueryTendermintUpdateHeader(clientCtx client.Context, trustedHeight types.Height) (ibctmtypes.Header, error) {
+	header, _, err := QueryTendermintHeader(clientCtx)
 	if err != nil {
-		return ibctmtypes.Header{}, 0, err
+		return ibctmtypes.Header{}, err
 	}
 
-	header := ibctmtypes.Header{
-		SignedHeader: protoCommit,
-		ValidatorSet: protoValset,
+	// the validator set trusted at a height is the next validator set of the trusted header
+	trustedValidators, err := QueryTendermintValidatorSet(clientCtx, int64(trustedHeight.RevisionHeight)+1)
+	if err != nil {
+		return ibctmtypes.Header{}, err
 	}
 
-	return header, height, nil
+	header.TrustedHeight = trustedHeight
+	header.TrustedValidators = trustedValidators
+
+	return header, nil
 }
 
 // QuerySelfConsensusState takes a client context and returns the appropriate
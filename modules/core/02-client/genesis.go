@@ -45,6 +45,30 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 		}
 	}
 
+	for _, cui := range gs.ClientsUpdateInfos {
+		for _, ui := range cui.UpdateInfos {
+			k.SetUpdateInfo(ctx, cui.ClientId, ui.Height, ui.UpdateInfo)
+		}
+	}
+
+	for _, cus := range gs.ClientsUpdateStats {
+		k.SetClientUpdateStats(ctx, cus.ClientId, cus.UpdateStats)
+	}
+
+	// run the client module genesis hooks once all client data has been written
+	for _, client := range gs.Clients {
+		cs := client.ClientState.GetCachedValue().(exported.ClientState)
+
+		clientModule, found := k.GetClientModule(cs.ClientType())
+		if !found {
+			panic(fmt.Sprintf("no client module registered for client type %s", cs.ClientType()))
+		}
+
+		if err := clientModule.InitGenesis(ctx, k.ClientStore(ctx, client.ClientId), cs); err != nil {
+			panic(fmt.Sprintf("failed to initialize genesis for client %s: %s", client.ClientId, err))
+		}
+	}
+
 	k.SetNextClientSequence(ctx, gs.NextClientSequence)
 
 	// NOTE: localhost creation is specifically disallowed for the time being.
@@ -67,5 +91,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		Params:             k.GetParams(ctx),
 		CreateLocalhost:    false,
 		NextClientSequence: k.GetNextClientSequence(ctx),
+		ClientsUpdateInfos: k.GetAllUpdateInfos(ctx),
+		ClientsUpdateStats: k.GetAllClientUpdateStats(ctx),
 	}
 }
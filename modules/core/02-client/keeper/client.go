@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"encoding/hex"
+	"fmt"
+	"strings"
 
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -9,7 +11,9 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 )
 
 // CreateClient creates a new client state and populates it with a given consensus
@@ -25,6 +29,11 @@ func (k Keeper) CreateClient(
 		)
 	}
 
+	clientModule, found := k.GetClientModule(clientState.ClientType())
+	if !found {
+		return "", sdkerrors.Wrapf(types.ErrClientModuleNotFound, "cannot create client of type %s", clientState.ClientType())
+	}
+
 	clientID := k.GenerateClientIdentifier(ctx, clientState.ClientType())
 
 	k.SetClientState(ctx, clientID, clientState)
@@ -32,7 +41,7 @@ func (k Keeper) CreateClient(
 
 	// verifies initial consensus state against client state and initializes client store with any client-specific metadata
 	// e.g. set ProcessedTime in Tendermint clients
-	if err := clientState.Initialize(ctx, k.cdc, k.ClientStore(ctx, clientID), consensusState); err != nil {
+	if err := clientModule.Initialize(ctx, k.cdc, k.ClientStore(ctx, clientID), clientState, consensusState); err != nil {
 		return "", err
 	}
 
@@ -63,15 +72,20 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot update client with ID %s", clientID)
 	}
 
+	clientModule, found := k.GetClientModule(clientState.ClientType())
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClientModuleNotFound, "cannot update client with ID %s of type %s", clientID, clientState.ClientType())
+	}
+
 	clientStore := k.ClientStore(ctx, clientID)
 
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+	if status := clientModule.Status(ctx, k.cdc, clientStore, clientState); status != exported.Active {
 		return sdkerrors.Wrapf(types.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
 	}
 
 	// Any writes made in CheckHeaderAndUpdateState are persisted on both valid updates and misbehaviour updates.
 	// Light client implementations are responsible for writing the correct metadata (if any) in either case.
-	newClientState, newConsensusState, err := clientState.CheckHeaderAndUpdateState(ctx, k.cdc, clientStore, header)
+	newClientState, newConsensusState, err := clientModule.CheckHeaderAndUpdateState(ctx, k.cdc, clientStore, clientState, header)
 	if err != nil {
 		return sdkerrors.Wrapf(err, "cannot update client with ID %s", clientID)
 	}
@@ -96,7 +110,7 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 	// If client state is not frozen after clientState CheckHeaderAndUpdateState,
 	// then update was valid. Write the update state changes, and set new consensus state.
 	// Else the update was proof of misbehaviour and we must emit appropriate misbehaviour events.
-	if status := newClientState.Status(ctx, clientStore, k.cdc); status != exported.Frozen {
+	if status := clientModule.Status(ctx, k.cdc, clientStore, newClientState); status != exported.Frozen {
 		// if update is not misbehaviour then update the consensus state
 		// we don't set consensus state for localhost client
 		if header != nil && clientID != exported.Localhost {
@@ -153,14 +167,19 @@ func (k Keeper) UpgradeClient(ctx sdk.Context, clientID string, upgradedClient e
 		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot update client with ID %s", clientID)
 	}
 
+	clientModule, found := k.GetClientModule(clientState.ClientType())
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClientModuleNotFound, "cannot upgrade client with ID %s of type %s", clientID, clientState.ClientType())
+	}
+
 	clientStore := k.ClientStore(ctx, clientID)
 
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+	if status := clientModule.Status(ctx, k.cdc, clientStore, clientState); status != exported.Active {
 		return sdkerrors.Wrapf(types.ErrClientNotActive, "cannot upgrade client (%s) with status %s", clientID, status)
 	}
 
-	updatedClientState, updatedConsState, err := clientState.VerifyUpgradeAndUpdateState(ctx, k.cdc, clientStore,
-		upgradedClient, upgradedConsState, proofUpgradeClient, proofUpgradeConsState)
+	updatedClientState, updatedConsState, err := clientModule.VerifyUpgradeAndUpdateState(ctx, k.cdc, clientStore,
+		clientState, upgradedClient, upgradedConsState, proofUpgradeClient, proofUpgradeConsState)
 	if err != nil {
 		return sdkerrors.Wrapf(err, "cannot upgrade client with ID %s", clientID)
 	}
@@ -195,9 +214,14 @@ func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour ex
 		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot check misbehaviour for client with ID %s", misbehaviour.GetClientID())
 	}
 
+	clientModule, found := k.GetClientModule(clientState.ClientType())
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClientModuleNotFound, "cannot check misbehaviour for client with ID %s of type %s", misbehaviour.GetClientID(), clientState.ClientType())
+	}
+
 	clientStore := k.ClientStore(ctx, misbehaviour.GetClientID())
 
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+	if status := clientModule.Status(ctx, k.cdc, clientStore, clientState); status != exported.Active {
 		return sdkerrors.Wrapf(types.ErrClientNotActive, "cannot process misbehaviour for client (%s) with status %s", misbehaviour.GetClientID(), status)
 	}
 
@@ -205,7 +229,7 @@ func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour ex
 		return err
 	}
 
-	clientState, err := clientState.CheckMisbehaviourAndUpdateState(ctx, k.cdc, clientStore, misbehaviour)
+	clientState, err := clientModule.CheckMisbehaviourAndUpdateState(ctx, k.cdc, clientStore, clientState, misbehaviour)
 	if err != nil {
 		return err
 	}
@@ -228,3 +252,107 @@ func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour ex
 
 	return nil
 }
+
+// CheckClientExpiry checks the expiry of at most MaxClientExpiryChecksPerBlock clients, resuming after the
+// last client checked in the previous call and starting over once every client has been checked. The update
+// statistics of expired clients are deleted and a telemetry gauge with the remaining time (in seconds) until
+// expiry is set for every active tendermint client which has less than 1/ClientExpiryGaugeTrustingPeriodDivisor
+// of its trusting period remaining. The cursor is only written when it changes, such that no state is written
+// in blocks where every client is checked.
+// Client states which cannot be decoded are skipped so that this function is safe to call in BeginBlock.
+func (k Keeper) CheckClientExpiry(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	prevCursor := string(store.Get([]byte(types.KeyClientExpiryCursor)))
+	cursor := prevCursor
+
+	for i := 0; i < types.MaxClientExpiryChecksPerBlock; i++ {
+		clientID, found := k.nextClientID(ctx, cursor)
+		if !found {
+			// every client has been checked, the next call starts over from the first client
+			cursor = ""
+			break
+		}
+
+		k.checkClientExpiry(ctx, clientID)
+		cursor = clientID
+	}
+
+	switch {
+	case cursor == prevCursor:
+		return
+	case cursor == "":
+		store.Delete([]byte(types.KeyClientExpiryCursor))
+	default:
+		store.Set([]byte(types.KeyClientExpiryCursor), []byte(cursor))
+	}
+}
+
+// nextClientID returns the identifier of the first client stored after the client with the provided
+// identifier. The first stored client is returned if the provided identifier is empty.
+func (k Keeper) nextClientID(ctx sdk.Context, clientID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	clientStorePrefix := []byte(fmt.Sprintf("%s/", host.KeyClientStorePrefix))
+
+	// skip over every key stored under the provided client
+	start := clientStorePrefix
+	if clientID != "" {
+		start = sdk.PrefixEndBytes([]byte(fmt.Sprintf("%s/%s/", host.KeyClientStorePrefix, clientID)))
+	}
+
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(clientStorePrefix))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return "", false
+	}
+
+	// key is clients/{clientid}/...
+	keySplit := strings.Split(string(iterator.Key()), "/")
+	return keySplit[1], true
+}
+
+// checkClientExpiry deletes the update statistics of the provided client if it is expired and sets the
+// time until expiry telemetry gauge if it is an active tendermint client close to expiry.
+func (k Keeper) checkClientExpiry(ctx sdk.Context, clientID string) {
+	bz := k.ClientStore(ctx, clientID).Get(host.ClientStateKey())
+	if bz == nil {
+		return
+	}
+
+	clientState, err := k.UnmarshalClientState(bz)
+	if err != nil {
+		return
+	}
+
+	status := k.GetClientStatus(ctx, clientState, clientID)
+	if status == exported.Expired {
+		if _, found := k.GetClientUpdateStats(ctx, clientID); found {
+			k.DeleteClientUpdateStats(ctx, clientID)
+		}
+
+		return
+	}
+
+	if status != exported.Active {
+		return
+	}
+
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return
+	}
+
+	timeUntilExpiry, found := k.GetClientTimeUntilExpiry(ctx, clientID)
+	if !found || timeUntilExpiry >= tmClientState.TrustingPeriod/types.ClientExpiryGaugeTrustingPeriodDivisor {
+		return
+	}
+
+	telemetry.SetGaugeWithLabels(
+		[]string{"ibc", "client", "time_until_expiry"},
+		float32(timeUntilExpiry.Seconds()),
+		[]metrics.Label{
+			telemetry.NewLabel(types.LabelClientType, clientState.ClientType()),
+			telemetry.NewLabel(types.LabelClientID, clientID),
+		},
+	)
+}
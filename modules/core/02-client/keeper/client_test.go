@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"time"
@@ -8,10 +9,14 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	"github.com/cosmos/ibc-go/v4/modules/core/02-client/keeper"
 	"github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	solomachine "github.com/cosmos/ibc-go/v4/modules/light-clients/06-solomachine"
+	ibctm "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint"
 	ibctmtypes "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 	localhosttypes "github.com/cosmos/ibc-go/v4/modules/light-clients/09-localhost/types"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
@@ -41,6 +46,37 @@ func (suite *KeeperTestSuite) TestCreateClient() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestClientModuleNotRegistered() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+
+	header, err := suite.chainA.ConstructUpdateTMClientHeader(suite.chainB, path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+
+	app := suite.chainA.GetSimApp()
+	clientState := ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false)
+
+	// client router without the 07-tendermint client module
+	clientRouter := types.NewClientRouter().AddRoute(solomachine.NewClientModule())
+	clientKeeper := keeper.NewKeeper(app.AppCodec(), app.GetKey(host.StoreKey), app.GetSubspace(host.ModuleName), app.StakingKeeper, app.UpgradeKeeper, clientRouter)
+
+	// the client router is sealed upon keeper creation
+	suite.Require().True(clientRouter.Sealed())
+	suite.Require().Panics(func() { clientRouter.AddRoute(ibctm.NewClientModule()) })
+
+	_, err = clientKeeper.CreateClient(suite.chainA.GetContext(), clientState, suite.consensusState)
+	suite.Require().ErrorIs(err, types.ErrClientModuleNotFound)
+
+	err = clientKeeper.UpdateClient(suite.chainA.GetContext(), path.EndpointA.ClientID, header)
+	suite.Require().ErrorIs(err, types.ErrClientModuleNotFound)
+
+	status := clientKeeper.GetClientStatus(suite.chainA.GetContext(), path.EndpointA.GetClientState(), path.EndpointA.ClientID)
+	suite.Require().Equal(exported.Unknown, status)
+
+	_, err = clientKeeper.GetAllClientMetadata(suite.chainA.GetContext(), clientKeeper.GetAllGenesisClients(suite.chainA.GetContext()))
+	suite.Require().ErrorIs(err, types.ErrClientModuleNotFound)
+}
+
 func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 	var (
 		path         *ibctesting.Path
@@ -700,3 +736,86 @@ func (suite *KeeperTestSuite) TestUpdateClientEventEmission() {
 	}
 	suite.Require().True(contains)
 }
+
+func (suite *KeeperTestSuite) TestCheckClientExpiry() {
+	suite.SetupTest()
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+	expiredPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(expiredPath)
+
+	stats := types.ClientUpdateStats{
+		LastUpdater:     suite.chainA.SenderAccount.GetAddress().String(),
+		FirstUpdateTime: suite.coordinator.CurrentTime,
+		LastUpdateTime:  suite.coordinator.CurrentTime,
+		UpdateCount:     1,
+	}
+	clientKeeper.SetClientUpdateStats(suite.chainA.GetContext(), path.EndpointA.ClientID, stats)
+	clientKeeper.SetClientUpdateStats(suite.chainA.GetContext(), expiredPath.EndpointA.ClientID, stats)
+
+	// shorten the trusting period of the client on expiredPath so that it expires
+	tmClientState, ok := expiredPath.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+	suite.Require().True(ok)
+	tmClientState.TrustingPeriod = time.Minute
+	expiredPath.EndpointA.SetClientState(tmClientState)
+
+	// store additional clients so that more than one call is needed to check every client
+	for i := 0; i < 2*types.MaxClientExpiryChecksPerBlock; i++ {
+		clientID := types.FormatClientIdentifier(exported.Tendermint, uint64(100+i))
+		clientKeeper.SetClientState(suite.chainA.GetContext(), clientID, path.EndpointA.GetClientState())
+	}
+
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(host.StoreKey))
+
+	// every client is checked after three calls: 20 + 20 + 3 (including the localhost client)
+	for i := 0; i < 2; i++ {
+		clientKeeper.CheckClientExpiry(suite.chainA.GetContext())
+		suite.Require().NotNil(store.Get([]byte(types.KeyClientExpiryCursor)))
+	}
+
+	clientKeeper.CheckClientExpiry(suite.chainA.GetContext())
+	suite.Require().Nil(store.Get([]byte(types.KeyClientExpiryCursor)))
+
+	_, found := clientKeeper.GetClientUpdateStats(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().True(found)
+
+	_, found = clientKeeper.GetClientUpdateStats(suite.chainA.GetContext(), expiredPath.EndpointA.ClientID)
+	suite.Require().False(found)
+}
+
+// TestCheckClientExpiryNoWrites asserts that no state is written when every client is checked
+// in a single call and the expired clients have no update statistics.
+func (suite *KeeperTestSuite) TestCheckClientExpiryNoWrites() {
+	suite.SetupTest()
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+	expiredPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(expiredPath)
+
+	tmClientState, ok := expiredPath.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+	suite.Require().True(ok)
+	tmClientState.TrustingPeriod = time.Minute
+	expiredPath.EndpointA.SetClientState(tmClientState)
+
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	// trace every store operation performed by the check, writes are traced once the cache is written
+	var trace bytes.Buffer
+	cacheMultiStore := suite.chainA.GetContext().MultiStore().SetTracer(&trace).CacheMultiStore()
+	ctx := suite.chainA.GetContext().WithMultiStore(cacheMultiStore)
+
+	clientKeeper.CheckClientExpiry(ctx)
+	cacheMultiStore.Write()
+
+	suite.Require().NotEmpty(trace.String())
+	suite.Require().NotContains(trace.String(), `"operation":"write"`)
+	suite.Require().NotContains(trace.String(), `"operation":"delete"`)
+}
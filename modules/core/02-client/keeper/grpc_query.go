@@ -226,6 +226,81 @@ func (q Keeper) ConsensusStateHeights(c context.Context, req *types.QueryConsens
 	}, nil
 }
 
+// UpdateInfo implements the Query/UpdateInfo gRPC method
+func (q Keeper) UpdateInfo(c context.Context, req *types.QueryUpdateInfoRequest) (*types.QueryUpdateInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.RevisionHeight == 0 {
+		return nil, status.Error(codes.InvalidArgument, "consensus state height cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height := types.NewHeight(req.RevisionNumber, req.RevisionHeight)
+	updateInfo, found := q.GetUpdateInfo(ctx, req.ClientId, height)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrUpdateInfoNotFound, "client-id: %s, height: %s", req.ClientId, height).Error(),
+		)
+	}
+
+	proofHeight := types.GetSelfHeight(ctx)
+	return &types.QueryUpdateInfoResponse{
+		UpdateInfo:  updateInfo,
+		ProofHeight: proofHeight,
+	}, nil
+}
+
+// ConsensusStateAtTime implements the Query/ConsensusStateAtTime gRPC method
+func (q Keeper) ConsensusStateAtTime(c context.Context, req *types.QueryConsensusStateAtTimeRequest) (*types.QueryConsensusStateAtTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Timestamp == 0 {
+		return nil, status.Error(codes.InvalidArgument, "timestamp cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height, consensusState, processedTime, found := q.GetClientConsensusStateAtTime(ctx, req.ClientId, req.Timestamp)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrConsensusStateNotFound, "client-id: %s, timestamp: %d", req.ClientId, req.Timestamp).Error(),
+		)
+	}
+
+	any, err := types.PackConsensusState(consensusState)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	consensusHeight, ok := height.(types.Height)
+	if !ok {
+		return nil, status.Error(codes.Internal, sdkerrors.Wrapf(types.ErrInvalidHeight, "expected %T, got %T", types.Height{}, height).Error())
+	}
+
+	proofHeight := types.GetSelfHeight(ctx)
+	return &types.QueryConsensusStateAtTimeResponse{
+		ConsensusState:  any,
+		ConsensusHeight: consensusHeight,
+		ProcessedTime:   processedTime,
+		ProofHeight:     proofHeight,
+	}, nil
+}
+
 // ClientStatus implements the Query/ClientStatus gRPC method
 func (q Keeper) ClientStatus(c context.Context, req *types.QueryClientStatusRequest) (*types.QueryClientStatusResponse, error) {
 	if req == nil {
@@ -245,14 +320,44 @@ func (q Keeper) ClientStatus(c context.Context, req *types.QueryClientStatusRequ
 		)
 	}
 
-	clientStore := q.ClientStore(ctx, req.ClientId)
-	status := clientState.Status(ctx, clientStore, q.cdc)
+	status := q.GetClientStatus(ctx, clientState, req.ClientId)
 
 	return &types.QueryClientStatusResponse{
 		Status: status.String(),
 	}, nil
 }
 
+// ClientHealth implements the Query/ClientHealth gRPC method
+func (q Keeper) ClientHealth(c context.Context, req *types.QueryClientHealthRequest) (*types.QueryClientHealthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	clientState, found := q.GetClientState(ctx, req.ClientId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrClientNotFound, req.ClientId).Error(),
+		)
+	}
+
+	clientStatus := q.GetClientStatus(ctx, clientState, req.ClientId)
+	timeUntilExpiry, _ := q.GetClientTimeUntilExpiry(ctx, req.ClientId)
+	updateStats, _ := q.GetClientUpdateStats(ctx, req.ClientId)
+
+	return &types.QueryClientHealthResponse{
+		Status:                clientStatus.String(),
+		TimeUntilExpiry:       timeUntilExpiry,
+		AverageUpdateInterval: updateStats.AverageUpdateInterval(),
+		UpdateStats:           updateStats,
+	}, nil
+}
+
 // ClientParams implements the Query/ClientParams gRPC method
 func (q Keeper) ClientParams(c context.Context, _ *types.QueryClientParamsRequest) (*types.QueryClientParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
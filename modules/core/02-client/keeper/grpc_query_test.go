@@ -2,11 +2,13 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
@@ -486,6 +488,142 @@ func (suite *KeeperTestSuite) TestQueryConsensusStateHeights() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryConsensusStateAtTime() {
+	var (
+		req               *types.QueryConsensusStateAtTimeRequest
+		expConsensusState *codectypes.Any
+		expHeight         types.Height
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid clientID",
+			func() {
+				req = &types.QueryConsensusStateAtTimeRequest{}
+			},
+			false,
+		},
+		{
+			"invalid timestamp",
+			func() {
+				req = &types.QueryConsensusStateAtTimeRequest{
+					ClientId:  testClientID,
+					Timestamp: 0,
+				}
+			},
+			false,
+		},
+		{
+			"client not found",
+			func() {
+				req = &types.QueryConsensusStateAtTimeRequest{
+					ClientId:  ibctesting.FirstClientID,
+					Timestamp: uint64(suite.chainA.GetContext().BlockTime().UnixNano()),
+				}
+			},
+			false,
+		},
+		{
+			"consensus state not found: timestamp before earliest consensus state",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+				cs := path.EndpointA.GetConsensusState(path.EndpointA.GetClientState().GetLatestHeight())
+
+				req = &types.QueryConsensusStateAtTimeRequest{
+					ClientId:  path.EndpointA.ClientID,
+					Timestamp: cs.GetTimestamp() - 1,
+				}
+			},
+			false,
+		},
+		{
+			"success: latest consensus state",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+
+				err := path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				expHeight = path.EndpointA.GetClientState().GetLatestHeight().(types.Height)
+				cs := path.EndpointA.GetConsensusState(expHeight)
+
+				expConsensusState, err = types.PackConsensusState(cs)
+				suite.Require().NoError(err)
+
+				req = &types.QueryConsensusStateAtTimeRequest{
+					ClientId:  path.EndpointA.ClientID,
+					Timestamp: cs.GetTimestamp() + 1,
+				}
+			},
+			true,
+		},
+		{
+			"success: earlier consensus state",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+
+				expHeight = path.EndpointA.GetClientState().GetLatestHeight().(types.Height)
+				cs := path.EndpointA.GetConsensusState(expHeight)
+
+				var err error
+				expConsensusState, err = types.PackConsensusState(cs)
+				suite.Require().NoError(err)
+
+				// update client to new height
+				err = path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				updatedCs := path.EndpointA.GetConsensusState(path.EndpointA.GetClientState().GetLatestHeight())
+				suite.Require().Greater(updatedCs.GetTimestamp(), cs.GetTimestamp())
+
+				req = &types.QueryConsensusStateAtTimeRequest{
+					ClientId:  path.EndpointA.ClientID,
+					Timestamp: updatedCs.GetTimestamp() - 1,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.ConsensusStateAtTime(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expConsensusState, res.ConsensusState)
+				suite.Require().Equal(expHeight, res.ConsensusHeight)
+				suite.Require().NotZero(res.ProcessedTime)
+
+				// ensure UnpackInterfaces is defined
+				cachedValue := res.ConsensusState.GetCachedValue()
+				suite.Require().NotNil(cachedValue)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryClientStatus() {
 	var req *types.QueryClientStatusRequest
 
@@ -583,6 +721,234 @@ func (suite *KeeperTestSuite) TestQueryClientStatus() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryClientHealth() {
+	var (
+		req       *types.QueryClientHealthRequest
+		expStatus string
+		expStats  types.ClientUpdateStats
+		expExpiry time.Duration
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid clientID",
+			func() {
+				req = &types.QueryClientHealthRequest{}
+			},
+			false,
+		},
+		{
+			"client not found",
+			func() {
+				req = &types.QueryClientHealthRequest{
+					ClientId: ibctesting.InvalidID,
+				}
+			},
+			false,
+		},
+		{
+			"success: no updates recorded",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+
+				expStatus = exported.Active.String()
+				expStats = types.ClientUpdateStats{}
+
+				clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+				cs := path.EndpointA.GetConsensusState(clientState.GetLatestHeight())
+				expExpiry = time.Unix(0, int64(cs.GetTimestamp())).Add(clientState.TrustingPeriod).Sub(suite.chainA.GetContext().BlockTime())
+
+				req = &types.QueryClientHealthRequest{
+					ClientId: path.EndpointA.ClientID,
+				}
+			},
+			true,
+		},
+		{
+			"success: updates recorded",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+
+				err := path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				err = path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				expStatus = exported.Active.String()
+
+				var found bool
+				expStats, found = suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientUpdateStats(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(2), expStats.UpdateCount)
+				suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), expStats.LastUpdater)
+
+				clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+				cs := path.EndpointA.GetConsensusState(clientState.GetLatestHeight())
+				expExpiry = time.Unix(0, int64(cs.GetTimestamp())).Add(clientState.TrustingPeriod).Sub(suite.chainA.GetContext().BlockTime())
+
+				req = &types.QueryClientHealthRequest{
+					ClientId: path.EndpointA.ClientID,
+				}
+			},
+			true,
+		},
+		{
+			"success: expired client",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+
+				clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+				suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod)
+
+				expStatus = exported.Expired.String()
+				expStats = types.ClientUpdateStats{}
+				expExpiry = 0
+
+				req = &types.QueryClientHealthRequest{
+					ClientId: path.EndpointA.ClientID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.ClientHealth(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expStatus, res.Status)
+				suite.Require().Equal(expExpiry, res.TimeUntilExpiry)
+				suite.Require().Equal(expStats.AverageUpdateInterval(), res.AverageUpdateInterval)
+				suite.Require().Equal(expStats.LastUpdater, res.UpdateStats.LastUpdater)
+				suite.Require().Equal(expStats.UpdateCount, res.UpdateStats.UpdateCount)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryUpdateInfo() {
+	var (
+		req           *types.QueryUpdateInfoRequest
+		expUpdateInfo types.UpdateInfo
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid clientID",
+			func() {
+				req = &types.QueryUpdateInfoRequest{
+					RevisionHeight: 1,
+				}
+			},
+			false,
+		},
+		{
+			"invalid height",
+			func() {
+				req = &types.QueryUpdateInfoRequest{
+					ClientId:       ibctesting.FirstClientID,
+					RevisionNumber: 0,
+					RevisionHeight: 0,
+				}
+			},
+			false,
+		},
+		{
+			"update info not found: consensus state created on client creation",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+
+				height := path.EndpointA.GetClientState().GetLatestHeight()
+				req = &types.QueryUpdateInfoRequest{
+					ClientId:       path.EndpointA.ClientID,
+					RevisionNumber: height.GetRevisionNumber(),
+					RevisionHeight: height.GetRevisionHeight(),
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupClients(path)
+
+				err := path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				height := path.EndpointA.GetClientState().GetLatestHeight()
+
+				var found bool
+				expUpdateInfo, found = suite.chainA.App.GetIBCKeeper().ClientKeeper.GetUpdateInfo(suite.chainA.GetContext(), path.EndpointA.ClientID, height)
+				suite.Require().True(found)
+				suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), expUpdateInfo.Submitter)
+				suite.Require().Len(expUpdateInfo.HeaderHash, tmhash.Size)
+
+				req = &types.QueryUpdateInfoRequest{
+					ClientId:       path.EndpointA.ClientID,
+					RevisionNumber: height.GetRevisionNumber(),
+					RevisionHeight: height.GetRevisionHeight(),
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.UpdateInfo(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expUpdateInfo, res.UpdateInfo)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryUpgradedConsensusStates() {
 	var (
 		req               *types.QueryUpgradedConsensusStateRequest
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -11,6 +12,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/light"
 
@@ -29,21 +31,33 @@ type Keeper struct {
 	paramSpace    paramtypes.Subspace
 	stakingKeeper types.StakingKeeper
 	upgradeKeeper types.UpgradeKeeper
+	router        *types.ClientRouter
 }
 
-// NewKeeper creates a new NewKeeper instance
-func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace, sk types.StakingKeeper, uk types.UpgradeKeeper) Keeper {
+// NewKeeper creates a new NewKeeper instance. The ClientRouter is shared by every copy of the
+// keeper and is sealed, such that no client modules can be registered once the keeper is created.
+// The method panics if the ClientRouter is nil.
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace, sk types.StakingKeeper, uk types.UpgradeKeeper, rtr *types.ClientRouter) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
+	if rtr == nil {
+		panic("client router cannot be nil")
+	}
+
+	if !rtr.Sealed() {
+		rtr.Seal()
+	}
+
 	return Keeper{
 		storeKey:      key,
 		cdc:           cdc,
 		paramSpace:    paramSpace,
 		stakingKeeper: sk,
 		upgradeKeeper: uk,
+		router:        rtr,
 	}
 }
 
@@ -52,6 +66,17 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.ModuleName+"/"+types.SubModuleName)
 }
 
+// GetRouter returns the ClientRouter used to dispatch client creation, updates and verification
+// to the client module registered for the client type.
+func (k Keeper) GetRouter() *types.ClientRouter {
+	return k.router
+}
+
+// GetClientModule returns the ClientModule registered for the provided client type.
+func (k Keeper) GetClientModule(clientType string) (types.ClientModule, bool) {
+	return k.router.GetRoute(clientType)
+}
+
 // GenerateClientIdentifier returns the next client identifier.
 func (k Keeper) GenerateClientIdentifier(ctx sdk.Context, clientType string) string {
 	nextClientSeq := k.GetNextClientSequence(ctx)
@@ -80,6 +105,18 @@ func (k Keeper) SetClientState(ctx sdk.Context, clientID string, clientState exp
 	store.Set(host.ClientStateKey(), k.MustMarshalClientState(clientState))
 }
 
+// GetClientStatus returns the status of the provided client state for the given client identifier.
+// The status is determined by the client module registered for the client type. The Unknown status
+// is returned if no client module is registered for the client type.
+func (k Keeper) GetClientStatus(ctx sdk.Context, clientState exported.ClientState, clientID string) exported.Status {
+	clientModule, found := k.GetClientModule(clientState.ClientType())
+	if !found {
+		return exported.Unknown
+	}
+
+	return clientModule.Status(ctx, k.cdc, k.ClientStore(ctx, clientID), clientState)
+}
+
 // GetClientConsensusState gets the stored consensus state from a client at a given height.
 func (k Keeper) GetClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) (exported.ConsensusState, bool) {
 	store := k.ClientStore(ctx, clientID)
@@ -99,6 +136,194 @@ func (k Keeper) SetClientConsensusState(ctx sdk.Context, clientID string, height
 	store.Set(host.ConsensusStateKey(height), k.MustMarshalConsensusState(consensusState))
 }
 
+// GetClientUpdateStats gets the update statistics recorded for a particular client
+func (k Keeper) GetClientUpdateStats(ctx sdk.Context, clientID string) (types.ClientUpdateStats, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ClientUpdateStatsKey(clientID))
+	if bz == nil {
+		return types.ClientUpdateStats{}, false
+	}
+
+	var stats types.ClientUpdateStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// SetClientUpdateStats sets the update statistics for a particular client
+func (k Keeper) SetClientUpdateStats(ctx sdk.Context, clientID string, stats types.ClientUpdateStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ClientUpdateStatsKey(clientID), k.cdc.MustMarshal(&stats))
+}
+
+// DeleteClientUpdateStats deletes the update statistics recorded for a particular client
+func (k Keeper) DeleteClientUpdateStats(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ClientUpdateStatsKey(clientID))
+}
+
+// IterateClientUpdateStats provides an iterator over all stored client update statistics.
+// For each ClientUpdateStats object, cb will be called. If the cb returns true,
+// the iterator will close and stop.
+func (k Keeper) IterateClientUpdateStats(ctx sdk.Context, cb func(clientID string, stats types.ClientUpdateStats) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.KeyClientUpdateStatsPrefix+"/"))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// key is in the format "clientUpdateStats/<clientID>"
+		clientID := strings.TrimPrefix(string(iterator.Key()), types.KeyClientUpdateStatsPrefix+"/")
+
+		var stats types.ClientUpdateStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+
+		if cb(clientID, stats) {
+			break
+		}
+	}
+}
+
+// GetAllClientUpdateStats returns the update statistics recorded for all clients.
+func (k Keeper) GetAllClientUpdateStats(ctx sdk.Context) []types.IdentifiedClientUpdateStats {
+	var clientsUpdateStats []types.IdentifiedClientUpdateStats
+	k.IterateClientUpdateStats(ctx, func(clientID string, stats types.ClientUpdateStats) bool {
+		clientsUpdateStats = append(clientsUpdateStats, types.NewIdentifiedClientUpdateStats(clientID, stats))
+		return false
+	})
+
+	return clientsUpdateStats
+}
+
+// GetUpdateInfo gets the update info recorded for the consensus state of a client at a given height.
+func (k Keeper) GetUpdateInfo(ctx sdk.Context, clientID string, height exported.Height) (types.UpdateInfo, bool) {
+	store := k.ClientStore(ctx, clientID)
+	bz := store.Get(types.UpdateInfoKey(height))
+	if bz == nil {
+		return types.UpdateInfo{}, false
+	}
+
+	var updateInfo types.UpdateInfo
+	k.cdc.MustUnmarshal(bz, &updateInfo)
+	return updateInfo, true
+}
+
+// SetUpdateInfo sets the update info for the consensus state of a client at a given height.
+func (k Keeper) SetUpdateInfo(ctx sdk.Context, clientID string, height exported.Height, updateInfo types.UpdateInfo) {
+	store := k.ClientStore(ctx, clientID)
+	store.Set(types.UpdateInfoKey(height), k.cdc.MustMarshal(&updateInfo))
+}
+
+// IterateUpdateInfos provides an iterator over all stored update infos.
+// For each UpdateInfo object, cb will be called. If the cb returns true,
+// the iterator will close and stop.
+func (k Keeper) IterateUpdateInfos(ctx sdk.Context, cb func(clientID string, ui types.UpdateInfoWithHeight) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, host.KeyClientStorePrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+		// update info key is in the format "clients/<clientID>/consensusStates/<height>/updateInfo"
+		if len(keySplit) != 5 || keySplit[2] != string(host.KeyConsensusStatePrefix) || keySplit[4] != types.KeyUpdateInfo {
+			continue
+		}
+		clientID := keySplit[1]
+		height := types.MustParseHeight(keySplit[3])
+
+		var updateInfo types.UpdateInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &updateInfo)
+
+		if cb(clientID, types.NewUpdateInfoWithHeight(height, updateInfo)) {
+			break
+		}
+	}
+}
+
+// GetAllUpdateInfos returns all stored client update infos.
+func (k Keeper) GetAllUpdateInfos(ctx sdk.Context) []types.ClientUpdateInfos {
+	var clientUpdateInfos []types.ClientUpdateInfos
+	mapClientIDToUpdateInfosIdx := make(map[string]int)
+
+	k.IterateUpdateInfos(ctx, func(clientID string, ui types.UpdateInfoWithHeight) bool {
+		idx, ok := mapClientIDToUpdateInfosIdx[clientID]
+		if ok {
+			clientUpdateInfos[idx].UpdateInfos = append(clientUpdateInfos[idx].UpdateInfos, ui)
+			return false
+		}
+
+		clientUpdateInfos = append(clientUpdateInfos, types.NewClientUpdateInfos(clientID, []types.UpdateInfoWithHeight{ui}))
+		mapClientIDToUpdateInfosIdx[clientID] = len(clientUpdateInfos) - 1
+		return false
+	})
+
+	return clientUpdateInfos
+}
+
+// RecordClientUpdate records a client update submitted by the provided signer. The update statistics
+// of the client are updated and, if the header created a new consensus state, the submitter, transaction
+// hash and header hash are stored as the update info for the consensus state height. An existing update
+// info is never overwritten so that the submitter of the header which created a consensus state is retained.
+func (k Keeper) RecordClientUpdate(ctx sdk.Context, clientID string, header exported.Header, signer string) {
+	stats, found := k.GetClientUpdateStats(ctx, clientID)
+	if !found {
+		stats.FirstUpdateTime = ctx.BlockTime()
+	}
+
+	stats.LastUpdater = signer
+	stats.LastUpdateTime = ctx.BlockTime()
+	stats.UpdateCount++
+
+	k.SetClientUpdateStats(ctx, clientID, stats)
+
+	if header == nil {
+		return
+	}
+
+	height := header.GetHeight()
+	if !k.HasClientConsensusState(ctx, clientID, height) {
+		return
+	}
+
+	if _, found := k.GetUpdateInfo(ctx, clientID, height); found {
+		return
+	}
+
+	var txHash []byte
+	if len(ctx.TxBytes()) != 0 {
+		txHash = tmhash.Sum(ctx.TxBytes())
+	}
+
+	headerHash := tmhash.Sum(types.MustMarshalHeader(k.cdc, header))
+	k.SetUpdateInfo(ctx, clientID, height, types.NewUpdateInfo(signer, txHash, headerHash))
+}
+
+// GetClientTimeUntilExpiry returns the time remaining until the given client expires. It is computed as the
+// trusting period minus the time elapsed since the timestamp of the latest consensus state. Zero is returned
+// for clients which have already expired. False is returned if the client does not exist or if its client
+// type does not expire.
+func (k Keeper) GetClientTimeUntilExpiry(ctx sdk.Context, clientID string) (time.Duration, bool) {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return 0, false
+	}
+
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return 0, false
+	}
+
+	consensusState, found := k.GetClientConsensusState(ctx, clientID, tmClientState.GetLatestHeight())
+	if !found {
+		return 0, false
+	}
+
+	expiry := time.Unix(0, int64(consensusState.GetTimestamp())).Add(tmClientState.TrustingPeriod)
+	if !expiry.After(ctx.BlockTime()) {
+		return 0, true
+	}
+
+	return expiry.Sub(ctx.BlockTime()), true
+}
+
 // GetNextClientSequence gets the next client sequence from the store.
 func (k Keeper) GetNextClientSequence(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
@@ -164,7 +389,12 @@ func (k Keeper) GetAllClientMetadata(ctx sdk.Context, genClients []types.Identif
 		if err != nil {
 			return nil, err
 		}
-		gms := cs.ExportMetadata(k.ClientStore(ctx, ic.ClientId))
+		clientModule, found := k.GetClientModule(cs.ClientType())
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrClientModuleNotFound, "client type %s", cs.ClientType())
+		}
+
+		gms := clientModule.ExportMetadata(k.ClientStore(ctx, ic.ClientId), cs)
 		if len(gms) == 0 {
 			continue
 		}
@@ -238,6 +468,51 @@ func (k Keeper) GetLatestClientConsensusState(ctx sdk.Context, clientID string)
 	return k.GetClientConsensusState(ctx, clientID, clientState.GetLatestHeight())
 }
 
+// GetClientConsensusStateAtTime returns the latest consensus state stored for a given client whose timestamp is
+// less than or equal to the provided unix timestamp (in nanoseconds). The height of the consensus state and the
+// time at which it was processed on this chain are returned alongside it. Consensus states are traversed in
+// descending height order using the tendermint iteration keys. Clients which do not store iteration keys only
+// have their latest consensus state considered and report a processed time of zero.
+func (k Keeper) GetClientConsensusStateAtTime(ctx sdk.Context, clientID string, timestamp uint64) (exported.Height, exported.ConsensusState, uint64, bool) {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return nil, nil, 0, false
+	}
+
+	var (
+		consensusHeight exported.Height
+		consensusState  exported.ConsensusState
+		iterated        bool
+	)
+
+	clientStore := k.ClientStore(ctx, clientID)
+	ibctmtypes.IterateConsensusStateDescending(clientStore, func(height exported.Height) bool {
+		iterated = true
+
+		cs, found := k.GetClientConsensusState(ctx, clientID, height)
+		if !found || cs.GetTimestamp() > timestamp {
+			return false
+		}
+
+		consensusHeight, consensusState = height, cs
+		return true
+	})
+
+	if !iterated {
+		cs, found := k.GetClientConsensusState(ctx, clientID, clientState.GetLatestHeight())
+		if found && cs.GetTimestamp() <= timestamp {
+			consensusHeight, consensusState = clientState.GetLatestHeight(), cs
+		}
+	}
+
+	if consensusState == nil {
+		return nil, nil, 0, false
+	}
+
+	processedTime, _ := ibctmtypes.GetProcessedTime(clientStore, consensusHeight)
+	return consensusHeight, consensusState, processedTime, true
+}
+
 // GetSelfConsensusState introspects the (self) past historical info at a given height
 // and returns the expected consensus state at that height.
 // For now, can only retrieve self consensus states for the current revision
@@ -30,7 +30,8 @@ func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdatePropo
 
 	subjectClientStore := k.ClientStore(ctx, p.SubjectClientId)
 
-	if status := subjectClientState.Status(ctx, subjectClientStore, k.cdc); status == exported.Active {
+	subjectStatus := k.GetClientStatus(ctx, subjectClientState, p.SubjectClientId)
+	if subjectStatus == exported.Active {
 		return sdkerrors.Wrap(types.ErrInvalidUpdateClientProposal, "cannot update Active subject client")
 	}
 
@@ -45,7 +46,7 @@ func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdatePropo
 
 	substituteClientStore := k.ClientStore(ctx, p.SubstituteClientId)
 
-	if status := substituteClientState.Status(ctx, substituteClientStore, k.cdc); status != exported.Active {
+	if status := k.GetClientStatus(ctx, substituteClientState, p.SubstituteClientId); status != exported.Active {
 		return sdkerrors.Wrapf(types.ErrClientNotActive, "substitute client is not Active, status is %s", status)
 	}
 
@@ -55,6 +56,11 @@ func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdatePropo
 	}
 	k.SetClientState(ctx, p.SubjectClientId, clientState)
 
+	// the update statistics of an expired subject client are not carried over to the recovered client
+	if subjectStatus == exported.Expired {
+		k.DeleteClientUpdateStats(ctx, p.SubjectClientId)
+	}
+
 	k.Logger(ctx).Info("client updated after governance proposal passed", "client-id", p.SubjectClientId, "height", clientState.GetLatestHeight().String())
 
 	defer func() {
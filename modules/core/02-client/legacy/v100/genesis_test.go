@@ -211,6 +211,10 @@ func (suite *LegacyTestSuite) TestMigrateGenesisTendermint() {
 	suite.Require().NotNil(clientGenState.ClientsConsensus)
 	suite.Require().NotNil(clientGenState.ClientsMetadata)
 
+	// update infos and update statistics did not exist in v1.0.0
+	clientGenState.ClientsUpdateInfos = nil
+	clientGenState.ClientsUpdateStats = nil
+
 	// Increment the time by another week, then update the client.
 	// This will cause the consensus states created before the first time increment
 	// to be expired
@@ -221,6 +225,8 @@ func (suite *LegacyTestSuite) TestMigrateGenesisTendermint() {
 	err := v100.MigrateStore(path1.EndpointA.Chain.GetContext(), path1.EndpointA.Chain.GetSimApp().GetKey(host.StoreKey), path1.EndpointA.Chain.App.AppCodec())
 	suite.Require().NoError(err)
 	expectedClientGenState := ibcclient.ExportGenesis(path1.EndpointA.Chain.GetContext(), path1.EndpointA.Chain.App.GetIBCKeeper().ClientKeeper)
+	expectedClientGenState.ClientsUpdateInfos = nil
+	expectedClientGenState.ClientsUpdateStats = nil
 
 	migrated, err := v100.MigrateGenesis(codec.NewProtoCodec(clientCtx.InterfaceRegistry), &clientGenState, suite.coordinator.CurrentTime, types.GetSelfHeight(suite.chainA.GetContext()))
 	suite.Require().NoError(err)
@@ -48,6 +48,15 @@ func (cs ConsensusState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 	return unpacker.UnpackAny(cs.PublicKey, new(cryptotypes.PubKey))
 }
 
+// VerifyNextSequenceAck panics!
+func (cs ClientState) VerifyNextSequenceAck(
+	sdk.Context, sdk.KVStore, codec.BinaryCodec, exported.Height,
+	uint64, uint64, exported.Prefix, []byte,
+	string, string, uint64,
+) error {
+	panic("legacy solo machine is deprecated!")
+}
+
 // ClientType panics!
 func (cs ClientState) ClientType() string {
 	panic("legacy solo machine is deprecated!")
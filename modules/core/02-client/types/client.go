@@ -5,10 +5,12 @@ import (
 	"math"
 	"sort"
 	"strings"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	proto "github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
@@ -85,6 +87,76 @@ func (cswh ConsensusStateWithHeight) UnpackInterfaces(unpacker codectypes.AnyUnp
 	return unpacker.UnpackAny(cswh.ConsensusState, new(exported.ConsensusState))
 }
 
+// AverageUpdateInterval returns the average time elapsed between the recorded client updates.
+// Zero is returned if fewer than two updates have been recorded.
+func (cus ClientUpdateStats) AverageUpdateInterval() time.Duration {
+	if cus.UpdateCount < 2 {
+		return 0
+	}
+
+	return cus.LastUpdateTime.Sub(cus.FirstUpdateTime) / time.Duration(cus.UpdateCount-1)
+}
+
+// ValidateBasic performs a basic validation of the client update statistics fields.
+func (cus ClientUpdateStats) ValidateBasic() error {
+	if strings.TrimSpace(cus.LastUpdater) == "" {
+		return sdkerrors.Wrap(ErrInvalidClientUpdateStats, "last updater cannot be blank")
+	}
+
+	if cus.UpdateCount == 0 {
+		return sdkerrors.Wrap(ErrInvalidClientUpdateStats, "update count cannot be zero")
+	}
+
+	if cus.LastUpdateTime.Before(cus.FirstUpdateTime) {
+		return sdkerrors.Wrapf(ErrInvalidClientUpdateStats, "last update time %s is before first update time %s", cus.LastUpdateTime, cus.FirstUpdateTime)
+	}
+
+	return nil
+}
+
+// NewIdentifiedClientUpdateStats creates a new IdentifiedClientUpdateStats instance
+func NewIdentifiedClientUpdateStats(clientID string, updateStats ClientUpdateStats) IdentifiedClientUpdateStats {
+	return IdentifiedClientUpdateStats{
+		ClientId:    clientID,
+		UpdateStats: updateStats,
+	}
+}
+
+// NewUpdateInfo creates a new UpdateInfo instance
+func NewUpdateInfo(submitter string, txHash, headerHash []byte) UpdateInfo {
+	return UpdateInfo{
+		Submitter:  submitter,
+		TxHash:     txHash,
+		HeaderHash: headerHash,
+	}
+}
+
+// ValidateBasic performs a basic validation of the update info fields. The transaction hash may be
+// empty for client updates which were not submitted in a transaction.
+func (ui UpdateInfo) ValidateBasic() error {
+	if strings.TrimSpace(ui.Submitter) == "" {
+		return sdkerrors.Wrap(ErrInvalidUpdateInfo, "submitter cannot be blank")
+	}
+
+	if len(ui.TxHash) != 0 && len(ui.TxHash) != tmhash.Size {
+		return sdkerrors.Wrapf(ErrInvalidUpdateInfo, "invalid tx hash length, expected: %d, got: %d", tmhash.Size, len(ui.TxHash))
+	}
+
+	if len(ui.HeaderHash) != tmhash.Size {
+		return sdkerrors.Wrapf(ErrInvalidUpdateInfo, "invalid header hash length, expected: %d, got: %d", tmhash.Size, len(ui.HeaderHash))
+	}
+
+	return nil
+}
+
+// NewUpdateInfoWithHeight creates a new UpdateInfoWithHeight instance
+func NewUpdateInfoWithHeight(height Height, updateInfo UpdateInfo) UpdateInfoWithHeight {
+	return UpdateInfoWithHeight{
+		Height:     height,
+		UpdateInfo: updateInfo,
+	}
+}
+
 // ValidateClientType validates the client type. It cannot be blank or empty. It must be a valid
 // client identifier when used with '0' or the maximum uint64 as the sequence.
 func ValidateClientType(clientType string) error {
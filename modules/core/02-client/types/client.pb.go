@@ -9,15 +9,19 @@ import (
 	types1 "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -193,6 +197,312 @@ func (m *ClientConsensusStates) GetConsensusStates() []ConsensusStateWithHeight
 	return nil
 }
 
+// ClientUpdateStats defines the bookkeeping information recorded for a client
+// each time it is successfully updated through a MsgUpdateClient.
+type ClientUpdateStats struct {
+	// address of the signer which submitted the most recent client update
+	LastUpdater string `protobuf:"bytes,1,opt,name=last_updater,json=lastUpdater,proto3" json:"last_updater,omitempty" yaml:"last_updater"`
+	// block time at which the first recorded client update was processed
+	FirstUpdateTime time.Time `protobuf:"bytes,2,opt,name=first_update_time,json=firstUpdateTime,proto3,stdtime" json:"first_update_time" yaml:"first_update_time"`
+	// block time at which the most recent client update was processed
+	LastUpdateTime time.Time `protobuf:"bytes,3,opt,name=last_update_time,json=lastUpdateTime,proto3,stdtime" json:"last_update_time" yaml:"last_update_time"`
+	// total number of recorded client updates
+	UpdateCount uint64 `protobuf:"varint,4,opt,name=update_count,json=updateCount,proto3" json:"update_count,omitempty" yaml:"update_count"`
+}
+
+func (m *ClientUpdateStats) Reset()         { *m = ClientUpdateStats{} }
+func (m *ClientUpdateStats) String() string { return proto.CompactTextString(m) }
+func (*ClientUpdateStats) ProtoMessage()    {}
+func (*ClientUpdateStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{3}
+}
+func (m *ClientUpdateStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientUpdateStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientUpdateStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientUpdateStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientUpdateStats.Merge(m, src)
+}
+func (m *ClientUpdateStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientUpdateStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientUpdateStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientUpdateStats proto.InternalMessageInfo
+
+func (m *ClientUpdateStats) GetLastUpdater() string {
+	if m != nil {
+		return m.LastUpdater
+	}
+	return ""
+}
+
+func (m *ClientUpdateStats) GetFirstUpdateTime() time.Time {
+	if m != nil {
+		return m.FirstUpdateTime
+	}
+	return time.Time{}
+}
+
+func (m *ClientUpdateStats) GetLastUpdateTime() time.Time {
+	if m != nil {
+		return m.LastUpdateTime
+	}
+	return time.Time{}
+}
+
+func (m *ClientUpdateStats) GetUpdateCount() uint64 {
+	if m != nil {
+		return m.UpdateCount
+	}
+	return 0
+}
+
+// IdentifiedClientUpdateStats defines the update statistics recorded for a given
+// client.
+type IdentifiedClientUpdateStats struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+	// update statistics recorded for the client
+	UpdateStats ClientUpdateStats `protobuf:"bytes,2,opt,name=update_stats,json=updateStats,proto3" json:"update_stats" yaml:"update_stats"`
+}
+
+func (m *IdentifiedClientUpdateStats) Reset()         { *m = IdentifiedClientUpdateStats{} }
+func (m *IdentifiedClientUpdateStats) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedClientUpdateStats) ProtoMessage()    {}
+func (*IdentifiedClientUpdateStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{4}
+}
+func (m *IdentifiedClientUpdateStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedClientUpdateStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedClientUpdateStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedClientUpdateStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedClientUpdateStats.Merge(m, src)
+}
+func (m *IdentifiedClientUpdateStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedClientUpdateStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedClientUpdateStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedClientUpdateStats proto.InternalMessageInfo
+
+func (m *IdentifiedClientUpdateStats) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *IdentifiedClientUpdateStats) GetUpdateStats() ClientUpdateStats {
+	if m != nil {
+		return m.UpdateStats
+	}
+	return ClientUpdateStats{}
+}
+
+// UpdateInfo defines the information recorded for the client update which created
+// the consensus state at a given height.
+type UpdateInfo struct {
+	// address of the signer which submitted the client update
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// hash of the transaction which contained the client update
+	TxHash []byte `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
+	// SHA-256 hash of the protobuf encoded header used to update the client
+	HeaderHash []byte `protobuf:"bytes,3,opt,name=header_hash,json=headerHash,proto3" json:"header_hash,omitempty" yaml:"header_hash"`
+}
+
+func (m *UpdateInfo) Reset()         { *m = UpdateInfo{} }
+func (m *UpdateInfo) String() string { return proto.CompactTextString(m) }
+func (*UpdateInfo) ProtoMessage()    {}
+func (*UpdateInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{5}
+}
+func (m *UpdateInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateInfo.Merge(m, src)
+}
+func (m *UpdateInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateInfo proto.InternalMessageInfo
+
+func (m *UpdateInfo) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *UpdateInfo) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *UpdateInfo) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+// UpdateInfoWithHeight defines an update info with the height of the consensus
+// state it is associated with.
+type UpdateInfoWithHeight struct {
+	// consensus state height
+	Height Height `protobuf:"bytes,1,opt,name=height,proto3" json:"height"`
+	// update info
+	UpdateInfo UpdateInfo `protobuf:"bytes,2,opt,name=update_info,json=updateInfo,proto3" json:"update_info" yaml:"update_info"`
+}
+
+func (m *UpdateInfoWithHeight) Reset()         { *m = UpdateInfoWithHeight{} }
+func (m *UpdateInfoWithHeight) String() string { return proto.CompactTextString(m) }
+func (*UpdateInfoWithHeight) ProtoMessage()    {}
+func (*UpdateInfoWithHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{6}
+}
+func (m *UpdateInfoWithHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateInfoWithHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateInfoWithHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateInfoWithHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateInfoWithHeight.Merge(m, src)
+}
+func (m *UpdateInfoWithHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateInfoWithHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateInfoWithHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateInfoWithHeight proto.InternalMessageInfo
+
+func (m *UpdateInfoWithHeight) GetHeight() Height {
+	if m != nil {
+		return m.Height
+	}
+	return Height{}
+}
+
+func (m *UpdateInfoWithHeight) GetUpdateInfo() UpdateInfo {
+	if m != nil {
+		return m.UpdateInfo
+	}
+	return UpdateInfo{}
+}
+
+// ClientUpdateInfos defines all the stored update infos for a given client.
+type ClientUpdateInfos struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+	// update infos and their heights associated with the client
+	UpdateInfos []UpdateInfoWithHeight `protobuf:"bytes,2,rep,name=update_infos,json=updateInfos,proto3" json:"update_infos" yaml:"update_infos"`
+}
+
+func (m *ClientUpdateInfos) Reset()         { *m = ClientUpdateInfos{} }
+func (m *ClientUpdateInfos) String() string { return proto.CompactTextString(m) }
+func (*ClientUpdateInfos) ProtoMessage()    {}
+func (*ClientUpdateInfos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{7}
+}
+func (m *ClientUpdateInfos) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientUpdateInfos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientUpdateInfos.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientUpdateInfos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientUpdateInfos.Merge(m, src)
+}
+func (m *ClientUpdateInfos) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientUpdateInfos) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientUpdateInfos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientUpdateInfos proto.InternalMessageInfo
+
+func (m *ClientUpdateInfos) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ClientUpdateInfos) GetUpdateInfos() []UpdateInfoWithHeight {
+	if m != nil {
+		return m.UpdateInfos
+	}
+	return nil
+}
+
 // ClientUpdateProposal is a governance proposal. If it passes, the substitute
 // client's latest consensus state is copied over to the subject client. The proposal
 // handler may fail if the subject and the substitute do not match in client and
@@ -213,7 +523,7 @@ func (m *ClientUpdateProposal) Reset()         { *m = ClientUpdateProposal{} }
 func (m *ClientUpdateProposal) String() string { return proto.CompactTextString(m) }
 func (*ClientUpdateProposal) ProtoMessage()    {}
 func (*ClientUpdateProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{3}
+	return fileDescriptor_b6bc4c8185546947, []int{8}
 }
 func (m *ClientUpdateProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -260,7 +570,7 @@ type UpgradeProposal struct {
 func (m *UpgradeProposal) Reset()      { *m = UpgradeProposal{} }
 func (*UpgradeProposal) ProtoMessage() {}
 func (*UpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{4}
+	return fileDescriptor_b6bc4c8185546947, []int{9}
 }
 func (m *UpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,7 +619,7 @@ type Height struct {
 func (m *Height) Reset()      { *m = Height{} }
 func (*Height) ProtoMessage() {}
 func (*Height) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{5}
+	return fileDescriptor_b6bc4c8185546947, []int{10}
 }
 func (m *Height) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,7 +658,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{6}
+	return fileDescriptor_b6bc4c8185546947, []int{11}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,6 +698,11 @@ func init() {
 	proto.RegisterType((*IdentifiedClientState)(nil), "ibc.core.client.v1.IdentifiedClientState")
 	proto.RegisterType((*ConsensusStateWithHeight)(nil), "ibc.core.client.v1.ConsensusStateWithHeight")
 	proto.RegisterType((*ClientConsensusStates)(nil), "ibc.core.client.v1.ClientConsensusStates")
+	proto.RegisterType((*ClientUpdateStats)(nil), "ibc.core.client.v1.ClientUpdateStats")
+	proto.RegisterType((*IdentifiedClientUpdateStats)(nil), "ibc.core.client.v1.IdentifiedClientUpdateStats")
+	proto.RegisterType((*UpdateInfo)(nil), "ibc.core.client.v1.UpdateInfo")
+	proto.RegisterType((*UpdateInfoWithHeight)(nil), "ibc.core.client.v1.UpdateInfoWithHeight")
+	proto.RegisterType((*ClientUpdateInfos)(nil), "ibc.core.client.v1.ClientUpdateInfos")
 	proto.RegisterType((*ClientUpdateProposal)(nil), "ibc.core.client.v1.ClientUpdateProposal")
 	proto.RegisterType((*UpgradeProposal)(nil), "ibc.core.client.v1.UpgradeProposal")
 	proto.RegisterType((*Height)(nil), "ibc.core.client.v1.Height")
@@ -397,52 +712,71 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0x8e, 0xdb, 0xfc, 0xa2, 0xe6, 0x52, 0x35, 0xfd, 0xb9, 0x29, 0x0d, 0xa1, 0xca, 0x45, 0x27,
-	0x86, 0x0c, 0xd4, 0x26, 0x01, 0xa1, 0x2a, 0x1b, 0xc9, 0xd2, 0x0e, 0xa0, 0x60, 0x54, 0x21, 0x58,
-	0x22, 0xff, 0xb9, 0x3a, 0x57, 0x39, 0xbe, 0xc8, 0x77, 0x0e, 0xe4, 0x1b, 0x30, 0x32, 0x32, 0x30,
-	0xf4, 0x1b, 0xf0, 0x25, 0x18, 0x3a, 0x76, 0x64, 0xb2, 0x50, 0xbb, 0xb0, 0x92, 0x95, 0x05, 0xe5,
-	0xee, 0xdc, 0xc6, 0xfd, 0x83, 0x10, 0x6c, 0x77, 0xcf, 0x3d, 0xf7, 0xdc, 0xf3, 0xbc, 0xf6, 0xfb,
-	0x02, 0x48, 0x1c, 0xd7, 0x74, 0x69, 0x84, 0x4d, 0x37, 0x20, 0x38, 0xe4, 0xe6, 0xa4, 0xa5, 0x56,
-	0xc6, 0x38, 0xa2, 0x9c, 0xea, 0x3a, 0x71, 0x5c, 0x63, 0x4e, 0x30, 0x14, 0x3c, 0x69, 0xd5, 0x2a,
-	0x3e, 0xf5, 0xa9, 0x38, 0x36, 0xe7, 0x2b, 0xc9, 0xac, 0xdd, 0xf5, 0x29, 0xf5, 0x03, 0x6c, 0x8a,
-	0x9d, 0x13, 0x1f, 0x9a, 0x76, 0x38, 0x55, 0x47, 0xf7, 0x5d, 0xca, 0x46, 0x94, 0x99, 0xf1, 0xd8,
-	0x8f, 0x6c, 0x0f, 0x9b, 0x93, 0x96, 0x83, 0xb9, 0xdd, 0x4a, 0xf7, 0x92, 0x85, 0x3e, 0x69, 0x60,
-	0x73, 0xdf, 0xc3, 0x21, 0x27, 0x87, 0x04, 0x7b, 0x3d, 0xf1, 0xdc, 0x4b, 0x6e, 0x73, 0xac, 0xb7,
-	0x40, 0x51, 0xbe, 0x3e, 0x20, 0x5e, 0x55, 0x6b, 0x68, 0xcd, 0x62, 0xb7, 0x32, 0x4b, 0xe0, 0xfa,
-	0xd4, 0x1e, 0x05, 0x1d, 0x74, 0x71, 0x84, 0xac, 0x15, 0xb9, 0xde, 0xf7, 0xf4, 0x3e, 0x58, 0x55,
-	0x38, 0x9b, 0x4b, 0x54, 0x97, 0x1a, 0x5a, 0xb3, 0xd4, 0xae, 0x18, 0xd2, 0xa4, 0x91, 0x9a, 0x34,
-	0x9e, 0x86, 0xd3, 0xee, 0xd6, 0x2c, 0x81, 0x1b, 0x19, 0x2d, 0x71, 0x07, 0x59, 0x25, 0xf7, 0xd2,
-	0x04, 0xfa, 0xac, 0x81, 0x6a, 0x8f, 0x86, 0x0c, 0x87, 0x2c, 0x66, 0x02, 0x7a, 0x45, 0xf8, 0x70,
-	0x0f, 0x13, 0x7f, 0xc8, 0xf5, 0x5d, 0x50, 0x18, 0x8a, 0x95, 0xb0, 0x57, 0x6a, 0xd7, 0x8c, 0xeb,
-	0x75, 0x33, 0x24, 0xb7, 0x9b, 0x3f, 0x49, 0x60, 0xce, 0x52, 0x7c, 0xfd, 0x35, 0x28, 0xbb, 0xa9,
-	0xea, 0x1f, 0x78, 0xad, 0xcd, 0x12, 0x78, 0x47, 0x79, 0xcd, 0x5e, 0x43, 0xd6, 0x9a, 0x9b, 0xb1,
-	0x87, 0xbe, 0x68, 0x60, 0x53, 0x96, 0x31, 0xeb, 0x9b, 0xfd, 0x4d, 0x41, 0xdf, 0x81, 0xf5, 0x2b,
-	0x0f, 0xb2, 0xea, 0x52, 0x63, 0xb9, 0x59, 0x6a, 0x3f, 0xb8, 0x29, 0xeb, 0x6d, 0x95, 0xea, 0xc2,
-	0x79, 0xfa, 0x59, 0x02, 0xb7, 0x6e, 0x0c, 0xc1, 0x90, 0x55, 0xce, 0xa6, 0x60, 0xe8, 0x87, 0x06,
-	0x2a, 0x32, 0xc6, 0xc1, 0xd8, 0xb3, 0x39, 0xee, 0x47, 0x74, 0x4c, 0x99, 0x1d, 0xe8, 0x15, 0xf0,
-	0x1f, 0x27, 0x3c, 0xc0, 0x32, 0x81, 0x25, 0x37, 0x7a, 0x03, 0x94, 0x3c, 0xcc, 0xdc, 0x88, 0x8c,
-	0x39, 0xa1, 0xa1, 0x28, 0x66, 0xd1, 0x5a, 0x84, 0xf4, 0x3d, 0xf0, 0x3f, 0x8b, 0x9d, 0x23, 0xec,
-	0xf2, 0xc1, 0x65, 0x15, 0x96, 0x45, 0x15, 0xb6, 0x67, 0x09, 0xac, 0x4a, 0x67, 0xd7, 0x28, 0xc8,
-	0x2a, 0x2b, 0xac, 0x97, 0x16, 0xe5, 0x05, 0xa8, 0xb0, 0xd8, 0x61, 0x9c, 0xf0, 0x98, 0xe3, 0x05,
-	0xb1, 0xbc, 0x10, 0x83, 0xb3, 0x04, 0xde, 0xbb, 0x10, 0xbb, 0xc6, 0x42, 0x96, 0x7e, 0x09, 0xa7,
-	0x92, 0x9d, 0xfc, 0xfb, 0x63, 0x98, 0x43, 0x3f, 0x35, 0x50, 0x3e, 0x90, 0xdd, 0xf1, 0xcf, 0x71,
-	0x9f, 0x80, 0xfc, 0x38, 0xb0, 0x43, 0x91, 0xb0, 0xd4, 0xde, 0x36, 0x64, 0x33, 0x1a, 0x69, 0xf3,
-	0xa9, 0x66, 0x34, 0xfa, 0x81, 0x1d, 0xaa, 0x7f, 0x53, 0xf0, 0xf5, 0x23, 0xb0, 0xa9, 0x38, 0xde,
-	0x20, 0xd3, 0x4b, 0xf9, 0xdf, 0xfc, 0x9f, 0x8d, 0x59, 0x02, 0xb7, 0x65, 0xe6, 0x1b, 0x2f, 0x23,
-	0x6b, 0x23, 0xc5, 0x17, 0x3a, 0xbc, 0xb3, 0x3a, 0x4f, 0xfd, 0xf1, 0x18, 0xe6, 0xbe, 0x1f, 0x43,
-	0x6d, 0x3e, 0x09, 0x0a, 0xaa, 0xb1, 0x7a, 0xa0, 0x1c, 0xe1, 0x09, 0x61, 0x84, 0x86, 0x83, 0x30,
-	0x1e, 0x39, 0x38, 0x12, 0xf1, 0xf3, 0x8b, 0x8d, 0x70, 0x85, 0x80, 0xac, 0xb5, 0x14, 0x79, 0x2e,
-	0x80, 0x8c, 0x88, 0x6a, 0xd3, 0xa5, 0x5b, 0x45, 0x24, 0x61, 0x41, 0x44, 0x3a, 0xe9, 0xac, 0xa4,
-	0x16, 0xd1, 0x33, 0x50, 0xe8, 0xdb, 0x91, 0x3d, 0x62, 0x73, 0x61, 0x3b, 0x08, 0xe8, 0xdb, 0x8b,
-	0x90, 0xac, 0xaa, 0x35, 0x96, 0x9b, 0xc5, 0x45, 0xe1, 0x2b, 0x04, 0x64, 0xad, 0x29, 0x44, 0xe6,
-	0x67, 0x5d, 0xeb, 0xe4, 0xac, 0xae, 0x9d, 0x9e, 0xd5, 0xb5, 0x6f, 0x67, 0x75, 0xed, 0xc3, 0x79,
-	0x3d, 0x77, 0x7a, 0x5e, 0xcf, 0x7d, 0x3d, 0xaf, 0xe7, 0xde, 0xec, 0xfa, 0x84, 0x0f, 0x63, 0xc7,
-	0x70, 0xe9, 0xc8, 0x54, 0x23, 0x94, 0x38, 0xee, 0x8e, 0x4f, 0xcd, 0xc9, 0x63, 0x73, 0x44, 0xbd,
-	0x38, 0xc0, 0x4c, 0x4e, 0xef, 0x87, 0xed, 0x1d, 0x35, 0xc0, 0xf9, 0x74, 0x8c, 0x99, 0x53, 0x10,
-	0x1f, 0xe5, 0xd1, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x92, 0x4e, 0x61, 0xe0, 0x05, 0x00,
-	0x00,
+	// 1017 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xbf, 0x6f, 0x23, 0xc5,
+	0x17, 0xf7, 0x26, 0xfe, 0xe6, 0x7b, 0x1e, 0x5b, 0x76, 0x32, 0xe7, 0xdc, 0x19, 0x27, 0xf2, 0x5a,
+	0xc3, 0x21, 0x59, 0x82, 0xdb, 0xc5, 0x01, 0xc1, 0xc9, 0x1d, 0x76, 0x93, 0x14, 0xa0, 0xb0, 0x70,
+	0x42, 0x40, 0x61, 0xed, 0x8f, 0xb1, 0x3d, 0xa7, 0xf5, 0x8e, 0xb5, 0x33, 0x6b, 0x92, 0xff, 0x80,
+	0x32, 0x25, 0x05, 0x45, 0xfe, 0x01, 0x04, 0x1d, 0xff, 0x00, 0xc5, 0x95, 0x57, 0x52, 0x19, 0x94,
+	0x50, 0xd0, 0xe2, 0x96, 0x06, 0xed, 0xcc, 0xec, 0x7a, 0xd7, 0xf6, 0x1d, 0x28, 0xd0, 0xed, 0xbc,
+	0xf7, 0x79, 0xef, 0x7d, 0xde, 0x67, 0x66, 0xf6, 0x0d, 0xd0, 0x89, 0xe3, 0x9a, 0x2e, 0x0d, 0xb1,
+	0xe9, 0xfa, 0x04, 0x07, 0xdc, 0x9c, 0x77, 0xd5, 0x97, 0x31, 0x0b, 0x29, 0xa7, 0x10, 0x12, 0xc7,
+	0x35, 0x62, 0x80, 0xa1, 0xcc, 0xf3, 0x6e, 0xb3, 0x3e, 0xa6, 0x63, 0x2a, 0xdc, 0x66, 0xfc, 0x25,
+	0x91, 0xcd, 0xd7, 0xc6, 0x94, 0x8e, 0x7d, 0x6c, 0x8a, 0x95, 0x13, 0x8d, 0x4c, 0x3b, 0xb8, 0x54,
+	0x2e, 0x7d, 0xdd, 0xc5, 0xc9, 0x14, 0x33, 0x6e, 0x4f, 0x67, 0x0a, 0xf0, 0xc8, 0xa5, 0x6c, 0x4a,
+	0x99, 0x19, 0xcd, 0xc6, 0xa1, 0xed, 0x61, 0x73, 0xde, 0x75, 0x30, 0xb7, 0xbb, 0xc9, 0x5a, 0xa2,
+	0xd0, 0xb7, 0x1a, 0x38, 0x3c, 0xf3, 0x70, 0xc0, 0xc9, 0x88, 0x60, 0x6f, 0x20, 0xf8, 0x7c, 0xc2,
+	0x6d, 0x8e, 0x61, 0x17, 0x94, 0x24, 0xbd, 0x21, 0xf1, 0x1a, 0x5a, 0x5b, 0xeb, 0x94, 0xfa, 0xf5,
+	0xe5, 0x42, 0xdf, 0xbf, 0xb4, 0xa7, 0x7e, 0x0f, 0xa5, 0x2e, 0x64, 0xdd, 0x93, 0xdf, 0x67, 0x1e,
+	0x3c, 0x07, 0x15, 0x65, 0x67, 0x71, 0x8a, 0xc6, 0x4e, 0x5b, 0xeb, 0x94, 0x4f, 0xea, 0x86, 0xa4,
+	0x6a, 0x24, 0x54, 0x8d, 0x0f, 0x82, 0xcb, 0xfe, 0xc3, 0xe5, 0x42, 0xbf, 0x9f, 0xcb, 0x25, 0x62,
+	0x90, 0x55, 0x76, 0x57, 0x24, 0xd0, 0xf7, 0x1a, 0x68, 0x0c, 0x68, 0xc0, 0x70, 0xc0, 0x22, 0x26,
+	0x4c, 0x9f, 0x11, 0x3e, 0x39, 0xc5, 0x64, 0x3c, 0xe1, 0xf0, 0x09, 0xd8, 0x9b, 0x88, 0x2f, 0x41,
+	0xaf, 0x7c, 0xd2, 0x34, 0x36, 0x85, 0x35, 0x24, 0xb6, 0x5f, 0x7c, 0xbe, 0xd0, 0x0b, 0x96, 0xc2,
+	0xc3, 0xcf, 0x41, 0xcd, 0x4d, 0xb2, 0xfe, 0x03, 0xae, 0xcd, 0xe5, 0x42, 0x7f, 0xa0, 0xb8, 0xe6,
+	0xc3, 0x90, 0x55, 0x75, 0x73, 0xf4, 0xd0, 0x4f, 0x1a, 0x38, 0x94, 0x32, 0xe6, 0x79, 0xb3, 0xbb,
+	0x08, 0x7a, 0x01, 0xf6, 0xd7, 0x0a, 0xb2, 0xc6, 0x4e, 0x7b, 0xb7, 0x53, 0x3e, 0x79, 0x6b, 0x5b,
+	0xaf, 0x2f, 0x53, 0xaa, 0xaf, 0xc7, 0xdd, 0x2f, 0x17, 0xfa, 0xc3, 0xad, 0x4d, 0x30, 0x64, 0xd5,
+	0xf2, 0x5d, 0x30, 0xf4, 0xdb, 0x0e, 0x38, 0x90, 0x6d, 0x3c, 0x9d, 0x79, 0x36, 0xc7, 0xb1, 0x99,
+	0xc1, 0x1e, 0xa8, 0xf8, 0x36, 0xe3, 0xc3, 0x48, 0xd8, 0x42, 0xd5, 0x45, 0x66, 0x2b, 0xb3, 0x5e,
+	0x64, 0x95, 0xe3, 0xa5, 0x8c, 0x0f, 0xa1, 0x0f, 0x0e, 0x46, 0x24, 0x4c, 0xdd, 0xc3, 0xf8, 0xbc,
+	0x2a, 0xd5, 0x9b, 0x1b, 0xaa, 0x7f, 0x9a, 0x1c, 0xe6, 0xfe, 0x23, 0x45, 0xbd, 0x21, 0x0b, 0x6c,
+	0xa4, 0x40, 0x57, 0xbf, 0xe8, 0x9a, 0x55, 0x13, 0x76, 0x59, 0x2a, 0x8e, 0x85, 0x04, 0xec, 0xfb,
+	0x76, 0x1e, 0xd9, 0xd8, 0xfd, 0xdb, 0x62, 0xaf, 0xe7, 0x75, 0xf2, 0xed, 0x6d, 0xb5, 0xaa, 0xab,
+	0xae, 0x44, 0xa9, 0x1e, 0xa8, 0x28, 0x8c, 0x4b, 0xa3, 0x80, 0x37, 0x8a, 0x6d, 0xad, 0x53, 0xcc,
+	0x8a, 0x92, 0xf5, 0x22, 0xab, 0x2c, 0x97, 0x03, 0xb1, 0xfa, 0x51, 0x03, 0x47, 0xeb, 0xd7, 0x2f,
+	0x2b, 0xf8, 0x1d, 0xce, 0x0c, 0x4e, 0xe9, 0xc4, 0x9b, 0xcb, 0x94, 0xc4, 0x6f, 0x6c, 0x3d, 0x2f,
+	0xeb, 0xf5, 0xfa, 0x47, 0x4a, 0x80, 0x3c, 0x73, 0x91, 0x28, 0x65, 0x2e, 0x90, 0xe8, 0x4a, 0x03,
+	0x40, 0x46, 0x9e, 0x05, 0x23, 0x0a, 0x8f, 0x41, 0x89, 0x45, 0xce, 0x94, 0xf0, 0xf4, 0x58, 0x58,
+	0x2b, 0x03, 0x7c, 0x13, 0xfc, 0x9f, 0x5f, 0x0c, 0x27, 0x36, 0x9b, 0x08, 0x3a, 0x95, 0x3e, 0x5c,
+	0x2e, 0xf4, 0xaa, 0xac, 0xa1, 0x1c, 0xc8, 0xda, 0xe3, 0x17, 0xa7, 0x36, 0x9b, 0xc0, 0xf7, 0x41,
+	0x79, 0x82, 0x6d, 0x0f, 0x87, 0x32, 0x60, 0x57, 0x04, 0x3c, 0x58, 0x2e, 0x74, 0x28, 0x03, 0x32,
+	0x4e, 0x64, 0x01, 0xb9, 0x8a, 0x03, 0xd1, 0x77, 0x1a, 0xa8, 0xaf, 0x28, 0xfd, 0x27, 0x3f, 0x8a,
+	0x2f, 0x81, 0x6a, 0x7a, 0x48, 0x82, 0x11, 0x55, 0x5a, 0xb6, 0xb6, 0x85, 0xaf, 0x0a, 0xf7, 0x9b,
+	0x4a, 0x44, 0x98, 0x13, 0x31, 0x4e, 0x80, 0x2c, 0x10, 0xa5, 0x38, 0xf4, 0x83, 0x96, 0xbf, 0x63,
+	0xb1, 0xf1, 0x4e, 0x5b, 0x3e, 0x01, 0x95, 0x4c, 0x91, 0xe4, 0x17, 0xd1, 0x79, 0x35, 0xcd, 0xcc,
+	0xef, 0x61, 0xfb, 0xae, 0x8b, 0x5c, 0xe9, 0xae, 0x0b, 0x72, 0xe8, 0x0f, 0x0d, 0xd4, 0xb3, 0x94,
+	0xcf, 0x43, 0x3a, 0xa3, 0xcc, 0xf6, 0x61, 0x1d, 0xfc, 0x8f, 0x13, 0xee, 0x63, 0xb5, 0xf7, 0x72,
+	0x01, 0xdb, 0xa0, 0xec, 0x61, 0xe6, 0x86, 0x64, 0xc6, 0x09, 0x0d, 0x84, 0x7c, 0x25, 0x2b, 0x6b,
+	0x82, 0xa7, 0xe0, 0x80, 0x45, 0xce, 0x33, 0xec, 0xf2, 0xe1, 0xaa, 0xeb, 0x5d, 0xd1, 0xf5, 0xf1,
+	0xea, 0xd6, 0x6f, 0x40, 0x90, 0x55, 0x53, 0xb6, 0x41, 0x22, 0xc2, 0xc7, 0xa0, 0xce, 0x22, 0x87,
+	0x71, 0xc2, 0xa3, 0xf8, 0xb2, 0xa5, 0xc9, 0x8a, 0x22, 0x99, 0xbe, 0x5c, 0xe8, 0x47, 0x69, 0xb2,
+	0x0d, 0x14, 0xb2, 0xe0, 0xca, 0x9c, 0xa4, 0xec, 0x15, 0xbf, 0xbe, 0xd6, 0x0b, 0xe8, 0x4f, 0x0d,
+	0xd4, 0x9e, 0xca, 0xa1, 0xf9, 0xaf, 0xdb, 0x7d, 0x0f, 0x14, 0x67, 0xbe, 0x1d, 0xa8, 0x5f, 0xd1,
+	0xb1, 0x21, 0x67, 0xb4, 0x91, 0xcc, 0x64, 0x35, 0xa3, 0x8d, 0x73, 0xdf, 0x0e, 0xd4, 0x49, 0x14,
+	0x78, 0xf8, 0x0c, 0x1c, 0x2a, 0x8c, 0x37, 0xcc, 0x8d, 0xd8, 0xe2, 0x2b, 0xc6, 0x56, 0x7b, 0xb9,
+	0xd0, 0x8f, 0x93, 0x2d, 0xdd, 0x12, 0x8c, 0xac, 0xfb, 0x89, 0x3d, 0x33, 0xf8, 0x7b, 0x95, 0xb8,
+	0xeb, 0x6f, 0xae, 0xf5, 0xc2, 0xef, 0xd7, 0xba, 0x16, 0x3f, 0x10, 0xf6, 0xd4, 0x35, 0x1a, 0x80,
+	0x5a, 0x88, 0xe7, 0x84, 0x11, 0x1a, 0x0c, 0x83, 0x68, 0xea, 0xa8, 0x9b, 0x5e, 0xcc, 0xce, 0xc7,
+	0x35, 0x00, 0xb2, 0xaa, 0x89, 0xe5, 0x23, 0x61, 0xc8, 0x25, 0x51, 0x97, 0x72, 0xe7, 0xa5, 0x49,
+	0x24, 0x20, 0x93, 0x44, 0x32, 0xe9, 0xdd, 0x4b, 0x28, 0xa2, 0x0f, 0xc1, 0xde, 0xb9, 0x1d, 0xda,
+	0x53, 0x16, 0x27, 0xb6, 0x7d, 0x9f, 0x7e, 0x95, 0x36, 0xc9, 0x1a, 0x5a, 0x7b, 0xb7, 0x53, 0xca,
+	0x26, 0x5e, 0x03, 0x20, 0xab, 0xaa, 0x2c, 0xb2, 0x7f, 0xd6, 0xb7, 0x9e, 0xdf, 0xb4, 0xb4, 0x17,
+	0x37, 0x2d, 0xed, 0xd7, 0x9b, 0x96, 0x76, 0x75, 0xdb, 0x2a, 0xbc, 0xb8, 0x6d, 0x15, 0x7e, 0xbe,
+	0x6d, 0x15, 0xbe, 0x78, 0x32, 0x26, 0x7c, 0x12, 0x39, 0x86, 0x4b, 0xa7, 0xa6, 0x7a, 0x59, 0x11,
+	0xc7, 0x7d, 0x3c, 0xa6, 0xe6, 0xfc, 0x5d, 0x73, 0x4a, 0xbd, 0xc8, 0xc7, 0x4c, 0xbe, 0xfa, 0xde,
+	0x3e, 0x79, 0xac, 0x1e, 0x7e, 0xfc, 0x72, 0x86, 0x99, 0xb3, 0x27, 0x36, 0xe5, 0x9d, 0xbf, 0x06,
+	0x00, 0x4c, 0x59, 0xbf, 0x4f, 0x18, 0x0a, 0x00, 0x00,
 }
 
 func (this *UpgradeProposal) Equal(that interface{}) bool {
@@ -609,7 +943,7 @@ func (m *ClientConsensusStates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ClientUpdateProposal) Marshal() (dAtA []byte, err error) {
+func (m *ClientUpdateStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -619,48 +953,48 @@ func (m *ClientUpdateProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClientUpdateProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClientUpdateStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClientUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClientUpdateStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SubstituteClientId) > 0 {
-		i -= len(m.SubstituteClientId)
-		copy(dAtA[i:], m.SubstituteClientId)
-		i = encodeVarintClient(dAtA, i, uint64(len(m.SubstituteClientId)))
+	if m.UpdateCount != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.UpdateCount))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if len(m.SubjectClientId) > 0 {
-		i -= len(m.SubjectClientId)
-		copy(dAtA[i:], m.SubjectClientId)
-		i = encodeVarintClient(dAtA, i, uint64(len(m.SubjectClientId)))
-		i--
-		dAtA[i] = 0x1a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdateTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintClient(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	i -= n4
+	i = encodeVarintClient(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.FirstUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.FirstUpdateTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintClient(dAtA, i, uint64(len(m.Title)))
+	i -= n5
+	i = encodeVarintClient(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.LastUpdater) > 0 {
+		i -= len(m.LastUpdater)
+		copy(dAtA[i:], m.LastUpdater)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.LastUpdater)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpgradeProposal) Marshal() (dAtA []byte, err error) {
+func (m *IdentifiedClientUpdateStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -670,30 +1004,18 @@ func (m *UpgradeProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpgradeProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *IdentifiedClientUpdateStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IdentifiedClientUpdateStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UpgradedClientState != nil {
-		{
-			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintClient(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
 	{
-		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.UpdateStats.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -701,25 +1023,18 @@ func (m *UpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintClient(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintClient(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintClient(dAtA, i, uint64(len(m.Title)))
+	dAtA[i] = 0x12
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Height) Marshal() (dAtA []byte, err error) {
+func (m *UpdateInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -729,25 +1044,266 @@ func (m *Height) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Height) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Height) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RevisionHeight != 0 {
-		i = encodeVarintClient(dAtA, i, uint64(m.RevisionHeight))
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.HeaderHash)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if m.RevisionNumber != 0 {
-		i = encodeVarintClient(dAtA, i, uint64(m.RevisionNumber))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.TxHash)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateInfoWithHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateInfoWithHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateInfoWithHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UpdateInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientUpdateInfos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientUpdateInfos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientUpdateInfos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdateInfos) > 0 {
+		for iNdEx := len(m.UpdateInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientUpdateProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientUpdateProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientUpdateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubstituteClientId) > 0 {
+		i -= len(m.SubstituteClientId)
+		copy(dAtA[i:], m.SubstituteClientId)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.SubstituteClientId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SubjectClientId) > 0 {
+		i -= len(m.SubjectClientId)
+		copy(dAtA[i:], m.SubjectClientId)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.SubjectClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpgradedClientState != nil {
+		{
+			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintClient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Height) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Height) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Height) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevisionHeight != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.RevisionHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RevisionNumber != 0 {
+		i = encodeVarintClient(dAtA, i, uint64(m.RevisionNumber))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
@@ -843,94 +1399,702 @@ func (m *ClientConsensusStates) Size() (n int) {
 			n += 1 + l + sovClient(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *ClientUpdateProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
-	}
-	l = len(m.SubjectClientId)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
-	}
-	l = len(m.SubstituteClientId)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
-	}
-	return n
-}
-
-func (m *UpgradeProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovClient(uint64(l))
-	}
-	l = m.Plan.Size()
-	n += 1 + l + sovClient(uint64(l))
-	if m.UpgradedClientState != nil {
-		l = m.UpgradedClientState.Size()
-		n += 1 + l + sovClient(uint64(l))
-	}
-	return n
-}
-
-func (m *Height) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RevisionNumber != 0 {
-		n += 1 + sovClient(uint64(m.RevisionNumber))
-	}
-	if m.RevisionHeight != 0 {
-		n += 1 + sovClient(uint64(m.RevisionHeight))
-	}
-	return n
-}
+	return n
+}
+
+func (m *ClientUpdateStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LastUpdater)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.FirstUpdateTime)
+	n += 1 + l + sovClient(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdateTime)
+	n += 1 + l + sovClient(uint64(l))
+	if m.UpdateCount != 0 {
+		n += 1 + sovClient(uint64(m.UpdateCount))
+	}
+	return n
+}
+
+func (m *IdentifiedClientUpdateStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = m.UpdateStats.Size()
+	n += 1 + l + sovClient(uint64(l))
+	return n
+}
+
+func (m *UpdateInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateInfoWithHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Height.Size()
+	n += 1 + l + sovClient(uint64(l))
+	l = m.UpdateInfo.Size()
+	n += 1 + l + sovClient(uint64(l))
+	return n
+}
+
+func (m *ClientUpdateInfos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if len(m.UpdateInfos) > 0 {
+		for _, e := range m.UpdateInfos {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClientUpdateProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.SubjectClientId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.SubstituteClientId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = m.Plan.Size()
+	n += 1 + l + sovClient(uint64(l))
+	if m.UpgradedClientState != nil {
+		l = m.UpgradedClientState.Size()
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
+func (m *Height) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RevisionNumber != 0 {
+		n += 1 + sovClient(uint64(m.RevisionNumber))
+	}
+	if m.RevisionHeight != 0 {
+		n += 1 + sovClient(uint64(m.RevisionHeight))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedClients) > 0 {
+		for _, s := range m.AllowedClients {
+			l = len(s)
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovClient(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClient(x uint64) (n int) {
+	return sovClient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IdentifiedClientState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedClientState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusStateWithHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusStateWithHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types.Any{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientConsensusStates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientConsensusStates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientConsensusStates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusStates = append(m.ConsensusStates, ConsensusStateWithHeight{})
+			if err := m.ConsensusStates[len(m.ConsensusStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientUpdateStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientUpdateStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientUpdateStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdater", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastUpdater = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.FirstUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateCount", wireType)
+			}
+			m.UpdateCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AllowedClients) > 0 {
-		for _, s := range m.AllowedClients {
-			l = len(s)
-			n += 1 + l + sovClient(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovClient(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozClient(x uint64) (n int) {
-	return sovClient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *IdentifiedClientState) Unmarshal(dAtA []byte) error {
+func (m *IdentifiedClientUpdateStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -953,10 +2117,10 @@ func (m *IdentifiedClientState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdentifiedClientState: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdentifiedClientUpdateStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentifiedClientState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdentifiedClientUpdateStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -993,7 +2157,7 @@ func (m *IdentifiedClientState) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1020,12 +2184,159 @@ func (m *IdentifiedClientState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &types.Any{}
+			if err := m.UpdateStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1048,7 +2359,7 @@ func (m *IdentifiedClientState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
+func (m *UpdateInfoWithHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1071,10 +2382,10 @@ func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsensusStateWithHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateInfoWithHeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsensusStateWithHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateInfoWithHeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1112,7 +2423,7 @@ func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1139,10 +2450,7 @@ func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusState == nil {
-				m.ConsensusState = &types.Any{}
-			}
-			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UpdateInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1167,7 +2475,7 @@ func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClientConsensusStates) Unmarshal(dAtA []byte) error {
+func (m *ClientUpdateInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1190,10 +2498,10 @@ func (m *ClientConsensusStates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientConsensusStates: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClientUpdateInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientConsensusStates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClientUpdateInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1230,7 +2538,7 @@ func (m *ClientConsensusStates) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1257,8 +2565,8 @@ func (m *ClientConsensusStates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsensusStates = append(m.ConsensusStates, ConsensusStateWithHeight{})
-			if err := m.ConsensusStates[len(m.ConsensusStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UpdateInfos = append(m.UpdateInfos, UpdateInfoWithHeight{})
+			if err := m.UpdateInfos[len(m.UpdateInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
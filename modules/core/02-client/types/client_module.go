@@ -0,0 +1,228 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+// ClientModule defines the interface a light client implementation must fulfill in order
+// to be registered with 02-client. Applications register client modules on the ClientRouter
+// which is set on the 02-client keeper. Client creation, updates, misbehaviour, upgrade and
+// proof verification as well as client status checks are dispatched to the module registered
+// for the client type.
+type ClientModule interface {
+	// ClientType returns the client type handled by the module, e.g. 07-tendermint.
+	ClientType() string
+
+	// RegisterInterfaces registers the concrete client state, consensus state, header and
+	// misbehaviour types of the light client on the provided interface registry.
+	RegisterInterfaces(registry codectypes.InterfaceRegistry)
+
+	// GetTxCmd returns the root tx command of the light client. It may return nil.
+	GetTxCmd() *cobra.Command
+
+	// GetQueryCmd returns the root query command of the light client. It may return nil.
+	GetQueryCmd() *cobra.Command
+
+	// InitGenesis is called for every client of the module's client type once its client state,
+	// consensus states and metadata have been set in the client store during genesis initialization.
+	InitGenesis(ctx sdk.Context, clientStore sdk.KVStore, clientState exported.ClientState) error
+
+	// ExportMetadata returns the client specific metadata to be exported in genesis.
+	ExportMetadata(clientStore sdk.KVStore, clientState exported.ClientState) []exported.GenesisMetadata
+
+	// Initialize is called upon client creation. It must validate the initial consensus state and
+	// may store any client specific metadata necessary for correct light client operation.
+	Initialize(
+		ctx sdk.Context,
+		cdc codec.BinaryCodec,
+		clientStore sdk.KVStore,
+		clientState exported.ClientState,
+		consensusState exported.ConsensusState,
+	) error
+
+	// CheckHeaderAndUpdateState verifies the provided header and returns the updated client and
+	// consensus states.
+	CheckHeaderAndUpdateState(
+		ctx sdk.Context,
+		cdc codec.BinaryCodec,
+		clientStore sdk.KVStore,
+		clientState exported.ClientState,
+		header exported.Header,
+	) (exported.ClientState, exported.ConsensusState, error)
+
+	// CheckMisbehaviourAndUpdateState verifies the provided misbehaviour and returns the frozen
+	// client state.
+	CheckMisbehaviourAndUpdateState(
+		ctx sdk.Context,
+		cdc codec.BinaryCodec,
+		clientStore sdk.KVStore,
+		clientState exported.ClientState,
+		misbehaviour exported.Misbehaviour,
+	) (exported.ClientState, error)
+
+	// VerifyUpgradeAndUpdateState verifies the upgraded client and consensus states against the
+	// proofs committed to by the counterparty and returns the upgraded client and consensus states.
+	VerifyUpgradeAndUpdateState(
+		ctx sdk.Context,
+		cdc codec.BinaryCodec,
+		clientStore sdk.KVStore,
+		clientState exported.ClientState,
+		newClient exported.ClientState,
+		newConsState exported.ConsensusState,
+		proofUpgradeClient,
+		proofUpgradeConsState []byte,
+	) (exported.ClientState, exported.ConsensusState, error)
+
+	// Status returns the status of the client. Only Active clients are allowed to process packets.
+	Status(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientState exported.ClientState) exported.Status
+
+	// VerifyClientState verifies a proof of the client state of the running chain stored on the
+	// counterparty chain.
+	VerifyClientState(
+		ctx sdk.Context,
+		cdc codec.BinaryCodec,
+		clientStore sdk.KVStore,
+		clientState exported.ClientState,
+		height exported.Height,
+		prefix exported.Prefix,
+		counterpartyClientIdentifier string,
+		proof []byte,
+		counterpartyClientState exported.ClientState,
+	) error
+
+	// VerifyClientConsensusState verifies a proof of the consensus state of the running chain
+	// stored on the counterparty chain.
+	VerifyClientConsensusState(
+		ctx sdk.Context,
+		cdc codec.BinaryCodec,
+		clientStore sdk.KVStore,
+		clientState exported.ClientState,
+		height exported.Height,
+		counterpartyClientIdentifier string,
+		consensusHeight exported.Height,
+		prefix exported.Prefix,
+		proof []byte,
+		consensusState exported.ConsensusState,
+	) error
+
+	// VerifyConnectionState verifies a proof of the connection state of the specified connection
+	// end stored on the counterparty chain.
+	VerifyConnectionState(
+		ctx sdk.Context,
+		cdc codec.BinaryCodec,
+		clientStore sdk.KVStore,
+		clientState exported.ClientState,
+		height exported.Height,
+		prefix exported.Prefix,
+		proof []byte,
+		connectionID string,
+		connectionEnd exported.ConnectionI,
+	) error
+
+	// VerifyChannelState verifies a proof of the channel state of the specified channel end
+	// stored on the counterparty chain.
+	VerifyChannelState(
+		ctx sdk.Context,
+		cdc codec.BinaryCodec,
+		clientStore sdk.KVStore,
+		clientState exported.ClientState,
+		height exported.Height,
+		prefix exported.Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		channel exported.ChannelI,
+	) error
+
+	// VerifyPacketCommitment verifies a proof of an outgoing packet commitment stored on the
+	// counterparty chain.
+	VerifyPacketCommitment(
+		ctx sdk.Context,
+		cdc codec.BinaryCodec,
+		clientStore sdk.KVStore,
+		clientState exported.ClientState,
+		height exported.Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		prefix exported.Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+		commitmentBytes []byte,
+	) error
+
+	// VerifyPacketAcknowledgement verifies a proof of an incoming packet acknowledgement stored
+	// on the counterparty chain.
+	VerifyPacketAcknowledgement(
+		ctx sdk.Context,
+		cdc codec.BinaryCodec,
+		clientStore sdk.KVStore,
+		clientState exported.ClientState,
+		height exported.Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		prefix exported.Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+		acknowledgement []byte,
+	) error
+
+	// VerifyPacketReceiptAbsence verifies a proof of the absence of an incoming packet receipt
+	// on the counterparty chain.
+	VerifyPacketReceiptAbsence(
+		ctx sdk.Context,
+		cdc codec.BinaryCodec,
+		clientStore sdk.KVStore,
+		clientState exported.ClientState,
+		height exported.Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		prefix exported.Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+	) error
+
+	// VerifyNextSequenceRecv verifies a proof of the next sequence number to be received on the
+	// counterparty chain.
+	VerifyNextSequenceRecv(
+		ctx sdk.Context,
+		cdc codec.BinaryCodec,
+		clientStore sdk.KVStore,
+		clientState exported.ClientState,
+		height exported.Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		prefix exported.Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		nextSequenceRecv uint64,
+	) error
+
+	// VerifyNextSequenceAck verifies a proof of the next sequence number to be acknowledged on
+	// the counterparty chain.
+	VerifyNextSequenceAck(
+		ctx sdk.Context,
+		cdc codec.BinaryCodec,
+		clientStore sdk.KVStore,
+		clientState exported.ClientState,
+		height exported.Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		prefix exported.Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		nextSequenceAck uint64,
+	) error
+}
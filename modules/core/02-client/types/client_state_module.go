@@ -0,0 +1,264 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+// ClientStateModule implements the light client logic of the ClientModule interface by
+// dispatching to the methods of the exported.ClientState of its client type. Light clients
+// which implement their logic on their client state embed a ClientStateModule in their
+// client module and only need to implement RegisterInterfaces. The tx and query commands
+// returned by a ClientStateModule are nil and no client specific genesis initialization
+// is performed.
+type ClientStateModule struct {
+	clientType string
+}
+
+// NewClientStateModule creates a new ClientStateModule for the provided client type.
+func NewClientStateModule(clientType string) ClientStateModule {
+	return ClientStateModule{
+		clientType: clientType,
+	}
+}
+
+// ClientType implements the ClientModule interface.
+func (m ClientStateModule) ClientType() string {
+	return m.clientType
+}
+
+// GetTxCmd implements the ClientModule interface.
+func (ClientStateModule) GetTxCmd() *cobra.Command {
+	return nil
+}
+
+// GetQueryCmd implements the ClientModule interface.
+func (ClientStateModule) GetQueryCmd() *cobra.Command {
+	return nil
+}
+
+// InitGenesis implements the ClientModule interface. The client metadata is restored from
+// the genesis client metadata, so only the client type is checked.
+func (m ClientStateModule) InitGenesis(_ sdk.Context, _ sdk.KVStore, clientState exported.ClientState) error {
+	return m.validateClientType(clientState)
+}
+
+// ExportMetadata implements the ClientModule interface.
+func (m ClientStateModule) ExportMetadata(clientStore sdk.KVStore, clientState exported.ClientState) []exported.GenesisMetadata {
+	if err := m.validateClientType(clientState); err != nil {
+		return nil
+	}
+
+	return clientState.ExportMetadata(clientStore)
+}
+
+// Initialize implements the ClientModule interface.
+func (m ClientStateModule) Initialize(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore,
+	clientState exported.ClientState, consensusState exported.ConsensusState,
+) error {
+	if err := m.validateClientType(clientState); err != nil {
+		return err
+	}
+
+	return clientState.Initialize(ctx, cdc, clientStore, consensusState)
+}
+
+// CheckHeaderAndUpdateState implements the ClientModule interface.
+func (m ClientStateModule) CheckHeaderAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore,
+	clientState exported.ClientState, header exported.Header,
+) (exported.ClientState, exported.ConsensusState, error) {
+	if err := m.validateClientType(clientState); err != nil {
+		return nil, nil, err
+	}
+
+	return clientState.CheckHeaderAndUpdateState(ctx, cdc, clientStore, header)
+}
+
+// CheckMisbehaviourAndUpdateState implements the ClientModule interface.
+func (m ClientStateModule) CheckMisbehaviourAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore,
+	clientState exported.ClientState, misbehaviour exported.Misbehaviour,
+) (exported.ClientState, error) {
+	if err := m.validateClientType(clientState); err != nil {
+		return nil, err
+	}
+
+	return clientState.CheckMisbehaviourAndUpdateState(ctx, cdc, clientStore, misbehaviour)
+}
+
+// VerifyUpgradeAndUpdateState implements the ClientModule interface.
+func (m ClientStateModule) VerifyUpgradeAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore,
+	clientState, newClient exported.ClientState, newConsState exported.ConsensusState,
+	proofUpgradeClient, proofUpgradeConsState []byte,
+) (exported.ClientState, exported.ConsensusState, error) {
+	if err := m.validateClientType(clientState); err != nil {
+		return nil, nil, err
+	}
+
+	return clientState.VerifyUpgradeAndUpdateState(ctx, cdc, clientStore, newClient, newConsState, proofUpgradeClient, proofUpgradeConsState)
+}
+
+// Status implements the ClientModule interface. The Unknown status is returned for client
+// states of a different client type.
+func (m ClientStateModule) Status(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientState exported.ClientState,
+) exported.Status {
+	if err := m.validateClientType(clientState); err != nil {
+		return exported.Unknown
+	}
+
+	return clientState.Status(ctx, clientStore, cdc)
+}
+
+// VerifyClientState implements the ClientModule interface.
+func (m ClientStateModule) VerifyClientState(
+	_ sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientState exported.ClientState,
+	height exported.Height, prefix exported.Prefix, counterpartyClientIdentifier string, proof []byte,
+	counterpartyClientState exported.ClientState,
+) error {
+	if err := m.validateClientType(clientState); err != nil {
+		return err
+	}
+
+	return clientState.VerifyClientState(clientStore, cdc, height, prefix, counterpartyClientIdentifier, proof, counterpartyClientState)
+}
+
+// VerifyClientConsensusState implements the ClientModule interface.
+func (m ClientStateModule) VerifyClientConsensusState(
+	_ sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientState exported.ClientState,
+	height exported.Height, counterpartyClientIdentifier string, consensusHeight exported.Height,
+	prefix exported.Prefix, proof []byte, consensusState exported.ConsensusState,
+) error {
+	if err := m.validateClientType(clientState); err != nil {
+		return err
+	}
+
+	return clientState.VerifyClientConsensusState(clientStore, cdc, height, counterpartyClientIdentifier, consensusHeight, prefix, proof, consensusState)
+}
+
+// VerifyConnectionState implements the ClientModule interface.
+func (m ClientStateModule) VerifyConnectionState(
+	_ sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientState exported.ClientState,
+	height exported.Height, prefix exported.Prefix, proof []byte,
+	connectionID string, connectionEnd exported.ConnectionI,
+) error {
+	if err := m.validateClientType(clientState); err != nil {
+		return err
+	}
+
+	return clientState.VerifyConnectionState(clientStore, cdc, height, prefix, proof, connectionID, connectionEnd)
+}
+
+// VerifyChannelState implements the ClientModule interface.
+func (m ClientStateModule) VerifyChannelState(
+	_ sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientState exported.ClientState,
+	height exported.Height, prefix exported.Prefix, proof []byte,
+	portID, channelID string, channel exported.ChannelI,
+) error {
+	if err := m.validateClientType(clientState); err != nil {
+		return err
+	}
+
+	return clientState.VerifyChannelState(clientStore, cdc, height, prefix, proof, portID, channelID, channel)
+}
+
+// VerifyPacketCommitment implements the ClientModule interface.
+func (m ClientStateModule) VerifyPacketCommitment(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientState exported.ClientState,
+	height exported.Height, delayTimePeriod, delayBlockPeriod uint64, prefix exported.Prefix, proof []byte,
+	portID, channelID string, sequence uint64, commitmentBytes []byte,
+) error {
+	if err := m.validateClientType(clientState); err != nil {
+		return err
+	}
+
+	return clientState.VerifyPacketCommitment(
+		ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod,
+		prefix, proof, portID, channelID, sequence, commitmentBytes,
+	)
+}
+
+// VerifyPacketAcknowledgement implements the ClientModule interface.
+func (m ClientStateModule) VerifyPacketAcknowledgement(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientState exported.ClientState,
+	height exported.Height, delayTimePeriod, delayBlockPeriod uint64, prefix exported.Prefix, proof []byte,
+	portID, channelID string, sequence uint64, acknowledgement []byte,
+) error {
+	if err := m.validateClientType(clientState); err != nil {
+		return err
+	}
+
+	return clientState.VerifyPacketAcknowledgement(
+		ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod,
+		prefix, proof, portID, channelID, sequence, acknowledgement,
+	)
+}
+
+// VerifyPacketReceiptAbsence implements the ClientModule interface.
+func (m ClientStateModule) VerifyPacketReceiptAbsence(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientState exported.ClientState,
+	height exported.Height, delayTimePeriod, delayBlockPeriod uint64, prefix exported.Prefix, proof []byte,
+	portID, channelID string, sequence uint64,
+) error {
+	if err := m.validateClientType(clientState); err != nil {
+		return err
+	}
+
+	return clientState.VerifyPacketReceiptAbsence(
+		ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod,
+		prefix, proof, portID, channelID, sequence,
+	)
+}
+
+// VerifyNextSequenceRecv implements the ClientModule interface.
+func (m ClientStateModule) VerifyNextSequenceRecv(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientState exported.ClientState,
+	height exported.Height, delayTimePeriod, delayBlockPeriod uint64, prefix exported.Prefix, proof []byte,
+	portID, channelID string, nextSequenceRecv uint64,
+) error {
+	if err := m.validateClientType(clientState); err != nil {
+		return err
+	}
+
+	return clientState.VerifyNextSequenceRecv(
+		ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod,
+		prefix, proof, portID, channelID, nextSequenceRecv,
+	)
+}
+
+// VerifyNextSequenceAck implements the ClientModule interface.
+func (m ClientStateModule) VerifyNextSequenceAck(
+	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, clientState exported.ClientState,
+	height exported.Height, delayTimePeriod, delayBlockPeriod uint64, prefix exported.Prefix, proof []byte,
+	portID, channelID string, nextSequenceAck uint64,
+) error {
+	if err := m.validateClientType(clientState); err != nil {
+		return err
+	}
+
+	return clientState.VerifyNextSequenceAck(
+		ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod,
+		prefix, proof, portID, channelID, nextSequenceAck,
+	)
+}
+
+// validateClientType returns an error if the provided client state is not of the client type
+// handled by the module.
+func (m ClientStateModule) validateClientType(clientState exported.ClientState) error {
+	if clientState == nil {
+		return sdkerrors.Wrapf(ErrInvalidClientType, "expected client type %s, got nil client state", m.clientType)
+	}
+
+	if clientType := clientState.ClientType(); clientType != m.clientType {
+		return sdkerrors.Wrapf(ErrInvalidClientType, "expected client type %s, got %s", m.clientType, clientType)
+	}
+
+	return nil
+}
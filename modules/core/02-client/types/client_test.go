@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -83,3 +84,22 @@ func TestValidateClientType(t *testing.T) {
 		}
 	}
 }
+
+func TestAverageUpdateInterval(t *testing.T) {
+	firstUpdateTime := time.Unix(1650000000, 0)
+
+	testCases := []struct {
+		name        string
+		stats       types.ClientUpdateStats
+		expInterval time.Duration
+	}{
+		{"no updates recorded", types.ClientUpdateStats{}, 0},
+		{"single update recorded", types.ClientUpdateStats{FirstUpdateTime: firstUpdateTime, LastUpdateTime: firstUpdateTime, UpdateCount: 1}, 0},
+		{"two updates recorded", types.ClientUpdateStats{FirstUpdateTime: firstUpdateTime, LastUpdateTime: firstUpdateTime.Add(time.Minute), UpdateCount: 2}, time.Minute},
+		{"multiple updates recorded", types.ClientUpdateStats{FirstUpdateTime: firstUpdateTime, LastUpdateTime: firstUpdateTime.Add(time.Hour), UpdateCount: 5}, 15 * time.Minute},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expInterval, tc.stats.AverageUpdateInterval(), tc.name)
+	}
+}
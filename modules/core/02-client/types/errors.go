@@ -34,4 +34,9 @@ var (
 	ErrInvalidSubstitute                      = sdkerrors.Register(SubModuleName, 27, "invalid client state substitute")
 	ErrInvalidUpgradeProposal                 = sdkerrors.Register(SubModuleName, 28, "invalid upgrade proposal")
 	ErrClientNotActive                        = sdkerrors.Register(SubModuleName, 29, "client is not active")
+	ErrInvalidUpdateInfo                      = sdkerrors.Register(SubModuleName, 30, "invalid update info")
+	ErrUpdateInfoNotFound                     = sdkerrors.Register(SubModuleName, 31, "update info not found")
+	ErrClientModuleNotFound                   = sdkerrors.Register(SubModuleName, 32, "client module not found")
+	ErrFailedNextSeqAckVerification           = sdkerrors.Register(SubModuleName, 33, "next sequence acknowledgement verification failed")
+	ErrInvalidClientUpdateStats               = sdkerrors.Register(SubModuleName, 34, "invalid client update statistics")
 )
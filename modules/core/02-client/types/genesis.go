@@ -200,6 +200,36 @@ func (gs GenesisState) Validate() error {
 
 	}
 
+	for _, cui := range gs.ClientsUpdateInfos {
+		// check that update infos are for a client in the genesis clients list
+		_, ok := validClients[cui.ClientId]
+		if !ok {
+			return fmt.Errorf("update info in genesis has a client id %s that does not map to a genesis client", cui.ClientId)
+		}
+
+		for i, ui := range cui.UpdateInfos {
+			if ui.Height.IsZero() {
+				return fmt.Errorf("update info height cannot be zero")
+			}
+
+			if err := ui.UpdateInfo.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid update info %v clientID %s index %d: %w", ui, cui.ClientId, i, err)
+			}
+		}
+	}
+
+	for i, cus := range gs.ClientsUpdateStats {
+		// check that update statistics are for a client in the genesis clients list
+		_, ok := validClients[cus.ClientId]
+		if !ok {
+			return fmt.Errorf("update statistics in genesis have a client id %s that does not map to a genesis client", cus.ClientId)
+		}
+
+		if err := cus.UpdateStats.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid update statistics %v clientID %s index %d: %w", cus.UpdateStats, cus.ClientId, i, err)
+		}
+	}
+
 	if gs.CreateLocalhost && !gs.Params.IsAllowedClient(exported.Localhost) {
 		return fmt.Errorf("localhost client is not registered on the allowlist")
 	}
@@ -211,6 +241,14 @@ func (gs GenesisState) Validate() error {
 	return nil
 }
 
+// NewClientUpdateInfos creates a new ClientUpdateInfos instance.
+func NewClientUpdateInfos(clientID string, updateInfos []UpdateInfoWithHeight) ClientUpdateInfos {
+	return ClientUpdateInfos{
+		ClientId:    clientID,
+		UpdateInfos: updateInfos,
+	}
+}
+
 // NewGenesisMetadata is a constructor for GenesisMetadata
 func NewGenesisMetadata(key, val []byte) GenesisMetadata {
 	return GenesisMetadata{
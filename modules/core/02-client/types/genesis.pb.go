@@ -36,6 +36,10 @@ type GenesisState struct {
 	CreateLocalhost bool `protobuf:"varint,5,opt,name=create_localhost,json=createLocalhost,proto3" json:"create_localhost,omitempty" yaml:"create_localhost"`
 	// the sequence for the next generated client identifier
 	NextClientSequence uint64 `protobuf:"varint,6,opt,name=next_client_sequence,json=nextClientSequence,proto3" json:"next_client_sequence,omitempty" yaml:"next_client_sequence"`
+	// update infos from each client
+	ClientsUpdateInfos []ClientUpdateInfos `protobuf:"bytes,7,rep,name=clients_update_infos,json=clientsUpdateInfos,proto3" json:"clients_update_infos" yaml:"clients_update_infos"`
+	// update statistics from each client
+	ClientsUpdateStats []IdentifiedClientUpdateStats `protobuf:"bytes,8,rep,name=clients_update_stats,json=clientsUpdateStats,proto3" json:"clients_update_stats" yaml:"clients_update_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -113,6 +117,20 @@ func (m *GenesisState) GetNextClientSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetClientsUpdateInfos() []ClientUpdateInfos {
+	if m != nil {
+		return m.ClientsUpdateInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClientsUpdateStats() []IdentifiedClientUpdateStats {
+	if m != nil {
+		return m.ClientsUpdateStats
+	}
+	return nil
+}
+
 // GenesisMetadata defines the genesis type for metadata that clients may return
 // with ExportMetadata
 type GenesisMetadata struct {
@@ -218,41 +236,46 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/genesis.proto", fileDescriptor_bcd0c0f1f2e6a91a) }
 
 var fileDescriptor_bcd0c0f1f2e6a91a = []byte{
-	// 539 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x41, 0x6e, 0xd3, 0x40,
-	0x14, 0xcd, 0x34, 0x69, 0x68, 0xa7, 0x15, 0x0d, 0xa3, 0xa8, 0x98, 0x54, 0xb2, 0x2d, 0xb3, 0x09,
-	0x8b, 0xd8, 0x24, 0xb0, 0xa8, 0xb2, 0x41, 0x72, 0x25, 0x50, 0x25, 0x90, 0xc0, 0xec, 0xd8, 0x58,
-	0x93, 0xf1, 0x90, 0x8e, 0xb0, 0x3d, 0x21, 0x33, 0x89, 0xc8, 0x0d, 0x58, 0x22, 0x4e, 0xc0, 0x9a,
-	0x33, 0x70, 0x80, 0x2e, 0xbb, 0xec, 0x2a, 0xa0, 0xe4, 0x06, 0x39, 0x01, 0xf2, 0xcc, 0x98, 0xb6,
-	0x69, 0xca, 0xee, 0xe7, 0xf9, 0xbd, 0xf7, 0x9f, 0xde, 0xcf, 0x40, 0x97, 0x0d, 0x48, 0x40, 0xf8,
-	0x98, 0x06, 0x24, 0x65, 0x34, 0x97, 0xc1, 0xb4, 0x1b, 0x0c, 0x69, 0x4e, 0x05, 0x13, 0xfe, 0x68,
-	0xcc, 0x25, 0x47, 0x88, 0x0d, 0x88, 0x5f, 0x30, 0x7c, 0xcd, 0xf0, 0xa7, 0xdd, 0x96, 0xb3, 0x41,
-	0x65, 0xbe, 0x2a, 0x51, 0xab, 0x39, 0xe4, 0x43, 0xae, 0xc6, 0xa0, 0x98, 0x34, 0xea, 0x5d, 0xd6,
-	0xe0, 0xfe, 0x2b, 0x6d, 0xfe, 0x5e, 0x62, 0x49, 0x11, 0x81, 0xf7, 0xb4, 0x4c, 0x58, 0xc0, 0xad,
-	0xb6, 0xf7, 0x7a, 0x4f, 0xfc, 0xdb, 0xdb, 0xfc, 0xd3, 0x84, 0xe6, 0x92, 0x7d, 0x64, 0x34, 0x39,
-	0x51, 0x98, 0xd2, 0x86, 0xf6, 0xf9, 0xdc, 0xa9, 0xfc, 0xfc, 0xed, 0x1c, 0x6e, 0xfc, 0x2c, 0xa2,
-	0xd2, 0x19, 0x7d, 0x07, 0xf0, 0x81, 0x99, 0x63, 0xc2, 0x73, 0x41, 0x73, 0x31, 0x11, 0xd6, 0xd6,
-	0xdd, 0xfb, 0xb4, 0xcd, 0x49, 0x49, 0xd5, 0x7e, 0x61, 0xbf, 0xd8, 0xb7, 0x9a, 0x3b, 0xd6, 0x0c,
-	0x67, 0x69, 0xdf, 0xbb, 0xe5, 0xe8, 0x15, 0x59, 0xb4, 0x54, 0xac, 0x69, 0xa3, 0x06, 0x59, 0xc3,
-	0xd1, 0x0c, 0x96, 0x58, 0x9c, 0x51, 0x89, 0x13, 0x2c, 0xb1, 0x55, 0x55, 0x91, 0x3a, 0xff, 0xaf,
-	0xc0, 0xf4, 0xf7, 0xc6, 0x88, 0x42, 0xc7, 0xc4, 0x7a, 0x78, 0x33, 0x56, 0x69, 0xea, 0x45, 0x07,
-	0x06, 0x2a, 0x15, 0xe8, 0x18, 0xd6, 0x47, 0x78, 0x8c, 0x33, 0x61, 0xd5, 0x5c, 0xd0, 0xde, 0xeb,
-	0xb5, 0x36, 0x2d, 0x7c, 0xab, 0x18, 0x61, 0xad, 0x70, 0x8f, 0x0c, 0x1f, 0xbd, 0x84, 0x0d, 0x32,
-	0xa6, 0x58, 0xd2, 0x38, 0xe5, 0x04, 0xa7, 0x67, 0x5c, 0x48, 0x6b, 0xdb, 0x05, 0xed, 0x9d, 0xf0,
-	0xe8, 0x5a, 0x82, 0x35, 0x46, 0x91, 0x40, 0x41, 0xaf, 0x4b, 0x04, 0xbd, 0x83, 0xcd, 0x9c, 0x7e,
-	0x91, 0xb1, 0x5e, 0x17, 0x0b, 0xfa, 0x79, 0x42, 0x73, 0x42, 0xad, 0xba, 0x0b, 0xda, 0xb5, 0xd0,
-	0x59, 0xcd, 0x9d, 0x23, 0xed, 0xb5, 0x89, 0xe5, 0x45, 0xa8, 0x80, 0xcd, 0xad, 0x4b, 0xf0, 0x05,
-	0x3c, 0x58, 0x6b, 0x06, 0x35, 0x60, 0xf5, 0x13, 0x9d, 0x59, 0xc0, 0x05, 0xed, 0xfd, 0xa8, 0x18,
-	0x51, 0x13, 0x6e, 0x4f, 0x71, 0x3a, 0xa1, 0xd6, 0x96, 0xc2, 0xf4, 0x8f, 0x7e, 0xed, 0xeb, 0x0f,
-	0xa7, 0xe2, 0xfd, 0x02, 0xf0, 0xd1, 0x9d, 0x2d, 0xa3, 0x2e, 0xdc, 0x35, 0x31, 0x58, 0xa2, 0x1c,
-	0x77, 0xc3, 0xe6, 0x6a, 0xee, 0x34, 0xae, 0x97, 0x1e, 0xb3, 0xc4, 0x8b, 0x76, 0xf4, 0x7c, 0x9a,
-	0xa0, 0x14, 0x9a, 0xe6, 0xaf, 0x0e, 0xac, 0xff, 0x73, 0x8f, 0x37, 0xf5, 0xbd, 0x7e, 0x56, 0xdb,
-	0x9c, 0xf5, 0xf0, 0xc6, 0x86, 0xab, 0xab, 0xde, 0xd7, 0xc8, 0x3f, 0x7e, 0x74, 0xbe, 0xb0, 0xc1,
-	0xc5, 0xc2, 0x06, 0x7f, 0x16, 0x36, 0xf8, 0xb6, 0xb4, 0x2b, 0x17, 0x4b, 0xbb, 0x72, 0xb9, 0xb4,
-	0x2b, 0x1f, 0x8e, 0x87, 0x4c, 0x9e, 0x4d, 0x06, 0x3e, 0xe1, 0x59, 0x40, 0xb8, 0xc8, 0xb8, 0x08,
-	0xd8, 0x80, 0x74, 0x86, 0x3c, 0x98, 0x3e, 0x0f, 0x32, 0x9e, 0x4c, 0x52, 0x2a, 0xf4, 0x5b, 0x7e,
-	0xda, 0xeb, 0x98, 0xe7, 0x2c, 0x67, 0x23, 0x2a, 0x06, 0x75, 0xf5, 0x6a, 0x9f, 0xfd, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0x05, 0xb6, 0x71, 0xe6, 0x24, 0x04, 0x00, 0x00,
+	// 612 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x6e, 0xd3, 0x4a,
+	0x14, 0xcd, 0xb4, 0x69, 0xda, 0x4e, 0xab, 0xd7, 0xbc, 0x51, 0x54, 0x4c, 0x2b, 0xd9, 0x96, 0x2b,
+	0xa4, 0xb0, 0xa8, 0x4d, 0x0b, 0x8b, 0x2a, 0x1b, 0x24, 0x57, 0x02, 0x45, 0x02, 0x09, 0x8c, 0xd8,
+	0xb0, 0xb1, 0x9c, 0xf1, 0x34, 0xb5, 0xb0, 0x3d, 0x21, 0x33, 0x8e, 0x88, 0xc4, 0x1a, 0xb1, 0x44,
+	0x7c, 0x01, 0x6b, 0xbe, 0x81, 0x0f, 0xe8, 0xb2, 0x4b, 0x56, 0x29, 0x4a, 0xfe, 0x20, 0x5f, 0x80,
+	0x3c, 0x33, 0xa6, 0x89, 0x93, 0x00, 0xbb, 0x9b, 0x33, 0xe7, 0x9c, 0x7b, 0x72, 0xe7, 0x7a, 0xa0,
+	0x19, 0x75, 0xb0, 0x83, 0x69, 0x9f, 0x38, 0x38, 0x8e, 0x48, 0xca, 0x9d, 0xc1, 0x89, 0xd3, 0x25,
+	0x29, 0x61, 0x11, 0xb3, 0x7b, 0x7d, 0xca, 0x29, 0x42, 0x51, 0x07, 0xdb, 0x39, 0xc3, 0x96, 0x0c,
+	0x7b, 0x70, 0x72, 0x60, 0x2c, 0x51, 0xa9, 0x53, 0x21, 0x3a, 0x68, 0x74, 0x69, 0x97, 0x8a, 0xd2,
+	0xc9, 0x2b, 0x89, 0x5a, 0x37, 0x35, 0xb8, 0xfb, 0x54, 0x9a, 0xbf, 0xe2, 0x01, 0x27, 0x08, 0xc3,
+	0x4d, 0x29, 0x63, 0x1a, 0x30, 0xd7, 0x9b, 0x3b, 0xa7, 0xf7, 0xed, 0xc5, 0x6e, 0x76, 0x3b, 0x24,
+	0x29, 0x8f, 0x2e, 0x22, 0x12, 0x9e, 0x0b, 0x4c, 0x68, 0x5d, 0xfd, 0x6a, 0x64, 0x54, 0xbe, 0xdd,
+	0x18, 0xfb, 0x4b, 0x8f, 0x99, 0x57, 0x38, 0xa3, 0x2f, 0x00, 0xfe, 0xaf, 0x6a, 0x1f, 0xd3, 0x94,
+	0x91, 0x94, 0x65, 0x4c, 0x5b, 0x5b, 0xdd, 0x4f, 0xda, 0x9c, 0x17, 0x54, 0xe9, 0xe7, 0xb6, 0xf2,
+	0x7e, 0xd3, 0x91, 0xa1, 0x0d, 0x83, 0x24, 0x6e, 0x59, 0x0b, 0x8e, 0x56, 0x9e, 0x45, 0x4a, 0x59,
+	0x49, 0xeb, 0xd5, 0x71, 0x09, 0x47, 0x43, 0x58, 0x60, 0x7e, 0x42, 0x78, 0x10, 0x06, 0x3c, 0xd0,
+	0xd6, 0x45, 0xa4, 0xe3, 0x3f, 0x8f, 0x40, 0xcd, 0xef, 0xb9, 0x12, 0xb9, 0x86, 0x8a, 0x75, 0x67,
+	0x3e, 0x56, 0x61, 0x6a, 0x79, 0x7b, 0x0a, 0x2a, 0x14, 0xe8, 0x0c, 0xd6, 0x7a, 0x41, 0x3f, 0x48,
+	0x98, 0x56, 0x35, 0x41, 0x73, 0xe7, 0xf4, 0x60, 0x59, 0xc3, 0x17, 0x82, 0xe1, 0x56, 0x73, 0x77,
+	0x4f, 0xf1, 0xd1, 0x13, 0x58, 0xc7, 0x7d, 0x12, 0x70, 0xe2, 0xc7, 0x14, 0x07, 0xf1, 0x25, 0x65,
+	0x5c, 0xdb, 0x30, 0x41, 0x73, 0xcb, 0x3d, 0x9c, 0x49, 0x50, 0x62, 0xe4, 0x09, 0x04, 0xf4, 0xac,
+	0x40, 0xd0, 0x4b, 0xd8, 0x48, 0xc9, 0x7b, 0xee, 0xcb, 0x76, 0x3e, 0x23, 0xef, 0x32, 0x92, 0x62,
+	0xa2, 0xd5, 0x4c, 0xd0, 0xac, 0xba, 0xc6, 0x74, 0x64, 0x1c, 0x4a, 0xaf, 0x65, 0x2c, 0xcb, 0x43,
+	0x39, 0xac, 0xee, 0x5a, 0x81, 0xe8, 0x03, 0x6c, 0x14, 0x7f, 0x3d, 0xeb, 0x85, 0x79, 0x80, 0x28,
+	0xbd, 0xa0, 0x4c, 0xdb, 0x14, 0x33, 0xbd, 0xb7, 0xfa, 0x9a, 0x5f, 0x0b, 0x76, 0x3b, 0x27, 0xbb,
+	0x47, 0x6a, 0x96, 0x87, 0xf3, 0xb3, 0x9c, 0x35, 0xb4, 0x3c, 0xa4, 0xe0, 0x19, 0x21, 0xfa, 0x08,
+	0x16, 0xda, 0x33, 0x1e, 0x70, 0xa6, 0x6d, 0x89, 0xf6, 0xce, 0xbf, 0x6c, 0xb5, 0xf4, 0xcb, 0x17,
+	0xe6, 0x6f, 0x41, 0x84, 0x75, 0x39, 0x88, 0x10, 0x5a, 0x8f, 0xe1, 0x5e, 0x69, 0x41, 0x50, 0x1d,
+	0xae, 0xbf, 0x25, 0x43, 0x0d, 0x98, 0xa0, 0xb9, 0xeb, 0xe5, 0x25, 0x6a, 0xc0, 0x8d, 0x41, 0x10,
+	0x67, 0x44, 0x5b, 0x13, 0x98, 0xfc, 0xd1, 0xaa, 0x7e, 0xfa, 0x6a, 0x54, 0xac, 0xef, 0x00, 0xde,
+	0x5d, 0xb9, 0x6c, 0xe8, 0x04, 0x6e, 0xab, 0xdb, 0x88, 0x42, 0xe1, 0xb8, 0xed, 0x36, 0xa6, 0x23,
+	0xa3, 0x3e, 0x1b, 0xd3, 0x8f, 0x42, 0xcb, 0xdb, 0x92, 0x75, 0x3b, 0x44, 0x31, 0x54, 0x0b, 0x78,
+	0xbb, 0xe7, 0xf2, 0xd3, 0x3b, 0x5a, 0x36, 0x94, 0xf2, 0x76, 0xeb, 0x6a, 0x10, 0xfb, 0x73, 0x1d,
+	0x6e, 0x97, 0xfb, 0x3f, 0x89, 0xfc, 0xe6, 0x7b, 0x57, 0x63, 0x1d, 0x5c, 0x8f, 0x75, 0xf0, 0x73,
+	0xac, 0x83, 0xcf, 0x13, 0xbd, 0x72, 0x3d, 0xd1, 0x2b, 0x3f, 0x26, 0x7a, 0xe5, 0xcd, 0x59, 0x37,
+	0xe2, 0x97, 0x59, 0xc7, 0xc6, 0x34, 0x71, 0x30, 0x65, 0x09, 0x65, 0x4e, 0xd4, 0xc1, 0xc7, 0x5d,
+	0xea, 0x0c, 0x1e, 0x39, 0x09, 0x0d, 0xb3, 0x98, 0x30, 0xf9, 0xa4, 0x3d, 0x38, 0x3d, 0x56, 0xaf,
+	0x1a, 0x1f, 0xf6, 0x08, 0xeb, 0xd4, 0xc4, 0xe3, 0xf5, 0xf0, 0xd7, 0x00, 0x13, 0x63, 0x2e, 0x8f,
+	0x2b, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -275,6 +298,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClientsUpdateStats) > 0 {
+		for iNdEx := len(m.ClientsUpdateStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientsUpdateStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ClientsUpdateInfos) > 0 {
+		for iNdEx := len(m.ClientsUpdateInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientsUpdateInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.NextClientSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextClientSequence))
 		i--
@@ -469,6 +520,18 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextClientSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.NextClientSequence))
 	}
+	if len(m.ClientsUpdateInfos) > 0 {
+		for _, e := range m.ClientsUpdateInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClientsUpdateStats) > 0 {
+		for _, e := range m.ClientsUpdateStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -717,6 +780,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientsUpdateInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientsUpdateInfos = append(m.ClientsUpdateInfos, ClientUpdateInfos{})
+			if err := m.ClientsUpdateInfos[len(m.ClientsUpdateInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientsUpdateStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientsUpdateStats = append(m.ClientsUpdateStats, IdentifiedClientUpdateStats{})
+			if err := m.ClientsUpdateStats[len(m.ClientsUpdateStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"time"
 
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	client "github.com/cosmos/ibc-go/v4/modules/core/02-client"
@@ -551,3 +552,171 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 		}
 	}
 }
+
+func (suite *TypesTestSuite) TestValidateGenesisUpdateInfos() {
+	var genState types.GenesisState
+
+	validUpdateInfo := types.NewUpdateInfo("cosmos1submitter", tmhash.Sum([]byte("tx")), tmhash.Sum([]byte("header")))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: update info without tx hash",
+			func() {
+				genState.ClientsUpdateInfos[0].UpdateInfos[0].UpdateInfo.TxHash = nil
+			},
+			true,
+		},
+		{
+			"update info client id does not match client id in genesis clients",
+			func() {
+				genState.ClientsUpdateInfos[0].ClientId = tmClientID1
+			},
+			false,
+		},
+		{
+			"update info height is zero",
+			func() {
+				genState.ClientsUpdateInfos[0].UpdateInfos[0].Height = types.ZeroHeight()
+			},
+			false,
+		},
+		{
+			"empty submitter",
+			func() {
+				genState.ClientsUpdateInfos[0].UpdateInfos[0].UpdateInfo.Submitter = ""
+			},
+			false,
+		},
+		{
+			"invalid header hash",
+			func() {
+				genState.ClientsUpdateInfos[0].UpdateInfos[0].UpdateInfo.HeaderHash = []byte("header")
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		genState = types.NewGenesisState(
+			[]types.IdentifiedClientState{
+				types.NewIdentifiedClientState(
+					tmClientID0, ibctmtypes.NewClientState(chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false),
+				),
+			},
+			nil,
+			nil,
+			types.NewParams(exported.Tendermint),
+			false,
+			1,
+		)
+		genState.ClientsUpdateInfos = []types.ClientUpdateInfos{
+			types.NewClientUpdateInfos(
+				tmClientID0,
+				[]types.UpdateInfoWithHeight{
+					types.NewUpdateInfoWithHeight(clientHeight, validUpdateInfo),
+				},
+			),
+		}
+
+		tc.malleate()
+
+		err := genState.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *TypesTestSuite) TestValidateGenesisUpdateStats() {
+	var genState types.GenesisState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"update statistics client id does not match client id in genesis clients",
+			func() {
+				genState.ClientsUpdateStats[0].ClientId = tmClientID1
+			},
+			false,
+		},
+		{
+			"empty last updater",
+			func() {
+				genState.ClientsUpdateStats[0].UpdateStats.LastUpdater = ""
+			},
+			false,
+		},
+		{
+			"zero update count",
+			func() {
+				genState.ClientsUpdateStats[0].UpdateStats.UpdateCount = 0
+			},
+			false,
+		},
+		{
+			"last update time before first update time",
+			func() {
+				genState.ClientsUpdateStats[0].UpdateStats.LastUpdateTime = genState.ClientsUpdateStats[0].UpdateStats.FirstUpdateTime.Add(-time.Hour)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		genState = types.NewGenesisState(
+			[]types.IdentifiedClientState{
+				types.NewIdentifiedClientState(
+					tmClientID0, ibctmtypes.NewClientState(chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false),
+				),
+			},
+			nil,
+			nil,
+			types.NewParams(exported.Tendermint),
+			false,
+			1,
+		)
+		genState.ClientsUpdateStats = []types.IdentifiedClientUpdateStats{
+			types.NewIdentifiedClientUpdateStats(
+				tmClientID0,
+				types.ClientUpdateStats{
+					LastUpdater:     "cosmos1updater",
+					FirstUpdateTime: time.Unix(1000, 0).UTC(),
+					LastUpdateTime:  time.Unix(2000, 0).UTC(),
+					UpdateCount:     2,
+				},
+			),
+		}
+
+		tc.malleate()
+
+		err := genState.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
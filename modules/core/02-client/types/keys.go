@@ -9,6 +9,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 )
 
 const (
@@ -24,8 +25,40 @@ const (
 	// KeyNextClientSequence is the key used to store the next client sequence in
 	// the keeper.
 	KeyNextClientSequence = "nextClientSequence"
+
+	// KeyClientUpdateStatsPrefix is the prefix under which the update statistics of each client
+	// are stored in the keeper.
+	KeyClientUpdateStatsPrefix = "clientUpdateStats"
+
+	// KeyClientExpiryCursor is the key used to store the identifier of the last client checked
+	// for expiry in the keeper.
+	KeyClientExpiryCursor = "clientExpiryCursor"
+
+	// MaxClientExpiryChecksPerBlock is the maximum number of clients checked for expiry in
+	// a single BeginBlock.
+	MaxClientExpiryChecksPerBlock = 20
+
+	// ClientExpiryGaugeTrustingPeriodDivisor defines the fraction of the trusting period, as its
+	// divisor, below which the time until expiry telemetry gauge is set for a tendermint client.
+	ClientExpiryGaugeTrustingPeriodDivisor = 3
+
+	// KeyUpdateInfo is appended to the consensus state key to store the update info of the client
+	// update which created the consensus state
+	KeyUpdateInfo = "updateInfo"
 )
 
+// ClientUpdateStatsKey returns the store key under which the update statistics of the
+// provided client are stored.
+func ClientUpdateStatsKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyClientUpdateStatsPrefix, clientID))
+}
+
+// UpdateInfoKey returns the key under which the update info of the consensus state at the given
+// height is stored in the client store.
+func UpdateInfoKey(height exported.Height) []byte {
+	return []byte(fmt.Sprintf("%s/%s", host.ConsensusStateKey(height), KeyUpdateInfo))
+}
+
 // FormatClientIdentifier returns the client identifier with the sequence appended.
 // This is a SDK specific format not enforced by IBC protocol.
 func FormatClientIdentifier(clientType string, sequence uint64) string {
@@ -11,6 +11,7 @@ var (
 	_ codectypes.UnpackInterfacesMessage = QueryClientStatesResponse{}
 	_ codectypes.UnpackInterfacesMessage = QueryConsensusStateResponse{}
 	_ codectypes.UnpackInterfacesMessage = QueryConsensusStatesResponse{}
+	_ codectypes.UnpackInterfacesMessage = QueryConsensusStateAtTimeResponse{}
 )
 
 // UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
@@ -64,3 +65,8 @@ func NewQueryConsensusStateResponse(
 func (qcsr QueryConsensusStateResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(qcsr.ConsensusState, new(exported.ConsensusState))
 }
+
+// UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
+func (qcsr QueryConsensusStateAtTimeResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(qcsr.ConsensusState, new(exported.ConsensusState))
+}
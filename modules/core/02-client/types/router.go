@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ClientRouter is a map from client type to the ClientModule which implements
+// the light client logic for that client type.
+type ClientRouter struct {
+	routes map[string]ClientModule
+	sealed bool
+}
+
+// NewClientRouter returns an empty ClientRouter.
+func NewClientRouter() *ClientRouter {
+	return &ClientRouter{
+		routes: make(map[string]ClientModule),
+	}
+}
+
+// Seal prevents the ClientRouter from any subsequent client modules to be registered.
+// Seal will panic if called more than once.
+func (rtr *ClientRouter) Seal() {
+	if rtr.sealed {
+		panic("client router already sealed")
+	}
+	rtr.sealed = true
+}
+
+// Sealed returns a boolean signifying if the ClientRouter is sealed or not.
+func (rtr ClientRouter) Sealed() bool {
+	return rtr.sealed
+}
+
+// AddRoute registers the ClientModule for the client type it handles. It returns the
+// ClientRouter so AddRoute calls can be linked. It will panic if the ClientRouter is
+// sealed, the client type is invalid or a module is already registered for the client type.
+func (rtr *ClientRouter) AddRoute(clientModule ClientModule) *ClientRouter {
+	clientType := clientModule.ClientType()
+	if rtr.sealed {
+		panic(fmt.Sprintf("client router sealed; cannot register %s client module", clientType))
+	}
+	if err := ValidateClientType(clientType); err != nil {
+		panic(fmt.Sprintf("invalid client type %s: %s", clientType, err))
+	}
+	if rtr.HasRoute(clientType) {
+		panic(fmt.Sprintf("client module for client type %s has already been registered", clientType))
+	}
+
+	rtr.routes[clientType] = clientModule
+	return rtr
+}
+
+// HasRoute returns true if the ClientRouter has a module registered for the client type or false otherwise.
+func (rtr *ClientRouter) HasRoute(clientType string) bool {
+	_, ok := rtr.routes[clientType]
+	return ok
+}
+
+// GetRoute returns the ClientModule registered for a given client type.
+func (rtr *ClientRouter) GetRoute(clientType string) (ClientModule, bool) {
+	if !rtr.HasRoute(clientType) {
+		return nil, false
+	}
+	return rtr.routes[clientType], true
+}
+
+// ClientModules returns all registered client modules sorted by client type.
+func (rtr *ClientRouter) ClientModules() []ClientModule {
+	clientTypes := make([]string, 0, len(rtr.routes))
+	for clientType := range rtr.routes {
+		clientTypes = append(clientTypes, clientType)
+	}
+	sort.Strings(clientTypes)
+
+	clientModules := make([]ClientModule, len(clientTypes))
+	for i, clientType := range clientTypes {
+		clientModules[i] = rtr.routes[clientType]
+	}
+	return clientModules
+}
@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	solomachine "github.com/cosmos/ibc-go/v4/modules/light-clients/06-solomachine"
+	tendermint "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint"
+	localhost "github.com/cosmos/ibc-go/v4/modules/light-clients/09-localhost"
+)
+
+func (suite *TypesTestSuite) TestClientRouter() {
+	rtr := types.NewClientRouter()
+
+	rtr.AddRoute(tendermint.NewClientModule()).AddRoute(solomachine.NewClientModule())
+	suite.Require().True(rtr.HasRoute(exported.Tendermint))
+	suite.Require().True(rtr.HasRoute(exported.Solomachine))
+	suite.Require().False(rtr.HasRoute(exported.Localhost))
+
+	clientModule, found := rtr.GetRoute(exported.Tendermint)
+	suite.Require().True(found)
+	suite.Require().Equal(exported.Tendermint, clientModule.ClientType())
+
+	_, found = rtr.GetRoute(exported.Localhost)
+	suite.Require().False(found)
+
+	// client modules are returned sorted by client type
+	clientModules := rtr.ClientModules()
+	suite.Require().Len(clientModules, 2)
+	suite.Require().Equal(exported.Solomachine, clientModules[0].ClientType())
+	suite.Require().Equal(exported.Tendermint, clientModules[1].ClientType())
+
+	// duplicate registration
+	suite.Require().Panics(func() {
+		rtr.AddRoute(tendermint.NewClientModule())
+	})
+
+	rtr.Seal()
+	suite.Require().True(rtr.Sealed())
+
+	suite.Require().Panics(func() {
+		rtr.Seal()
+	})
+
+	suite.Require().Panics(func() {
+		rtr.AddRoute(localhost.NewClientModule())
+	})
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
@@ -23,3 +24,23 @@ func GetQueryCmd() *cobra.Command {
 
 	return queryCmd
 }
+
+// NewTxCmd returns a CLI command handler for all x/ibc connection transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.SubModuleName,
+		Short:                      "IBC connection transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewConnectionOpenInitCmd(),
+		NewConnectionOpenTryCmd(),
+		NewConnectionOpenAckCmd(),
+		NewConnectionOpenConfirmCmd(),
+	)
+
+	return txCmd
+}
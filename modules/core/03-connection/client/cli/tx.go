@@ -0,0 +1,398 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	clientutils "github.com/cosmos/ibc-go/v4/modules/core/02-client/client/utils"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/03-connection/client/utils"
+	"github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibcclient "github.com/cosmos/ibc-go/v4/modules/core/client"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+const (
+	flagVersionIdentifier  = "version-identifier"
+	flagVersionFeatures    = "version-features"
+	flagCounterpartyPrefix = "counterparty-prefix"
+	flagDelayPeriod        = "delay-period"
+	flagTimeoutTimestamp   = "timeout-timestamp"
+)
+
+// NewCmdSubmitConnectionUpgradeProposal implements a command handler for submitting a connection upgrade proposal transaction.
+func NewCmdSubmitConnectionUpgradeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "connection-upgrade [connection-id] [delay-period]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit an IBC connection upgrade proposal",
+		Long: "Submit an IBC connection upgrade proposal along with an initial deposit.\n" +
+			"Please specify the connection identifier and the delay period (in nanoseconds) the connection will be upgraded to.\n" +
+			"The connection is upgraded once the same upgrade has been proposed on the counterparty chain and the\n" +
+			"connection upgrade handshake has been relayed. The upgrade timeout timestamp (in UNIX nanoseconds) must\n" +
+			"be the same on both chains, the upgrade can be cancelled once it has timed out.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal connection-upgrade connection-0 3600000000000 --timeout-timestamp 1700000000000000000 --version-identifier 1 --version-features ORDER_ORDERED,ORDER_UNORDERED", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			connectionID := args[0]
+			delayPeriod, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			versionIdentifier, err := cmd.Flags().GetString(flagVersionIdentifier)
+			if err != nil {
+				return err
+			}
+
+			versionFeatures, err := cmd.Flags().GetStringSlice(flagVersionFeatures)
+			if err != nil {
+				return err
+			}
+
+			counterpartyPrefix, err := cmd.Flags().GetString(flagCounterpartyPrefix)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewConnectionUpgradeProposal(
+				title, description, connectionID,
+				types.NewVersion(versionIdentifier, versionFeatures), delayPeriod,
+				commitmenttypes.NewMerklePrefix([]byte(counterpartyPrefix)), timeoutTimestamp,
+			)
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(flagVersionIdentifier, types.DefaultIBCVersionIdentifier, "identifier of the upgraded connection version")
+	cmd.Flags().StringSlice(flagVersionFeatures, types.DefaultIBCVersion.GetFeatures(), "features of the upgraded connection version")
+	cmd.Flags().String(flagCounterpartyPrefix, host.StoreKey, "commitment prefix of the counterparty chain")
+	cmd.Flags().Uint64(flagTimeoutTimestamp, 0, "timestamp in UNIX nanoseconds after which the connection upgrade can be cancelled")
+	_ = cmd.MarkFlagRequired(flagTimeoutTimestamp)
+
+	return cmd
+}
+
+// NewConnectionOpenInitCmd defines the command to initialize a connection on chain A with a given counterparty chain B.
+func NewConnectionOpenInitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "open-init [client-id] [counterparty-client-id]",
+		Short:   "Initialize a connection on chain A with a given counterparty chain B",
+		Long:    "Initialize a connection on chain A with a given counterparty chain B. If no version identifier is provided, the default connection version is used.",
+		Example: fmt.Sprintf("%s tx ibc %s open-init 07-tendermint-0 07-tendermint-1 --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientID, counterpartyClientID := args[0], args[1]
+
+			counterpartyPrefix, err := cmd.Flags().GetString(flagCounterpartyPrefix)
+			if err != nil {
+				return err
+			}
+
+			delayPeriod, err := cmd.Flags().GetUint64(flagDelayPeriod)
+			if err != nil {
+				return err
+			}
+
+			var connectionVersion *types.Version
+			if cmd.Flags().Changed(flagVersionIdentifier) {
+				versionIdentifier, err := cmd.Flags().GetString(flagVersionIdentifier)
+				if err != nil {
+					return err
+				}
+
+				versionFeatures, err := cmd.Flags().GetStringSlice(flagVersionFeatures)
+				if err != nil {
+					return err
+				}
+
+				connectionVersion = types.NewVersion(versionIdentifier, versionFeatures)
+			}
+
+			msg := types.NewMsgConnectionOpenInit(
+				clientID, counterpartyClientID,
+				commitmenttypes.NewMerklePrefix([]byte(counterpartyPrefix)),
+				connectionVersion, delayPeriod, clientCtx.GetFromAddress().String(),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagCounterpartyPrefix, host.StoreKey, "commitment prefix of the counterparty chain")
+	cmd.Flags().Uint64(flagDelayPeriod, 0, "delay period of the connection in nanoseconds")
+	cmd.Flags().String(flagVersionIdentifier, types.DefaultIBCVersionIdentifier, "identifier of the connection version")
+	cmd.Flags().StringSlice(flagVersionFeatures, types.DefaultIBCVersion.GetFeatures(), "features of the connection version")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewConnectionOpenTryCmd defines the command to relay a connection open attempt from chain A to a counterparty chain B.
+func NewConnectionOpenTryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-try [client-id] [counterparty-connection-id]",
+		Short: "Relay a connection open attempt from the counterparty chain",
+		Long: `Relay a connection open attempt from the counterparty chain. The counterparty connection, client state and consensus
+state are queried with their proofs from the counterparty node at the latest height of the provided client, which must
+therefore be updated beforehand.`,
+		Example: fmt.Sprintf("%s tx ibc %s open-try 07-tendermint-1 connection-0 --counterparty-node tcp://localhost:26657 --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientID, counterpartyConnectionID := args[0], args[1]
+
+			counterpartyPrefix, err := cmd.Flags().GetString(flagCounterpartyPrefix)
+			if err != nil {
+				return err
+			}
+
+			proofs, err := queryCounterpartyConnectionProofs(cmd, clientCtx, clientID, counterpartyConnectionID)
+			if err != nil {
+				return err
+			}
+
+			counterpartyConnection := proofs.connection.Connection
+			msg := types.NewMsgConnectionOpenTry(
+				"", clientID, counterpartyConnectionID, counterpartyConnection.ClientId, proofs.clientState,
+				commitmenttypes.NewMerklePrefix([]byte(counterpartyPrefix)), counterpartyConnection.Versions, counterpartyConnection.DelayPeriod,
+				proofs.connection.Proof, proofs.clientProof, proofs.consensusProof,
+				proofs.connection.ProofHeight, proofs.consensusHeight, clientCtx.GetFromAddress().String(),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagCounterpartyPrefix, host.StoreKey, "commitment prefix of the counterparty chain")
+	ibcclient.AddCounterpartyFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewConnectionOpenAckCmd defines the command to relay the acceptance of a connection open attempt from chain B back
+// to chain A.
+func NewConnectionOpenAckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-ack [connection-id] [counterparty-connection-id]",
+		Short: "Relay the acceptance of a connection open attempt from the counterparty chain",
+		Long: `Relay the acceptance of a connection open attempt from the counterparty chain. The counterparty connection, client
+state and consensus state are queried with their proofs from the counterparty node at the latest height of the client
+of the connection, which must therefore be updated beforehand.`,
+		Example: fmt.Sprintf("%s tx ibc %s open-ack connection-0 connection-1 --counterparty-node tcp://localhost:26657 --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			connectionID, counterpartyConnectionID := args[0], args[1]
+
+			connectionRes, err := utils.QueryConnection(clientCtx, connectionID, false)
+			if err != nil {
+				return err
+			}
+
+			proofs, err := queryCounterpartyConnectionProofs(cmd, clientCtx, connectionRes.Connection.ClientId, counterpartyConnectionID)
+			if err != nil {
+				return err
+			}
+
+			counterpartyVersions := proofs.connection.Connection.Versions
+			if len(counterpartyVersions) != 1 {
+				return fmt.Errorf("counterparty connection %s must have a single selected version, got %d", counterpartyConnectionID, len(counterpartyVersions))
+			}
+
+			msg := types.NewMsgConnectionOpenAck(
+				connectionID, counterpartyConnectionID, proofs.clientState,
+				proofs.connection.Proof, proofs.clientProof, proofs.consensusProof,
+				proofs.connection.ProofHeight, proofs.consensusHeight,
+				counterpartyVersions[0], clientCtx.GetFromAddress().String(),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	ibcclient.AddCounterpartyFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewConnectionOpenConfirmCmd defines the command to confirm to chain B that the connection is open on chain A.
+func NewConnectionOpenConfirmCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-confirm [connection-id]",
+		Short: "Confirm that the connection is open on the counterparty chain",
+		Long: `Confirm that the connection is open on the counterparty chain. The counterparty connection is queried with its proof
+from the counterparty node at the latest height of the client of the connection, which must therefore be updated
+beforehand.`,
+		Example: fmt.Sprintf("%s tx ibc %s open-confirm connection-1 --counterparty-node tcp://localhost:26657 --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			connectionID := args[0]
+
+			connectionRes, err := utils.QueryConnection(clientCtx, connectionID, false)
+			if err != nil {
+				return err
+			}
+
+			counterpartyCtx, err := getCounterpartyQueryContext(cmd, clientCtx, connectionRes.Connection.ClientId)
+			if err != nil {
+				return err
+			}
+
+			counterpartyConnectionRes, err := utils.QueryConnection(counterpartyCtx, connectionRes.Connection.Counterparty.ConnectionId, true)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgConnectionOpenConfirm(
+				connectionID, counterpartyConnectionRes.Proof, counterpartyConnectionRes.ProofHeight,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	ibcclient.AddCounterpartyFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// counterpartyConnectionProofs defines the counterparty connection, client state and consensus state queried with
+// their proofs at the same counterparty height
+type counterpartyConnectionProofs struct {
+	connection      *types.QueryConnectionResponse
+	clientState     exported.ClientState
+	clientProof     []byte
+	consensusHeight clienttypes.Height
+	consensusProof  []byte
+}
+
+// getCounterpartyQueryContext returns a client context querying the counterparty node at the latest height of the
+// client with the provided identifier
+func getCounterpartyQueryContext(cmd *cobra.Command, clientCtx client.Context, clientID string) (client.Context, error) {
+	proofHeight, err := clientutils.QueryClientLatestHeight(clientCtx, clientID)
+	if err != nil {
+		return client.Context{}, err
+	}
+
+	return ibcclient.GetCounterpartyQueryContext(cmd, clientCtx, proofHeight)
+}
+
+// queryCounterpartyConnectionProofs queries the counterparty connection with the provided identifier, along with the
+// client state and consensus state of its client, with their proofs at the latest height of the client with the
+// provided identifier
+func queryCounterpartyConnectionProofs(cmd *cobra.Command, clientCtx client.Context, clientID, counterpartyConnectionID string) (counterpartyConnectionProofs, error) {
+	counterpartyCtx, err := getCounterpartyQueryContext(cmd, clientCtx, clientID)
+	if err != nil {
+		return counterpartyConnectionProofs{}, err
+	}
+
+	connectionRes, err := utils.QueryConnection(counterpartyCtx, counterpartyConnectionID, true)
+	if err != nil {
+		return counterpartyConnectionProofs{}, err
+	}
+
+	clientStateRes, err := utils.QueryConnectionClientState(counterpartyCtx, counterpartyConnectionID, true)
+	if err != nil {
+		return counterpartyConnectionProofs{}, err
+	}
+
+	clientState, err := clienttypes.UnpackClientState(clientStateRes.IdentifiedClientState.ClientState)
+	if err != nil {
+		return counterpartyConnectionProofs{}, err
+	}
+
+	consensusHeight, ok := clientState.GetLatestHeight().(clienttypes.Height)
+	if !ok {
+		return counterpartyConnectionProofs{}, fmt.Errorf("invalid height type %T", clientState.GetLatestHeight())
+	}
+
+	consensusStateRes, err := utils.QueryConnectionConsensusState(counterpartyCtx, counterpartyConnectionID, consensusHeight, true)
+	if err != nil {
+		return counterpartyConnectionProofs{}, err
+	}
+
+	return counterpartyConnectionProofs{
+		connection:      connectionRes,
+		clientState:     clientState,
+		clientProof:     clientStateRes.Proof,
+		consensusHeight: consensusHeight,
+		consensusProof:  consensusStateRes.Proof,
+	}, nil
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
@@ -73,3 +75,100 @@ func EmitConnectionOpenConfirmEvent(ctx sdk.Context, connectionID string, connec
 		),
 	})
 }
+
+// EmitConnectionUpgradeInitEvent emits a connection upgrade init event
+func EmitConnectionUpgradeInitEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd, upgrade types.ConnectionUpgrade) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeInit,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeVersion, upgrade.Version.GetIdentifier()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeDelayPeriod, strconv.FormatUint(upgrade.DelayPeriod, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeTimeoutTimestamp, strconv.FormatUint(upgrade.TimeoutTimestamp, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitConnectionUpgradeTryEvent emits a connection upgrade try event
+func EmitConnectionUpgradeTryEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd, upgrade types.ConnectionUpgrade) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeTry,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeVersion, upgrade.Version.GetIdentifier()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeDelayPeriod, strconv.FormatUint(upgrade.DelayPeriod, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitConnectionUpgradeAckEvent emits a connection upgrade acknowledge event
+func EmitConnectionUpgradeAckEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd, upgrade types.ConnectionUpgrade) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeAck,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeVersion, upgrade.Version.GetIdentifier()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeDelayPeriod, strconv.FormatUint(upgrade.DelayPeriod, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitConnectionUpgradeConfirmEvent emits a connection upgrade confirm event
+func EmitConnectionUpgradeConfirmEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd, upgrade types.ConnectionUpgrade) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeConfirm,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeVersion, upgrade.Version.GetIdentifier()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeDelayPeriod, strconv.FormatUint(upgrade.DelayPeriod, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitConnectionUpgradeCancelEvent emits a connection upgrade cancel event
+func EmitConnectionUpgradeCancelEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd, upgrade types.ConnectionUpgrade) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionUpgradeCancel,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyUpgradeVersion, upgrade.Version.GetIdentifier()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeDelayPeriod, strconv.FormatUint(upgrade.DelayPeriod, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeTimeoutTimestamp, strconv.FormatUint(upgrade.TimeoutTimestamp, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -18,12 +19,16 @@ type KeeperTestSuite struct {
 	// testing chains used for convenience and readability
 	chainA *ibctesting.TestChain
 	chainB *ibctesting.TestChain
+
+	// timeout timestamp of the connection upgrades proposed in tests
+	upgradeTimeoutTimestamp uint64
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
 	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
 	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
 	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.upgradeTimeoutTimestamp = uint64(suite.coordinator.CurrentTime.Add(time.Hour).UnixNano())
 }
 
 func TestKeeperTestSuite(t *testing.T) {
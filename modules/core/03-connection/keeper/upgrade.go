@@ -0,0 +1,402 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+// ConnUpgradeInit proposes an upgrade of the version, delay period and counterparty prefix of an
+// open connection. It is executed by the ConnectionUpgradeProposal handler on both chains.
+// The upgrade is stored on the connection end so that it can be proven to the counterparty.
+// The connection remains OPEN throughout the upgrade handshake, so that the channels built on
+// top of it can continue to send and receive packets.
+//
+// A connection upgrade in INIT may be replaced by a subsequent proposal, a connection upgrade
+// in TRY may not. Once the upgrade timeout has passed, a connection upgrade in INIT or TRY can
+// no longer be applied and may be cancelled using ConnUpgradeCancel.
+func (k Keeper) ConnUpgradeInit(
+	ctx sdk.Context,
+	connectionID string,
+	version *types.Version,
+	delayPeriod uint64,
+	counterpartyPrefix commitmenttypes.MerklePrefix,
+	timeoutTimestamp uint64,
+) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidConnectionState,
+			"connection state is not OPEN (got %s)", connection.State.String(),
+		)
+	}
+
+	if connection.Upgrade != nil && connection.Upgrade.State != types.UPGRADE_INIT {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidConnectionUpgrade,
+			"cannot replace connection upgrade in state %s", connection.Upgrade.State.String(),
+		)
+	}
+
+	if !types.IsSupportedVersion(version) {
+		return sdkerrors.Wrapf(types.ErrInvalidConnectionUpgrade, "upgrade version is not supported %s", version)
+	}
+
+	if counterpartyPrefix.Empty() {
+		return sdkerrors.Wrap(types.ErrInvalidConnectionUpgrade, "counterparty prefix cannot be empty")
+	}
+
+	if len(connection.Versions) == 1 && proto.Equal(connection.Versions[0], version) &&
+		connection.DelayPeriod == delayPeriod &&
+		bytes.Equal(connection.Counterparty.Prefix.Bytes(), counterpartyPrefix.Bytes()) {
+		return sdkerrors.Wrap(types.ErrInvalidConnectionUpgrade, "upgrade does not modify the connection")
+	}
+
+	upgrade := types.NewConnectionUpgrade(version, delayPeriod, counterpartyPrefix, types.UPGRADE_INIT, timeoutTimestamp)
+	if upgrade.IsTimedOut(uint64(ctx.BlockTime().UnixNano())) {
+		return sdkerrors.Wrapf(
+			types.ErrConnectionUpgradeTimeout,
+			"upgrade timeout timestamp %d has already passed (block time %d)", timeoutTimestamp, ctx.BlockTime().UnixNano(),
+		)
+	}
+
+	if err := k.validateChannelOrderings(ctx, connectionID, version); err != nil {
+		return err
+	}
+
+	connection.Upgrade = upgrade
+	k.SetConnection(ctx, connectionID, connection)
+	k.Logger(ctx).Info("connection upgrade proposed", "connection-id", connectionID, "upgrade-state", "INIT")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "connection", "upgrade-init")
+	}()
+
+	EmitConnectionUpgradeInitEvent(ctx, connectionID, connection, *connection.Upgrade)
+
+	return nil
+}
+
+// ConnUpgradeTry verifies that the connection upgrade proposed on chain B has also been proposed
+// on chain A (this code is executed on chain B).
+func (k Keeper) ConnUpgradeTry(
+	ctx sdk.Context,
+	connectionID string,
+	proofInit []byte, // proof that chainA stored the matching connection upgrade in INIT
+	proofHeight exported.Height, // height that relayer constructed proofInit
+) error {
+	connection, upgrade, err := k.getConnectionUpgrade(ctx, connectionID, types.UPGRADE_INIT)
+	if err != nil {
+		return err
+	}
+
+	if err := k.validateUpgradeTimeout(ctx, upgrade); err != nil {
+		return err
+	}
+
+	expectedConnection := k.expectedCounterpartyConnection(
+		connectionID, connection, connection.Versions, connection.DelayPeriod, connection.UpgradeSequence,
+		k.expectedCounterpartyUpgrade(upgrade, types.UPGRADE_INIT),
+	)
+
+	// Ensure that ChainA has proposed the same connection upgrade
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, proofInit, connection.Counterparty.ConnectionId,
+		expectedConnection,
+	); err != nil {
+		return err
+	}
+
+	connection.Upgrade.State = types.UPGRADE_TRY
+	k.SetConnection(ctx, connectionID, connection)
+	k.Logger(ctx).Info("connection upgrade state updated", "connection-id", connectionID, "previous-upgrade-state", "INIT", "new-upgrade-state", "TRY")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "connection", "upgrade-try")
+	}()
+
+	EmitConnectionUpgradeTryEvent(ctx, connectionID, connection, upgrade)
+
+	return nil
+}
+
+// ConnUpgradeAck verifies that chain B has accepted the connection upgrade and applies it to the
+// connection end on chain A (this code is executed on chain A).
+func (k Keeper) ConnUpgradeAck(
+	ctx sdk.Context,
+	connectionID string,
+	proofTry []byte, // proof that chainB moved the matching connection upgrade to TRY
+	proofHeight exported.Height, // height that relayer constructed proofTry
+) error {
+	// the connection upgrade on ChainA is in TRY in the case of crossing hellos
+	connection, upgrade, err := k.getConnectionUpgrade(ctx, connectionID, types.UPGRADE_INIT, types.UPGRADE_TRY)
+	if err != nil {
+		return err
+	}
+
+	// the upgrade must not be applied once it has timed out, so that it can be cancelled on the counterparty
+	if err := k.validateUpgradeTimeout(ctx, upgrade); err != nil {
+		return err
+	}
+
+	expectedConnection := k.expectedCounterpartyConnection(
+		connectionID, connection, connection.Versions, connection.DelayPeriod, connection.UpgradeSequence,
+		k.expectedCounterpartyUpgrade(upgrade, types.UPGRADE_TRY),
+	)
+
+	// Ensure that ChainB has accepted the connection upgrade
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, proofTry, connection.Counterparty.ConnectionId,
+		expectedConnection,
+	); err != nil {
+		return err
+	}
+
+	previousState := upgrade.State
+	k.applyConnectionUpgrade(ctx, connectionID, connection, upgrade)
+	k.Logger(ctx).Info("connection upgraded", "connection-id", connectionID, "previous-upgrade-state", previousState.String())
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "connection", "upgrade-ack")
+	}()
+
+	EmitConnectionUpgradeAckEvent(ctx, connectionID, connection, upgrade)
+
+	return nil
+}
+
+// ConnUpgradeConfirm verifies that the connection upgrade has been applied on chain A and applies
+// it to the connection end on chain B (this code is executed on chain B).
+func (k Keeper) ConnUpgradeConfirm(
+	ctx sdk.Context,
+	connectionID string,
+	proofAck []byte, // proof that chainA applied the connection upgrade during ConnUpgradeAck
+	proofHeight exported.Height, // height that relayer constructed proofAck
+) error {
+	connection, upgrade, err := k.getConnectionUpgrade(ctx, connectionID, types.UPGRADE_TRY)
+	if err != nil {
+		return err
+	}
+
+	// the connection upgrade may be confirmed after the upgrade timeout as it has already been applied on chain A
+	expectedConnection := k.expectedCounterpartyConnection(
+		connectionID, connection, []*types.Version{upgrade.Version}, upgrade.DelayPeriod, connection.UpgradeSequence+1, nil,
+	)
+
+	// Ensure that ChainA has applied the connection upgrade
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, proofAck, connection.Counterparty.ConnectionId,
+		expectedConnection,
+	); err != nil {
+		return err
+	}
+
+	k.applyConnectionUpgrade(ctx, connectionID, connection, upgrade)
+	k.Logger(ctx).Info("connection upgraded", "connection-id", connectionID, "previous-upgrade-state", "TRY")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "connection", "upgrade-confirm")
+	}()
+
+	EmitConnectionUpgradeConfirmEvent(ctx, connectionID, connection, upgrade)
+
+	return nil
+}
+
+// ConnUpgradeCancel cancels a timed out connection upgrade in INIT or TRY. The relayer proves the
+// connection end stored on the counterparty chain at a height whose timestamp is at or after the
+// upgrade timeout. As ConnUpgradeAck fails once the upgrade has timed out, a counterparty which has
+// not applied the upgrade at that height can no longer apply it and the upgrade is removed from
+// the connection end.
+func (k Keeper) ConnUpgradeCancel(
+	ctx sdk.Context,
+	connectionID string,
+	counterpartyConnection types.ConnectionEnd, // connection end stored on the counterparty chain
+	proofConnection []byte, // proof of the counterparty connection end
+	proofHeight exported.Height, // height that relayer constructed proofConnection
+) error {
+	connection, upgrade, err := k.getConnectionUpgrade(ctx, connectionID, types.UPGRADE_INIT, types.UPGRADE_TRY)
+	if err != nil {
+		return err
+	}
+
+	// the upgrade sequence of the counterparty is incremented once it has applied the connection upgrade
+	if counterpartyConnection.UpgradeSequence != connection.UpgradeSequence {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidConnectionUpgrade,
+			"counterparty connection upgrade sequence %d does not match upgrade sequence %d, the connection upgrade may have been applied on the counterparty",
+			counterpartyConnection.UpgradeSequence, connection.UpgradeSequence,
+		)
+	}
+
+	proofTimestamp, err := k.GetTimestampAtHeight(ctx, connection, proofHeight)
+	if err != nil {
+		return err
+	}
+
+	if !upgrade.IsTimedOut(proofTimestamp) {
+		return sdkerrors.Wrapf(
+			types.ErrConnectionUpgradeTimeout,
+			"connection upgrade has not timed out at proof height %s (proof timestamp %d < upgrade timeout timestamp %d)",
+			proofHeight, proofTimestamp, upgrade.TimeoutTimestamp,
+		)
+	}
+
+	if err := k.VerifyConnectionState(
+		ctx, connection, proofHeight, proofConnection, connection.Counterparty.ConnectionId,
+		counterpartyConnection,
+	); err != nil {
+		return err
+	}
+
+	connection.Upgrade = nil
+	k.SetConnection(ctx, connectionID, connection)
+	k.Logger(ctx).Info("connection upgrade cancelled", "connection-id", connectionID, "previous-upgrade-state", upgrade.State.String())
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "connection", "upgrade-cancel")
+	}()
+
+	EmitConnectionUpgradeCancelEvent(ctx, connectionID, connection, upgrade)
+
+	return nil
+}
+
+// getConnectionUpgrade returns the open connection and its upgrade. An error is returned if the
+// connection does not exist, is not OPEN or its upgrade is not in one of the provided states.
+func (k Keeper) getConnectionUpgrade(
+	ctx sdk.Context, connectionID string, states ...types.UpgradeState,
+) (types.ConnectionEnd, types.ConnectionUpgrade, error) {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return types.ConnectionEnd{}, types.ConnectionUpgrade{}, sdkerrors.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if connection.State != types.OPEN {
+		return types.ConnectionEnd{}, types.ConnectionUpgrade{}, sdkerrors.Wrapf(
+			types.ErrInvalidConnectionState,
+			"connection state is not OPEN (got %s)", connection.State.String(),
+		)
+	}
+
+	if connection.Upgrade == nil {
+		return types.ConnectionEnd{}, types.ConnectionUpgrade{}, sdkerrors.Wrapf(
+			types.ErrInvalidConnectionUpgrade, "no upgrade proposed for connection %s", connectionID,
+		)
+	}
+
+	for _, state := range states {
+		if connection.Upgrade.State == state {
+			return connection, *connection.Upgrade, nil
+		}
+	}
+
+	return types.ConnectionEnd{}, types.ConnectionUpgrade{}, sdkerrors.Wrapf(
+		types.ErrInvalidConnectionUpgrade,
+		"connection upgrade state is not %v (got %s)", states, connection.Upgrade.State.String(),
+	)
+}
+
+// expectedCounterpartyUpgrade returns the connection upgrade which is expected to be stored on the
+// counterparty connection end for the provided connection upgrade.
+func (k Keeper) expectedCounterpartyUpgrade(upgrade types.ConnectionUpgrade, state types.UpgradeState) *types.ConnectionUpgrade {
+	// the counterparty must upgrade its counterparty prefix to the commitment prefix of this chain
+	prefix := k.GetCommitmentPrefix()
+	return types.NewConnectionUpgrade(
+		upgrade.Version, upgrade.DelayPeriod, commitmenttypes.NewMerklePrefix(prefix.Bytes()), state, upgrade.TimeoutTimestamp,
+	)
+}
+
+// expectedCounterpartyConnection returns the open connection end which is expected to be stored on
+// the counterparty chain for the provided connection.
+func (k Keeper) expectedCounterpartyConnection(
+	connectionID string, connection types.ConnectionEnd, versions []*types.Version, delayPeriod, upgradeSequence uint64,
+	upgrade *types.ConnectionUpgrade,
+) types.ConnectionEnd {
+	prefix := k.GetCommitmentPrefix()
+	expectedCounterparty := types.NewCounterparty(connection.ClientId, connectionID, commitmenttypes.NewMerklePrefix(prefix.Bytes()))
+	expectedConnection := types.NewConnectionEnd(types.OPEN, connection.Counterparty.ClientId, expectedCounterparty, versions, delayPeriod)
+	expectedConnection.Upgrade = upgrade
+	expectedConnection.UpgradeSequence = upgradeSequence
+
+	return expectedConnection
+}
+
+// validateUpgradeTimeout returns an error if the connection upgrade has timed out at the current block time.
+func (k Keeper) validateUpgradeTimeout(ctx sdk.Context, upgrade types.ConnectionUpgrade) error {
+	if blockTime := uint64(ctx.BlockTime().UnixNano()); upgrade.IsTimedOut(blockTime) {
+		return sdkerrors.Wrapf(
+			types.ErrConnectionUpgradeTimeout,
+			"block time %d >= upgrade timeout timestamp %d", blockTime, upgrade.TimeoutTimestamp,
+		)
+	}
+
+	return nil
+}
+
+// validateChannelOrderings returns an error if the provided upgrade version does not support the
+// ordering of a channel built on top of the connection which has not been closed. Only the channels
+// indexed under the connection are iterated.
+func (k Keeper) validateChannelOrderings(ctx sdk.Context, connectionID string, version *types.Version) error {
+	store := ctx.KVStore(k.storeKey)
+	prefix := []byte(host.ConnectionChannelsPrefixPath(connectionID) + "/")
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// the index key ends with the key under which the channel is stored
+		channelKey := bytes.TrimPrefix(iterator.Key(), prefix)
+
+		bz := store.Get(channelKey)
+		if bz == nil {
+			continue
+		}
+
+		var channel channeltypes.Channel
+		k.cdc.MustUnmarshal(bz, &channel)
+
+		if channel.State == channeltypes.CLOSED {
+			continue
+		}
+
+		if !types.VerifySupportedFeature(version, channel.Ordering.String()) {
+			portID, channelID, err := host.ParseChannelPath(string(channelKey))
+			if err != nil {
+				return err
+			}
+
+			return sdkerrors.Wrapf(
+				types.ErrInvalidConnectionUpgrade,
+				"upgrade version %s does not support the ordering %s of channel %s on port %s",
+				version, channel.Ordering, channelID, portID,
+			)
+		}
+	}
+
+	return nil
+}
+
+// applyConnectionUpgrade sets the upgraded fields on the connection end, removes the upgrade,
+// increments the upgrade sequence and stores the connection end.
+func (k Keeper) applyConnectionUpgrade(ctx sdk.Context, connectionID string, connection types.ConnectionEnd, upgrade types.ConnectionUpgrade) {
+	connection.Versions = []*types.Version{upgrade.Version}
+	connection.DelayPeriod = upgrade.DelayPeriod
+	connection.Counterparty.Prefix = upgrade.CounterpartyPrefix
+	connection.Upgrade = nil
+	connection.UpgradeSequence++
+
+	k.SetConnection(ctx, connectionID, connection)
+}
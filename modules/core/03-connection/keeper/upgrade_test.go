@@ -0,0 +1,477 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+var upgradeDelayPeriod = uint64(time.Hour.Nanoseconds())
+
+// connUpgradeInit proposes a connection upgrade of the delay period on the connection of the provided endpoint
+// and commits a block on the chain of the endpoint.
+func (suite *KeeperTestSuite) connUpgradeInit(endpoint *ibctesting.Endpoint) error {
+	version := types.ExportedVersionsToProto(types.GetCompatibleVersions())[0]
+
+	err := endpoint.Chain.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeInit(
+		endpoint.Chain.GetContext(), endpoint.ConnectionID, version, upgradeDelayPeriod, endpoint.Counterparty.Chain.GetPrefix(),
+		suite.upgradeTimeoutTimestamp,
+	)
+	suite.coordinator.CommitBlock(endpoint.Chain)
+
+	return err
+}
+
+// connUpgradeTry executes ConnUpgradeTry on the provided endpoint with a proof of the counterparty connection end
+// and commits a block on the chain of the endpoint.
+func (suite *KeeperTestSuite) connUpgradeTry(endpoint *ibctesting.Endpoint) error {
+	suite.Require().NoError(endpoint.UpdateClient())
+
+	proofInit, proofHeight := endpoint.Counterparty.Chain.QueryProof(host.ConnectionKey(endpoint.Counterparty.ConnectionID))
+	err := endpoint.Chain.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeTry(
+		endpoint.Chain.GetContext(), endpoint.ConnectionID, proofInit, proofHeight,
+	)
+	suite.coordinator.CommitBlock(endpoint.Chain)
+
+	return err
+}
+
+// connUpgradeAck executes ConnUpgradeAck on the provided endpoint with a proof of the counterparty connection end
+// and commits a block on the chain of the endpoint.
+func (suite *KeeperTestSuite) connUpgradeAck(endpoint *ibctesting.Endpoint) error {
+	suite.Require().NoError(endpoint.UpdateClient())
+
+	proofTry, proofHeight := endpoint.Counterparty.Chain.QueryProof(host.ConnectionKey(endpoint.Counterparty.ConnectionID))
+	err := endpoint.Chain.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeAck(
+		endpoint.Chain.GetContext(), endpoint.ConnectionID, proofTry, proofHeight,
+	)
+	suite.coordinator.CommitBlock(endpoint.Chain)
+
+	return err
+}
+
+// timeoutConnUpgrade increments the coordinator time past the connection upgrade timeout and commits
+// a block on both chains.
+func (suite *KeeperTestSuite) timeoutConnUpgrade() {
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+}
+
+// TestConnUpgradeInit - chainA proposes an upgrade of an OPEN connection
+func (suite *KeeperTestSuite) TestConnUpgradeInit() {
+	var (
+		path               *ibctesting.Path
+		version            *types.Version
+		delayPeriod        uint64
+		counterpartyPrefix commitmenttypes.MerklePrefix
+		timeoutTimestamp   uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success: replace upgrade in INIT", func() {
+			err := suite.connUpgradeInit(path.EndpointA)
+			suite.Require().NoError(err)
+
+			delayPeriod = 2 * upgradeDelayPeriod
+		}, true},
+		{"connection not found", func() {
+			path.EndpointA.ConnectionID = ibctesting.InvalidID
+		}, false},
+		{"connection is not OPEN", func() {
+			suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetConnection(
+				suite.chainA.GetContext(), path.EndpointA.ConnectionID,
+				types.NewConnectionEnd(types.TRYOPEN, path.EndpointA.ClientID, types.NewCounterparty(path.EndpointB.ClientID, path.EndpointB.ConnectionID, suite.chainB.GetPrefix()), types.ExportedVersionsToProto(types.GetCompatibleVersions()), 0),
+			)
+		}, false},
+		{"upgrade in TRY cannot be replaced", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointA))
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+			suite.Require().NoError(suite.connUpgradeTry(path.EndpointB))
+
+			path.EndpointA, path.EndpointB = path.EndpointB, path.EndpointA
+		}, false},
+		{"unsupported version", func() {
+			version = types.NewVersion("2", []string{"ORDER_UNORDERED"})
+		}, false},
+		{"empty counterparty prefix", func() {
+			counterpartyPrefix = commitmenttypes.MerklePrefix{}
+		}, false},
+		{"upgrade does not modify the connection", func() {
+			delayPeriod = 0
+		}, false},
+		{"upgrade timeout timestamp has passed", func() {
+			timeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+		}, false},
+		{"success: upgrade version supports the ordering of open channels", func() {
+			suite.coordinator.CreateChannels(path)
+
+			version = types.NewVersion(types.DefaultIBCVersionIdentifier, []string{"ORDER_UNORDERED"})
+		}, true},
+		{"upgrade version does not support the ordering of an open channel", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.CreateChannels(path)
+
+			version = types.NewVersion(types.DefaultIBCVersionIdentifier, []string{"ORDER_UNORDERED"})
+		}, false},
+		{"success: ordering of closed channels is not checked", func() {
+			path.SetChannelOrdered()
+			suite.coordinator.CreateChannels(path)
+			suite.Require().NoError(path.EndpointA.SetChannelClosed())
+
+			version = types.NewVersion(types.DefaultIBCVersionIdentifier, []string{"ORDER_UNORDERED"})
+		}, true},		{"success: ordering of channels on other connections is not checked", func() {
+			otherPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			otherPath.SetChannelOrdered()
+			suite.coordinator.Setup(otherPath)
+
+			version = types.NewVersion(types.DefaultIBCVersionIdentifier, []string{"ORDER_UNORDERED"})
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			version = types.ExportedVersionsToProto(types.GetCompatibleVersions())[0]
+			delayPeriod = upgradeDelayPeriod
+			counterpartyPrefix = suite.chainB.GetPrefix()
+			timeoutTimestamp = suite.upgradeTimeoutTimestamp
+
+			tc.malleate()
+
+			err := path.EndpointA.Chain.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeInit(
+				path.EndpointA.Chain.GetContext(), path.EndpointA.ConnectionID, version, delayPeriod, counterpartyPrefix, timeoutTimestamp,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				connection := path.EndpointA.GetConnection()
+				suite.Require().Equal(types.OPEN, connection.State)
+				suite.Require().Equal(types.NewConnectionUpgrade(version, delayPeriod, counterpartyPrefix, types.UPGRADE_INIT, timeoutTimestamp), connection.Upgrade)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestConnUpgradeTry - chainB calls ConnUpgradeTry to verify that chainA
+// proposed the same connection upgrade
+func (suite *KeeperTestSuite) TestConnUpgradeTry() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointA))
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+		}, true},
+		{"no upgrade proposed on chainB", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointA))
+		}, false},
+		{"no upgrade proposed on chainA", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+		}, false},
+		{"mismatched upgrade on chainA", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+
+			version := types.ExportedVersionsToProto(types.GetCompatibleVersions())[0]
+			err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeInit(
+				suite.chainA.GetContext(), path.EndpointA.ConnectionID, version, 2*upgradeDelayPeriod, suite.chainB.GetPrefix(),
+				suite.upgradeTimeoutTimestamp,
+			)
+			suite.Require().NoError(err)
+		}, false},
+		{"mismatched upgrade timeout on chainA", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+
+			version := types.ExportedVersionsToProto(types.GetCompatibleVersions())[0]
+			err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeInit(
+				suite.chainA.GetContext(), path.EndpointA.ConnectionID, version, upgradeDelayPeriod, suite.chainB.GetPrefix(),
+				2*suite.upgradeTimeoutTimestamp,
+			)
+			suite.Require().NoError(err)
+		}, false},
+		{"upgrade has timed out", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointA))
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+
+			suite.timeoutConnUpgrade()
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			tc.malleate()
+
+			err := suite.connUpgradeTry(path.EndpointB)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.UPGRADE_TRY, path.EndpointB.GetConnection().Upgrade.State)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestConnUpgradeAck - chainA calls ConnUpgradeAck to verify that chainB
+// accepted the connection upgrade and applies it
+func (suite *KeeperTestSuite) TestConnUpgradeAck() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointA))
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+			suite.Require().NoError(suite.connUpgradeTry(path.EndpointB))
+		}, true},
+		{"success with crossing hellos", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointA))
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+
+			// both chains move their connection upgrade to TRY using proofs of the counterparty upgrade in INIT
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			proofInitA, proofHeightA := suite.chainA.QueryProof(host.ConnectionKey(path.EndpointA.ConnectionID))
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			proofInitB, proofHeightB := suite.chainB.QueryProof(host.ConnectionKey(path.EndpointB.ConnectionID))
+
+			err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeTry(suite.chainA.GetContext(), path.EndpointA.ConnectionID, proofInitB, proofHeightB)
+			suite.Require().NoError(err)
+			err = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeTry(suite.chainB.GetContext(), path.EndpointB.ConnectionID, proofInitA, proofHeightA)
+			suite.Require().NoError(err)
+
+			suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+		}, true},
+		{"no upgrade proposed on chainA", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+		}, false},
+		{"chainB upgrade is not in TRY", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointA))
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+		}, false},
+		{"upgrade has timed out", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointA))
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+			suite.Require().NoError(suite.connUpgradeTry(path.EndpointB))
+
+			suite.timeoutConnUpgrade()
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			tc.malleate()
+
+			err := suite.connUpgradeAck(path.EndpointA)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				connection := path.EndpointA.GetConnection()
+				suite.Require().Nil(connection.Upgrade)
+				suite.Require().Equal(upgradeDelayPeriod, connection.DelayPeriod)
+				suite.Require().Equal(types.ExportedVersionsToProto(types.GetCompatibleVersions())[:1], connection.Versions)
+				suite.Require().Equal(suite.chainB.GetPrefix(), connection.Counterparty.Prefix)
+				suite.Require().Equal(uint64(1), connection.UpgradeSequence)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestConnUpgradeConfirm - chainB calls ConnUpgradeConfirm to verify that chainA
+// applied the connection upgrade and applies it
+func (suite *KeeperTestSuite) TestConnUpgradeConfirm() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointA))
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+			suite.Require().NoError(suite.connUpgradeTry(path.EndpointB))
+			suite.Require().NoError(suite.connUpgradeAck(path.EndpointA))
+		}, true},
+		{"success after the upgrade timeout", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointA))
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+			suite.Require().NoError(suite.connUpgradeTry(path.EndpointB))
+			suite.Require().NoError(suite.connUpgradeAck(path.EndpointA))
+
+			suite.timeoutConnUpgrade()
+		}, true},
+		{"chainB upgrade is not in TRY", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+		}, false},
+		{"chainA has not applied the upgrade", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointA))
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+			suite.Require().NoError(suite.connUpgradeTry(path.EndpointB))
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			proofAck, proofHeight := suite.chainA.QueryProof(host.ConnectionKey(path.EndpointA.ConnectionID))
+			err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeConfirm(
+				suite.chainB.GetContext(), path.EndpointB.ConnectionID, proofAck, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				connection := path.EndpointB.GetConnection()
+				suite.Require().Nil(connection.Upgrade)
+				suite.Require().Equal(upgradeDelayPeriod, connection.DelayPeriod)
+				suite.Require().Equal(path.EndpointA.GetConnection().Versions, connection.Versions)
+				suite.Require().Equal(uint64(1), connection.UpgradeSequence)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestConnUpgradeCancel - chainB calls ConnUpgradeCancel to cancel a timed out
+// connection upgrade which has not been applied on chainA
+func (suite *KeeperTestSuite) TestConnUpgradeCancel() {
+	var (
+		path                   *ibctesting.Path
+		counterpartyConnection types.ConnectionEnd
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: upgrade in TRY", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointA))
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+			suite.Require().NoError(suite.connUpgradeTry(path.EndpointB))
+
+			suite.timeoutConnUpgrade()
+		}, true},
+		{"success: upgrade in INIT", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+
+			suite.timeoutConnUpgrade()
+		}, true},
+		{"success: upgrade replaced on chainA", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointA))
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+			suite.Require().NoError(suite.connUpgradeTry(path.EndpointB))
+
+			version := types.ExportedVersionsToProto(types.GetCompatibleVersions())[0]
+			err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeInit(
+				suite.chainA.GetContext(), path.EndpointA.ConnectionID, version, 2*upgradeDelayPeriod, suite.chainB.GetPrefix(),
+				2*suite.upgradeTimeoutTimestamp,
+			)
+			suite.Require().NoError(err)
+
+			suite.timeoutConnUpgrade()
+		}, true},
+		{"no upgrade proposed on chainB", func() {
+			suite.timeoutConnUpgrade()
+		}, false},
+		{"upgrade has not timed out", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointA))
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+			suite.Require().NoError(suite.connUpgradeTry(path.EndpointB))
+		}, false},
+		{"upgrade applied on chainA", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointA))
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+			suite.Require().NoError(suite.connUpgradeTry(path.EndpointB))
+			suite.Require().NoError(suite.connUpgradeAck(path.EndpointA))
+
+			suite.timeoutConnUpgrade()
+		}, false},
+		{"counterparty connection does not match the proof", func() {
+			suite.Require().NoError(suite.connUpgradeInit(path.EndpointB))
+
+			suite.timeoutConnUpgrade()
+
+			counterpartyConnection = path.EndpointA.GetConnection()
+			counterpartyConnection.DelayPeriod = upgradeDelayPeriod
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+			counterpartyConnection = types.ConnectionEnd{}
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			if counterpartyConnection.ClientId == "" {
+				counterpartyConnection = path.EndpointA.GetConnection()
+			}
+
+			proofConnection, proofHeight := suite.chainA.QueryProof(host.ConnectionKey(path.EndpointA.ConnectionID))
+			err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeCancel(
+				suite.chainB.GetContext(), path.EndpointB.ConnectionID, counterpartyConnection, proofConnection, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				connection := path.EndpointB.GetConnection()
+				suite.Require().Nil(connection.Upgrade)
+				suite.Require().Equal(uint64(0), connection.UpgradeSequence)
+				suite.Require().Equal(path.EndpointA.GetConnection().DelayPeriod, connection.DelayPeriod)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -20,20 +20,15 @@ func (k Keeper) VerifyClientState(
 	clientState exported.ClientState,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
-
-	targetClient, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	clientModule, clientStore, targetClient, err := k.getActiveClient(ctx, clientID)
+	if err != nil {
+		return err
 	}
 
-	if status := targetClient.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
-	}
-
-	if err := targetClient.VerifyClientState(
-		clientStore, k.cdc, height,
-		connection.GetCounterparty().GetPrefix(), connection.GetCounterparty().GetClientID(), proof, clientState); err != nil {
+	if err := clientModule.VerifyClientState(
+		ctx, k.cdc, clientStore, targetClient, height,
+		connection.GetCounterparty().GetPrefix(), connection.GetCounterparty().GetClientID(), proof, clientState,
+	); err != nil {
 		return sdkerrors.Wrapf(err, "failed client state verification for target client: %s", clientID)
 	}
 
@@ -51,19 +46,13 @@ func (k Keeper) VerifyClientConsensusState(
 	consensusState exported.ConsensusState,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
-
-	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	clientModule, clientStore, targetClient, err := k.getActiveClient(ctx, clientID)
+	if err != nil {
+		return err
 	}
 
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
-	}
-
-	if err := clientState.VerifyClientConsensusState(
-		clientStore, k.cdc, height,
+	if err := clientModule.VerifyClientConsensusState(
+		ctx, k.cdc, clientStore, targetClient, height,
 		connection.GetCounterparty().GetClientID(), consensusHeight, connection.GetCounterparty().GetPrefix(), proof, consensusState,
 	); err != nil {
 		return sdkerrors.Wrapf(err, "failed consensus state verification for client (%s)", clientID)
@@ -83,19 +72,13 @@ func (k Keeper) VerifyConnectionState(
 	connectionEnd exported.ConnectionI, // opposite connection
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
-
-	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	clientModule, clientStore, targetClient, err := k.getActiveClient(ctx, clientID)
+	if err != nil {
+		return err
 	}
 
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
-	}
-
-	if err := clientState.VerifyConnectionState(
-		clientStore, k.cdc, height,
+	if err := clientModule.VerifyConnectionState(
+		ctx, k.cdc, clientStore, targetClient, height,
 		connection.GetCounterparty().GetPrefix(), proof, connectionID, connectionEnd,
 	); err != nil {
 		return sdkerrors.Wrapf(err, "failed connection state verification for client (%s)", clientID)
@@ -116,19 +99,13 @@ func (k Keeper) VerifyChannelState(
 	channel exported.ChannelI,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
-
-	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
-	}
-
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	clientModule, clientStore, targetClient, err := k.getActiveClient(ctx, clientID)
+	if err != nil {
+		return err
 	}
 
-	if err := clientState.VerifyChannelState(
-		clientStore, k.cdc, height,
+	if err := clientModule.VerifyChannelState(
+		ctx, k.cdc, clientStore, targetClient, height,
 		connection.GetCounterparty().GetPrefix(), proof,
 		portID, channelID, channel,
 	); err != nil {
@@ -151,23 +128,17 @@ func (k Keeper) VerifyPacketCommitment(
 	commitmentBytes []byte,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
-
-	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
-	}
-
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	clientModule, clientStore, targetClient, err := k.getActiveClient(ctx, clientID)
+	if err != nil {
+		return err
 	}
 
 	// get time and block delays
 	timeDelay := connection.GetDelayPeriod()
 	blockDelay := k.getBlockDelay(ctx, connection)
 
-	if err := clientState.VerifyPacketCommitment(
-		ctx, clientStore, k.cdc, height,
+	if err := clientModule.VerifyPacketCommitment(
+		ctx, k.cdc, clientStore, targetClient, height,
 		timeDelay, blockDelay,
 		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
 		sequence, commitmentBytes,
@@ -191,23 +162,17 @@ func (k Keeper) VerifyPacketAcknowledgement(
 	acknowledgement []byte,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
-
-	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
-	}
-
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	clientModule, clientStore, targetClient, err := k.getActiveClient(ctx, clientID)
+	if err != nil {
+		return err
 	}
 
 	// get time and block delays
 	timeDelay := connection.GetDelayPeriod()
 	blockDelay := k.getBlockDelay(ctx, connection)
 
-	if err := clientState.VerifyPacketAcknowledgement(
-		ctx, clientStore, k.cdc, height,
+	if err := clientModule.VerifyPacketAcknowledgement(
+		ctx, k.cdc, clientStore, targetClient, height,
 		timeDelay, blockDelay,
 		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
 		sequence, acknowledgement,
@@ -231,23 +196,17 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 	sequence uint64,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
-
-	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
-	}
-
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	clientModule, clientStore, targetClient, err := k.getActiveClient(ctx, clientID)
+	if err != nil {
+		return err
 	}
 
 	// get time and block delays
 	timeDelay := connection.GetDelayPeriod()
 	blockDelay := k.getBlockDelay(ctx, connection)
 
-	if err := clientState.VerifyPacketReceiptAbsence(
-		ctx, clientStore, k.cdc, height,
+	if err := clientModule.VerifyPacketReceiptAbsence(
+		ctx, k.cdc, clientStore, targetClient, height,
 		timeDelay, blockDelay,
 		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
 		sequence,
@@ -270,33 +229,83 @@ func (k Keeper) VerifyNextSequenceRecv(
 	nextSequenceRecv uint64,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+	clientModule, clientStore, targetClient, err := k.getActiveClient(ctx, clientID)
+	if err != nil {
+		return err
+	}
 
-	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	if err := clientModule.VerifyNextSequenceRecv(
+		ctx, k.cdc, clientStore, targetClient, height,
+		timeDelay, blockDelay,
+		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
+		nextSequenceRecv,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed next sequence receive verification for client (%s)", clientID)
 	}
 
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	return nil
+}
+
+// VerifyNextSequenceAck verifies a proof of the next sequence number to be
+// acknowledged of the specified channel at the specified port.
+func (k Keeper) VerifyNextSequenceAck(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+) error {
+	clientID := connection.GetClientID()
+	clientModule, clientStore, targetClient, err := k.getActiveClient(ctx, clientID)
+	if err != nil {
+		return err
 	}
 
 	// get time and block delays
 	timeDelay := connection.GetDelayPeriod()
 	blockDelay := k.getBlockDelay(ctx, connection)
 
-	if err := clientState.VerifyNextSequenceRecv(
-		ctx, clientStore, k.cdc, height,
+	if err := clientModule.VerifyNextSequenceAck(
+		ctx, k.cdc, clientStore, targetClient, height,
 		timeDelay, blockDelay,
 		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
-		nextSequenceRecv,
+		nextSequenceAck,
 	); err != nil {
-		return sdkerrors.Wrapf(err, "failed next sequence receive verification for client (%s)", clientID)
+		return sdkerrors.Wrapf(err, "failed next sequence acknowledgement verification for client (%s)", clientID)
 	}
 
 	return nil
 }
 
+// getActiveClient returns the client module, client store and client state of the client with
+// the given identifier. An error is returned if the client does not exist, if no client module
+// is registered for its client type or if the client is not Active.
+func (k Keeper) getActiveClient(ctx sdk.Context, clientID string) (clienttypes.ClientModule, sdk.KVStore, exported.ClientState, error) {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return nil, nil, nil, sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	clientModule, found := k.clientKeeper.GetClientModule(clientState.ClientType())
+	if !found {
+		return nil, nil, nil, sdkerrors.Wrapf(clienttypes.ErrClientModuleNotFound, "client (%s) of type %s", clientID, clientState.ClientType())
+	}
+
+	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+
+	if status := clientModule.Status(ctx, k.cdc, clientStore, clientState); status != exported.Active {
+		return nil, nil, nil, sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	return clientModule, clientStore, clientState, nil
+}
+
 // getBlockDelay calculates the block delay period from the time delay of the connection
 // and the maximum expected time per block.
 func (k Keeper) getBlockDelay(ctx sdk.Context, connection exported.ConnectionI) uint64 {
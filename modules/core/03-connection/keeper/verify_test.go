@@ -681,6 +681,111 @@ func (suite *KeeperTestSuite) TestVerifyNextSequenceRecv() {
 	}
 }
 
+// TestVerifyNextSequenceAck verifies a proof of the next sequence number to be
+// acknowledged on chainA by chainB.
+func (suite *KeeperTestSuite) TestVerifyNextSequenceAck() {
+	var (
+		path            *ibctesting.Path
+		heightDiff      uint64
+		delayTimePeriod uint64
+		timePerBlock    uint64
+		offsetSeq       uint64
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"verification success", func() {}, true},
+		{"verification success: delay period passed", func() {
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+		}, true},
+		{"delay time period has not passed", func() {
+			delayTimePeriod = uint64(1 * time.Hour.Nanoseconds())
+		}, false},
+		{"delay block period has not passed", func() {
+			// make timePerBlock 1 nanosecond so that block delay is not passed.
+			// must also set a non-zero time delay to ensure block delay is enforced.
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+			timePerBlock = 1
+		}, false},
+		{"client state not found- changed client ID", func() {
+			connection := path.EndpointB.GetConnection()
+			connection.ClientId = ibctesting.InvalidID
+			path.EndpointB.SetConnection(connection)
+		}, false},
+		{"consensus state not found - increased proof height", func() {
+			heightDiff = 5
+		}, false},
+		{"verification failed - wrong expected next seq ack", func() {
+			offsetSeq = 1
+		}, false},
+		{"client status is not active - client is expired", func() {
+			clientState := path.EndpointB.GetClientState().(*ibctmtypes.ClientState)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointB.SetClientState(clientState)
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// send, receive and acknowledge packet
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.AcknowledgePacket(packet, ibctesting.MockAcknowledgement)
+			suite.Require().NoError(err)
+
+			// increment time by 2 hours to always pass the delay period
+			suite.coordinator.IncrementTimeBy(time.Hour * 2)
+			suite.coordinator.CommitBlock(suite.chainA)
+
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			nextSeqAckKey := host.NextSequenceAckKey(packet.GetSourcePort(), packet.GetSourceChannel())
+			proof, proofHeight := suite.chainA.QueryProof(nextSeqAckKey)
+
+			// reset variables
+			heightDiff = 0
+			delayTimePeriod = 0
+			timePerBlock = 0
+			offsetSeq = 0
+			tc.malleate()
+
+			// set time per block param
+			if timePerBlock != 0 {
+				suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(timePerBlock))
+			}
+
+			connection := path.EndpointB.GetConnection()
+			connection.DelayPeriod = delayTimePeriod
+			err = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.VerifyNextSequenceAck(
+				suite.chainB.GetContext(), connection, malleateHeight(proofHeight, heightDiff), proof,
+				packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()+1+offsetSeq,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func malleateHeight(height exported.Height, diff uint64) exported.Height {
 	return clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()+diff)
 }
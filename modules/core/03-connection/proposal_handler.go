@@ -0,0 +1,23 @@
+package connection
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/core/03-connection/keeper"
+	"github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+)
+
+// NewConnectionProposalHandler defines the 03-connection proposal handler
+func NewConnectionProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ConnectionUpgradeProposal:
+			return k.ConnUpgradeInit(ctx, c.ConnectionId, c.Version, c.DelayPeriod, c.CounterpartyPrefix, c.TimeoutTimestamp)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ibc connection proposal content type: %T", c)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 )
@@ -33,6 +34,14 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgConnectionOpenTry{},
 		&MsgConnectionOpenAck{},
 		&MsgConnectionOpenConfirm{},
+		&MsgConnectionUpgradeTry{},
+		&MsgConnectionUpgradeAck{},
+		&MsgConnectionUpgradeConfirm{},
+		&MsgConnectionUpgradeCancel{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&ConnectionUpgradeProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -61,9 +61,49 @@ func (c ConnectionEnd) ValidateBasic() error {
 			return err
 		}
 	}
+	if c.Upgrade != nil {
+		if err := c.Upgrade.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	return c.Counterparty.ValidateBasic()
 }
 
+// NewConnectionUpgrade creates a new ConnectionUpgrade instance.
+func NewConnectionUpgrade(
+	version *Version, delayPeriod uint64, counterpartyPrefix commitmenttypes.MerklePrefix, state UpgradeState, timeoutTimestamp uint64,
+) *ConnectionUpgrade {
+	return &ConnectionUpgrade{
+		Version:            version,
+		DelayPeriod:        delayPeriod,
+		CounterpartyPrefix: counterpartyPrefix,
+		State:              state,
+		TimeoutTimestamp:   timeoutTimestamp,
+	}
+}
+
+// IsTimedOut returns true if the connection upgrade has timed out at the provided block time.
+func (u ConnectionUpgrade) IsTimedOut(blockTime uint64) bool {
+	return blockTime >= u.TimeoutTimestamp
+}
+
+// ValidateBasic performs a basic validation of the upgrade version, counterparty prefix, state and timeout.
+func (u ConnectionUpgrade) ValidateBasic() error {
+	if err := ValidateVersion(u.Version); err != nil {
+		return sdkerrors.Wrap(err, "invalid upgrade version")
+	}
+	if u.CounterpartyPrefix.Empty() {
+		return sdkerrors.Wrap(ErrInvalidConnectionUpgrade, "counterparty prefix cannot be empty")
+	}
+	if u.State != UPGRADE_INIT && u.State != UPGRADE_TRY {
+		return sdkerrors.Wrapf(ErrInvalidConnectionUpgrade, "invalid upgrade state %s", u.State)
+	}
+	if u.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidConnectionUpgrade, "upgrade timeout timestamp cannot be zero")
+	}
+	return nil
+}
+
 var _ exported.CounterpartyConnectionI = (*Counterparty)(nil)
 
 // NewCounterparty creates a new Counterparty instance.
@@ -109,12 +149,14 @@ func (c Counterparty) ValidateBasic() error {
 // NewIdentifiedConnection creates a new IdentifiedConnection instance
 func NewIdentifiedConnection(connectionID string, conn ConnectionEnd) IdentifiedConnection {
 	return IdentifiedConnection{
-		Id:           connectionID,
-		ClientId:     conn.ClientId,
-		Versions:     conn.Versions,
-		State:        conn.State,
-		Counterparty: conn.Counterparty,
-		DelayPeriod:  conn.DelayPeriod,
+		Id:              connectionID,
+		ClientId:        conn.ClientId,
+		Versions:        conn.Versions,
+		State:           conn.State,
+		Counterparty:    conn.Counterparty,
+		DelayPeriod:     conn.DelayPeriod,
+		Upgrade:         conn.Upgrade,
+		UpgradeSequence: conn.UpgradeSequence,
 	}
 }
 
@@ -124,5 +166,7 @@ func (ic IdentifiedConnection) ValidateBasic() error {
 		return sdkerrors.Wrap(err, "invalid connection ID")
 	}
 	connection := NewConnectionEnd(ic.State, ic.ClientId, ic.Counterparty, ic.Versions, ic.DelayPeriod)
+	connection.Upgrade = ic.Upgrade
+	connection.UpgradeSequence = ic.UpgradeSequence
 	return connection.ValidateBasic()
 }
@@ -62,6 +62,40 @@ func (State) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_90572467c054e43a, []int{0}
 }
 
+// UpgradeState defines if a connection upgrade is in one of the following
+// states: INIT, TRY or UNINITIALIZED.
+type UpgradeState int32
+
+const (
+	// Default State
+	UPGRADE_UNINITIALIZED UpgradeState = 0
+	// A connection upgrade has been proposed by governance.
+	UPGRADE_INIT UpgradeState = 1
+	// A connection upgrade has been proposed by governance and the matching
+	// upgrade proposed on the counterparty chain has been verified.
+	UPGRADE_TRY UpgradeState = 2
+)
+
+var UpgradeState_name = map[int32]string{
+	0: "UPGRADE_STATE_UNINITIALIZED_UNSPECIFIED",
+	1: "UPGRADE_STATE_INIT",
+	2: "UPGRADE_STATE_TRY",
+}
+
+var UpgradeState_value = map[string]int32{
+	"UPGRADE_STATE_UNINITIALIZED_UNSPECIFIED": 0,
+	"UPGRADE_STATE_INIT":                      1,
+	"UPGRADE_STATE_TRY":                       2,
+}
+
+func (x UpgradeState) String() string {
+	return proto.EnumName(UpgradeState_name, int32(x))
+}
+
+func (UpgradeState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_90572467c054e43a, []int{1}
+}
+
 // ConnectionEnd defines a stateful object on a chain connected to another
 // separate one.
 // NOTE: there must only be 2 defined ConnectionEnds to establish
@@ -80,6 +114,11 @@ type ConnectionEnd struct {
 	// packet-verification NOTE: delay period logic is only implemented by some
 	// clients.
 	DelayPeriod uint64 `protobuf:"varint,5,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty" yaml:"delay_period"`
+	// upgrade proposed for the connection end. It is only set while a connection
+	// upgrade handshake is in progress.
+	Upgrade *ConnectionUpgrade `protobuf:"bytes,6,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	// number of connection upgrades applied to the connection end.
+	UpgradeSequence uint64 `protobuf:"varint,7,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty" yaml:"upgrade_sequence"`
 }
 
 func (m *ConnectionEnd) Reset()         { *m = ConnectionEnd{} }
@@ -131,6 +170,10 @@ type IdentifiedConnection struct {
 	Counterparty Counterparty `protobuf:"bytes,5,opt,name=counterparty,proto3" json:"counterparty"`
 	// delay period associated with this connection.
 	DelayPeriod uint64 `protobuf:"varint,6,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty" yaml:"delay_period"`
+	// upgrade proposed for this connection.
+	Upgrade *ConnectionUpgrade `protobuf:"bytes,7,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	// number of connection upgrades applied to this connection.
+	UpgradeSequence uint64 `protobuf:"varint,8,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty" yaml:"upgrade_sequence"`
 }
 
 func (m *IdentifiedConnection) Reset()         { *m = IdentifiedConnection{} }
@@ -166,6 +209,112 @@ func (m *IdentifiedConnection) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_IdentifiedConnection proto.InternalMessageInfo
 
+// ConnectionUpgrade defines the connection end fields which are modified by a
+// connection upgrade handshake.
+type ConnectionUpgrade struct {
+	// version to be used by the connection once upgraded.
+	Version *Version `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// delay period to be used by the connection once upgraded.
+	DelayPeriod uint64 `protobuf:"varint,2,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty" yaml:"delay_period"`
+	// commitment merkle prefix of the counterparty chain to be used by the
+	// connection once upgraded.
+	CounterpartyPrefix types.MerklePrefix `protobuf:"bytes,3,opt,name=counterparty_prefix,json=counterpartyPrefix,proto3" json:"counterparty_prefix" yaml:"counterparty_prefix"`
+	// current state of the connection upgrade handshake.
+	State UpgradeState `protobuf:"varint,4,opt,name=state,proto3,enum=ibc.core.connection.v1.UpgradeState" json:"state,omitempty"`
+	// timestamp in UNIX nanoseconds after which the connection upgrade can no
+	// longer be accepted or applied and may be cancelled.
+	TimeoutTimestamp uint64 `protobuf:"varint,5,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+}
+
+func (m *ConnectionUpgrade) Reset()         { *m = ConnectionUpgrade{} }
+func (m *ConnectionUpgrade) String() string { return proto.CompactTextString(m) }
+func (*ConnectionUpgrade) ProtoMessage()    {}
+func (*ConnectionUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90572467c054e43a, []int{2}
+}
+func (m *ConnectionUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectionUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionUpgrade.Merge(m, src)
+}
+func (m *ConnectionUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionUpgrade proto.InternalMessageInfo
+
+// ConnectionUpgradeProposal is a gov Content type for proposing an upgrade of
+// an open connection. The connection upgrade handshake completes once the same
+// upgrade has been proposed on the counterparty chain and relayed.
+type ConnectionUpgradeProposal struct {
+	// the title of the connection upgrade proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the identifier of the connection to be upgraded
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// version to be used by the connection once upgraded
+	Version *Version `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// delay period to be used by the connection once upgraded
+	DelayPeriod uint64 `protobuf:"varint,5,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty" yaml:"delay_period"`
+	// commitment merkle prefix of the counterparty chain to be used by the
+	// connection once upgraded
+	CounterpartyPrefix types.MerklePrefix `protobuf:"bytes,6,opt,name=counterparty_prefix,json=counterpartyPrefix,proto3" json:"counterparty_prefix" yaml:"counterparty_prefix"`
+	// timestamp in UNIX nanoseconds after which the connection upgrade can no
+	// longer be accepted or applied and may be cancelled. It must be the same
+	// on both chains.
+	TimeoutTimestamp uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+}
+
+func (m *ConnectionUpgradeProposal) Reset()         { *m = ConnectionUpgradeProposal{} }
+func (m *ConnectionUpgradeProposal) String() string { return proto.CompactTextString(m) }
+func (*ConnectionUpgradeProposal) ProtoMessage()    {}
+func (*ConnectionUpgradeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90572467c054e43a, []int{3}
+}
+func (m *ConnectionUpgradeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionUpgradeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionUpgradeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectionUpgradeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionUpgradeProposal.Merge(m, src)
+}
+func (m *ConnectionUpgradeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionUpgradeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionUpgradeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionUpgradeProposal proto.InternalMessageInfo
+
 // Counterparty defines the counterparty chain associated with a connection end.
 type Counterparty struct {
 	// identifies the client on the counterparty chain associated with a given
@@ -182,7 +331,7 @@ func (m *Counterparty) Reset()         { *m = Counterparty{} }
 func (m *Counterparty) String() string { return proto.CompactTextString(m) }
 func (*Counterparty) ProtoMessage()    {}
 func (*Counterparty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90572467c054e43a, []int{2}
+	return fileDescriptor_90572467c054e43a, []int{4}
 }
 func (m *Counterparty) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -221,7 +370,7 @@ func (m *ClientPaths) Reset()         { *m = ClientPaths{} }
 func (m *ClientPaths) String() string { return proto.CompactTextString(m) }
 func (*ClientPaths) ProtoMessage()    {}
 func (*ClientPaths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90572467c054e43a, []int{3}
+	return fileDescriptor_90572467c054e43a, []int{5}
 }
 func (m *ClientPaths) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,7 +418,7 @@ func (m *ConnectionPaths) Reset()         { *m = ConnectionPaths{} }
 func (m *ConnectionPaths) String() string { return proto.CompactTextString(m) }
 func (*ConnectionPaths) ProtoMessage()    {}
 func (*ConnectionPaths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90572467c054e43a, []int{4}
+	return fileDescriptor_90572467c054e43a, []int{6}
 }
 func (m *ConnectionPaths) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,7 +474,7 @@ func (m *Version) Reset()         { *m = Version{} }
 func (m *Version) String() string { return proto.CompactTextString(m) }
 func (*Version) ProtoMessage()    {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90572467c054e43a, []int{5}
+	return fileDescriptor_90572467c054e43a, []int{7}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +515,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_90572467c054e43a, []int{6}
+	return fileDescriptor_90572467c054e43a, []int{8}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -404,8 +553,11 @@ func (m *Params) GetMaxExpectedTimePerBlock() uint64 {
 
 func init() {
 	proto.RegisterEnum("ibc.core.connection.v1.State", State_name, State_value)
+	proto.RegisterEnum("ibc.core.connection.v1.UpgradeState", UpgradeState_name, UpgradeState_value)
 	proto.RegisterType((*ConnectionEnd)(nil), "ibc.core.connection.v1.ConnectionEnd")
 	proto.RegisterType((*IdentifiedConnection)(nil), "ibc.core.connection.v1.IdentifiedConnection")
+	proto.RegisterType((*ConnectionUpgrade)(nil), "ibc.core.connection.v1.ConnectionUpgrade")
+	proto.RegisterType((*ConnectionUpgradeProposal)(nil), "ibc.core.connection.v1.ConnectionUpgradeProposal")
 	proto.RegisterType((*Counterparty)(nil), "ibc.core.connection.v1.Counterparty")
 	proto.RegisterType((*ClientPaths)(nil), "ibc.core.connection.v1.ClientPaths")
 	proto.RegisterType((*ConnectionPaths)(nil), "ibc.core.connection.v1.ConnectionPaths")
@@ -418,53 +570,71 @@ func init() {
 }
 
 var fileDescriptor_90572467c054e43a = []byte{
-	// 721 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xda, 0x48,
-	0x14, 0xc7, 0xc6, 0x10, 0x18, 0xc2, 0x2e, 0x3b, 0x8b, 0x36, 0x16, 0xab, 0xd8, 0x96, 0x77, 0xb5,
-	0x8b, 0x56, 0x0a, 0x5e, 0x92, 0xd5, 0x1e, 0xd2, 0xf6, 0x10, 0x08, 0x95, 0xac, 0xb6, 0x14, 0x39,
-	0x24, 0x52, 0x73, 0x41, 0xc6, 0x9e, 0x90, 0x51, 0xb0, 0x07, 0xd9, 0x03, 0x82, 0x6f, 0x10, 0xe5,
-	0xd4, 0x6b, 0x0f, 0x91, 0x2a, 0xf5, 0xbb, 0x54, 0x51, 0x4f, 0x39, 0xf6, 0x84, 0xaa, 0xe4, 0xda,
-	0x13, 0x9f, 0xa0, 0xb2, 0xc7, 0x80, 0x13, 0x35, 0x95, 0x92, 0xf6, 0xf6, 0x9e, 0x7f, 0x7f, 0x78,
-	0xef, 0xc7, 0xc3, 0x80, 0xbf, 0x71, 0xd7, 0xd2, 0x2c, 0xe2, 0x21, 0xcd, 0x22, 0xae, 0x8b, 0x2c,
-	0x8a, 0x89, 0xab, 0x8d, 0xaa, 0xb1, 0xae, 0x32, 0xf0, 0x08, 0x25, 0xf0, 0x37, 0xdc, 0xb5, 0x2a,
-	0x01, 0xb1, 0x12, 0x83, 0x46, 0xd5, 0x52, 0xb1, 0x47, 0x7a, 0x24, 0xa4, 0x68, 0x41, 0xc5, 0xd8,
-	0xa5, 0xb8, 0xad, 0xe3, 0x60, 0xea, 0x20, 0x97, 0x32, 0xdb, 0x79, 0xc7, 0x88, 0xea, 0x7b, 0x1e,
-	0xe4, 0xeb, 0x0b, 0xc3, 0x86, 0x6b, 0xc3, 0x2a, 0xc8, 0x5a, 0x7d, 0x8c, 0x5c, 0xda, 0xc1, 0xb6,
-	0xc8, 0x29, 0x5c, 0x39, 0x5b, 0x2b, 0xce, 0xa6, 0x72, 0x61, 0x62, 0x3a, 0xfd, 0x6d, 0x75, 0x01,
-	0xa9, 0x46, 0x86, 0xd5, 0xba, 0x0d, 0x1f, 0x81, 0xcc, 0x08, 0x79, 0x3e, 0x26, 0xae, 0x2f, 0xf2,
-	0x4a, 0xb2, 0x9c, 0xdb, 0x94, 0x2b, 0x5f, 0x1f, 0xb7, 0x72, 0xc0, 0x78, 0xc6, 0x42, 0x00, 0xb7,
-	0x40, 0xca, 0xa7, 0x26, 0x45, 0x62, 0x52, 0xe1, 0xca, 0x3f, 0x6d, 0xae, 0xdf, 0xa5, 0xdc, 0x0b,
-	0x48, 0x06, 0xe3, 0xc2, 0x26, 0x58, 0xb5, 0xc8, 0xd0, 0xa5, 0xc8, 0x1b, 0x98, 0x1e, 0x9d, 0x88,
-	0x82, 0xc2, 0x95, 0x73, 0x9b, 0x7f, 0xde, 0xa5, 0xad, 0xc7, 0xb8, 0x35, 0xe1, 0x62, 0x2a, 0x27,
-	0x8c, 0x1b, 0x7a, 0xb8, 0x0d, 0x56, 0x6d, 0xd4, 0x37, 0x27, 0x9d, 0x01, 0xf2, 0x30, 0xb1, 0xc5,
-	0x94, 0xc2, 0x95, 0x85, 0xda, 0xda, 0x6c, 0x2a, 0xff, 0xca, 0xf6, 0x8e, 0xa3, 0xaa, 0x91, 0x0b,
-	0xdb, 0x56, 0xd8, 0x6d, 0x0b, 0xa7, 0x6f, 0xe5, 0x84, 0xfa, 0x99, 0x07, 0x45, 0xdd, 0x46, 0x2e,
-	0xc5, 0x47, 0x18, 0xd9, 0xcb, 0x48, 0xe1, 0x3a, 0xe0, 0x17, 0x41, 0xe6, 0x67, 0x53, 0x39, 0xcb,
-	0x0c, 0x83, 0x04, 0x79, 0x7c, 0x2b, 0x6e, 0xfe, 0xde, 0x71, 0x27, 0x1f, 0x1c, 0xb7, 0xf0, 0x1d,
-	0x71, 0xa7, 0x7e, 0x70, 0xdc, 0xe9, 0x7b, 0xc7, 0xfd, 0x81, 0x03, 0xab, 0xf1, 0x8f, 0x79, 0xc8,
-	0xd9, 0x3e, 0x01, 0xf9, 0xe5, 0xdc, 0xcb, 0xf8, 0xc5, 0xd9, 0x54, 0x2e, 0x46, 0xb2, 0x38, 0xac,
-	0x06, 0x4b, 0xcc, 0x7b, 0xdd, 0x86, 0x35, 0x90, 0x1e, 0x78, 0xe8, 0x08, 0x8f, 0xc3, 0xcb, 0xbd,
-	0x15, 0xc7, 0xe2, 0x67, 0x36, 0xaa, 0x56, 0x5e, 0x20, 0xef, 0xa4, 0x8f, 0x5a, 0x21, 0x37, 0x8a,
-	0x23, 0x52, 0x46, 0xcb, 0xfc, 0x01, 0x72, 0xf5, 0x70, 0xa8, 0x96, 0x49, 0x8f, 0x7d, 0x58, 0x04,
-	0xa9, 0x41, 0x50, 0x88, 0x9c, 0x92, 0x2c, 0x67, 0x0d, 0xd6, 0xa8, 0x87, 0xe0, 0xe7, 0xe5, 0x55,
-	0x31, 0xe2, 0x03, 0x76, 0x5e, 0x78, 0xf3, 0x71, 0xef, 0x67, 0x60, 0x25, 0xba, 0x14, 0x28, 0x01,
-	0x80, 0xe7, 0x67, 0xec, 0x31, 0x53, 0x23, 0xf6, 0x04, 0x96, 0x40, 0xe6, 0x08, 0x99, 0x74, 0xe8,
-	0xa1, 0xb9, 0xc7, 0xa2, 0x8f, 0xb6, 0x71, 0x41, 0xba, 0x65, 0x7a, 0xa6, 0xe3, 0x43, 0x1b, 0xfc,
-	0xee, 0x98, 0xe3, 0x0e, 0x1a, 0x0f, 0x90, 0x45, 0x91, 0xdd, 0xa1, 0xd8, 0x41, 0xc1, 0x97, 0xda,
-	0xe9, 0xf6, 0x89, 0x75, 0x12, 0x9a, 0x0b, 0xb5, 0xbf, 0x66, 0x53, 0x59, 0x65, 0x13, 0x7f, 0x83,
-	0xac, 0x1a, 0x6b, 0x8e, 0x39, 0x6e, 0x44, 0x60, 0x1b, 0x3b, 0xa8, 0x85, 0xbc, 0x5a, 0x80, 0xfc,
-	0xf3, 0x86, 0x03, 0xa9, 0xf0, 0x5a, 0xe1, 0xff, 0x40, 0xde, 0x6b, 0xef, 0xb4, 0x1b, 0x9d, 0xfd,
-	0xa6, 0xde, 0xd4, 0xdb, 0xfa, 0xce, 0x73, 0xfd, 0xb0, 0xb1, 0xdb, 0xd9, 0x6f, 0xee, 0xb5, 0x1a,
-	0x75, 0xfd, 0xa9, 0xde, 0xd8, 0x2d, 0x24, 0x4a, 0xbf, 0x9c, 0x9d, 0x2b, 0xf9, 0x1b, 0x04, 0x28,
-	0x02, 0xc0, 0x74, 0xc1, 0xc3, 0x02, 0x57, 0xca, 0x9c, 0x9d, 0x2b, 0x42, 0x50, 0x43, 0x09, 0xe4,
-	0x19, 0xd2, 0x36, 0x5e, 0xbd, 0x6c, 0x35, 0x9a, 0x05, 0xbe, 0x94, 0x3b, 0x3b, 0x57, 0x56, 0xa2,
-	0x76, 0xa9, 0x0c, 0xc1, 0x24, 0x53, 0x06, 0x75, 0x49, 0x38, 0x7d, 0x27, 0x25, 0x6a, 0x07, 0x17,
-	0x57, 0x12, 0x77, 0x79, 0x25, 0x71, 0x9f, 0xae, 0x24, 0xee, 0xf5, 0xb5, 0x94, 0xb8, 0xbc, 0x96,
-	0x12, 0x1f, 0xaf, 0xa5, 0xc4, 0xe1, 0xe3, 0x1e, 0xa6, 0xc7, 0xc3, 0x6e, 0x70, 0x2a, 0x9a, 0x45,
-	0x7c, 0x87, 0xf8, 0x1a, 0xee, 0x5a, 0x1b, 0x3d, 0xa2, 0x8d, 0xfe, 0xd3, 0x1c, 0x62, 0x0f, 0xfb,
-	0xc8, 0x67, 0x6f, 0xf0, 0x7f, 0xb7, 0x36, 0x62, 0xff, 0x0d, 0x74, 0x32, 0x40, 0x7e, 0x37, 0x1d,
-	0xbe, 0xbd, 0xb7, 0xbe, 0x04, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x2c, 0xbf, 0x64, 0x3f, 0x06, 0x00,
-	0x00,
+	// 1014 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xe3, 0xfc, 0x69, 0x5f, 0x52, 0x9a, 0xce, 0x16, 0xea, 0xcd, 0xb2, 0xb1, 0x65, 0xd0,
+	0x6e, 0x58, 0x69, 0x13, 0xda, 0x22, 0x24, 0x0a, 0x1c, 0x9a, 0x36, 0x45, 0x16, 0x10, 0x22, 0x37,
+	0x5d, 0xa9, 0xbd, 0x58, 0x8e, 0x3d, 0xcd, 0x5a, 0x1b, 0xff, 0xc1, 0x9e, 0x44, 0xcd, 0x37, 0x58,
+	0xe5, 0x84, 0xb8, 0x71, 0x88, 0x84, 0xc4, 0x9d, 0xcf, 0xb1, 0xe2, 0xb4, 0x12, 0x42, 0xe2, 0x14,
+	0xa1, 0xf6, 0x1b, 0xe4, 0xc6, 0x0d, 0xd9, 0x63, 0x27, 0xce, 0x66, 0x0b, 0x34, 0x5d, 0xed, 0x29,
+	0xf3, 0xde, 0xfb, 0xbd, 0x97, 0x37, 0xf3, 0xfb, 0xcd, 0xf3, 0xc0, 0x43, 0xa3, 0xad, 0x55, 0x35,
+	0xdb, 0xc5, 0x55, 0xcd, 0xb6, 0x2c, 0xac, 0x11, 0xc3, 0xb6, 0xaa, 0xfd, 0xed, 0x98, 0x55, 0x71,
+	0x5c, 0x9b, 0xd8, 0xe8, 0x3d, 0xa3, 0xad, 0x55, 0x7c, 0x60, 0x25, 0x16, 0xea, 0x6f, 0x17, 0x37,
+	0x3b, 0x76, 0xc7, 0x0e, 0x20, 0x55, 0x7f, 0x45, 0xd1, 0xc5, 0x78, 0x59, 0xd3, 0x34, 0x88, 0x89,
+	0x2d, 0x42, 0xcb, 0x46, 0x16, 0x05, 0x8a, 0xbf, 0xb3, 0xb0, 0x76, 0x30, 0x2d, 0x58, 0xb7, 0x74,
+	0xb4, 0x0d, 0xab, 0x5a, 0xd7, 0xc0, 0x16, 0x51, 0x0c, 0x9d, 0x63, 0x04, 0xa6, 0xbc, 0x5a, 0xdb,
+	0x9c, 0x8c, 0xf9, 0xc2, 0x40, 0x35, 0xbb, 0x7b, 0xe2, 0x34, 0x24, 0xca, 0x2b, 0x74, 0x2d, 0xe9,
+	0xe8, 0x73, 0x58, 0xe9, 0x63, 0xd7, 0x33, 0x6c, 0xcb, 0xe3, 0x92, 0x02, 0x5b, 0xce, 0xed, 0xf0,
+	0x95, 0xd7, 0xb7, 0x5b, 0x79, 0x42, 0x71, 0xf2, 0x34, 0x01, 0xed, 0x42, 0xda, 0x23, 0x2a, 0xc1,
+	0x1c, 0x2b, 0x30, 0xe5, 0x77, 0x76, 0xee, 0x5f, 0x97, 0x79, 0xec, 0x83, 0x64, 0x8a, 0x45, 0x0d,
+	0xc8, 0x6b, 0x76, 0xcf, 0x22, 0xd8, 0x75, 0x54, 0x97, 0x0c, 0xb8, 0x94, 0xc0, 0x94, 0x73, 0x3b,
+	0x1f, 0x5e, 0x97, 0x7b, 0x10, 0xc3, 0xd6, 0x52, 0x2f, 0xc6, 0x7c, 0x42, 0x9e, 0xcb, 0x47, 0x7b,
+	0x90, 0xd7, 0x71, 0x57, 0x1d, 0x28, 0x0e, 0x76, 0x0d, 0x5b, 0xe7, 0xd2, 0x02, 0x53, 0x4e, 0xd5,
+	0xb6, 0x26, 0x63, 0xfe, 0x0e, 0xdd, 0x77, 0x3c, 0x2a, 0xca, 0xb9, 0xc0, 0x6c, 0x06, 0x16, 0x3a,
+	0x80, 0x6c, 0xcf, 0xe9, 0xb8, 0xaa, 0x8e, 0xb9, 0x4c, 0xd0, 0xc6, 0x47, 0xd7, 0xb7, 0x11, 0x59,
+	0x27, 0x34, 0x41, 0x8e, 0x32, 0xd1, 0x11, 0x14, 0xc2, 0xa5, 0xe2, 0xe1, 0xef, 0x7b, 0xd8, 0xd2,
+	0x30, 0x97, 0x0d, 0x9a, 0xb8, 0x37, 0x19, 0xf3, 0x5b, 0xb4, 0x89, 0x57, 0x11, 0xa2, 0xbc, 0x1e,
+	0xba, 0x8e, 0x43, 0xcf, 0x5e, 0xea, 0xf9, 0xcf, 0x7c, 0x42, 0xfc, 0x9b, 0x85, 0x4d, 0x49, 0xc7,
+	0x16, 0x31, 0xce, 0x0d, 0xac, 0xcf, 0xfe, 0x16, 0xdd, 0x87, 0xe4, 0x94, 0xd5, 0xb5, 0xc9, 0x98,
+	0x5f, 0xa5, 0x85, 0x7d, 0x3a, 0x93, 0xc6, 0x2b, 0xdc, 0x27, 0x6f, 0xcc, 0x3d, 0xbb, 0x34, 0xf7,
+	0xa9, 0x5b, 0x70, 0x9f, 0x7e, 0xc3, 0xdc, 0x67, 0x96, 0xe3, 0x3e, 0xfb, 0x46, 0xb9, 0x5f, 0x59,
+	0x9a, 0xfb, 0x1f, 0x59, 0xd8, 0x58, 0xf8, 0x33, 0xf4, 0x19, 0x64, 0xc3, 0x53, 0x0f, 0xd8, 0xff,
+	0x1f, 0x2c, 0x45, 0xf8, 0x85, 0xf3, 0x49, 0xde, 0xe0, 0x7c, 0x06, 0x70, 0x27, 0x7e, 0xd6, 0x8a,
+	0xe3, 0xe2, 0x73, 0xe3, 0x82, 0x63, 0x17, 0x29, 0x9b, 0xce, 0xa5, 0xfe, 0x76, 0xe5, 0x5b, 0xec,
+	0x3e, 0xeb, 0xe2, 0x66, 0x80, 0xad, 0x89, 0x3e, 0x65, 0x93, 0x31, 0x5f, 0x0c, 0x45, 0xb8, 0x58,
+	0x4e, 0x94, 0x51, 0xdc, 0x4b, 0xf3, 0xd0, 0xde, 0xbc, 0xb6, 0xae, 0xd5, 0x47, 0x78, 0x42, 0x73,
+	0x12, 0x93, 0x60, 0x83, 0x18, 0x26, 0xb6, 0x7b, 0x44, 0xf1, 0x7f, 0x3d, 0xa2, 0x9a, 0x4e, 0x38,
+	0x13, 0xde, 0x9f, 0x8c, 0x79, 0x8e, 0xb6, 0xb2, 0x00, 0x11, 0xe5, 0x42, 0xe8, 0x6b, 0x45, 0xae,
+	0x90, 0x94, 0x3f, 0x58, 0xb8, 0xbb, 0x40, 0x4a, 0xd3, 0xb5, 0x1d, 0xdb, 0x53, 0xbb, 0x68, 0x13,
+	0xd2, 0xc4, 0x20, 0x5d, 0x4c, 0x2f, 0xa6, 0x4c, 0x0d, 0x24, 0x40, 0x4e, 0xc7, 0x9e, 0xe6, 0x1a,
+	0x8e, 0x9f, 0x43, 0xaf, 0xa3, 0x1c, 0x77, 0xa1, 0x2f, 0x61, 0x6d, 0xb6, 0x17, 0xff, 0xca, 0xb2,
+	0xc1, 0x95, 0xe5, 0x26, 0x63, 0x7e, 0x33, 0x3a, 0xad, 0x58, 0x58, 0x94, 0xf3, 0x33, 0x5b, 0xd2,
+	0xe3, 0x9a, 0x48, 0xdd, 0x52, 0x13, 0xe9, 0xdb, 0x6b, 0x22, 0xf3, 0x16, 0x34, 0xf1, 0x5a, 0x5e,
+	0xb3, 0xb7, 0xe0, 0xf5, 0x37, 0x06, 0xf2, 0xf1, 0x01, 0xb3, 0xcc, 0xd7, 0x73, 0x81, 0xc5, 0xe4,
+	0x8d, 0x58, 0xac, 0x41, 0x66, 0x89, 0x5b, 0x45, 0x07, 0x61, 0x98, 0x19, 0x6e, 0xe6, 0x03, 0xc8,
+	0x1d, 0x04, 0x4d, 0x35, 0x55, 0xf2, 0xd4, 0xf3, 0x55, 0xe9, 0xf8, 0x0b, 0x8e, 0x11, 0x58, 0x5f,
+	0x95, 0x81, 0x21, 0x9e, 0xc1, 0xfa, 0x4c, 0xc8, 0x14, 0xb8, 0xc4, 0x9e, 0xa7, 0xb5, 0x93, 0xf1,
+	0xda, 0x5f, 0x43, 0x36, 0x54, 0x1a, 0x2a, 0x01, 0x18, 0xd1, 0x07, 0xcc, 0x0d, 0xef, 0x45, 0xcc,
+	0x83, 0x8a, 0xb0, 0x72, 0x8e, 0x55, 0xd2, 0x73, 0x71, 0x54, 0x63, 0x6a, 0x87, 0xbb, 0xb1, 0x20,
+	0xd3, 0x54, 0x5d, 0xd5, 0xf4, 0x90, 0x0e, 0xf7, 0x4c, 0xf5, 0x42, 0xc1, 0x17, 0x0e, 0xd6, 0x08,
+	0xd6, 0x03, 0x5e, 0x7d, 0x69, 0x2a, 0xed, 0xae, 0xad, 0x3d, 0x0b, 0x8a, 0xa7, 0x6a, 0x0f, 0x26,
+	0x63, 0x5e, 0xa4, 0x1d, 0xff, 0x0b, 0x58, 0x94, 0xb7, 0x4c, 0xf5, 0xa2, 0x1e, 0x06, 0x7d, 0x35,
+	0x34, 0xb1, 0x5b, 0xf3, 0x23, 0x8f, 0x7e, 0x62, 0x20, 0x1d, 0x0c, 0x11, 0xf4, 0x29, 0xf0, 0xc7,
+	0xad, 0xfd, 0x56, 0x5d, 0x39, 0x69, 0x48, 0x0d, 0xa9, 0x25, 0xed, 0x7f, 0x23, 0x9d, 0xd5, 0x0f,
+	0x95, 0x93, 0xc6, 0x71, 0xb3, 0x7e, 0x20, 0x1d, 0x49, 0xf5, 0xc3, 0x42, 0xa2, 0xb8, 0x31, 0x1c,
+	0x09, 0x6b, 0x73, 0x00, 0xc4, 0x01, 0xd0, 0x3c, 0xdf, 0x59, 0x60, 0x8a, 0x2b, 0xc3, 0x91, 0x90,
+	0xf2, 0xd7, 0xa8, 0x04, 0x6b, 0x34, 0xd2, 0x92, 0x4f, 0xbf, 0x6b, 0xd6, 0x1b, 0x85, 0x64, 0x31,
+	0x37, 0x1c, 0x09, 0xd9, 0xd0, 0x9c, 0x65, 0x06, 0x41, 0x96, 0x66, 0xfa, 0xeb, 0x62, 0xea, 0xf9,
+	0x2f, 0xa5, 0xc4, 0xa3, 0x5f, 0x19, 0xc8, 0xc7, 0xe7, 0x1c, 0x3a, 0x82, 0x87, 0x27, 0xcd, 0xaf,
+	0xe4, 0xfd, 0xc3, 0xba, 0xf2, 0xdf, 0xad, 0xde, 0x1d, 0x8e, 0x84, 0x77, 0x23, 0xf8, 0x7c, 0xcb,
+	0x65, 0x40, 0xf3, 0x75, 0xc2, 0xd6, 0x0b, 0xc3, 0x91, 0x90, 0x8f, 0x22, 0xc1, 0x16, 0x1e, 0xc0,
+	0xc6, 0x3c, 0xb2, 0x25, 0x9f, 0x16, 0x92, 0xc5, 0xf5, 0xe1, 0x48, 0xc8, 0x45, 0x81, 0x96, 0x7c,
+	0x4a, 0x1b, 0xae, 0x3d, 0x79, 0x71, 0x59, 0x62, 0x5e, 0x5e, 0x96, 0x98, 0xbf, 0x2e, 0x4b, 0xcc,
+	0x0f, 0x57, 0xa5, 0xc4, 0xcb, 0xab, 0x52, 0xe2, 0xcf, 0xab, 0x52, 0xe2, 0xec, 0x8b, 0x8e, 0x41,
+	0x9e, 0xf6, 0xda, 0xbe, 0xb6, 0xab, 0x9a, 0xed, 0x99, 0xb6, 0x57, 0x35, 0xda, 0xda, 0xe3, 0x8e,
+	0x5d, 0xed, 0x7f, 0x52, 0x35, 0x6d, 0xbd, 0xd7, 0xc5, 0x1e, 0x7d, 0xf9, 0x7e, 0xbc, 0xfb, 0x38,
+	0xf6, 0xa6, 0x26, 0x03, 0x07, 0x7b, 0xed, 0x4c, 0xf0, 0xea, 0xdd, 0xfd, 0x67, 0x00, 0xad, 0xf7,
+	0xad, 0x11, 0x77, 0x0b, 0x00, 0x00,
 }
 
 func (m *ConnectionEnd) Marshal() (dAtA []byte, err error) {
@@ -487,6 +657,23 @@ func (m *ConnectionEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Upgrade != nil {
+		{
+			size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConnection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.DelayPeriod != 0 {
 		i = encodeVarintConnection(dAtA, i, uint64(m.DelayPeriod))
 		i--
@@ -551,6 +738,23 @@ func (m *IdentifiedConnection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Upgrade != nil {
+		{
+			size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConnection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.DelayPeriod != 0 {
 		i = encodeVarintConnection(dAtA, i, uint64(m.DelayPeriod))
 		i--
@@ -602,6 +806,142 @@ func (m *IdentifiedConnection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConnectionUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.State != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.CounterpartyPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConnection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DelayPeriod != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.DelayPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != nil {
+		{
+			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConnection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectionUpgradeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionUpgradeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.CounterpartyPrefix.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConnection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.DelayPeriod != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.DelayPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Version != nil {
+		{
+			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConnection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintConnection(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintConnection(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintConnection(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Counterparty) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -822,6 +1162,13 @@ func (m *ConnectionEnd) Size() (n int) {
 	if m.DelayPeriod != 0 {
 		n += 1 + sovConnection(uint64(m.DelayPeriod))
 	}
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovConnection(uint64(l))
+	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovConnection(uint64(m.UpgradeSequence))
+	}
 	return n
 }
 
@@ -853,6 +1200,70 @@ func (m *IdentifiedConnection) Size() (n int) {
 	if m.DelayPeriod != 0 {
 		n += 1 + sovConnection(uint64(m.DelayPeriod))
 	}
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovConnection(uint64(l))
+	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovConnection(uint64(m.UpgradeSequence))
+	}
+	return n
+}
+
+func (m *ConnectionUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != nil {
+		l = m.Version.Size()
+		n += 1 + l + sovConnection(uint64(l))
+	}
+	if m.DelayPeriod != 0 {
+		n += 1 + sovConnection(uint64(m.DelayPeriod))
+	}
+	l = m.CounterpartyPrefix.Size()
+	n += 1 + l + sovConnection(uint64(l))
+	if m.State != 0 {
+		n += 1 + sovConnection(uint64(m.State))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovConnection(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *ConnectionUpgradeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovConnection(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovConnection(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovConnection(uint64(l))
+	}
+	if m.Version != nil {
+		l = m.Version.Size()
+		n += 1 + l + sovConnection(uint64(l))
+	}
+	if m.DelayPeriod != 0 {
+		n += 1 + sovConnection(uint64(m.DelayPeriod))
+	}
+	l = m.CounterpartyPrefix.Size()
+	n += 1 + l + sovConnection(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovConnection(uint64(m.TimeoutTimestamp))
+	}
 	return n
 }
 
@@ -1112,22 +1523,77 @@ func (m *ConnectionEnd) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipConnection(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthConnection
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
+			if m.Upgrade == nil {
+				m.Upgrade = &ConnectionUpgrade{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConnection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -1331,6 +1797,490 @@ func (m *IdentifiedConnection) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Upgrade == nil {
+				m.Upgrade = &ConnectionUpgrade{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConnection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConnection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Version == nil {
+				m.Version = &Version{}
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+			}
+			m.DelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CounterpartyPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= UpgradeState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConnection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionUpgradeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConnection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionUpgradeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionUpgradeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Version == nil {
+				m.Version = &Version{}
+			}
+			if err := m.Version.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+			}
+			m.DelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CounterpartyPrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConnection(dAtA[iNdEx:])
@@ -29,27 +29,52 @@ func TestConnectionValidateBasic(t *testing.T) {
 	}{
 		{
 			"valid connection",
-			types.ConnectionEnd{clientID, []*types.Version{ibctesting.ConnectionVersion}, types.INIT, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500},
+			types.ConnectionEnd{clientID, []*types.Version{ibctesting.ConnectionVersion}, types.INIT, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500, nil, 0},
 			true,
 		},
 		{
 			"invalid client id",
-			types.ConnectionEnd{"(clientID1)", []*types.Version{ibctesting.ConnectionVersion}, types.INIT, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500},
+			types.ConnectionEnd{"(clientID1)", []*types.Version{ibctesting.ConnectionVersion}, types.INIT, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500, nil, 0},
 			false,
 		},
 		{
 			"empty versions",
-			types.ConnectionEnd{clientID, nil, types.INIT, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500},
+			types.ConnectionEnd{clientID, nil, types.INIT, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500, nil, 0},
 			false,
 		},
 		{
 			"invalid version",
-			types.ConnectionEnd{clientID, []*types.Version{{}}, types.INIT, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500},
+			types.ConnectionEnd{clientID, []*types.Version{{}}, types.INIT, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500, nil, 0},
 			false,
 		},
 		{
 			"invalid counterparty",
-			types.ConnectionEnd{clientID, []*types.Version{ibctesting.ConnectionVersion}, types.INIT, types.Counterparty{clientID2, connectionID2, emptyPrefix}, 500},
+			types.ConnectionEnd{clientID, []*types.Version{ibctesting.ConnectionVersion}, types.INIT, types.Counterparty{clientID2, connectionID2, emptyPrefix}, 500, nil, 0},
+			false,
+		},
+		{
+			"valid connection with upgrade",
+			types.ConnectionEnd{clientID, []*types.Version{ibctesting.ConnectionVersion}, types.OPEN, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500, types.NewConnectionUpgrade(ibctesting.ConnectionVersion, 1000, commitmenttypes.NewMerklePrefix([]byte("prefix")), types.UPGRADE_INIT, 1000), 0},
+			true,
+		},
+		{
+			"invalid upgrade version",
+			types.ConnectionEnd{clientID, []*types.Version{ibctesting.ConnectionVersion}, types.OPEN, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500, types.NewConnectionUpgrade(&types.Version{}, 1000, commitmenttypes.NewMerklePrefix([]byte("prefix")), types.UPGRADE_INIT, 1000), 0},
+			false,
+		},
+		{
+			"invalid upgrade counterparty prefix",
+			types.ConnectionEnd{clientID, []*types.Version{ibctesting.ConnectionVersion}, types.OPEN, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500, types.NewConnectionUpgrade(ibctesting.ConnectionVersion, 1000, emptyPrefix, types.UPGRADE_INIT, 1000), 0},
+			false,
+		},
+		{
+			"invalid upgrade timeout timestamp",
+			types.ConnectionEnd{clientID, []*types.Version{ibctesting.ConnectionVersion}, types.OPEN, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500, types.NewConnectionUpgrade(ibctesting.ConnectionVersion, 1000, commitmenttypes.NewMerklePrefix([]byte("prefix")), types.UPGRADE_INIT, 0), 0},
+			false,
+		},
+		{
+			"invalid upgrade state",
+			types.ConnectionEnd{clientID, []*types.Version{ibctesting.ConnectionVersion}, types.OPEN, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500, types.NewConnectionUpgrade(ibctesting.ConnectionVersion, 1000, commitmenttypes.NewMerklePrefix([]byte("prefix")), types.UPGRADE_UNINITIALIZED, 1000), 0},
 			false,
 		},
 	}
@@ -98,12 +123,12 @@ func TestIdentifiedConnectionValidateBasic(t *testing.T) {
 	}{
 		{
 			"valid connection",
-			types.NewIdentifiedConnection(clientID, types.ConnectionEnd{clientID, []*types.Version{ibctesting.ConnectionVersion}, types.INIT, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500}),
+			types.NewIdentifiedConnection(clientID, types.ConnectionEnd{clientID, []*types.Version{ibctesting.ConnectionVersion}, types.INIT, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500, nil, 0}),
 			true,
 		},
 		{
 			"invalid connection id",
-			types.NewIdentifiedConnection("(connectionIDONE)", types.ConnectionEnd{clientID, []*types.Version{ibctesting.ConnectionVersion}, types.INIT, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500}),
+			types.NewIdentifiedConnection("(connectionIDONE)", types.ConnectionEnd{clientID, []*types.Version{ibctesting.ConnectionVersion}, types.INIT, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, 500, nil, 0}),
 			false,
 		},
 	}
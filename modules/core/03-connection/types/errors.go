@@ -16,4 +16,6 @@ var (
 	ErrInvalidVersion                = sdkerrors.Register(SubModuleName, 9, "invalid connection version")
 	ErrVersionNegotiationFailed      = sdkerrors.Register(SubModuleName, 10, "connection version negotiation failed")
 	ErrInvalidConnectionIdentifier   = sdkerrors.Register(SubModuleName, 11, "invalid connection identifier")
+	ErrInvalidConnectionUpgrade      = sdkerrors.Register(SubModuleName, 12, "invalid connection upgrade")
+	ErrConnectionUpgradeTimeout      = sdkerrors.Register(SubModuleName, 13, "connection upgrade timeout")
 )
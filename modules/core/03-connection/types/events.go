@@ -12,6 +12,9 @@ const (
 	AttributeKeyClientID                 = "client_id"
 	AttributeKeyCounterpartyClientID     = "counterparty_client_id"
 	AttributeKeyCounterpartyConnectionID = "counterparty_connection_id"
+	AttributeKeyUpgradeVersion           = "upgrade_version"
+	AttributeKeyUpgradeDelayPeriod       = "upgrade_delay_period"
+	AttributeKeyUpgradeTimeoutTimestamp  = "upgrade_timeout_timestamp"
 )
 
 // IBC connection events vars
@@ -21,5 +24,11 @@ var (
 	EventTypeConnectionOpenAck     = "connection_open_ack"
 	EventTypeConnectionOpenConfirm = "connection_open_confirm"
 
+	EventTypeConnectionUpgradeInit    = "connection_upgrade_init"
+	EventTypeConnectionUpgradeTry     = "connection_upgrade_try"
+	EventTypeConnectionUpgradeAck     = "connection_upgrade_ack"
+	EventTypeConnectionUpgradeConfirm = "connection_upgrade_confirm"
+	EventTypeConnectionUpgradeCancel  = "connection_upgrade_cancel"
+
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
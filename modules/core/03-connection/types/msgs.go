@@ -16,6 +16,10 @@ var (
 	_ sdk.Msg = &MsgConnectionOpenConfirm{}
 	_ sdk.Msg = &MsgConnectionOpenAck{}
 	_ sdk.Msg = &MsgConnectionOpenTry{}
+	_ sdk.Msg = &MsgConnectionUpgradeTry{}
+	_ sdk.Msg = &MsgConnectionUpgradeAck{}
+	_ sdk.Msg = &MsgConnectionUpgradeConfirm{}
+	_ sdk.Msg = &MsgConnectionUpgradeCancel{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgConnectionOpenTry{}
 	_ codectypes.UnpackInterfacesMessage = MsgConnectionOpenAck{}
@@ -289,3 +293,171 @@ func (msg MsgConnectionOpenConfirm) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{accAddr}
 }
+
+// NewMsgConnectionUpgradeTry creates a new MsgConnectionUpgradeTry instance
+//nolint:interfacer
+func NewMsgConnectionUpgradeTry(
+	connectionID string, proofInit []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgConnectionUpgradeTry {
+	return &MsgConnectionUpgradeTry{
+		ConnectionId: connectionID,
+		ProofInit:    proofInit,
+		ProofHeight:  proofHeight,
+		Signer:       signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeTry) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if len(msg.ProofInit) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof init")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgConnectionUpgradeTry) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// NewMsgConnectionUpgradeAck creates a new MsgConnectionUpgradeAck instance
+//nolint:interfacer
+func NewMsgConnectionUpgradeAck(
+	connectionID string, proofTry []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgConnectionUpgradeAck {
+	return &MsgConnectionUpgradeAck{
+		ConnectionId: connectionID,
+		ProofTry:     proofTry,
+		ProofHeight:  proofHeight,
+		Signer:       signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeAck) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if len(msg.ProofTry) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof try")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgConnectionUpgradeAck) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// NewMsgConnectionUpgradeConfirm creates a new MsgConnectionUpgradeConfirm instance
+//nolint:interfacer
+func NewMsgConnectionUpgradeConfirm(
+	connectionID string, proofAck []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgConnectionUpgradeConfirm {
+	return &MsgConnectionUpgradeConfirm{
+		ConnectionId: connectionID,
+		ProofAck:     proofAck,
+		ProofHeight:  proofHeight,
+		Signer:       signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeConfirm) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if len(msg.ProofAck) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof ack")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgConnectionUpgradeConfirm) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// NewMsgConnectionUpgradeCancel creates a new MsgConnectionUpgradeCancel instance
+//nolint:interfacer
+func NewMsgConnectionUpgradeCancel(
+	connectionID string, counterpartyConnection ConnectionEnd, proofConnection []byte,
+	proofHeight clienttypes.Height, signer string,
+) *MsgConnectionUpgradeCancel {
+	return &MsgConnectionUpgradeCancel{
+		ConnectionId:           connectionID,
+		CounterpartyConnection: counterpartyConnection,
+		ProofConnection:        proofConnection,
+		ProofHeight:            proofHeight,
+		Signer:                 signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionUpgradeCancel) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if err := msg.CounterpartyConnection.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid counterparty connection")
+	}
+	if len(msg.ProofConnection) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof connection")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgConnectionUpgradeCancel) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
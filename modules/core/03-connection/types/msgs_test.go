@@ -235,3 +235,132 @@ func (suite *MsgTestSuite) TestNewMsgConnectionOpenConfirm() {
 		}
 	}
 }
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeTry() {
+	testMsgs := []*types.MsgConnectionUpgradeTry{
+		types.NewMsgConnectionUpgradeTry("test/conn1", suite.proof, clientHeight, signer),
+		types.NewMsgConnectionUpgradeTry(connectionID, emptyProof, clientHeight, signer),
+		types.NewMsgConnectionUpgradeTry(connectionID, suite.proof, clienttypes.ZeroHeight(), signer),
+		types.NewMsgConnectionUpgradeTry(connectionID, suite.proof, clientHeight, ""),
+		types.NewMsgConnectionUpgradeTry(connectionID, suite.proof, clientHeight, signer),
+	}
+
+	testCases := []struct {
+		msg     *types.MsgConnectionUpgradeTry
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], false, "invalid connection ID"},
+		{testMsgs[1], false, "empty proofInit"},
+		{testMsgs[2], false, "invalid proofHeight"},
+		{testMsgs[3], false, "empty signer"},
+		{testMsgs[4], true, "success"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, "Msg %d failed: %s", i, tc.errMsg)
+		} else {
+			suite.Require().Error(err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeAck() {
+	testMsgs := []*types.MsgConnectionUpgradeAck{
+		types.NewMsgConnectionUpgradeAck("test/conn1", suite.proof, clientHeight, signer),
+		types.NewMsgConnectionUpgradeAck(connectionID, emptyProof, clientHeight, signer),
+		types.NewMsgConnectionUpgradeAck(connectionID, suite.proof, clienttypes.ZeroHeight(), signer),
+		types.NewMsgConnectionUpgradeAck(connectionID, suite.proof, clientHeight, ""),
+		types.NewMsgConnectionUpgradeAck(connectionID, suite.proof, clientHeight, signer),
+	}
+
+	testCases := []struct {
+		msg     *types.MsgConnectionUpgradeAck
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], false, "invalid connection ID"},
+		{testMsgs[1], false, "empty proofTry"},
+		{testMsgs[2], false, "invalid proofHeight"},
+		{testMsgs[3], false, "empty signer"},
+		{testMsgs[4], true, "success"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, "Msg %d failed: %s", i, tc.errMsg)
+		} else {
+			suite.Require().Error(err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeConfirm() {
+	testMsgs := []*types.MsgConnectionUpgradeConfirm{
+		types.NewMsgConnectionUpgradeConfirm("test/conn1", suite.proof, clientHeight, signer),
+		types.NewMsgConnectionUpgradeConfirm(connectionID, emptyProof, clientHeight, signer),
+		types.NewMsgConnectionUpgradeConfirm(connectionID, suite.proof, clienttypes.ZeroHeight(), signer),
+		types.NewMsgConnectionUpgradeConfirm(connectionID, suite.proof, clientHeight, ""),
+		types.NewMsgConnectionUpgradeConfirm(connectionID, suite.proof, clientHeight, signer),
+	}
+
+	testCases := []struct {
+		msg     *types.MsgConnectionUpgradeConfirm
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], false, "invalid connection ID"},
+		{testMsgs[1], false, "empty proofAck"},
+		{testMsgs[2], false, "invalid proofHeight"},
+		{testMsgs[3], false, "empty signer"},
+		{testMsgs[4], true, "success"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, "Msg %d failed: %s", i, tc.errMsg)
+		} else {
+			suite.Require().Error(err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+func (suite *MsgTestSuite) TestNewMsgConnectionUpgradeCancel() {
+	counterpartyConnection := types.NewConnectionEnd(types.OPEN, clientID, types.NewCounterparty(clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("storePrefixKey"))), []*types.Version{ibctesting.ConnectionVersion}, 500)
+	invalidCounterpartyConnection := types.NewConnectionEnd(types.OPEN, "(clientID1)", types.NewCounterparty(clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("storePrefixKey"))), []*types.Version{ibctesting.ConnectionVersion}, 500)
+
+	testMsgs := []*types.MsgConnectionUpgradeCancel{
+		types.NewMsgConnectionUpgradeCancel("test/conn1", counterpartyConnection, suite.proof, clientHeight, signer),
+		types.NewMsgConnectionUpgradeCancel(connectionID, invalidCounterpartyConnection, suite.proof, clientHeight, signer),
+		types.NewMsgConnectionUpgradeCancel(connectionID, counterpartyConnection, emptyProof, clientHeight, signer),
+		types.NewMsgConnectionUpgradeCancel(connectionID, counterpartyConnection, suite.proof, clienttypes.ZeroHeight(), signer),
+		types.NewMsgConnectionUpgradeCancel(connectionID, counterpartyConnection, suite.proof, clientHeight, ""),
+		types.NewMsgConnectionUpgradeCancel(connectionID, counterpartyConnection, suite.proof, clientHeight, signer),
+	}
+
+	testCases := []struct {
+		msg     *types.MsgConnectionUpgradeCancel
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], false, "invalid connection ID"},
+		{testMsgs[1], false, "invalid counterparty connection"},
+		{testMsgs[2], false, "empty proofConnection"},
+		{testMsgs[3], false, "invalid proofHeight"},
+		{testMsgs[4], false, "empty signer"},
+		{testMsgs[5], true, "success"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, "Msg %d failed: %s", i, tc.errMsg)
+		} else {
+			suite.Require().Error(err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
+)
+
+const (
+	// ProposalTypeConnectionUpgrade defines the type for a ConnectionUpgradeProposal
+	ProposalTypeConnectionUpgrade = "ConnectionUpgrade"
+)
+
+var _ govtypes.Content = &ConnectionUpgradeProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeConnectionUpgrade)
+}
+
+// NewConnectionUpgradeProposal creates a new connection upgrade proposal.
+func NewConnectionUpgradeProposal(
+	title, description, connectionID string, version *Version, delayPeriod uint64,
+	counterpartyPrefix commitmenttypes.MerklePrefix, timeoutTimestamp uint64,
+) govtypes.Content {
+	return &ConnectionUpgradeProposal{
+		Title:              title,
+		Description:        description,
+		ConnectionId:       connectionID,
+		Version:            version,
+		DelayPeriod:        delayPeriod,
+		CounterpartyPrefix: counterpartyPrefix,
+		TimeoutTimestamp:   timeoutTimestamp,
+	}
+}
+
+// GetTitle returns the title of a connection upgrade proposal.
+func (cup *ConnectionUpgradeProposal) GetTitle() string { return cup.Title }
+
+// GetDescription returns the description of a connection upgrade proposal.
+func (cup *ConnectionUpgradeProposal) GetDescription() string { return cup.Description }
+
+// ProposalRoute returns the routing key of a connection upgrade proposal.
+func (cup *ConnectionUpgradeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a connection upgrade proposal.
+func (cup *ConnectionUpgradeProposal) ProposalType() string { return ProposalTypeConnectionUpgrade }
+
+// ValidateBasic runs basic stateless validity checks
+func (cup *ConnectionUpgradeProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(cup); err != nil {
+		return err
+	}
+
+	if !IsValidConnectionID(cup.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+
+	if err := ValidateVersion(cup.Version); err != nil {
+		return sdkerrors.Wrap(err, "invalid upgrade version")
+	}
+
+	if cup.CounterpartyPrefix.Empty() {
+		return sdkerrors.Wrap(ErrInvalidConnectionUpgrade, "counterparty prefix cannot be empty")
+	}
+
+	if cup.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidConnectionUpgrade, "upgrade timeout timestamp cannot be zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v4/modules/core/23-commitment/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+func TestConnectionUpgradeProposalValidateBasic(t *testing.T) {
+	prefix := commitmenttypes.NewMerklePrefix([]byte("ibc"))
+
+	testCases := []struct {
+		name     string
+		proposal govtypes.Content
+		expPass  bool
+	}{
+		{
+			"success",
+			types.NewConnectionUpgradeProposal(ibctesting.Title, ibctesting.Description, connectionID, ibctesting.ConnectionVersion, 500, prefix, 1000),
+			true,
+		},
+		{
+			"fails validate abstract - empty title",
+			types.NewConnectionUpgradeProposal("", ibctesting.Description, connectionID, ibctesting.ConnectionVersion, 500, prefix, 1000),
+			false,
+		},
+		{
+			"invalid connection ID",
+			types.NewConnectionUpgradeProposal(ibctesting.Title, ibctesting.Description, ibctesting.InvalidID, ibctesting.ConnectionVersion, 500, prefix, 1000),
+			false,
+		},
+		{
+			"invalid version",
+			types.NewConnectionUpgradeProposal(ibctesting.Title, ibctesting.Description, connectionID, &types.Version{}, 500, prefix, 1000),
+			false,
+		},
+		{
+			"empty counterparty prefix",
+			types.NewConnectionUpgradeProposal(ibctesting.Title, ibctesting.Description, connectionID, ibctesting.ConnectionVersion, 500, emptyPrefix, 1000),
+			false,
+		},
+		{
+			"zero timeout timestamp",
+			types.NewConnectionUpgradeProposal(ibctesting.Title, ibctesting.Description, connectionID, ibctesting.ConnectionVersion, 500, prefix, 0),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+// tests a connection upgrade proposal can be marshaled and unmarshaled
+func TestMarshalConnectionUpgradeProposal(t *testing.T) {
+	proposal := types.NewConnectionUpgradeProposal(
+		ibctesting.Title, ibctesting.Description, connectionID, ibctesting.ConnectionVersion, 500, commitmenttypes.NewMerklePrefix([]byte("ibc")), 1000,
+	)
+
+	// create codec
+	ir := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(ir)
+	govtypes.RegisterInterfaces(ir)
+	cdc := codec.NewProtoCodec(ir)
+
+	// marshal message
+	bz, err := cdc.MarshalJSON(proposal.(*types.ConnectionUpgradeProposal))
+	require.NoError(t, err)
+
+	// unmarshal proposal
+	newProposal := &types.ConnectionUpgradeProposal{}
+	err = cdc.UnmarshalJSON(bz, newProposal)
+	require.NoError(t, err)
+	require.Equal(t, proposal, newProposal)
+}
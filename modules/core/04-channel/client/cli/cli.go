@@ -29,6 +29,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryUnreceivedPackets(),
 		GetCmdQueryUnreceivedAcks(),
 		GetCmdQueryNextSequenceReceive(),
+		GetCmdParams(),
+		GetCmdQueryPrunableRange(),
+		GetCmdQueryPacket(),
 		// TODO: next sequence Send ?
 	)
 
@@ -45,7 +48,17 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	txCmd.AddCommand()
+	txCmd.AddCommand(
+		NewChannelOpenInitCmd(),
+		NewChannelOpenTryCmd(),
+		NewChannelOpenAckCmd(),
+		NewChannelOpenConfirmCmd(),
+		NewChannelCloseInitCmd(),
+		NewChannelCloseConfirmCmd(),
+		NewRelayPacketCmd(),
+		NewRelayAcknowledgementCmd(),
+		NewRelayTimeoutCmd(),
+	)
 
 	return txCmd
 }
@@ -455,3 +455,111 @@ func GetCmdQueryNextSequenceReceive() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdParams returns the command handler for ibc channel parameter querying.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current ibc channel parameters",
+		Long:    "Query the current ibc channel parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query %s %s params", version.AppName, host.ModuleName, types.SubModuleName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChannelParams(cmd.Context(), &types.QueryChannelParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPrunableRange defines the command to query the range of sequences whose
+// packet receipts and acknowledgements can be pruned for a given channel.
+func GetCmdQueryPrunableRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prunable-range [port-id] [channel-id]",
+		Short: "Query the prunable range of a channel",
+		Long:  "Query the range of sequences whose packet receipts and acknowledgements can be pruned for a given unordered channel",
+		Example: fmt.Sprintf(
+			"%s query %s %s prunable-range [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPrunableRangeRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.PrunableRange(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPacket defines the command to query a sent packet stored until it is acknowledged or timed out
+func GetCmdQueryPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet [port-id] [channel-id] [sequence]",
+		Short: "Query a sent packet",
+		Long:  "Query a sent packet stored until it is acknowledged or timed out. Sent packets are only stored if enabled by the MaxStoredPackets parameter.",
+		Example: fmt.Sprintf(
+			"%s query %s %s packet [port-id] [channel-id] [sequence]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  seq,
+			}
+
+			res, err := queryClient.Packet(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,607 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	clientutils "github.com/cosmos/ibc-go/v4/modules/core/02-client/client/utils"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	connectionutils "github.com/cosmos/ibc-go/v4/modules/core/03-connection/client/utils"
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/client/utils"
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibcclient "github.com/cosmos/ibc-go/v4/modules/core/client"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+const (
+	flagOrdered    = "ordered"
+	flagAppVersion = "app-version"
+)
+
+// NewChannelOpenInitCmd defines the command to initialize a channel on chain A with a given counterparty chain B.
+func NewChannelOpenInitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "open-init [port-id] [counterparty-port-id] [connection-hops]",
+		Short:   "Initialize a channel on chain A with a given counterparty chain B",
+		Long:    "Initialize a channel on chain A with a given counterparty chain B. The connection hops are provided as a comma separated list.",
+		Example: fmt.Sprintf("%s tx ibc %s open-init transfer transfer connection-0 --app-version ics20-1 --ordered=false --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			portID, counterpartyPortID := args[0], args[1]
+			connectionHops := strings.Split(args[2], ",")
+
+			order, err := channelOrderFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			appVersion, err := cmd.Flags().GetString(flagAppVersion)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChannelOpenInit(
+				portID, appVersion, order, connectionHops, counterpartyPortID, clientCtx.GetFromAddress().String(),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagOrdered, true, "open an ORDERED channel, an UNORDERED channel is opened otherwise")
+	cmd.Flags().String(flagAppVersion, "", "application version of the channel")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewChannelOpenTryCmd defines the command to relay a channel open attempt from chain A to a counterparty chain B.
+func NewChannelOpenTryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-try [port-id] [connection-id] [counterparty-port-id] [counterparty-channel-id]",
+		Short: "Relay a channel open attempt from the counterparty chain",
+		Long: `Relay a channel open attempt from the counterparty chain. The counterparty channel is queried with its proof from
+the counterparty node at the latest height of the client of the provided connection, which must therefore be updated
+beforehand. The channel order is taken from the counterparty channel and, unless provided, the application version
+defaults to the counterparty version.`,
+		Example: fmt.Sprintf("%s tx ibc %s open-try transfer connection-1 transfer channel-0 --counterparty-node tcp://localhost:26657 --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			portID, connectionID, counterpartyPortID, counterpartyChannelID := args[0], args[1], args[2], args[3]
+
+			counterpartyCtx, err := getCounterpartyQueryContext(cmd, clientCtx, connectionID)
+			if err != nil {
+				return err
+			}
+
+			counterpartyChannelRes, err := utils.QueryChannel(counterpartyCtx, counterpartyPortID, counterpartyChannelID, true)
+			if err != nil {
+				return err
+			}
+
+			counterpartyChannel := counterpartyChannelRes.Channel
+
+			appVersion := counterpartyChannel.Version
+			if cmd.Flags().Changed(flagAppVersion) {
+				if appVersion, err = cmd.Flags().GetString(flagAppVersion); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgChannelOpenTry(
+				portID, "", appVersion, counterpartyChannel.Ordering, []string{connectionID},
+				counterpartyPortID, counterpartyChannelID, counterpartyChannel.Version,
+				counterpartyChannelRes.Proof, counterpartyChannelRes.ProofHeight, clientCtx.GetFromAddress().String(),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAppVersion, "", "application version of the channel, defaults to the counterparty version")
+	ibcclient.AddCounterpartyFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewChannelOpenAckCmd defines the command to relay the acceptance of a channel open attempt from chain B back to
+// chain A.
+func NewChannelOpenAckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-ack [port-id] [channel-id] [counterparty-channel-id]",
+		Short: "Relay the acceptance of a channel open attempt from the counterparty chain",
+		Long: `Relay the acceptance of a channel open attempt from the counterparty chain. The counterparty channel is queried
+with its proof from the counterparty node at the latest height of the client of the channel connection, which must
+therefore be updated beforehand.`,
+		Example: fmt.Sprintf("%s tx ibc %s open-ack transfer channel-0 channel-1 --counterparty-node tcp://localhost:26657 --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			portID, channelID, counterpartyChannelID := args[0], args[1], args[2]
+
+			channelRes, err := utils.QueryChannel(clientCtx, portID, channelID, false)
+			if err != nil {
+				return err
+			}
+
+			counterpartyCtx, err := getCounterpartyQueryContext(cmd, clientCtx, channelRes.Channel.ConnectionHops[0])
+			if err != nil {
+				return err
+			}
+
+			counterpartyChannelRes, err := utils.QueryChannel(counterpartyCtx, channelRes.Channel.Counterparty.PortId, counterpartyChannelID, true)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChannelOpenAck(
+				portID, channelID, counterpartyChannelID, counterpartyChannelRes.Channel.Version,
+				counterpartyChannelRes.Proof, counterpartyChannelRes.ProofHeight, clientCtx.GetFromAddress().String(),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	ibcclient.AddCounterpartyFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewChannelOpenConfirmCmd defines the command to confirm to chain B that the channel is open on chain A.
+func NewChannelOpenConfirmCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-confirm [port-id] [channel-id]",
+		Short: "Confirm that the channel is open on the counterparty chain",
+		Long: `Confirm that the channel is open on the counterparty chain. The counterparty channel is queried with its proof
+from the counterparty node at the latest height of the client of the channel connection, which must therefore be
+updated beforehand.`,
+		Example: fmt.Sprintf("%s tx ibc %s open-confirm transfer channel-1 --counterparty-node tcp://localhost:26657 --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			portID, channelID := args[0], args[1]
+
+			counterpartyChannelRes, err := queryCounterpartyChannel(cmd, clientCtx, portID, channelID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChannelOpenConfirm(
+				portID, channelID, counterpartyChannelRes.Proof, counterpartyChannelRes.ProofHeight,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	ibcclient.AddCounterpartyFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewChannelCloseInitCmd defines the command to close a channel on chain A. The application bound to the port
+// may reject the closure.
+func NewChannelCloseInitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "close-init [port-id] [channel-id]",
+		Short:   "Close a channel on chain A",
+		Long:    "Close a channel on chain A. The closure may be rejected by the application bound to the port.",
+		Example: fmt.Sprintf("%s tx ibc %s close-init transfer channel-0 --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChannelCloseInit(args[0], args[1], clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewChannelCloseConfirmCmd defines the command to confirm to chain B that the channel is closed on chain A.
+func NewChannelCloseConfirmCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-confirm [port-id] [channel-id]",
+		Short: "Confirm that the channel is closed on the counterparty chain",
+		Long: `Confirm that the channel is closed on the counterparty chain. The counterparty channel is queried with its proof
+from the counterparty node at the latest height of the client of the channel connection, which must therefore be
+updated beforehand.`,
+		Example: fmt.Sprintf("%s tx ibc %s close-confirm transfer channel-1 --counterparty-node tcp://localhost:26657 --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			portID, channelID := args[0], args[1]
+
+			counterpartyChannelRes, err := queryCounterpartyChannel(cmd, clientCtx, portID, channelID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChannelCloseConfirm(
+				portID, channelID, counterpartyChannelRes.Proof, counterpartyChannelRes.ProofHeight,
+				clientCtx.GetFromAddress().String(),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	ibcclient.AddCounterpartyFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRelayPacketCmd defines the command to relay a packet sent on a counterparty chain to this chain.
+func NewRelayPacketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay-packet [src-chain-node] [src-port-id] [src-channel-id] [sequence]",
+		Short: "Relay a packet sent on the source chain to this chain",
+		Long: `Relay a packet sent on the source chain to this chain. The packet is queried from the source chain node if stored,
+otherwise it is reconstructed from the send packet event indexed by the node. Its commitment is queried with its proof at the latest height of the source chain.
+The client of the channel connection is updated to that height within the same transaction. Packets sent outside of a
+transaction can only be relayed if stored.`,
+		Example: fmt.Sprintf("%s tx ibc %s relay-packet tcp://localhost:26657 transfer channel-0 1 --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			srcPortID, srcChannelID := args[1], args[2]
+
+			sequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			srcCtx, err := ibcclient.NewCounterpartyQueryContext(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			packet, err := queryPacket(srcCtx, srcPortID, srcChannelID, sequence)
+			if err != nil {
+				return err
+			}
+
+			proofCtx, msgs, err := relayProofContext(clientCtx, srcCtx, packet.DestinationPort, packet.DestinationChannel)
+			if err != nil {
+				return err
+			}
+
+			commitmentRes, err := utils.QueryPacketCommitment(proofCtx, srcPortID, srcChannelID, sequence, true)
+			if err != nil {
+				return err
+			}
+
+			if !bytes.Equal(commitmentRes.Commitment, types.CommitPacket(clientCtx.Codec, packet)) {
+				return fmt.Errorf("packet reconstructed from the send packet event does not match the packet commitment")
+			}
+
+			msgs = append(msgs, types.NewMsgRecvPacket(
+				packet, commitmentRes.Proof, commitmentRes.ProofHeight, clientCtx.GetFromAddress().String(),
+			))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRelayAcknowledgementCmd defines the command to relay the acknowledgement of a packet sent on this chain from
+// the counterparty chain.
+func NewRelayAcknowledgementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay-ack [dst-chain-node] [src-port-id] [src-channel-id] [sequence]",
+		Short: "Relay the acknowledgement of a packet sent on this chain from the destination chain",
+		Long: `Relay the acknowledgement of a packet sent on this chain from the destination chain. The packet is
+queried from this chain, as described for relay-packet, and the acknowledgement is reconstructed from the write
+acknowledgement event indexed by the destination chain node. The acknowledgement is queried with its proof at the
+latest height of the destination chain and the client of the channel connection is updated to that height within the
+same transaction. Acknowledgements written outside of a transaction cannot be relayed.`,
+		Example: fmt.Sprintf("%s tx ibc %s relay-ack tcp://localhost:26657 transfer channel-0 1 --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			srcPortID, srcChannelID := args[1], args[2]
+
+			sequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			dstCtx, err := ibcclient.NewCounterpartyQueryContext(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			packet, err := queryPacket(clientCtx, srcPortID, srcChannelID, sequence)
+			if err != nil {
+				return err
+			}
+
+			acknowledgement, err := utils.QueryAcknowledgementFromWriteEvents(dstCtx, packet)
+			if err != nil {
+				return err
+			}
+
+			proofCtx, msgs, err := relayProofContext(clientCtx, dstCtx, srcPortID, srcChannelID)
+			if err != nil {
+				return err
+			}
+
+			ackRes, err := utils.QueryPacketAcknowledgement(proofCtx, packet.DestinationPort, packet.DestinationChannel, sequence, true)
+			if err != nil {
+				return err
+			}
+
+			msgs = append(msgs, types.NewMsgAcknowledgement(
+				packet, acknowledgement, ackRes.Proof, ackRes.ProofHeight, clientCtx.GetFromAddress().String(),
+			))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRelayTimeoutCmd defines the command to relay the timeout of a packet sent on this chain which was not received
+// on the counterparty chain.
+func NewRelayTimeoutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relay-timeout [dst-chain-node] [src-port-id] [src-channel-id] [sequence]",
+		Short: "Relay the timeout of a packet sent on this chain which was not received on the destination chain",
+		Long: `Relay the timeout of a packet sent on this chain which was not received on the destination chain. The packet
+is queried from this chain, as described for relay-packet. The absence of the packet receipt, or the next
+sequence receive for ORDERED channels, is queried with its proof at the latest height of the destination chain and the
+client of the channel connection is updated to that height within the same transaction. The timeout height or
+timestamp of the packet must have elapsed on the destination chain at that height.`,
+		Example: fmt.Sprintf("%s tx ibc %s relay-timeout tcp://localhost:26657 transfer channel-0 1 --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			srcPortID, srcChannelID := args[1], args[2]
+
+			sequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			dstCtx, err := ibcclient.NewCounterpartyQueryContext(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			packet, err := queryPacket(clientCtx, srcPortID, srcChannelID, sequence)
+			if err != nil {
+				return err
+			}
+
+			channelRes, err := utils.QueryChannel(clientCtx, srcPortID, srcChannelID, false)
+			if err != nil {
+				return err
+			}
+
+			proofCtx, msgs, err := relayProofContext(clientCtx, dstCtx, srcPortID, srcChannelID)
+			if err != nil {
+				return err
+			}
+
+			ordered := channelRes.Channel.Ordering == types.ORDERED
+
+			nextSequenceRecvRes, err := utils.QueryNextSequenceReceive(proofCtx, packet.DestinationPort, packet.DestinationChannel, ordered)
+			if err != nil {
+				return err
+			}
+
+			proofUnreceived, proofHeight := nextSequenceRecvRes.Proof, nextSequenceRecvRes.ProofHeight
+			if !ordered {
+				receiptRes, err := utils.QueryPacketReceipt(proofCtx, packet.DestinationPort, packet.DestinationChannel, sequence, true)
+				if err != nil {
+					return err
+				}
+
+				if receiptRes.Received {
+					return sdkerrors.Wrapf(types.ErrPacketReceived, "packet with sequence %d was received on the destination chain", sequence)
+				}
+
+				proofUnreceived, proofHeight = receiptRes.Proof, receiptRes.ProofHeight
+			}
+
+			msgs = append(msgs, types.NewMsgTimeout(
+				packet, nextSequenceRecvRes.NextSequenceReceive, proofUnreceived, proofHeight,
+				clientCtx.GetFromAddress().String(),
+			))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// queryPacket returns the sent packet with the provided source port, source channel and sequence. The packet stored
+// by the chain is returned if available, otherwise the packet is reconstructed from its send packet event.
+func queryPacket(clientCtx client.Context, portID, channelID string, sequence uint64) (types.Packet, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	res, err := queryClient.Packet(context.Background(), &types.QueryPacketRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  sequence,
+	})
+	if err == nil {
+		return res.Packet, nil
+	}
+
+	return utils.QueryPacketFromSendEvents(clientCtx, portID, channelID, sequence)
+}
+
+// channelOrderFromFlags returns the channel order selected by the ordered flag
+func channelOrderFromFlags(cmd *cobra.Command) (types.Order, error) {
+	ordered, err := cmd.Flags().GetBool(flagOrdered)
+	if err != nil {
+		return types.NONE, err
+	}
+
+	if ordered {
+		return types.ORDERED, nil
+	}
+
+	return types.UNORDERED, nil
+}
+
+// getCounterpartyQueryContext returns a client context querying the counterparty node at the latest height of the
+// client of the connection with the provided identifier
+func getCounterpartyQueryContext(cmd *cobra.Command, clientCtx client.Context, connectionID string) (client.Context, error) {
+	connectionRes, err := connectionutils.QueryConnection(clientCtx, connectionID, false)
+	if err != nil {
+		return client.Context{}, err
+	}
+
+	proofHeight, err := clientutils.QueryClientLatestHeight(clientCtx, connectionRes.Connection.ClientId)
+	if err != nil {
+		return client.Context{}, err
+	}
+
+	return ibcclient.GetCounterpartyQueryContext(cmd, clientCtx, proofHeight)
+}
+
+// queryCounterpartyChannel queries the counterparty of the channel with the provided port and channel identifiers,
+// along with its proof, from the counterparty node
+func queryCounterpartyChannel(cmd *cobra.Command, clientCtx client.Context, portID, channelID string) (*types.QueryChannelResponse, error) {
+	channelRes, err := utils.QueryChannel(clientCtx, portID, channelID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	counterpartyCtx, err := getCounterpartyQueryContext(cmd, clientCtx, channelRes.Channel.ConnectionHops[0])
+	if err != nil {
+		return nil, err
+	}
+
+	counterparty := channelRes.Channel.Counterparty
+	return utils.QueryChannel(counterpartyCtx, counterparty.PortId, counterparty.ChannelId, true)
+}
+
+// relayProofContext returns a client context querying the provided counterparty context at the latest height of the
+// counterparty chain, along with the message updating the client of the connection of the provided channel to that
+// height. No message is returned if the client is already at or beyond the latest counterparty height, in which case
+// proofs are queried at the latest client height. Only Tendermint clients are supported.
+func relayProofContext(clientCtx, counterpartyCtx client.Context, portID, channelID string) (client.Context, []sdk.Msg, error) {
+	channelRes, err := utils.QueryChannel(clientCtx, portID, channelID, false)
+	if err != nil {
+		return client.Context{}, nil, err
+	}
+
+	connectionRes, err := connectionutils.QueryConnection(clientCtx, channelRes.Channel.ConnectionHops[0], false)
+	if err != nil {
+		return client.Context{}, nil, err
+	}
+
+	clientID := connectionRes.Connection.ClientId
+
+	clientStateRes, err := clientutils.QueryClientState(clientCtx, clientID, false)
+	if err != nil {
+		return client.Context{}, nil, err
+	}
+
+	clientState, err := clienttypes.UnpackClientState(clientStateRes.ClientState)
+	if err != nil {
+		return client.Context{}, nil, err
+	}
+
+	if clientState.ClientType() != exported.Tendermint {
+		return client.Context{}, nil, sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "expected client type %s, got %s", exported.Tendermint, clientState.ClientType())
+	}
+
+	trustedHeight, ok := clientState.GetLatestHeight().(clienttypes.Height)
+	if !ok {
+		return client.Context{}, nil, sdkerrors.Wrapf(clienttypes.ErrInvalidHeight, "invalid height type %T", clientState.GetLatestHeight())
+	}
+
+	header, err := clientutils.QueryTendermintUpdateHeader(counterpartyCtx, trustedHeight)
+	if err != nil {
+		return client.Context{}, nil, err
+	}
+
+	if header.GetHeight().LTE(trustedHeight) {
+		proofCtx, err := ibcclient.WithCounterpartyProofHeight(counterpartyCtx, trustedHeight)
+		return proofCtx, nil, err
+	}
+
+	msg, err := clienttypes.NewMsgUpdateClient(clientID, &header, clientCtx.GetFromAddress().String())
+	if err != nil {
+		return client.Context{}, nil, err
+	}
+
+	proofCtx, err := ibcclient.WithCounterpartyProofHeight(counterpartyCtx, header.GetHeight())
+	if err != nil {
+		return client.Context{}, nil, err
+	}
+
+	return proofCtx, []sdk.Msg{msg}, nil
+}
@@ -0,0 +1,137 @@
+package utils
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// maxEventSearchResults defines the maximum number of transactions returned by a packet event search
+const maxEventSearchResults = 100
+
+// QueryPacketFromSendEvents searches the transactions indexed by the node for the send packet event of the
+// packet with the provided source port, source channel and sequence, and returns the packet reconstructed from
+// the event attributes. Packets sent outside of a transaction, e.g. in the begin or end blocker, cannot be found.
+func QueryPacketFromSendEvents(clientCtx client.Context, portID, channelID string, sequence uint64) (types.Packet, error) {
+	conditions := map[string]string{
+		types.AttributeKeySrcPort:    portID,
+		types.AttributeKeySrcChannel: channelID,
+		types.AttributeKeySequence:   strconv.FormatUint(sequence, 10),
+	}
+
+	attributes, err := searchPacketEvent(clientCtx, types.EventTypeSendPacket, conditions)
+	if err != nil {
+		return types.Packet{}, sdkerrors.Wrapf(err, "portID (%s), channelID (%s), sequence (%d)", portID, channelID, sequence)
+	}
+
+	data, err := hex.DecodeString(attributes[types.AttributeKeyDataHex])
+	if err != nil {
+		return types.Packet{}, err
+	}
+
+	timeoutHeight, err := clienttypes.ParseHeight(attributes[types.AttributeKeyTimeoutHeight])
+	if err != nil {
+		return types.Packet{}, err
+	}
+
+	timeoutTimestamp, err := strconv.ParseUint(attributes[types.AttributeKeyTimeoutTimestamp], 10, 64)
+	if err != nil {
+		return types.Packet{}, err
+	}
+
+	return types.NewPacket(
+		data, sequence, portID, channelID,
+		attributes[types.AttributeKeyDstPort], attributes[types.AttributeKeyDstChannel],
+		timeoutHeight, timeoutTimestamp,
+	), nil
+}
+
+// QueryAcknowledgementFromWriteEvents searches the transactions indexed by the node for the write acknowledgement
+// event of the provided packet and returns the acknowledgement bytes. Acknowledgements written asynchronously
+// outside of a transaction cannot be found.
+func QueryAcknowledgementFromWriteEvents(clientCtx client.Context, packet types.Packet) ([]byte, error) {
+	conditions := map[string]string{
+		types.AttributeKeyDstPort:    packet.DestinationPort,
+		types.AttributeKeyDstChannel: packet.DestinationChannel,
+		types.AttributeKeySequence:   strconv.FormatUint(packet.Sequence, 10),
+	}
+
+	attributes, err := searchPacketEvent(clientCtx, types.EventTypeWriteAck, conditions)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "portID (%s), channelID (%s), sequence (%d)", packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	}
+
+	return hex.DecodeString(attributes[types.AttributeKeyAckHex])
+}
+
+// searchPacketEvent searches the transactions indexed by the node for an event of the provided type whose
+// attributes match all the provided conditions, and returns the attributes of the first matching event.
+// The transaction results are not decoded, such that events of any chain can be searched.
+func searchPacketEvent(clientCtx client.Context, eventType string, conditions map[string]string) (map[string]string, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	queryConditions := make([]string, 0, len(conditions))
+	for key, value := range conditions {
+		queryConditions = append(queryConditions, fmt.Sprintf("%s.%s='%s'", eventType, key, value))
+	}
+
+	sort.Strings(queryConditions)
+	query := strings.Join(queryConditions, " AND ")
+
+	page, limit := 1, maxEventSearchResults
+
+	res, err := node.TxSearch(context.Background(), query, false, &page, &limit, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// the conditions of a search may be matched by distinct events of the same transaction
+	for _, tx := range res.Txs {
+		for _, event := range tx.TxResult.Events {
+			if event.Type != eventType {
+				continue
+			}
+
+			attributes := eventAttributes(event)
+			if matchesConditions(attributes, conditions) {
+				return attributes, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("%s event not found", eventType)
+}
+
+// eventAttributes returns the attributes of the provided event keyed by attribute key
+func eventAttributes(event abci.Event) map[string]string {
+	attributes := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attributes[string(attr.Key)] = string(attr.Value)
+	}
+
+	return attributes
+}
+
+// matchesConditions returns true if the provided attributes contain all the provided conditions
+func matchesConditions(attributes, conditions map[string]string) bool {
+	for key, value := range conditions {
+		if attributes[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -13,6 +13,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, channel := range gs.Channels {
 		ch := types.NewChannel(channel.State, channel.Ordering, channel.Counterparty, channel.ConnectionHops, channel.Version)
 		k.SetChannel(ctx, channel.PortId, channel.ChannelId, ch)
+		k.SetConnectionChannel(ctx, channel.ConnectionHops[0], channel.PortId, channel.ChannelId)
 	}
 	for _, ack := range gs.Acknowledgements {
 		k.SetPacketAcknowledgement(ctx, ack.PortId, ack.ChannelId, ack.Sequence, ack.Data)
@@ -32,19 +33,34 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, as := range gs.AckSequences {
 		k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
 	}
+	for _, rss := range gs.RecvStartSequences {
+		k.SetRecvStartSequence(ctx, rss.PortId, rss.ChannelId, rss.Sequence)
+	}
+	for _, pss := range gs.PruningSequenceStarts {
+		k.SetPruningSequenceStart(ctx, pss.PortId, pss.ChannelId, pss.Sequence)
+	}
+	for _, packet := range gs.StoredPackets {
+		k.SetStoredPacket(ctx, packet)
+		k.SetStoredPacketCount(ctx, packet.SourcePort, packet.SourceChannel, k.GetStoredPacketCount(ctx, packet.SourcePort, packet.SourceChannel)+1)
+	}
 	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
+	k.SetParams(ctx, gs.Params)
 }
 
 // ExportGenesis returns the ibc channel submodule's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	return types.GenesisState{
-		Channels:            k.GetAllChannels(ctx),
-		Acknowledgements:    k.GetAllPacketAcks(ctx),
-		Commitments:         k.GetAllPacketCommitments(ctx),
-		Receipts:            k.GetAllPacketReceipts(ctx),
-		SendSequences:       k.GetAllPacketSendSeqs(ctx),
-		RecvSequences:       k.GetAllPacketRecvSeqs(ctx),
-		AckSequences:        k.GetAllPacketAckSeqs(ctx),
-		NextChannelSequence: k.GetNextChannelSequence(ctx),
+		Channels:              k.GetAllChannels(ctx),
+		Acknowledgements:      k.GetAllPacketAcks(ctx),
+		Commitments:           k.GetAllPacketCommitments(ctx),
+		Receipts:              k.GetAllPacketReceipts(ctx),
+		SendSequences:         k.GetAllPacketSendSeqs(ctx),
+		RecvSequences:         k.GetAllPacketRecvSeqs(ctx),
+		AckSequences:          k.GetAllPacketAckSeqs(ctx),
+		NextChannelSequence:   k.GetNextChannelSequence(ctx),
+		Params:                k.GetParams(ctx),
+		RecvStartSequences:    k.GetAllRecvStartSeqs(ctx),
+		PruningSequenceStarts: k.GetAllPruningSequenceStarts(ctx),
+		StoredPackets:         k.GetAllStoredPackets(ctx),
 	}
 }
@@ -267,3 +267,21 @@ func EmitChannelClosedEvent(ctx sdk.Context, packet exported.PacketI, channel ty
 		),
 	})
 }
+
+// EmitPruneAcknowledgementsEvent emits an event marking that the packet receipts and
+// acknowledgements of a channel have been pruned.
+func EmitPruneAcknowledgementsEvent(ctx sdk.Context, portID, channelID string, pruningSequenceStart, recvStartSequence uint64) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePruneAcknowledgements,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyPruningSequenceStart, fmt.Sprintf("%d", pruningSequenceStart)),
+			sdk.NewAttribute(types.AttributeKeyRecvStartSequence, fmt.Sprintf("%d", recvStartSequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
@@ -491,6 +491,76 @@ func (q Keeper) NextSequenceReceive(c context.Context, req *types.QueryNextSeque
 	return types.NewQueryNextSequenceReceiveResponse(sequence, nil, selfHeight), nil
 }
 
+// ChannelParams implements the Query/ChannelParams gRPC method
+func (q Keeper) ChannelParams(c context.Context, _ *types.QueryChannelParamsRequest) (*types.QueryChannelParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.GetParams(ctx)
+
+	return &types.QueryChannelParamsResponse{
+		Params: &params,
+	}, nil
+}
+
+// PrunableRange implements the Query/PrunableRange gRPC method
+func (q Keeper) PrunableRange(c context.Context, req *types.QueryPrunableRangeRequest) (*types.QueryPrunableRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := q.GetChannel(ctx, req.PortId, req.ChannelId); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	pruningSequenceStart, found := q.GetPruningSequenceStart(ctx, req.PortId, req.ChannelId)
+	if !found {
+		pruningSequenceStart = 1
+	}
+
+	recvStartSequence, found := q.GetRecvStartSequence(ctx, req.PortId, req.ChannelId)
+	if !found {
+		recvStartSequence = 1
+	}
+
+	return &types.QueryPrunableRangeResponse{
+		PruningSequenceStart: pruningSequenceStart,
+		PruningSequenceEnd:   recvStartSequence,
+	}, nil
+}
+
+// Packet implements the Query/Packet gRPC method
+func (q Keeper) Packet(c context.Context, req *types.QueryPacketRequest) (*types.QueryPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	packet, found := q.GetStoredPacket(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrPacketNotFound, "port-id: %s, channel-id %s, sequence %d", req.PortId, req.ChannelId, req.Sequence).Error(),
+		)
+	}
+
+	return &types.QueryPacketResponse{Packet: packet}, nil
+}
+
 func validategRPCRequest(portID, channelID string) error {
 	if err := host.PortIdentifierValidator(portID); err != nil {
 		return status.Error(codes.InvalidArgument, err.Error())
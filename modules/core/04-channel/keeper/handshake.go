@@ -50,6 +50,15 @@ func (k Keeper) ChanOpenInit(
 		)
 	}
 
+	// the channel ordering must remain supported once a connection upgrade in progress is applied
+	if connectionEnd.Upgrade != nil && !connectiontypes.VerifySupportedFeature(connectionEnd.Upgrade.Version, order.String()) {
+		return "", nil, sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidVersion,
+			"connection upgrade version %s does not support channel ordering: %s",
+			connectionEnd.Upgrade.Version, order.String(),
+		)
+	}
+
 	if !k.portKeeper.Authenticate(ctx, portCap, portID) {
 		return "", nil, sdkerrors.Wrapf(porttypes.ErrInvalidPort, "caller does not own port capability for port ID %s", portID)
 	}
@@ -78,6 +87,7 @@ func (k Keeper) WriteOpenInitChannel(
 ) {
 	channel := types.NewChannel(types.INIT, order, counterparty, connectionHops, version)
 	k.SetChannel(ctx, portID, channelID, channel)
+	k.SetConnectionChannel(ctx, connectionHops[0], portID, channelID)
 
 	k.SetNextSequenceSend(ctx, portID, channelID, 1)
 	k.SetNextSequenceRecv(ctx, portID, channelID, 1)
@@ -177,6 +187,15 @@ func (k Keeper) ChanOpenTry(
 		)
 	}
 
+	// the channel ordering must remain supported once a connection upgrade in progress is applied
+	if connectionEnd.Upgrade != nil && !connectiontypes.VerifySupportedFeature(connectionEnd.Upgrade.Version, order.String()) {
+		return "", nil, sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidVersion,
+			"connection upgrade version %s does not support channel ordering: %s",
+			connectionEnd.Upgrade.Version, order.String(),
+		)
+	}
+
 	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
 
 	// expectedCounterpaty is the counterparty of the counterparty's channel end
@@ -235,6 +254,7 @@ func (k Keeper) WriteOpenTryChannel(
 		k.SetNextSequenceSend(ctx, portID, channelID, 1)
 		k.SetNextSequenceRecv(ctx, portID, channelID, 1)
 		k.SetNextSequenceAck(ctx, portID, channelID, 1)
+		k.SetConnectionChannel(ctx, connectionHops[0], portID, channelID)
 	}
 
 	channel := types.NewChannel(types.TRYOPEN, order, counterparty, connectionHops, version)
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
@@ -85,6 +86,22 @@ func (suite *KeeperTestSuite) TestChanOpenInit() {
 			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
 			portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
 		}, true},
+		{"connection upgrade does not support ORDERED channels", func() {
+			suite.coordinator.SetupConnections(path)
+
+			// propose a connection upgrade on connA to a version which only supports UNORDERED channels
+			version := connectiontypes.NewVersion("1", []string{"ORDER_UNORDERED"})
+			err := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.ConnUpgradeInit(
+				suite.chainA.GetContext(), path.EndpointA.ConnectionID, version, 0, suite.chainB.GetPrefix(),
+				uint64(suite.coordinator.CurrentTime.Add(time.Hour).UnixNano()),
+			)
+			suite.Require().NoError(err)
+
+			// NOTE: Opening UNORDERED channels is still expected to pass but ORDERED channels should fail
+			features = []string{"ORDER_UNORDERED"}
+			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+			portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
+		}, true},
 	}
 
 	for _, tc := range testCases {
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"math/bits"
 	"strconv"
 	"time"
 
@@ -28,6 +29,13 @@ func (k Keeper) SendPacket(
 		return sdkerrors.Wrap(err, "packet failed basic validation")
 	}
 
+	if maxPacketDataSize := k.GetMaxPacketDataSize(ctx); uint64(len(packet.GetData())) > maxPacketDataSize {
+		return sdkerrors.Wrapf(
+			types.ErrPacketDataTooLarge,
+			"packet data size exceeds the maximum packet data size (%d > %d)", len(packet.GetData()), maxPacketDataSize,
+		)
+	}
+
 	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	if !found {
 		return sdkerrors.Wrap(types.ErrChannelNotFound, packet.GetSourceChannel())
@@ -69,8 +77,7 @@ func (k Keeper) SendPacket(
 	}
 
 	// prevent accidental sends with clients that cannot be updated
-	clientStore := k.clientKeeper.ClientStore(ctx, connectionEnd.GetClientID())
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+	if status := k.clientKeeper.GetClientStatus(ctx, clientState, connectionEnd.GetClientID()); status != exported.Active {
 		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "cannot send packet using client (%s) with status %s", connectionEnd.GetClientID(), status)
 	}
 
@@ -125,6 +132,7 @@ func (k Keeper) SendPacket(
 	nextSequenceSend++
 	k.SetNextSequenceSend(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), nextSequenceSend)
 	k.SetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), commitment)
+	k.storeSentPacket(ctx, packet)
 
 	EmitSendPacketEvent(ctx, packet, channel, timeoutHeight)
 
@@ -149,6 +157,13 @@ func (k Keeper) RecvPacket(
 	proof []byte,
 	proofHeight exported.Height,
 ) error {
+	// consume gas proportional to the size of the packet data before any proof verification is performed
+	overflow, recvPacketGas := bits.Mul64(k.GetRecvPacketGasPerByte(ctx), uint64(len(packet.GetData())))
+	if overflow != 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidPacket, "gas consumed for packet data of %d bytes overflows", len(packet.GetData()))
+	}
+	ctx.GasMeter().ConsumeGas(recvPacketGas, "recv packet data")
+
 	channel, found := k.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
 	if !found {
 		return sdkerrors.Wrap(types.ErrChannelNotFound, packet.GetDestChannel())
@@ -233,7 +248,10 @@ func (k Keeper) RecvPacket(
 	case types.UNORDERED:
 		// check if the packet receipt has been received already for unordered channels
 		_, found := k.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
-		if found {
+		// packets below the recv start sequence have already been acknowledged or timed out on
+		// the counterparty and their packet receipts may have been pruned
+		recvStartSequence, hasRecvStart := k.GetRecvStartSequence(ctx, packet.GetDestPort(), packet.GetDestChannel())
+		if found || (hasRecvStart && packet.GetSequence() < recvStartSequence) {
 			EmitRecvPacketEvent(ctx, packet, channel)
 			// This error indicates that the packet has already been relayed. Core IBC will
 			// treat this error as a no-op in order to prevent an entire relay transaction
@@ -491,6 +509,11 @@ func (k Keeper) AcknowledgePacket(
 
 	// Delete packet commitment, since the packet has been acknowledged, the commitement is no longer necessary
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.deleteStoredPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if channel.Ordering == types.UNORDERED {
+		k.advanceNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), maxNextSequenceAckAdvance)
+	}
 
 	// log that a packet has been acknowledged
 	k.Logger(ctx).Info(
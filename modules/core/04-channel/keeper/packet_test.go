@@ -3,7 +3,9 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
@@ -96,6 +98,18 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 			packet = types.NewPacket([]byte{}, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
 			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 		}, false},
+		{"packet data exceeds the maximum packet data size param", func() {
+			suite.coordinator.Setup(path)
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(uint64(len(ibctesting.MockPacketData))-1, 0, 0))
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, false},
+		{"success: packet data equals the maximum packet data size param", func() {
+			suite.coordinator.Setup(path)
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(uint64(len(ibctesting.MockPacketData)), 0, 0))
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, true},
 		{"channel not found", func() {
 			// use wrong channel naming
 			suite.coordinator.Setup(path)
@@ -435,6 +449,16 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1)
 			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
 		}, false},
+		{"packet below recv start sequence", func() {
+			expError = types.ErrNoOpMsg
+			suite.coordinator.Setup(path)
+
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			path.EndpointA.SendPacket(packet)
+			// the packet receipt has been pruned
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetRecvStartSequence(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 2)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
 		{"validation failed", func() {
 			// skip error code check, downstream error code is used from light-client implementations
 
@@ -488,6 +512,42 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 	}
 }
 
+// TestRecvPacketGasPerByte tests that RecvPacket consumes gas proportional to the size of the packet data.
+func (suite *KeeperTestSuite) TestRecvPacketGasPerByte() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	err := path.EndpointA.SendPacket(packet)
+	suite.Require().NoError(err)
+
+	channelCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+
+	recvPacketGas := func(gasPerByte uint64) uint64 {
+		ctx, _ := suite.chainB.GetContext().CacheContext()
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(ctx, types.NewParams(types.DefaultMaxPacketDataSize, gasPerByte, types.DefaultMaxStoredPackets))
+
+		gasBefore := ctx.GasMeter().GasConsumed()
+		err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.RecvPacket(ctx, channelCap, packet, proof, proofHeight)
+		suite.Require().NoError(err)
+
+		return ctx.GasMeter().GasConsumed() - gasBefore
+	}
+
+	// the gas per byte values are encoded with the same length to consume the same amount of gas for reading the params
+	suite.Require().Equal(recvPacketGas(10)+10*uint64(len(packet.GetData())), recvPacketGas(20))
+
+	// a gas per byte value bypassing the parameter validation must not overflow the gas consumed
+	ctx, _ := suite.chainB.GetContext().CacheContext()
+	suite.chainB.GetSimApp().GetSubspace(host.ModuleName).Set(ctx, types.KeyRecvPacketGasPerByte, uint64(math.MaxUint64))
+
+	err = suite.chainB.App.GetIBCKeeper().ChannelKeeper.RecvPacket(ctx, channelCap, packet, proof, proofHeight)
+	suite.Require().ErrorIs(err, types.ErrInvalidPacket)
+}
+
 func (suite *KeeperTestSuite) TestWriteAcknowledgement() {
 	var (
 		path       *ibctesting.Path
@@ -846,18 +906,15 @@ func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.AcknowledgePacket(suite.chainA.GetContext(), channelCap, packet, ack.Acknowledgement(), proof, proofHeight)
 			pc := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
-			channelA, _ := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel())
 			sequenceAck, _ := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel())
 
 			if tc.expPass {
 				suite.NoError(err)
 				suite.Nil(pc)
 
-				if channelA.Ordering == types.ORDERED {
-					suite.Require().Equal(packet.GetSequence()+1, sequenceAck, "sequence not incremented in ordered channel")
-				} else {
-					suite.Require().Equal(uint64(1), sequenceAck, "sequence incremented for UNORDERED channel")
-				}
+				// the next sequence ack is incremented on ORDERED channels and advanced over
+				// the acknowledged packets on UNORDERED channels
+				suite.Require().Equal(packet.GetSequence()+1, sequenceAck, "next sequence ack not incremented")
 			} else {
 				suite.Error(err)
 				// only check if expError is set, since not all error codes can be known
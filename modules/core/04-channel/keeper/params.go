@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+// GetMaxPacketDataSize retrieves the maximum size of the data of packets sent from the paramstore
+func (k Keeper) GetMaxPacketDataSize(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyMaxPacketDataSize, &res)
+	return res
+}
+
+// GetRecvPacketGasPerByte retrieves the gas consumed per byte of packet data received from the paramstore
+func (k Keeper) GetRecvPacketGasPerByte(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyRecvPacketGasPerByte, &res)
+	return res
+}
+
+// GetMaxStoredPackets retrieves the maximum number of sent packets stored per channel from the paramstore
+func (k Keeper) GetMaxStoredPackets(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.Get(ctx, types.KeyMaxStoredPackets, &res)
+	return res
+}
+
+// GetParams returns the total set of ibc-channel parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.GetMaxPacketDataSize(ctx), k.GetRecvPacketGasPerByte(ctx), k.GetMaxStoredPackets(ctx))
+}
+
+// SetParams sets the total set of ibc-channel parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
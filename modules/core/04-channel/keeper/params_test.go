@@ -0,0 +1,19 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+func (suite *KeeperTestSuite) TestParams() {
+	expParams := types.DefaultParams()
+
+	params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(expParams, params)
+
+	expParams.MaxPacketDataSize = 1024
+	expParams.RecvPacketGasPerByte = 10
+	expParams.MaxStoredPackets = 100
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), expParams)
+	params = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(expParams, params)
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	connectiontypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+// maxNextSequenceAckAdvance is the maximum number of sequences the next sequence
+// acknowledgement of an unordered channel is advanced by when a packet is acknowledged
+// or timed out. It bounds the gas consumed by packet relaying, the remaining sequences
+// are advanced over by subsequent packets or by MsgPruneAcknowledgements.
+const maxNextSequenceAckAdvance = 10
+
+// advanceNextSequenceAck advances the next sequence acknowledgement of an unordered
+// channel over all the sent packets whose packet commitments have been deleted, up to
+// a maximum of limit sequences. On unordered channels the next sequence acknowledgement
+// thus defines the sequence below which all packets have been acknowledged or timed out.
+func (k Keeper) advanceNextSequenceAck(ctx sdk.Context, portID, channelID string, limit uint64) {
+	nextSequenceAck, found := k.GetNextSequenceAck(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	nextSequenceSend, found := k.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	sequence := nextSequenceAck
+	for i := uint64(0); i < limit && sequence < nextSequenceSend; i++ {
+		if k.HasPacketCommitment(ctx, portID, channelID, sequence) {
+			break
+		}
+
+		sequence++
+	}
+
+	if sequence != nextSequenceAck {
+		k.SetNextSequenceAck(ctx, portID, channelID, sequence)
+	}
+}
+
+// PruneAcknowledgements prunes up to limit packet receipts and acknowledgements of an
+// unordered channel. Packet receipts and acknowledgements can only be pruned for the
+// packets with a sequence lower than the recv start sequence, which is updated to the
+// counterparty next sequence acknowledgement if a valid proof of a greater sequence is
+// provided. All packets below the counterparty next sequence acknowledgement have been
+// acknowledged or timed out on the counterparty and can no longer be received. The
+// channel's own next sequence acknowledgement is advanced by up to limit sequences to
+// allow the counterparty to prune its packet receipts and acknowledgements.
+//
+// The number of pruned sequences and the number of sequences which remain to be pruned
+// below the recv start sequence are returned.
+func (k Keeper) PruneAcknowledgements(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+	proof []byte,
+	proofHeight exported.Height,
+	limit uint64,
+) (uint64, uint64, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, 0, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.Ordering != types.UNORDERED {
+		return 0, 0, sdkerrors.Wrapf(
+			types.ErrInvalidChannelOrdering,
+			"packet receipts and acknowledgements can only be pruned on unordered channels, got %s", channel.Ordering,
+		)
+	}
+
+	k.advanceNextSequenceAck(ctx, portID, channelID, limit)
+
+	recvStartSequence, found := k.GetRecvStartSequence(ctx, portID, channelID)
+	if !found {
+		recvStartSequence = 1
+	}
+
+	if nextSequenceAck > recvStartSequence {
+		connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+		if !found {
+			return 0, 0, sdkerrors.Wrap(
+				connectiontypes.ErrConnectionNotFound,
+				channel.ConnectionHops[0],
+			)
+		}
+
+		if err := k.connectionKeeper.VerifyNextSequenceAck(
+			ctx, connectionEnd, proofHeight, proof,
+			channel.Counterparty.PortId, channel.Counterparty.ChannelId, nextSequenceAck,
+		); err != nil {
+			return 0, 0, sdkerrors.Wrap(err, "couldn't verify counterparty next sequence acknowledgement")
+		}
+
+		recvStartSequence = nextSequenceAck
+		k.SetRecvStartSequence(ctx, portID, channelID, recvStartSequence)
+	}
+
+	pruningSequenceStart, found := k.GetPruningSequenceStart(ctx, portID, channelID)
+	if !found {
+		pruningSequenceStart = 1
+	}
+
+	pruningSequenceEnd := recvStartSequence
+	if pruningSequenceEnd > pruningSequenceStart && pruningSequenceEnd-pruningSequenceStart > limit {
+		pruningSequenceEnd = pruningSequenceStart + limit
+	}
+
+	var totalPruned uint64
+	for sequence := pruningSequenceStart; sequence < pruningSequenceEnd; sequence++ {
+		k.deletePacketReceipt(ctx, portID, channelID, sequence)
+		k.deletePacketAcknowledgement(ctx, portID, channelID, sequence)
+		totalPruned++
+	}
+
+	if totalPruned > 0 {
+		k.SetPruningSequenceStart(ctx, portID, channelID, pruningSequenceEnd)
+		pruningSequenceStart = pruningSequenceEnd
+	}
+
+	var totalRemaining uint64
+	if recvStartSequence > pruningSequenceStart {
+		totalRemaining = recvStartSequence - pruningSequenceStart
+	}
+
+	k.Logger(ctx).Info(
+		"packet receipts and acknowledgements pruned",
+		"port_id", portID,
+		"channel_id", channelID,
+		"total_pruned", totalPruned,
+		"total_remaining", totalRemaining,
+	)
+
+	EmitPruneAcknowledgementsEvent(ctx, portID, channelID, pruningSequenceStart, recvStartSequence)
+
+	return totalPruned, totalRemaining, nil
+}
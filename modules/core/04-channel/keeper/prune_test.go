@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+// TestPruneAcknowledgements tests the pruning of the packet receipts and acknowledgements
+// on chainA of the packets sent by chainB, which have all been acknowledged on chainB.
+func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
+	var (
+		path            *ibctesting.Path
+		portID          string
+		channelID       string
+		nextSequenceAck uint64
+		proof           []byte
+		proofHeight     exported.Height
+		limit           uint64
+	)
+
+	const numPackets = 5
+
+	testCases := []struct {
+		msg          string
+		malleate     func()
+		expPass      bool
+		expPruned    uint64
+		expRemaining uint64
+	}{
+		{"success", func() {}, true, 5, 0},
+		{"success: limit lower than prunable sequences", func() {
+			limit = 2
+		}, true, 2, 3},
+		{"success: recv start sequence already updated", func() {
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetRecvStartSequence(suite.chainA.GetContext(), portID, channelID, numPackets+1)
+
+			// a stale next sequence ack is not verified
+			nextSequenceAck = 3
+			proof = nil
+		}, true, 5, 0},
+		{"success: packet receipts and acknowledgements already pruned", func() {
+			_, _, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PruneAcknowledgements(suite.chainA.GetContext(), portID, channelID, nextSequenceAck, proof, proofHeight, limit)
+			suite.Require().NoError(err)
+		}, true, 0, 0},
+		{"channel not found", func() {
+			channelID = ibctesting.InvalidID
+		}, false, 0, 0},
+		{"channel is ORDERED", func() {
+			channel := path.EndpointA.GetChannel()
+			channel.Ordering = types.ORDERED
+			path.EndpointA.SetChannel(channel)
+		}, false, 0, 0},
+		{"invalid proof", func() {
+			proof = []byte("invalid proof")
+		}, false, 0, 0},
+		{"next sequence ack does not match the counterparty", func() {
+			nextSequenceAck = numPackets + 2
+		}, false, 0, 0},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s, %d/%d tests", tc.msg, i, len(testCases)), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			for sequence := uint64(1); sequence <= numPackets; sequence++ {
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				err := path.EndpointB.SendPacket(packet)
+				suite.Require().NoError(err)
+
+				err = path.RelayPacket(packet)
+				suite.Require().NoError(err)
+			}
+
+			err := path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			portID, channelID = path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+			nextSequenceAck = numPackets + 1
+			proof, proofHeight = suite.chainB.QueryProof(host.NextSequenceAckKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+			limit = 10
+
+			tc.malleate()
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			ctx := suite.chainA.GetContext()
+
+			pruned, remaining, err := channelKeeper.PruneAcknowledgements(ctx, portID, channelID, nextSequenceAck, proof, proofHeight, limit)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPruned, pruned)
+				suite.Require().Equal(tc.expRemaining, remaining)
+
+				recvStartSequence, found := channelKeeper.GetRecvStartSequence(ctx, portID, channelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(numPackets+1), recvStartSequence)
+
+				pruningSequenceStart, found := channelKeeper.GetPruningSequenceStart(ctx, portID, channelID)
+				suite.Require().True(found)
+				suite.Require().Equal(recvStartSequence-remaining, pruningSequenceStart)
+
+				for sequence := uint64(1); sequence <= numPackets; sequence++ {
+					_, hasReceipt := channelKeeper.GetPacketReceipt(ctx, portID, channelID, sequence)
+					hasAck := channelKeeper.HasPacketAcknowledgement(ctx, portID, channelID, sequence)
+
+					pruned := sequence < pruningSequenceStart
+					suite.Require().Equal(!pruned, hasReceipt, "sequence %d", sequence)
+					suite.Require().Equal(!pruned, hasAck, "sequence %d", sequence)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestAdvanceNextSequenceAck tests that the next sequence ack of an UNORDERED channel on chainA
+// is advanced over the acknowledged packets.
+func (suite *KeeperTestSuite) TestAdvanceNextSequenceAck() {
+	const numPackets = 12
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	var packets []types.Packet
+	for sequence := uint64(1); sequence <= numPackets; sequence++ {
+		packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+		err := path.EndpointA.SendPacket(packet)
+		suite.Require().NoError(err)
+
+		err = path.EndpointB.RecvPacket(packet)
+		suite.Require().NoError(err)
+
+		packets = append(packets, packet)
+	}
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+
+	// acknowledge all packets but the first one
+	for _, packet := range packets[1:] {
+		err := path.EndpointA.AcknowledgePacket(packet, ibctesting.MockAcknowledgement)
+		suite.Require().NoError(err)
+	}
+
+	nextSequenceAck, _ := channelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), portID, channelID)
+	suite.Require().Equal(uint64(1), nextSequenceAck, "next sequence ack advanced over unacknowledged packet")
+
+	// the next sequence ack is advanced by a bounded number of sequences
+	err := path.EndpointA.AcknowledgePacket(packets[0], ibctesting.MockAcknowledgement)
+	suite.Require().NoError(err)
+
+	nextSequenceAck, _ = channelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), portID, channelID)
+	suite.Require().Equal(uint64(11), nextSequenceAck)
+
+	// pruning advances the next sequence ack over the remaining acknowledged packets
+	_, _, err = channelKeeper.PruneAcknowledgements(suite.chainA.GetContext(), portID, channelID, 0, nil, clienttypes.ZeroHeight(), 10)
+	suite.Require().NoError(err)
+
+	nextSequenceAck, _ = channelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), portID, channelID)
+	suite.Require().Equal(uint64(numPackets+1), nextSequenceAck)
+}
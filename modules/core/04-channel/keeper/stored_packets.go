@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+// GetStoredPacket gets a sent packet stored until it is acknowledged or timed out from the store
+func (k Keeper) GetStoredPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.Packet, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.StoredPacketKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.Packet{}, false
+	}
+
+	var packet types.Packet
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// SetStoredPacket sets a sent packet to the store
+func (k Keeper) SetStoredPacket(ctx sdk.Context, packet types.Packet) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&packet)
+	store.Set(host.StoredPacketKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()), bz)
+}
+
+// GetStoredPacketCount gets the number of sent packets stored for a channel from the store
+func (k Keeper) GetStoredPacketCount(ctx sdk.Context, portID, channelID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.StoredPacketCountKey(portID, channelID))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetStoredPacketCount sets the number of sent packets stored for a channel to the store.
+// The count is deleted from the store if it is zero.
+func (k Keeper) SetStoredPacketCount(ctx sdk.Context, portID, channelID string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(host.StoredPacketCountKey(portID, channelID))
+		return
+	}
+
+	store.Set(host.StoredPacketCountKey(portID, channelID), sdk.Uint64ToBigEndian(count))
+}
+
+// GetAllStoredPackets returns all the sent packets stored until they are acknowledged or timed out.
+func (k Keeper) GetAllStoredPackets(ctx sdk.Context) (packets []types.Packet) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyStoredPacketPrefix))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.Packet
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
+
+// storeSentPacket stores a sent packet until it is acknowledged or timed out, unless the
+// number of packets stored for its channel has reached the MaxStoredPackets parameter.
+// Sent packets are not stored if the parameter is zero.
+func (k Keeper) storeSentPacket(ctx sdk.Context, packet exported.PacketI) {
+	count := k.GetStoredPacketCount(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if count >= k.GetMaxStoredPackets(ctx) {
+		return
+	}
+
+	k.SetStoredPacket(ctx, types.NewPacket(
+		packet.GetData(), packet.GetSequence(),
+		packet.GetSourcePort(), packet.GetSourceChannel(),
+		packet.GetDestPort(), packet.GetDestChannel(),
+		clienttypes.NewHeight(packet.GetTimeoutHeight().GetRevisionNumber(), packet.GetTimeoutHeight().GetRevisionHeight()),
+		packet.GetTimeoutTimestamp(),
+	))
+	k.SetStoredPacketCount(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), count+1)
+}
+
+// deleteStoredPacket deletes a sent packet from the store once it is acknowledged or timed out.
+// Stored packets are deleted even if the storage of sent packets has since been disabled.
+func (k Keeper) deleteStoredPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := host.StoredPacketKey(portID, channelID, sequence)
+	if !store.Has(key) {
+		return
+	}
+
+	store.Delete(key)
+
+	count := k.GetStoredPacketCount(ctx, portID, channelID)
+	if count > 0 {
+		k.SetStoredPacketCount(ctx, portID, channelID, count-1)
+	}
+}
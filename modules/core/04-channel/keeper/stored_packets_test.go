@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+// TestStoredPackets tests that the packets sent by chainA are stored up to the MaxStoredPackets
+// parameter and deleted once they are acknowledged or timed out.
+func (suite *KeeperTestSuite) TestStoredPackets() {
+	const maxStoredPackets = 2
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+
+	newPacket := func(sequence uint64) types.Packet {
+		return types.NewPacket(ibctesting.MockPacketData, sequence, portID, channelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	}
+
+	// sent packets are not stored by default
+	err := path.EndpointA.SendPacket(newPacket(1))
+	suite.Require().NoError(err)
+
+	_, found := channelKeeper.GetStoredPacket(suite.chainA.GetContext(), portID, channelID, 1)
+	suite.Require().False(found)
+
+	params := types.DefaultParams()
+	params.MaxStoredPackets = maxStoredPackets
+	channelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	// sent packets are stored up to the maximum number of stored packets
+	var packets []types.Packet
+	for sequence := uint64(2); sequence <= 4; sequence++ {
+		packet := newPacket(sequence)
+		err := path.EndpointA.SendPacket(packet)
+		suite.Require().NoError(err)
+
+		packets = append(packets, packet)
+	}
+
+	for _, packet := range packets[:maxStoredPackets] {
+		storedPacket, found := channelKeeper.GetStoredPacket(suite.chainA.GetContext(), portID, channelID, packet.Sequence)
+		suite.Require().True(found)
+		suite.Require().Equal(packet, storedPacket)
+	}
+
+	_, found = channelKeeper.GetStoredPacket(suite.chainA.GetContext(), portID, channelID, 4)
+	suite.Require().False(found)
+	suite.Require().Equal(uint64(maxStoredPackets), channelKeeper.GetStoredPacketCount(suite.chainA.GetContext(), portID, channelID))
+
+	// acknowledged packets are deleted
+	err = path.EndpointB.RecvPacket(packets[0])
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.AcknowledgePacket(packets[0], ibctesting.MockAcknowledgement)
+	suite.Require().NoError(err)
+
+	_, found = channelKeeper.GetStoredPacket(suite.chainA.GetContext(), portID, channelID, packets[0].Sequence)
+	suite.Require().False(found)
+	suite.Require().Equal(uint64(1), channelKeeper.GetStoredPacketCount(suite.chainA.GetContext(), portID, channelID))
+
+	// timed out packets are deleted
+	chanCap := suite.chainA.GetChannelCapability(portID, channelID)
+	err = channelKeeper.TimeoutExecuted(suite.chainA.GetContext(), chanCap, packets[1])
+	suite.Require().NoError(err)
+
+	_, found = channelKeeper.GetStoredPacket(suite.chainA.GetContext(), portID, channelID, packets[1].Sequence)
+	suite.Require().False(found)
+	suite.Require().Equal(uint64(0), channelKeeper.GetStoredPacketCount(suite.chainA.GetContext(), portID, channelID))
+	suite.Require().Empty(channelKeeper.GetAllStoredPackets(suite.chainA.GetContext()))
+}
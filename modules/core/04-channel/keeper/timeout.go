@@ -152,10 +152,14 @@ func (k Keeper) TimeoutExecuted(
 	}
 
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.deleteStoredPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
-	if channel.Ordering == types.ORDERED {
+	switch channel.Ordering {
+	case types.ORDERED:
 		channel.State = types.CLOSED
 		k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+	case types.UNORDERED:
+		k.advanceNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), maxNextSequenceAckAdvance)
 	}
 
 	k.Logger(ctx).Info(
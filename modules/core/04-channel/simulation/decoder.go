@@ -37,6 +37,27 @@ func NewDecodeStore(cdc codec.BinaryCodec, kvA, kvB kv.Pair) (string, bool) {
 		seqB := sdk.BigEndianToUint64(kvB.Value)
 		return fmt.Sprintf("NextSeqAck A: %d\nNextSeqAck B: %d", seqA, seqB), true
 
+	case bytes.HasPrefix(kvA.Key, []byte(host.KeyRecvStartSequencePrefix)):
+		seqA := sdk.BigEndianToUint64(kvA.Value)
+		seqB := sdk.BigEndianToUint64(kvB.Value)
+		return fmt.Sprintf("RecvStartSeq A: %d\nRecvStartSeq B: %d", seqA, seqB), true
+
+	case bytes.HasPrefix(kvA.Key, []byte(host.KeyPruningSequenceStartPrefix)):
+		seqA := sdk.BigEndianToUint64(kvA.Value)
+		seqB := sdk.BigEndianToUint64(kvB.Value)
+		return fmt.Sprintf("PruningSeqStart A: %d\nPruningSeqStart B: %d", seqA, seqB), true
+
+	case bytes.HasPrefix(kvA.Key, []byte(host.KeyStoredPacketCountPrefix)):
+		countA := sdk.BigEndianToUint64(kvA.Value)
+		countB := sdk.BigEndianToUint64(kvB.Value)
+		return fmt.Sprintf("StoredPacketCount A: %d\nStoredPacketCount B: %d", countA, countB), true
+
+	case bytes.HasPrefix(kvA.Key, []byte(host.KeyStoredPacketPrefix)):
+		var packetA, packetB types.Packet
+		cdc.MustUnmarshal(kvA.Value, &packetA)
+		cdc.MustUnmarshal(kvB.Value, &packetB)
+		return fmt.Sprintf("StoredPacket A: %v\nStoredPacket B: %v", packetA, packetB), true
+
 	case bytes.HasPrefix(kvA.Key, []byte(host.KeyPacketCommitmentPrefix)):
 		return fmt.Sprintf("CommitmentHash A: %X\nCommitmentHash B: %X", kvA.Value, kvB.Value), true
 
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/stretchr/testify/require"
 
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/simulation"
 	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
@@ -26,6 +27,8 @@ func TestDecodeStore(t *testing.T) {
 		Version: "1.0",
 	}
 
+	packet := types.NewPacket([]byte("data"), 1, portID, channelID, portID, channelID, clienttypes.NewHeight(0, 10), 0)
+
 	bz := []byte{0x1, 0x2, 0x3}
 
 	kvPairs := kv.Pairs{
@@ -46,6 +49,22 @@ func TestDecodeStore(t *testing.T) {
 				Key:   host.NextSequenceAckKey(portID, channelID),
 				Value: sdk.Uint64ToBigEndian(1),
 			},
+			{
+				Key:   host.RecvStartSequenceKey(portID, channelID),
+				Value: sdk.Uint64ToBigEndian(1),
+			},
+			{
+				Key:   host.PruningSequenceStartKey(portID, channelID),
+				Value: sdk.Uint64ToBigEndian(1),
+			},
+			{
+				Key:   host.StoredPacketCountKey(portID, channelID),
+				Value: sdk.Uint64ToBigEndian(1),
+			},
+			{
+				Key:   host.StoredPacketKey(portID, channelID, 1),
+				Value: cdc.MustMarshal(&packet),
+			},
 			{
 				Key:   host.PacketCommitmentKey(portID, channelID, 1),
 				Value: bz,
@@ -68,6 +87,10 @@ func TestDecodeStore(t *testing.T) {
 		{"NextSeqSend", "NextSeqSend A: 1\nNextSeqSend B: 1"},
 		{"NextSeqRecv", "NextSeqRecv A: 1\nNextSeqRecv B: 1"},
 		{"NextSeqAck", "NextSeqAck A: 1\nNextSeqAck B: 1"},
+		{"RecvStartSeq", "RecvStartSeq A: 1\nRecvStartSeq B: 1"},
+		{"PruningSeqStart", "PruningSeqStart A: 1\nPruningSeqStart B: 1"},
+		{"StoredPacketCount", "StoredPacketCount A: 1\nStoredPacketCount B: 1"},
+		{"StoredPacket", fmt.Sprintf("StoredPacket A: %v\nStoredPacket B: %v", packet, packet)},
 		{"CommitmentHash", fmt.Sprintf("CommitmentHash A: %X\nCommitmentHash B: %X", bz, bz)},
 		{"AckHash", fmt.Sprintf("AckHash A: %X\nAckHash B: %X", bz, bz)},
 		{"other", ""},
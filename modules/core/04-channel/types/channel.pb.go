@@ -486,6 +486,73 @@ func (*Acknowledgement) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// Params defines the set of Channel parameters.
+type Params struct {
+	// maximum size (in bytes) of the data of packets sent on any channel. It must not exceed the
+	// maximum packet data size enforced by the packet basic validation.
+	MaxPacketDataSize uint64 `protobuf:"varint,1,opt,name=max_packet_data_size,json=maxPacketDataSize,proto3" json:"max_packet_data_size,omitempty" yaml:"max_packet_data_size"`
+	// amount of gas consumed per byte of packet data when a packet is received. Setting it to zero
+	// disables gas metering by packet data size. It must not exceed the maximum gas per byte of 10000.
+	RecvPacketGasPerByte uint64 `protobuf:"varint,2,opt,name=recv_packet_gas_per_byte,json=recvPacketGasPerByte,proto3" json:"recv_packet_gas_per_byte,omitempty" yaml:"recv_packet_gas_per_byte"`
+	// maximum number of sent packets stored per channel until they are acknowledged or timed out.
+	// Setting it to zero disables the storage of sent packets.
+	MaxStoredPackets uint64 `protobuf:"varint,3,opt,name=max_stored_packets,json=maxStoredPackets,proto3" json:"max_stored_packets,omitempty" yaml:"max_stored_packets"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{7}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxPacketDataSize() uint64 {
+	if m != nil {
+		return m.MaxPacketDataSize
+	}
+	return 0
+}
+
+func (m *Params) GetRecvPacketGasPerByte() uint64 {
+	if m != nil {
+		return m.RecvPacketGasPerByte
+	}
+	return 0
+}
+
+func (m *Params) GetMaxStoredPackets() uint64 {
+	if m != nil {
+		return m.MaxStoredPackets
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.State", State_name, State_value)
 	proto.RegisterEnum("ibc.core.channel.v1.Order", Order_name, Order_value)
@@ -496,70 +563,79 @@ func init() {
 	proto.RegisterType((*PacketState)(nil), "ibc.core.channel.v1.PacketState")
 	proto.RegisterType((*PacketId)(nil), "ibc.core.channel.v1.PacketId")
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
+	proto.RegisterType((*Params)(nil), "ibc.core.channel.v1.Params")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 925 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x8e, 0x1a, 0x47,
-	0x10, 0x66, 0x60, 0xf8, 0x2b, 0x16, 0x96, 0x6d, 0x67, 0xf1, 0x64, 0x62, 0x33, 0x78, 0x94, 0xc3,
-	0xca, 0x91, 0xc1, 0xeb, 0xac, 0x12, 0xc5, 0xa7, 0x2c, 0x3f, 0xd6, 0x8e, 0x62, 0xc1, 0x6a, 0x60,
-	0x0f, 0xf1, 0x85, 0xc0, 0x4c, 0x07, 0x46, 0x86, 0x69, 0x32, 0xd3, 0xb0, 0xda, 0x37, 0xb0, 0xb8,
-	0x24, 0x2f, 0x80, 0x14, 0x29, 0x4a, 0x5e, 0x21, 0xaf, 0xe0, 0xa3, 0x8f, 0x39, 0xa1, 0x68, 0xf7,
-	0x90, 0x3b, 0x2f, 0x90, 0x68, 0xba, 0x7b, 0xf8, 0x59, 0x5b, 0x7b, 0x4c, 0x2e, 0x39, 0xd1, 0x55,
-	0xdf, 0x57, 0x55, 0xdf, 0x54, 0x15, 0xad, 0x86, 0x47, 0x4e, 0xdf, 0xaa, 0x58, 0xc4, 0xc3, 0x15,
-	0x6b, 0xd8, 0x73, 0x5d, 0x3c, 0xaa, 0xcc, 0x8e, 0xc3, 0x63, 0x79, 0xe2, 0x11, 0x4a, 0xd0, 0x3d,
-	0xa7, 0x6f, 0x95, 0x03, 0x4a, 0x39, 0xf4, 0xcf, 0x8e, 0xd5, 0x8f, 0x06, 0x64, 0x40, 0x18, 0x5e,
-	0x09, 0x4e, 0x9c, 0xaa, 0x6a, 0x9b, 0x6c, 0x23, 0x07, 0xbb, 0x94, 0x25, 0x63, 0x27, 0x4e, 0xd0,
-	0x7f, 0x8d, 0x42, 0xb2, 0xc6, 0xb3, 0xa0, 0xa7, 0x10, 0xf7, 0x69, 0x8f, 0x62, 0x45, 0x2a, 0x49,
-	0x47, 0xb9, 0x67, 0x6a, 0xf9, 0x03, 0x75, 0xca, 0xed, 0x80, 0x61, 0x72, 0x22, 0xfa, 0x02, 0x52,
-	0xc4, 0xb3, 0xb1, 0xe7, 0xb8, 0x03, 0x25, 0x7a, 0x47, 0x50, 0x2b, 0x20, 0x99, 0x6b, 0x2e, 0xfa,
-	0x06, 0xf6, 0x2c, 0x32, 0x75, 0x29, 0xf6, 0x26, 0x3d, 0x8f, 0x5e, 0x29, 0xb1, 0x92, 0x74, 0x94,
-	0x79, 0xf6, 0xe8, 0x83, 0xb1, 0xb5, 0x2d, 0x62, 0x55, 0x7e, 0xbb, 0xd4, 0x22, 0xe6, 0x4e, 0x30,
-	0xaa, 0xc1, 0xbe, 0x45, 0x5c, 0x17, 0x5b, 0xd4, 0x21, 0x6e, 0x77, 0x48, 0x26, 0xbe, 0x22, 0x97,
-	0x62, 0x47, 0xe9, 0xaa, 0xba, 0x5a, 0x6a, 0x85, 0xab, 0xde, 0x78, 0xf4, 0x5c, 0xbf, 0x45, 0xd0,
-	0xcd, 0xdc, 0xc6, 0x73, 0x46, 0x26, 0x3e, 0x52, 0x20, 0x39, 0xc3, 0x9e, 0xef, 0x10, 0x57, 0x89,
-	0x97, 0xa4, 0xa3, 0xb4, 0x19, 0x9a, 0xcf, 0xe5, 0x37, 0x3f, 0x6b, 0x11, 0xfd, 0xaf, 0x28, 0x1c,
-	0x18, 0x36, 0x76, 0xa9, 0xf3, 0xbd, 0x83, 0xed, 0xff, 0x3b, 0x76, 0x47, 0xc7, 0xd0, 0x7d, 0x48,
-	0x4e, 0x88, 0x47, 0xbb, 0x8e, 0xad, 0x24, 0x18, 0x92, 0x08, 0x4c, 0xc3, 0x46, 0x0f, 0x01, 0x84,
-	0xcc, 0x00, 0x4b, 0x32, 0x2c, 0x2d, 0x3c, 0x86, 0x2d, 0x3a, 0x7d, 0x09, 0x7b, 0xdb, 0x1f, 0x80,
-	0x3e, 0xdb, 0x64, 0x0b, 0xba, 0x9c, 0xae, 0xa2, 0xd5, 0x52, 0xcb, 0x71, 0x91, 0x02, 0xd0, 0xd7,
-	0x15, 0x4e, 0x76, 0x2a, 0x44, 0x19, 0xff, 0x70, 0xb5, 0xd4, 0x0e, 0xc4, 0x47, 0xad, 0x31, 0xfd,
-	0xfd, 0xc2, 0x7f, 0xc7, 0x20, 0x71, 0xde, 0xb3, 0x5e, 0x63, 0x8a, 0x54, 0x48, 0xf9, 0xf8, 0x87,
-	0x29, 0x76, 0x2d, 0x3e, 0x5a, 0xd9, 0x5c, 0xdb, 0xe8, 0x4b, 0xc8, 0xf8, 0x64, 0xea, 0x59, 0xb8,
-	0x1b, 0xd4, 0x14, 0x35, 0x0a, 0xab, 0xa5, 0x86, 0x78, 0x8d, 0x2d, 0x50, 0x37, 0x81, 0x5b, 0xe7,
-	0xc4, 0xa3, 0xe8, 0x6b, 0xc8, 0x09, 0x4c, 0x54, 0x66, 0x43, 0x4c, 0x57, 0x3f, 0x5e, 0x2d, 0xb5,
-	0xc3, 0x9d, 0x58, 0x81, 0xeb, 0x66, 0x96, 0x3b, 0xc2, 0x75, 0x7b, 0x01, 0x79, 0x1b, 0xfb, 0xd4,
-	0x71, 0x7b, 0x6c, 0x2e, 0xac, 0xbe, 0xcc, 0x72, 0x7c, 0xb2, 0x5a, 0x6a, 0xf7, 0x79, 0x8e, 0xdb,
-	0x0c, 0xdd, 0xdc, 0xdf, 0x72, 0x31, 0x25, 0x2d, 0xb8, 0xb7, 0xcd, 0x0a, 0xe5, 0xb0, 0x31, 0x56,
-	0x8b, 0xab, 0xa5, 0xa6, 0xbe, 0x9f, 0x6a, 0xad, 0x09, 0x6d, 0x79, 0x43, 0x61, 0x08, 0x64, 0xbb,
-	0x47, 0x7b, 0x6c, 0xdc, 0x7b, 0x26, 0x3b, 0xa3, 0xef, 0x20, 0x47, 0x9d, 0x31, 0x26, 0x53, 0xda,
-	0x1d, 0x62, 0x67, 0x30, 0xa4, 0x6c, 0xe0, 0x99, 0x9d, 0x7d, 0xe7, 0x37, 0xd1, 0xec, 0xb8, 0x7c,
-	0xc6, 0x18, 0xd5, 0x87, 0xc1, 0xb2, 0x6e, 0xda, 0xb1, 0x1b, 0xaf, 0x9b, 0x59, 0xe1, 0xe0, 0x6c,
-	0x64, 0xc0, 0x41, 0xc8, 0x08, 0x7e, 0x7d, 0xda, 0x1b, 0x4f, 0x94, 0x54, 0x30, 0xae, 0xea, 0x83,
-	0xd5, 0x52, 0x53, 0x76, 0x93, 0xac, 0x29, 0xba, 0x99, 0x17, 0xbe, 0x4e, 0xe8, 0x12, 0x1b, 0xf0,
-	0x9b, 0x04, 0x19, 0xbe, 0x01, 0xec, 0x3f, 0xfb, 0x2f, 0xac, 0xde, 0xce, 0xa6, 0xc5, 0x6e, 0x6d,
-	0x5a, 0xd8, 0x55, 0x79, 0xd3, 0x55, 0x21, 0xf4, 0x47, 0x09, 0x52, 0x5c, 0xa8, 0x61, 0xff, 0xc7,
-	0x2a, 0x85, 0xa2, 0x16, 0xec, 0x9f, 0x5a, 0xaf, 0x5d, 0x72, 0x39, 0xc2, 0xf6, 0x00, 0x8f, 0xb1,
-	0x4b, 0x91, 0x02, 0x09, 0x0f, 0xfb, 0xd3, 0x11, 0x55, 0x0e, 0x83, 0x0f, 0x38, 0x8b, 0x98, 0xc2,
-	0x46, 0x05, 0x88, 0x63, 0xcf, 0x23, 0x9e, 0x52, 0x08, 0xea, 0x9f, 0x45, 0x4c, 0x6e, 0x56, 0x01,
-	0x52, 0x1e, 0xf6, 0x27, 0xc4, 0xf5, 0xf1, 0xe3, 0xdf, 0x25, 0x88, 0xb7, 0xc5, 0x95, 0xa9, 0xb5,
-	0x3b, 0xa7, 0x9d, 0x46, 0xf7, 0xa2, 0x69, 0x34, 0x8d, 0x8e, 0x71, 0xfa, 0xd2, 0x78, 0xd5, 0xa8,
-	0x77, 0x2f, 0x9a, 0xed, 0xf3, 0x46, 0xcd, 0x78, 0x61, 0x34, 0xea, 0xf9, 0x88, 0x7a, 0x30, 0x5f,
-	0x94, 0xb2, 0x3b, 0x04, 0xa4, 0x00, 0xf0, 0xb8, 0xc0, 0x99, 0x97, 0xd4, 0xd4, 0x7c, 0x51, 0x92,
-	0x83, 0x33, 0x2a, 0x42, 0x96, 0x23, 0x1d, 0xf3, 0xdb, 0xd6, 0x79, 0xa3, 0x99, 0x8f, 0xaa, 0x99,
-	0xf9, 0xa2, 0x94, 0x14, 0xe6, 0x26, 0x92, 0x81, 0x31, 0x1e, 0xc9, 0x90, 0x07, 0xb0, 0xc7, 0x91,
-	0xda, 0xcb, 0x56, 0xbb, 0x51, 0xcf, 0xcb, 0x2a, 0xcc, 0x17, 0xa5, 0x04, 0xb7, 0x54, 0xf9, 0xcd,
-	0x2f, 0xc5, 0xc8, 0xe3, 0x4b, 0x88, 0xb3, 0xdb, 0x1b, 0x7d, 0x0a, 0x85, 0x96, 0x59, 0x6f, 0x98,
-	0xdd, 0x66, 0xab, 0xd9, 0xb8, 0xa5, 0x97, 0xa5, 0x0c, 0xfc, 0x48, 0x87, 0x7d, 0xce, 0xba, 0x68,
-	0xb2, 0xdf, 0x46, 0x3d, 0x2f, 0xa9, 0xd9, 0xf9, 0xa2, 0x94, 0x5e, 0x3b, 0x02, 0xc1, 0x9c, 0x13,
-	0x32, 0x84, 0x60, 0x61, 0xf2, 0xc2, 0xd5, 0xf6, 0xdb, 0xeb, 0xa2, 0xf4, 0xee, 0xba, 0x28, 0xfd,
-	0x79, 0x5d, 0x94, 0x7e, 0xba, 0x29, 0x46, 0xde, 0xdd, 0x14, 0x23, 0x7f, 0xdc, 0x14, 0x23, 0xaf,
-	0xbe, 0x1a, 0x38, 0x74, 0x38, 0xed, 0x97, 0x2d, 0x32, 0xae, 0x58, 0xc4, 0x1f, 0x13, 0xbf, 0xe2,
-	0xf4, 0xad, 0x27, 0x03, 0x52, 0x99, 0x9d, 0x54, 0xc6, 0xc4, 0x9e, 0x8e, 0xb0, 0xcf, 0x9f, 0x09,
-	0x4f, 0x4f, 0x9e, 0x84, 0xef, 0x0e, 0x7a, 0x35, 0xc1, 0x7e, 0x3f, 0xc1, 0xde, 0x09, 0x9f, 0xff,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0x59, 0xc1, 0x4c, 0x94, 0x98, 0x08, 0x00, 0x00,
+	// 1051 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x65, 0x59, 0x96, 0xd6, 0x7f, 0xf2, 0xc6, 0x76, 0x18, 0x26, 0x16, 0x15, 0xb6, 0x07,
+	0x23, 0x45, 0xa4, 0x38, 0x35, 0x5a, 0x34, 0xa7, 0x9a, 0x96, 0x52, 0x13, 0x09, 0x24, 0x81, 0xb2,
+	0x0f, 0x4d, 0x0f, 0x2c, 0x45, 0x6e, 0x65, 0x22, 0x22, 0x57, 0xe5, 0xae, 0x64, 0x3b, 0xe7, 0x1e,
+	0x02, 0x5f, 0xda, 0x17, 0x30, 0x50, 0xa0, 0x68, 0x5f, 0xa1, 0xaf, 0x90, 0x63, 0x8e, 0x3d, 0x11,
+	0x85, 0x7d, 0xe8, 0x9d, 0x2f, 0xd0, 0x82, 0xbb, 0x4b, 0xfd, 0x38, 0x6e, 0x8e, 0xed, 0x25, 0x27,
+	0xed, 0xcc, 0xf7, 0xcd, 0xcc, 0xb7, 0x33, 0x43, 0x91, 0xe0, 0xbe, 0xd7, 0x75, 0x6a, 0x0e, 0x0e,
+	0x51, 0xcd, 0x39, 0xb6, 0x83, 0x00, 0xf5, 0x6b, 0xa3, 0x9d, 0xf4, 0x58, 0x1d, 0x84, 0x98, 0x62,
+	0x78, 0xcb, 0xeb, 0x3a, 0xd5, 0x84, 0x52, 0x4d, 0xfd, 0xa3, 0x1d, 0x65, 0xbd, 0x87, 0x7b, 0x98,
+	0xe1, 0xb5, 0xe4, 0xc4, 0xa9, 0x8a, 0x3a, 0xc9, 0xd6, 0xf7, 0x50, 0x40, 0x59, 0x32, 0x76, 0xe2,
+	0x04, 0xed, 0xd7, 0x2c, 0x58, 0xd8, 0xe7, 0x59, 0xe0, 0x23, 0x30, 0x4f, 0xa8, 0x4d, 0x91, 0x2c,
+	0x55, 0xa4, 0xed, 0x95, 0xc7, 0x4a, 0xf5, 0x86, 0x3a, 0xd5, 0x4e, 0xc2, 0x30, 0x39, 0x11, 0x7e,
+	0x06, 0x0a, 0x38, 0x74, 0x51, 0xe8, 0x05, 0x3d, 0x39, 0xfb, 0x9e, 0xa0, 0x56, 0x42, 0x32, 0xc7,
+	0x5c, 0xf8, 0x0c, 0x2c, 0x39, 0x78, 0x18, 0x50, 0x14, 0x0e, 0xec, 0x90, 0x9e, 0xc9, 0x73, 0x15,
+	0x69, 0x7b, 0xf1, 0xf1, 0xfd, 0x1b, 0x63, 0xf7, 0xa7, 0x88, 0x7a, 0xee, 0x4d, 0xa4, 0x66, 0xcc,
+	0x99, 0x60, 0xb8, 0x0f, 0x56, 0x1d, 0x1c, 0x04, 0xc8, 0xa1, 0x1e, 0x0e, 0xac, 0x63, 0x3c, 0x20,
+	0x72, 0xae, 0x32, 0xb7, 0x5d, 0xd4, 0x95, 0x38, 0x52, 0x37, 0xcf, 0x6c, 0xbf, 0xff, 0x44, 0xbb,
+	0x46, 0xd0, 0xcc, 0x95, 0x89, 0xe7, 0x00, 0x0f, 0x08, 0x94, 0xc1, 0xc2, 0x08, 0x85, 0xc4, 0xc3,
+	0x81, 0x3c, 0x5f, 0x91, 0xb6, 0x8b, 0x66, 0x6a, 0x3e, 0xc9, 0xbd, 0xfe, 0x59, 0xcd, 0x68, 0x7f,
+	0x65, 0xc1, 0x9a, 0xe1, 0xa2, 0x80, 0x7a, 0xdf, 0x79, 0xc8, 0xfd, 0xd0, 0xb1, 0xf7, 0x74, 0x0c,
+	0xde, 0x06, 0x0b, 0x03, 0x1c, 0x52, 0xcb, 0x73, 0xe5, 0x3c, 0x43, 0xf2, 0x89, 0x69, 0xb8, 0x70,
+	0x0b, 0x00, 0x21, 0x33, 0xc1, 0x16, 0x18, 0x56, 0x14, 0x1e, 0xc3, 0x15, 0x9d, 0x3e, 0x01, 0x4b,
+	0xd3, 0x17, 0x80, 0x9f, 0x4c, 0xb2, 0x25, 0x5d, 0x2e, 0xea, 0x30, 0x8e, 0xd4, 0x15, 0x2e, 0x52,
+	0x00, 0xda, 0xb8, 0xc2, 0xee, 0x4c, 0x85, 0x2c, 0xe3, 0x6f, 0xc4, 0x91, 0xba, 0x26, 0x2e, 0x35,
+	0xc6, 0xb4, 0x77, 0x0b, 0xff, 0x3d, 0x07, 0xf2, 0x6d, 0xdb, 0x79, 0x89, 0x28, 0x54, 0x40, 0x81,
+	0xa0, 0xef, 0x87, 0x28, 0x70, 0xf8, 0x68, 0x73, 0xe6, 0xd8, 0x86, 0x9f, 0x83, 0x45, 0x82, 0x87,
+	0xa1, 0x83, 0xac, 0xa4, 0xa6, 0xa8, 0xb1, 0x19, 0x47, 0x2a, 0xe4, 0x35, 0xa6, 0x40, 0xcd, 0x04,
+	0xdc, 0x6a, 0xe3, 0x90, 0xc2, 0x2f, 0xc1, 0x8a, 0xc0, 0x44, 0x65, 0x36, 0xc4, 0xa2, 0x7e, 0x27,
+	0x8e, 0xd4, 0x8d, 0x99, 0x58, 0x81, 0x6b, 0xe6, 0x32, 0x77, 0xa4, 0xeb, 0xf6, 0x14, 0x94, 0x5c,
+	0x44, 0xa8, 0x17, 0xd8, 0x6c, 0x2e, 0xac, 0x7e, 0x8e, 0xe5, 0xb8, 0x1b, 0x47, 0xea, 0x6d, 0x9e,
+	0xe3, 0x3a, 0x43, 0x33, 0x57, 0xa7, 0x5c, 0x4c, 0x49, 0x0b, 0xdc, 0x9a, 0x66, 0xa5, 0x72, 0xd8,
+	0x18, 0xf5, 0x72, 0x1c, 0xa9, 0xca, 0xbb, 0xa9, 0xc6, 0x9a, 0xe0, 0x94, 0x37, 0x15, 0x06, 0x41,
+	0xce, 0xb5, 0xa9, 0xcd, 0xc6, 0xbd, 0x64, 0xb2, 0x33, 0xfc, 0x16, 0xac, 0x50, 0xcf, 0x47, 0x78,
+	0x48, 0xad, 0x63, 0xe4, 0xf5, 0x8e, 0x29, 0x1b, 0xf8, 0xe2, 0xcc, 0xbe, 0xf3, 0x7f, 0xa2, 0xd1,
+	0x4e, 0xf5, 0x80, 0x31, 0xf4, 0xad, 0x64, 0x59, 0x27, 0xed, 0x98, 0x8d, 0xd7, 0xcc, 0x65, 0xe1,
+	0xe0, 0x6c, 0x68, 0x80, 0xb5, 0x94, 0x91, 0xfc, 0x12, 0x6a, 0xfb, 0x03, 0xb9, 0x90, 0x8c, 0x4b,
+	0xbf, 0x17, 0x47, 0xaa, 0x3c, 0x9b, 0x64, 0x4c, 0xd1, 0xcc, 0x92, 0xf0, 0x1d, 0xa6, 0x2e, 0xb1,
+	0x01, 0xbf, 0x49, 0x60, 0x91, 0x6f, 0x00, 0x7b, 0x66, 0xff, 0x83, 0xd5, 0x9b, 0xd9, 0xb4, 0xb9,
+	0x6b, 0x9b, 0x96, 0x76, 0x35, 0x37, 0xe9, 0xaa, 0x10, 0xfa, 0xa3, 0x04, 0x0a, 0x5c, 0xa8, 0xe1,
+	0xfe, 0xcf, 0x2a, 0x85, 0xa2, 0x16, 0x58, 0xdd, 0x73, 0x5e, 0x06, 0xf8, 0xa4, 0x8f, 0xdc, 0x1e,
+	0xf2, 0x51, 0x40, 0xa1, 0x0c, 0xf2, 0x21, 0x22, 0xc3, 0x3e, 0x95, 0x37, 0x92, 0x0b, 0x1c, 0x64,
+	0x4c, 0x61, 0xc3, 0x4d, 0x30, 0x8f, 0xc2, 0x10, 0x87, 0xf2, 0x66, 0x52, 0xff, 0x20, 0x63, 0x72,
+	0x53, 0x07, 0xa0, 0x10, 0x22, 0x32, 0xc0, 0x01, 0x41, 0xda, 0x0f, 0xd9, 0xe4, 0x69, 0x0c, 0x6d,
+	0x9f, 0xc0, 0x36, 0x58, 0xf7, 0xed, 0x53, 0x6b, 0xc0, 0x2e, 0x6c, 0x25, 0x6d, 0xb0, 0x88, 0xf7,
+	0x4a, 0x3c, 0x99, 0xba, 0x1a, 0x47, 0xea, 0x5d, 0xae, 0xfe, 0x26, 0x96, 0x66, 0xae, 0xf9, 0xf6,
+	0x29, 0x6f, 0x56, 0xdd, 0xa6, 0x76, 0xc7, 0x7b, 0x85, 0xe0, 0x37, 0x40, 0x0e, 0x91, 0x33, 0x4a,
+	0xc9, 0x3d, 0x9b, 0x58, 0x03, 0x14, 0x5a, 0xdd, 0x33, 0x8a, 0x58, 0x4f, 0x72, 0xfa, 0x47, 0x71,
+	0xa4, 0xaa, 0x3c, 0xeb, 0xbf, 0x31, 0x35, 0x73, 0x3d, 0x81, 0x78, 0xea, 0xaf, 0x6c, 0xd2, 0x46,
+	0xa1, 0x7e, 0x46, 0x11, 0x7c, 0x06, 0x60, 0x22, 0x84, 0x50, 0x1c, 0x22, 0x57, 0x04, 0x12, 0xde,
+	0x36, 0x7d, 0x2b, 0x8e, 0xd4, 0x3b, 0x13, 0xb1, 0xb3, 0x1c, 0xcd, 0x2c, 0xf9, 0xf6, 0x69, 0x87,
+	0xf9, 0x78, 0x56, 0xf2, 0xe0, 0x77, 0x09, 0xcc, 0x77, 0xc4, 0x9b, 0x43, 0xed, 0x1c, 0xee, 0x1d,
+	0x36, 0xac, 0xa3, 0xa6, 0xd1, 0x34, 0x0e, 0x8d, 0xbd, 0xe7, 0xc6, 0x8b, 0x46, 0xdd, 0x3a, 0x6a,
+	0x76, 0xda, 0x8d, 0x7d, 0xe3, 0xa9, 0xd1, 0xa8, 0x97, 0x32, 0xca, 0xda, 0xf9, 0x45, 0x65, 0x79,
+	0x86, 0x00, 0x65, 0x00, 0x78, 0x5c, 0xe2, 0x2c, 0x49, 0x4a, 0xe1, 0xfc, 0xa2, 0x92, 0x4b, 0xce,
+	0xb0, 0x0c, 0x96, 0x39, 0x72, 0x68, 0x7e, 0xdd, 0x6a, 0x37, 0x9a, 0xa5, 0xac, 0xb2, 0x78, 0x7e,
+	0x51, 0x59, 0x10, 0xe6, 0x24, 0x92, 0x81, 0x73, 0x3c, 0x92, 0x21, 0xf7, 0xc0, 0x12, 0x47, 0xf6,
+	0x9f, 0xb7, 0x3a, 0x8d, 0x7a, 0x29, 0xa7, 0x80, 0xf3, 0x8b, 0x4a, 0x9e, 0x5b, 0x4a, 0xee, 0xf5,
+	0x2f, 0xe5, 0xcc, 0x83, 0x13, 0x30, 0xcf, 0x5e, 0x62, 0xf0, 0x63, 0xb0, 0xd9, 0x32, 0xeb, 0x0d,
+	0xd3, 0x6a, 0xb6, 0x9a, 0x8d, 0x6b, 0x7a, 0x59, 0xca, 0xc4, 0x0f, 0x35, 0xb0, 0xca, 0x59, 0x47,
+	0x4d, 0xf6, 0xdb, 0xa8, 0x97, 0x24, 0x65, 0xf9, 0xfc, 0xa2, 0x52, 0x1c, 0x3b, 0x12, 0xc1, 0x9c,
+	0x93, 0x32, 0x84, 0x60, 0x61, 0xf2, 0xc2, 0x7a, 0xe7, 0xcd, 0x65, 0x59, 0x7a, 0x7b, 0x59, 0x96,
+	0xfe, 0xbc, 0x2c, 0x4b, 0x3f, 0x5d, 0x95, 0x33, 0x6f, 0xaf, 0xca, 0x99, 0x3f, 0xae, 0xca, 0x99,
+	0x17, 0x5f, 0xf4, 0x3c, 0x7a, 0x3c, 0xec, 0x56, 0x1d, 0xec, 0xd7, 0x1c, 0x4c, 0x7c, 0x4c, 0x6a,
+	0x5e, 0xd7, 0x79, 0xd8, 0xc3, 0xb5, 0xd1, 0x6e, 0xcd, 0xc7, 0xee, 0xb0, 0x8f, 0x08, 0xff, 0x5a,
+	0x7a, 0xb4, 0xfb, 0x30, 0xfd, 0xfc, 0xa2, 0x67, 0x03, 0x44, 0xba, 0x79, 0xf6, 0xb9, 0xf4, 0xe9,
+	0x3f, 0x03, 0x00, 0x8a, 0x5d, 0x84, 0xaf, 0x9f, 0x09, 0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -966,6 +1042,44 @@ func (m *Acknowledgement_Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	dAtA[i] = 0xb2
 	return len(dAtA) - i, nil
 }
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxStoredPackets != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.MaxStoredPackets))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RecvPacketGasPerByte != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.RecvPacketGasPerByte))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxPacketDataSize != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.MaxPacketDataSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintChannel(dAtA []byte, offset int, v uint64) int {
 	offset -= sovChannel(v)
 	base := offset
@@ -1171,6 +1285,23 @@ func (m *Acknowledgement_Error) Size() (n int) {
 	n += 2 + l + sovChannel(uint64(l))
 	return n
 }
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPacketDataSize != 0 {
+		n += 1 + sovChannel(uint64(m.MaxPacketDataSize))
+	}
+	if m.RecvPacketGasPerByte != 0 {
+		n += 1 + sovChannel(uint64(m.RecvPacketGasPerByte))
+	}
+	if m.MaxStoredPackets != 0 {
+		n += 1 + sovChannel(uint64(m.MaxStoredPackets))
+	}
+	return n
+}
 
 func sovChannel(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -2424,6 +2555,113 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketDataSize", wireType)
+			}
+			m.MaxPacketDataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketDataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvPacketGasPerByte", wireType)
+			}
+			m.RecvPacketGasPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecvPacketGasPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStoredPackets", wireType)
+			}
+			m.MaxStoredPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStoredPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipChannel(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
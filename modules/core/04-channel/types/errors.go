@@ -39,4 +39,6 @@ var (
 
 	ErrInvalidChannelVersion = sdkerrors.Register(SubModuleName, 24, "invalid channel version")
 	ErrPacketNotSent         = sdkerrors.Register(SubModuleName, 25, "packet has not been sent")
+	ErrPacketDataTooLarge    = sdkerrors.Register(SubModuleName, 26, "packet data exceeds the maximum size")
+	ErrPacketNotFound        = sdkerrors.Register(SubModuleName, 27, "packet not found")
 )
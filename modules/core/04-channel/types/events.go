@@ -38,6 +38,10 @@ const (
 	AttributeKeyDstChannel       = "packet_dst_channel"
 	AttributeKeyChannelOrdering  = "packet_channel_ordering"
 	AttributeKeyConnection       = "packet_connection"
+
+	EventTypePruneAcknowledgements   = "prune_acknowledgements"
+	AttributeKeyPruningSequenceStart = "pruning_sequence_start"
+	AttributeKeyRecvStartSequence    = "recv_start_sequence"
 )
 
 // IBC channel events vars
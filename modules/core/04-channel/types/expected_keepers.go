@@ -13,6 +13,7 @@ type ClientKeeper interface {
 	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
 	GetClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) (exported.ConsensusState, bool)
 	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+	GetClientStatus(ctx sdk.Context, clientState exported.ClientState, clientID string) exported.Status
 }
 
 // ConnectionKeeper expected account IBC connection keeper
@@ -70,6 +71,15 @@ type ConnectionKeeper interface {
 		channelID string,
 		nextSequenceRecv uint64,
 	) error
+	VerifyNextSequenceAck(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		nextSequenceAck uint64,
+	) error
 }
 
 // PortKeeper expected account IBC port keeper
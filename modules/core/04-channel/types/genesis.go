@@ -44,7 +44,7 @@ func (ps PacketSequence) Validate() error {
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	channels []IdentifiedChannel, acks, receipts, commitments []PacketState,
-	sendSeqs, recvSeqs, ackSeqs []PacketSequence, nextChannelSequence uint64,
+	sendSeqs, recvSeqs, ackSeqs []PacketSequence, nextChannelSequence uint64, params Params,
 ) GenesisState {
 	return GenesisState{
 		Channels:            channels,
@@ -54,20 +54,25 @@ func NewGenesisState(
 		RecvSequences:       recvSeqs,
 		AckSequences:        ackSeqs,
 		NextChannelSequence: nextChannelSequence,
+		Params:              params,
 	}
 }
 
 // DefaultGenesisState returns the ibc channel submodule's default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Channels:            []IdentifiedChannel{},
-		Acknowledgements:    []PacketState{},
-		Receipts:            []PacketState{},
-		Commitments:         []PacketState{},
-		SendSequences:       []PacketSequence{},
-		RecvSequences:       []PacketSequence{},
-		AckSequences:        []PacketSequence{},
-		NextChannelSequence: 0,
+		Channels:              []IdentifiedChannel{},
+		Acknowledgements:      []PacketState{},
+		Receipts:              []PacketState{},
+		Commitments:           []PacketState{},
+		SendSequences:         []PacketSequence{},
+		RecvSequences:         []PacketSequence{},
+		AckSequences:          []PacketSequence{},
+		NextChannelSequence:   0,
+		Params:                DefaultParams(),
+		RecvStartSequences:    []PacketSequence{},
+		PruningSequenceStarts: []PacketSequence{},
+		StoredPackets:         []Packet{},
 	}
 }
 
@@ -139,6 +144,28 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, rss := range gs.RecvStartSequences {
+		if err := rss.Validate(); err != nil {
+			return fmt.Errorf("invalid recv start sequence %v index %d: %w", rss, i, err)
+		}
+	}
+
+	for i, pss := range gs.PruningSequenceStarts {
+		if err := pss.Validate(); err != nil {
+			return fmt.Errorf("invalid pruning sequence start %v index %d: %w", pss, i, err)
+		}
+	}
+
+	for i, packet := range gs.StoredPackets {
+		if err := packet.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid stored packet %v index %d: %w", packet, i, err)
+		}
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
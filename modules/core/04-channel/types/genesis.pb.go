@@ -34,6 +34,13 @@ type GenesisState struct {
 	AckSequences     []PacketSequence    `protobuf:"bytes,7,rep,name=ack_sequences,json=ackSequences,proto3" json:"ack_sequences" yaml:"ack_sequences"`
 	// the sequence for the next generated channel identifier
 	NextChannelSequence uint64 `protobuf:"varint,8,opt,name=next_channel_sequence,json=nextChannelSequence,proto3" json:"next_channel_sequence,omitempty" yaml:"next_channel_sequence"`
+	Params              Params `protobuf:"bytes,9,opt,name=params,proto3" json:"params"`
+	// the sequences below which packets are no longer received on unordered channels
+	RecvStartSequences []PacketSequence `protobuf:"bytes,10,rep,name=recv_start_sequences,json=recvStartSequences,proto3" json:"recv_start_sequences" yaml:"recv_start_sequences"`
+	// the sequences from which the next pruning of receipts and acknowledgements starts
+	PruningSequenceStarts []PacketSequence `protobuf:"bytes,11,rep,name=pruning_sequence_starts,json=pruningSequenceStarts,proto3" json:"pruning_sequence_starts" yaml:"pruning_sequence_starts"`
+	// the sent packets stored until they are acknowledged or timed out
+	StoredPackets []Packet `protobuf:"bytes,12,rep,name=stored_packets,json=storedPackets,proto3" json:"stored_packets" yaml:"stored_packets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,6 +132,34 @@ func (m *GenesisState) GetNextChannelSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetRecvStartSequences() []PacketSequence {
+	if m != nil {
+		return m.RecvStartSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPruningSequenceStarts() []PacketSequence {
+	if m != nil {
+		return m.PruningSequenceStarts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStoredPackets() []Packet {
+	if m != nil {
+		return m.StoredPackets
+	}
+	return nil
+}
+
 // PacketSequence defines the genesis type necessary to retrieve and store
 // next send and receive sequences.
 type PacketSequence struct {
@@ -195,39 +230,47 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/genesis.proto", fileDescriptor_cb06ec201f452595) }
 
 var fileDescriptor_cb06ec201f452595 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0x87, 0xe3, 0x26, 0x4d, 0xd3, 0x6d, 0x13, 0xd1, 0x6d, 0x23, 0x99, 0xa8, 0xd8, 0xc1, 0x48,
-	0x28, 0x12, 0xaa, 0x4d, 0x21, 0x17, 0x38, 0x9a, 0x03, 0xe4, 0x86, 0xb6, 0x9c, 0x90, 0x50, 0x64,
-	0xaf, 0xa7, 0xee, 0x2a, 0xb1, 0x37, 0x78, 0x37, 0x81, 0x3e, 0x05, 0x3c, 0x56, 0x8f, 0x3d, 0x72,
-	0xb2, 0x50, 0xf2, 0x06, 0x39, 0x72, 0x42, 0xfe, 0x9b, 0x44, 0x8d, 0x10, 0xed, 0xcd, 0x3b, 0xf3,
-	0x9b, 0xef, 0x9b, 0x83, 0x07, 0x3d, 0x65, 0x2e, 0xb5, 0x28, 0x8f, 0xc0, 0xa2, 0x57, 0x4e, 0x18,
-	0xc2, 0xd8, 0x9a, 0x9d, 0x5b, 0x3e, 0x84, 0x20, 0x98, 0x30, 0x27, 0x11, 0x97, 0x1c, 0x1f, 0x33,
-	0x97, 0x9a, 0x49, 0xc4, 0xcc, 0x23, 0xe6, 0xec, 0xbc, 0x73, 0xe2, 0x73, 0x9f, 0xa7, 0x7d, 0x2b,
-	0xf9, 0xca, 0xa2, 0x9d, 0xad, 0xb4, 0x62, 0x2a, 0x8d, 0x18, 0x8b, 0x5d, 0x74, 0xf8, 0x3e, 0xe3,
-	0x5f, 0x48, 0x47, 0x02, 0xfe, 0x82, 0x1a, 0x79, 0x42, 0xa8, 0x4a, 0xb7, 0xda, 0x3b, 0x78, 0xf5,
-	0xdc, 0xdc, 0x62, 0x34, 0x07, 0x1e, 0x84, 0x92, 0x5d, 0x32, 0xf0, 0xde, 0x65, 0x45, 0xfb, 0xf1,
-	0x4d, 0xac, 0x57, 0xfe, 0xc4, 0xfa, 0xd1, 0x9d, 0x16, 0x29, 0x91, 0x98, 0xa0, 0x47, 0x0e, 0x1d,
-	0x85, 0xfc, 0xdb, 0x18, 0x3c, 0x1f, 0x02, 0x08, 0xa5, 0x50, 0x77, 0x52, 0x4d, 0x77, 0xab, 0xe6,
-	0xa3, 0x43, 0x47, 0x20, 0xd3, 0xd5, 0xec, 0x5a, 0x22, 0x20, 0x77, 0xe6, 0xf1, 0x07, 0x74, 0x40,
-	0x79, 0x10, 0x30, 0x99, 0xe1, 0xaa, 0xf7, 0xc2, 0xad, 0x8f, 0x62, 0x1b, 0x35, 0x22, 0xa0, 0xc0,
-	0x26, 0x52, 0xa8, 0xb5, 0x7b, 0x61, 0xca, 0x39, 0xcc, 0x50, 0x4b, 0x40, 0xe8, 0x0d, 0x05, 0x7c,
-	0x9d, 0x42, 0x48, 0x41, 0xa8, 0xbb, 0x29, 0xe9, 0xd9, 0xbf, 0x48, 0x79, 0xd6, 0x7e, 0x92, 0xc0,
-	0x96, 0xb1, 0xde, 0xbe, 0x76, 0x82, 0xf1, 0x5b, 0x63, 0x13, 0x64, 0x90, 0x66, 0x52, 0x28, 0xc2,
-	0xa9, 0x2a, 0x02, 0x3a, 0x5b, 0x53, 0xd5, 0x1f, 0xac, 0xda, 0x04, 0x19, 0xa4, 0x99, 0x14, 0x56,
-	0xaa, 0x4b, 0xd4, 0x74, 0xe8, 0x68, 0xcd, 0xb4, 0xf7, 0xff, 0xa6, 0xd3, 0xdc, 0x74, 0x92, 0x99,
-	0x36, 0x38, 0x06, 0x39, 0x74, 0xe8, 0x68, 0xe5, 0xf9, 0x84, 0xda, 0x21, 0x7c, 0x97, 0xc3, 0x9c,
-	0x56, 0x06, 0xd5, 0x46, 0x57, 0xe9, 0xd5, 0xec, 0xee, 0x32, 0xd6, 0x4f, 0x33, 0xcc, 0xd6, 0x98,
-	0x41, 0x8e, 0x93, 0x7a, 0xfe, 0xdf, 0x15, 0x58, 0xe3, 0x87, 0x82, 0x5a, 0x9b, 0x4b, 0xe1, 0x17,
-	0x68, 0x6f, 0xc2, 0x23, 0x39, 0x64, 0x9e, 0xaa, 0x74, 0x95, 0xde, 0xbe, 0x8d, 0x97, 0xb1, 0xde,
-	0xca, 0xd0, 0x79, 0xc3, 0x20, 0xf5, 0xe4, 0x6b, 0xe0, 0xe1, 0x3e, 0x42, 0x85, 0x89, 0x79, 0xea,
-	0x4e, 0x9a, 0x6f, 0x2f, 0x63, 0xfd, 0x28, 0xcb, 0xaf, 0x7a, 0x06, 0xd9, 0xcf, 0x1f, 0x03, 0x0f,
-	0x77, 0x50, 0xa3, 0x5c, 0xbf, 0x9a, 0xac, 0x4f, 0xca, 0xb7, 0x7d, 0x71, 0x33, 0xd7, 0x94, 0xdb,
-	0xb9, 0xa6, 0xfc, 0x9e, 0x6b, 0xca, 0xcf, 0x85, 0x56, 0xb9, 0x5d, 0x68, 0x95, 0x5f, 0x0b, 0xad,
-	0xf2, 0xf9, 0x8d, 0xcf, 0xe4, 0xd5, 0xd4, 0x35, 0x29, 0x0f, 0x2c, 0xca, 0x45, 0xc0, 0x85, 0xc5,
-	0x5c, 0x7a, 0xe6, 0x73, 0x6b, 0xd6, 0xb7, 0x02, 0xee, 0x4d, 0xc7, 0x20, 0xb2, 0xa3, 0x7e, 0xd9,
-	0x3f, 0x2b, 0xee, 0x5a, 0x5e, 0x4f, 0x40, 0xb8, 0xf5, 0xf4, 0xa6, 0x5f, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0x37, 0x95, 0x0a, 0xe0, 0x46, 0x04, 0x00, 0x00,
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4d, 0x4f, 0xdb, 0x4c,
+	0x10, 0xc7, 0x63, 0xc2, 0x13, 0x92, 0x0d, 0x44, 0x0f, 0x0b, 0x51, 0x5d, 0xa0, 0x8e, 0x6b, 0x24,
+	0x14, 0xa9, 0xc2, 0x2e, 0x94, 0x0b, 0x3d, 0xba, 0x87, 0x96, 0x1b, 0x32, 0x3d, 0x55, 0xaa, 0x22,
+	0x67, 0xbd, 0x98, 0x55, 0x62, 0xaf, 0xeb, 0xdd, 0xa4, 0xa5, 0x57, 0x3e, 0x40, 0xfb, 0xb1, 0x38,
+	0x72, 0xec, 0x29, 0xaa, 0xe0, 0x1b, 0xe4, 0xd8, 0x53, 0xb5, 0x2f, 0x79, 0x13, 0x01, 0x85, 0xde,
+	0xbc, 0x33, 0xff, 0xf9, 0xfd, 0x77, 0xc6, 0xa3, 0x05, 0x2f, 0x49, 0x1b, 0x79, 0x88, 0xe6, 0xd8,
+	0x43, 0x17, 0x61, 0x9a, 0xe2, 0xae, 0xd7, 0x3f, 0xf0, 0x62, 0x9c, 0x62, 0x46, 0x98, 0x9b, 0xe5,
+	0x94, 0x53, 0xb8, 0x41, 0xda, 0xc8, 0x15, 0x12, 0x57, 0x4b, 0xdc, 0xfe, 0xc1, 0xd6, 0x66, 0x4c,
+	0x63, 0x2a, 0xf3, 0x9e, 0xf8, 0x52, 0xd2, 0xad, 0xb9, 0xb4, 0x51, 0x95, 0x94, 0x38, 0x57, 0x15,
+	0xb0, 0xfa, 0x5e, 0xf1, 0xcf, 0x78, 0xc8, 0x31, 0xfc, 0x0c, 0xca, 0x5a, 0xc1, 0x4c, 0xc3, 0x2e,
+	0x36, 0xab, 0x87, 0x7b, 0xee, 0x1c, 0x47, 0xf7, 0x24, 0xc2, 0x29, 0x27, 0xe7, 0x04, 0x47, 0xef,
+	0x54, 0xd0, 0x7f, 0x7e, 0x3d, 0x68, 0x14, 0xfe, 0x0c, 0x1a, 0xeb, 0xf7, 0x52, 0xc1, 0x18, 0x09,
+	0x03, 0xf0, 0x7f, 0x88, 0x3a, 0x29, 0xfd, 0xda, 0xc5, 0x51, 0x8c, 0x13, 0x9c, 0x72, 0x66, 0x2e,
+	0x49, 0x1b, 0x7b, 0xae, 0xcd, 0x69, 0x88, 0x3a, 0x98, 0xcb, 0xab, 0xf9, 0xcb, 0xc2, 0x20, 0xb8,
+	0x57, 0x0f, 0x3f, 0x80, 0x2a, 0xa2, 0x49, 0x42, 0xb8, 0xc2, 0x15, 0x9f, 0x84, 0x9b, 0x2e, 0x85,
+	0x3e, 0x28, 0xe7, 0x18, 0x61, 0x92, 0x71, 0x66, 0x2e, 0x3f, 0x09, 0x33, 0xae, 0x83, 0x04, 0xd4,
+	0x18, 0x4e, 0xa3, 0x16, 0xc3, 0x5f, 0x7a, 0x38, 0x45, 0x98, 0x99, 0xff, 0x49, 0xd2, 0xee, 0x63,
+	0x24, 0xad, 0xf5, 0x5f, 0x08, 0xd8, 0x70, 0xd0, 0xa8, 0x5f, 0x86, 0x49, 0xf7, 0xad, 0x33, 0x0b,
+	0x72, 0x82, 0x35, 0x11, 0x18, 0x89, 0xa5, 0x55, 0x8e, 0x51, 0x7f, 0xca, 0xaa, 0xf4, 0xcf, 0x56,
+	0xb3, 0x20, 0x27, 0x58, 0x13, 0x81, 0x89, 0xd5, 0x39, 0x58, 0x0b, 0x51, 0x67, 0xca, 0x69, 0x65,
+	0x71, 0xa7, 0x1d, 0xed, 0xb4, 0xa9, 0x9c, 0x66, 0x38, 0x4e, 0xb0, 0x1a, 0xa2, 0xce, 0xc4, 0xe7,
+	0x23, 0xa8, 0xa7, 0xf8, 0x1b, 0x6f, 0x69, 0xda, 0x58, 0x68, 0x96, 0x6d, 0xa3, 0xb9, 0xec, 0xdb,
+	0xc3, 0x41, 0x63, 0x47, 0x61, 0xe6, 0xca, 0x9c, 0x60, 0x43, 0xc4, 0xf5, 0xde, 0x8d, 0xb0, 0xf0,
+	0x18, 0x94, 0xb2, 0x30, 0x0f, 0x13, 0x66, 0x56, 0x6c, 0xa3, 0x59, 0x3d, 0xdc, 0x7e, 0xe0, 0xda,
+	0x42, 0xa2, 0x7f, 0xa8, 0x2e, 0x80, 0xdf, 0xc1, 0xa6, 0x1a, 0x0d, 0x0f, 0x73, 0x3e, 0xd5, 0x3f,
+	0x58, 0xbc, 0xff, 0x5d, 0xdd, 0xff, 0xf6, 0xf4, 0xa4, 0x67, 0x71, 0x4e, 0x00, 0xe5, 0xbc, 0x45,
+	0x74, 0x32, 0x8c, 0x2b, 0x03, 0x3c, 0xcb, 0xf2, 0x5e, 0x4a, 0xd2, 0x78, 0x2c, 0x55, 0x95, 0xcc,
+	0xac, 0x2e, 0xee, 0xbf, 0xa7, 0xfd, 0x2d, 0xe5, 0xff, 0x00, 0xd1, 0x09, 0xea, 0x3a, 0x33, 0x2a,
+	0x94, 0xb7, 0x61, 0x30, 0x04, 0x35, 0xc6, 0x69, 0x8e, 0xa3, 0x56, 0x26, 0xb9, 0xcc, 0x5c, 0xb5,
+	0x8b, 0x8f, 0x0c, 0x51, 0x68, 0xee, 0x2d, 0xf2, 0x0c, 0x40, 0x2c, 0xb2, 0x0c, 0x9c, 0xea, 0xf3,
+	0x0f, 0x03, 0xd4, 0x66, 0x2f, 0x0d, 0x5f, 0x81, 0x95, 0x8c, 0xe6, 0xbc, 0x45, 0x22, 0xd3, 0xb0,
+	0x8d, 0x66, 0xc5, 0x87, 0xc3, 0x41, 0xa3, 0xa6, 0x3b, 0x50, 0x09, 0x27, 0x28, 0x89, 0xaf, 0x93,
+	0x08, 0x1e, 0x01, 0x30, 0xda, 0x04, 0x12, 0x99, 0x4b, 0x52, 0x5f, 0x1f, 0x0e, 0x1a, 0xeb, 0x4a,
+	0x3f, 0xc9, 0x39, 0x41, 0x45, 0x1f, 0x4e, 0x22, 0xb8, 0x05, 0xca, 0xe3, 0xf5, 0x2a, 0x8a, 0xf5,
+	0x0a, 0xc6, 0x67, 0xff, 0xec, 0xfa, 0xd6, 0x32, 0x6e, 0x6e, 0x2d, 0xe3, 0xf7, 0xad, 0x65, 0xfc,
+	0xbc, 0xb3, 0x0a, 0x37, 0x77, 0x56, 0xe1, 0xd7, 0x9d, 0x55, 0xf8, 0x74, 0x1c, 0x13, 0x7e, 0xd1,
+	0x6b, 0xbb, 0x88, 0x26, 0x1e, 0xa2, 0x2c, 0xa1, 0xcc, 0x23, 0x6d, 0xb4, 0x1f, 0x53, 0xaf, 0x7f,
+	0xe4, 0x25, 0x34, 0xea, 0x75, 0x31, 0x53, 0x8f, 0xee, 0xeb, 0xa3, 0xfd, 0xd1, 0xbb, 0xcb, 0x2f,
+	0x33, 0xcc, 0xda, 0x25, 0xf9, 0xe6, 0xbe, 0xf9, 0x3b, 0x00, 0x34, 0xf9, 0x2b, 0x92, 0xe6, 0x05,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -250,6 +293,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StoredPackets) > 0 {
+		for iNdEx := len(m.StoredPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoredPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PruningSequenceStarts) > 0 {
+		for iNdEx := len(m.PruningSequenceStarts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PruningSequenceStarts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RecvStartSequences) > 0 {
+		for iNdEx := len(m.RecvStartSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvStartSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if m.NextChannelSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextChannelSequence))
 		i--
@@ -460,6 +555,26 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextChannelSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.NextChannelSequence))
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RecvStartSequences) > 0 {
+		for _, e := range m.RecvStartSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PruningSequenceStarts) > 0 {
+		for _, e := range m.PruningSequenceStarts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StoredPackets) > 0 {
+		for _, e := range m.StoredPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -775,6 +890,141 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvStartSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvStartSequences = append(m.RecvStartSequences, PacketSequence{})
+			if err := m.RecvStartSequences[len(m.RecvStartSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningSequenceStarts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruningSequenceStarts = append(m.PruningSequenceStarts, PacketSequence{})
+			if err := m.PruningSequenceStarts[len(m.PruningSequenceStarts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoredPackets = append(m.StoredPackets, Packet{})
+			if err := m.StoredPackets[len(m.StoredPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
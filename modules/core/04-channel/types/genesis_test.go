@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 )
 
@@ -67,6 +68,7 @@ func TestValidateGenesis(t *testing.T) {
 					types.NewPacketSequence(testPort2, testChannel2, 1),
 				},
 				2,
+				types.DefaultParams(),
 			),
 			expPass: true,
 		},
@@ -137,6 +139,53 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expPass: false,
 		},
+		{
+			name: "invalid recv start seq",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				RecvStartSequences: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, testChannel1, 0),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid pruning seq start",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				PruningSequenceStarts: []types.PacketSequence{
+					types.NewPacketSequence("(testPort1)", testChannel1, 1),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid stored packet",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				StoredPackets: []types.Packet{
+					types.NewPacket([]byte("data"), 1, testPort1, testChannel1, testPort2, testChannel2, clienttypes.NewHeight(0, 10), 0),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid stored packet",
+			genState: types.GenesisState{
+				Params: types.DefaultParams(),
+				StoredPackets: []types.Packet{
+					types.NewPacket([]byte("data"), 0, testPort1, testChannel1, testPort2, testChannel2, clienttypes.NewHeight(0, 10), 0),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid params",
+			genState: types.GenesisState{
+				Params: types.NewParams(0, 0, 0),
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid channel identifier",
 			genState: types.NewGenesisState(
@@ -171,6 +220,7 @@ func TestValidateGenesis(t *testing.T) {
 					types.NewPacketSequence(testPort2, testChannel2, 1),
 				},
 				0,
+				types.DefaultParams(),
 			),
 			expPass: false,
 		},
@@ -208,6 +258,7 @@ func TestValidateGenesis(t *testing.T) {
 					types.NewPacketSequence(testPort2, testChannel2, 1),
 				},
 				0,
+				types.DefaultParams(),
 			),
 			expPass: false,
 		},
@@ -493,3 +493,62 @@ func (msg MsgAcknowledgement) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+var _ sdk.Msg = &MsgPruneAcknowledgements{}
+
+// NewMsgPruneAcknowledgements constructs a new MsgPruneAcknowledgements
+// nolint:interfacer
+func NewMsgPruneAcknowledgements(
+	portID, channelID string,
+	nextSequenceAck uint64,
+	proofNextSequenceAck []byte,
+	proofHeight clienttypes.Height,
+	limit uint64,
+	signer string,
+) *MsgPruneAcknowledgements {
+	return &MsgPruneAcknowledgements{
+		PortId:               portID,
+		ChannelId:            channelID,
+		NextSequenceAck:      nextSequenceAck,
+		ProofNextSequenceAck: proofNextSequenceAck,
+		ProofHeight:          proofHeight,
+		Limit:                limit,
+		Signer:               signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg. A proof of the counterparty next sequence
+// acknowledgement is only required if a non-zero sequence is provided.
+func (msg MsgPruneAcknowledgements) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if msg.NextSequenceAck != 0 {
+		if len(msg.ProofNextSequenceAck) == 0 {
+			return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof")
+		}
+		if msg.ProofHeight.IsZero() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+		}
+	}
+	if msg.Limit == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "limit cannot be 0")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgPruneAcknowledgements) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
@@ -438,3 +438,34 @@ func (suite *TypesTestSuite) TestMsgAcknowledgementValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgPruneAcknowledgements
+		expPass bool
+	}{
+		{"success", types.NewMsgPruneAcknowledgements(portid, chanid, 10, suite.proof, height, 100, addr), true},
+		{"success: no next sequence ack", types.NewMsgPruneAcknowledgements(portid, chanid, 0, nil, clienttypes.ZeroHeight(), 100, addr), true},
+		{"port id contains non-alpha", types.NewMsgPruneAcknowledgements(invalidPort, chanid, 10, suite.proof, height, 100, addr), false},
+		{"channel id contains non-alpha", types.NewMsgPruneAcknowledgements(portid, invalidChannel, 10, suite.proof, height, 100, addr), false},
+		{"cannot submit an empty proof", types.NewMsgPruneAcknowledgements(portid, chanid, 10, emptyProof, height, 100, addr), false},
+		{"proof height must be > 0", types.NewMsgPruneAcknowledgements(portid, chanid, 10, suite.proof, clienttypes.ZeroHeight(), 100, addr), false},
+		{"limit is zero", types.NewMsgPruneAcknowledgements(portid, chanid, 10, suite.proof, height, 0, addr), false},
+		{"missing signer address", types.NewMsgPruneAcknowledgements(portid, chanid, 10, suite.proof, height, 100, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
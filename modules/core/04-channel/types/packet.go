@@ -109,6 +109,9 @@ func (p Packet) ValidateBasic() error {
 	if len(p.Data) == 0 {
 		return sdkerrors.Wrap(ErrInvalidPacket, "packet data bytes cannot be empty")
 	}
+	if len(p.Data) > MaximumPacketDataSize {
+		return sdkerrors.Wrapf(ErrPacketDataTooLarge, "packet data bytes cannot exceed %d bytes, got %d", MaximumPacketDataSize, len(p.Data))
+	}
 	return nil
 }
 
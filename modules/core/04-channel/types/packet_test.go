@@ -40,6 +40,8 @@ func TestPacketValidateBasic(t *testing.T) {
 		{types.NewPacket(validPacketData, 1, portid, chanid, cpportid, cpchanid, disabledTimeout, timeoutTimestamp), true, "disabled timeout height, valid timeout timestamp"},
 		{types.NewPacket(validPacketData, 1, portid, chanid, cpportid, cpchanid, timeoutHeight, 0), true, "disabled timeout timestamp, valid timeout height"},
 		{types.NewPacket(unknownPacketData, 1, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp), true, ""},
+		{types.NewPacket(make([]byte, types.MaximumPacketDataSize), 1, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp), true, "maximum packet data size"},
+		{types.NewPacket(make([]byte, types.MaximumPacketDataSize+1), 1, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp), false, "packet data exceeds maximum size"},
 	}
 
 	for i, tc := range testCases {
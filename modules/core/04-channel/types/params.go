@@ -0,0 +1,114 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	// MaximumPacketDataSize is the maximum size (in bytes) of packet data enforced by
+	// the packet basic validation. The MaxPacketDataSize parameter cannot exceed it.
+	MaximumPacketDataSize = 1024 * 1024
+
+	// DefaultMaxPacketDataSize is the default maximum size (in bytes) of the data of packets sent.
+	DefaultMaxPacketDataSize = 512 * 1024
+
+	// DefaultRecvPacketGasPerByte is the default amount of gas consumed per byte of packet data
+	// received. Gas metering by packet data size is disabled by default.
+	DefaultRecvPacketGasPerByte = 0
+
+	// MaximumRecvPacketGasPerByte is the maximum amount of gas consumed per byte of packet data
+	// received. The RecvPacketGasPerByte parameter cannot exceed it.
+	MaximumRecvPacketGasPerByte = 10_000
+
+	// DefaultMaxStoredPackets is the default maximum number of sent packets stored per channel.
+	// The storage of sent packets is disabled by default.
+	DefaultMaxStoredPackets = 0
+)
+
+var (
+	// KeyMaxPacketDataSize is store's key for MaxPacketDataSize parameter
+	KeyMaxPacketDataSize = []byte("MaxPacketDataSize")
+	// KeyRecvPacketGasPerByte is store's key for RecvPacketGasPerByte parameter
+	KeyRecvPacketGasPerByte = []byte("RecvPacketGasPerByte")
+	// KeyMaxStoredPackets is store's key for MaxStoredPackets parameter
+	KeyMaxStoredPackets = []byte("MaxStoredPackets")
+)
+
+// ParamKeyTable type declaration for parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the ibc channel module
+func NewParams(maxPacketDataSize, recvPacketGasPerByte, maxStoredPackets uint64) Params {
+	return Params{
+		MaxPacketDataSize:    maxPacketDataSize,
+		RecvPacketGasPerByte: recvPacketGasPerByte,
+		MaxStoredPackets:     maxStoredPackets,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the ibc channel module
+func DefaultParams() Params {
+	return NewParams(DefaultMaxPacketDataSize, DefaultRecvPacketGasPerByte, DefaultMaxStoredPackets)
+}
+
+// Validate ensures MaxPacketDataSize is non-zero and does not exceed MaximumPacketDataSize
+// and that RecvPacketGasPerByte does not exceed MaximumRecvPacketGasPerByte
+func (p Params) Validate() error {
+	if err := validateMaxPacketDataSize(p.MaxPacketDataSize); err != nil {
+		return err
+	}
+
+	return validateRecvPacketGasPerByte(p.RecvPacketGasPerByte)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxPacketDataSize, p.MaxPacketDataSize, validateMaxPacketDataSize),
+		paramtypes.NewParamSetPair(KeyRecvPacketGasPerByte, p.RecvPacketGasPerByte, validateRecvPacketGasPerByte),
+		paramtypes.NewParamSetPair(KeyMaxStoredPackets, p.MaxStoredPackets, validateMaxStoredPackets),
+	}
+}
+
+func validateMaxPacketDataSize(i interface{}) error {
+	maxPacketDataSize, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter. expected %T, got type: %T", uint64(1), i)
+	}
+
+	if maxPacketDataSize == 0 {
+		return fmt.Errorf("MaxPacketDataSize cannot be zero")
+	}
+
+	if maxPacketDataSize > MaximumPacketDataSize {
+		return fmt.Errorf("MaxPacketDataSize cannot exceed %d bytes, got %d", MaximumPacketDataSize, maxPacketDataSize)
+	}
+
+	return nil
+}
+
+func validateRecvPacketGasPerByte(i interface{}) error {
+	recvPacketGasPerByte, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter. expected %T, got type: %T", uint64(1), i)
+	}
+
+	if recvPacketGasPerByte > MaximumRecvPacketGasPerByte {
+		return fmt.Errorf("RecvPacketGasPerByte cannot exceed %d, got %d", MaximumRecvPacketGasPerByte, recvPacketGasPerByte)
+	}
+
+	return nil
+}
+
+func validateMaxStoredPackets(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter. expected %T, got type: %T", uint64(1), i)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+func TestValidateParams(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{"default params", types.DefaultParams(), true},
+		{"custom params", types.NewParams(1024, 10, 100), true},
+		{"maximum packet data size", types.NewParams(types.MaximumPacketDataSize, 0, 0), true},
+		{"zero max packet data size", types.NewParams(0, 10, 100), false},
+		{"max packet data size exceeds maximum", types.NewParams(types.MaximumPacketDataSize+1, 10, 100), false},
+		{"maximum recv packet gas per byte", types.NewParams(1024, types.MaximumRecvPacketGasPerByte, 100), true},
+		{"recv packet gas per byte exceeds maximum", types.NewParams(1024, types.MaximumRecvPacketGasPerByte+1, 100), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
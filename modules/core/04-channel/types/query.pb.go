@@ -1676,6 +1676,314 @@ func (m *QueryNextSequenceReceiveResponse) GetProofHeight() types.Height {
 	return types.Height{}
 }
 
+// QueryChannelParamsRequest is the request type for the Query/ChannelParams RPC
+// method.
+type QueryChannelParamsRequest struct {
+}
+
+func (m *QueryChannelParamsRequest) Reset()         { *m = QueryChannelParamsRequest{} }
+func (m *QueryChannelParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelParamsRequest) ProtoMessage()    {}
+func (*QueryChannelParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{26}
+}
+func (m *QueryChannelParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelParamsRequest.Merge(m, src)
+}
+func (m *QueryChannelParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelParamsRequest proto.InternalMessageInfo
+
+// QueryChannelParamsResponse is the response type for the Query/ChannelParams RPC
+// method.
+type QueryChannelParamsResponse struct {
+	// params defines the parameters of the module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryChannelParamsResponse) Reset()         { *m = QueryChannelParamsResponse{} }
+func (m *QueryChannelParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelParamsResponse) ProtoMessage()    {}
+func (*QueryChannelParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{27}
+}
+func (m *QueryChannelParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelParamsResponse.Merge(m, src)
+}
+func (m *QueryChannelParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelParamsResponse proto.InternalMessageInfo
+
+func (m *QueryChannelParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// QueryPrunableRangeRequest is the request type for the Query/PrunableRange RPC
+// method
+type QueryPrunableRangeRequest struct {
+	// port unique identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel unique identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryPrunableRangeRequest) Reset()         { *m = QueryPrunableRangeRequest{} }
+func (m *QueryPrunableRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPrunableRangeRequest) ProtoMessage()    {}
+func (*QueryPrunableRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{28}
+}
+func (m *QueryPrunableRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrunableRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrunableRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPrunableRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrunableRangeRequest.Merge(m, src)
+}
+func (m *QueryPrunableRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrunableRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrunableRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrunableRangeRequest proto.InternalMessageInfo
+
+func (m *QueryPrunableRangeRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryPrunableRangeRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryPrunableRangeResponse is the response type for the Query/PrunableRange RPC
+// method. Packet receipts and acknowledgements with sequences in the range
+// [pruning_sequence_start, pruning_sequence_end) can be pruned.
+type QueryPrunableRangeResponse struct {
+	// the sequence from which the next pruning starts
+	PruningSequenceStart uint64 `protobuf:"varint,1,opt,name=pruning_sequence_start,json=pruningSequenceStart,proto3" json:"pruning_sequence_start,omitempty"`
+	// the sequence below which packets are no longer received
+	PruningSequenceEnd uint64 `protobuf:"varint,2,opt,name=pruning_sequence_end,json=pruningSequenceEnd,proto3" json:"pruning_sequence_end,omitempty"`
+}
+
+func (m *QueryPrunableRangeResponse) Reset()         { *m = QueryPrunableRangeResponse{} }
+func (m *QueryPrunableRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPrunableRangeResponse) ProtoMessage()    {}
+func (*QueryPrunableRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{29}
+}
+func (m *QueryPrunableRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrunableRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrunableRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPrunableRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrunableRangeResponse.Merge(m, src)
+}
+func (m *QueryPrunableRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrunableRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrunableRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPrunableRangeResponse proto.InternalMessageInfo
+
+func (m *QueryPrunableRangeResponse) GetPruningSequenceStart() uint64 {
+	if m != nil {
+		return m.PruningSequenceStart
+	}
+	return 0
+}
+
+func (m *QueryPrunableRangeResponse) GetPruningSequenceEnd() uint64 {
+	if m != nil {
+		return m.PruningSequenceEnd
+	}
+	return 0
+}
+
+// QueryPacketRequest is the request type for the Query/Packet RPC method
+type QueryPacketRequest struct {
+	// port unique identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel unique identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// packet sequence
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryPacketRequest) Reset()         { *m = QueryPacketRequest{} }
+func (m *QueryPacketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketRequest) ProtoMessage()    {}
+func (*QueryPacketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{30}
+}
+func (m *QueryPacketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketRequest.Merge(m, src)
+}
+func (m *QueryPacketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketRequest proto.InternalMessageInfo
+
+func (m *QueryPacketRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryPacketRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryPacketRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryPacketResponse is the response type for the Query/Packet RPC method
+type QueryPacketResponse struct {
+	// sent packet associated with the request fields
+	Packet Packet `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+}
+
+func (m *QueryPacketResponse) Reset()         { *m = QueryPacketResponse{} }
+func (m *QueryPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketResponse) ProtoMessage()    {}
+func (*QueryPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{31}
+}
+func (m *QueryPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketResponse.Merge(m, src)
+}
+func (m *QueryPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketResponse proto.InternalMessageInfo
+
+func (m *QueryPacketResponse) GetPacket() Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return Packet{}
+}
+
 func init() {
 	proto.RegisterType((*QueryChannelRequest)(nil), "ibc.core.channel.v1.QueryChannelRequest")
 	proto.RegisterType((*QueryChannelResponse)(nil), "ibc.core.channel.v1.QueryChannelResponse")
@@ -1703,106 +2011,124 @@ func init() {
 	proto.RegisterType((*QueryUnreceivedAcksResponse)(nil), "ibc.core.channel.v1.QueryUnreceivedAcksResponse")
 	proto.RegisterType((*QueryNextSequenceReceiveRequest)(nil), "ibc.core.channel.v1.QueryNextSequenceReceiveRequest")
 	proto.RegisterType((*QueryNextSequenceReceiveResponse)(nil), "ibc.core.channel.v1.QueryNextSequenceReceiveResponse")
+	proto.RegisterType((*QueryChannelParamsRequest)(nil), "ibc.core.channel.v1.QueryChannelParamsRequest")
+	proto.RegisterType((*QueryChannelParamsResponse)(nil), "ibc.core.channel.v1.QueryChannelParamsResponse")
+	proto.RegisterType((*QueryPrunableRangeRequest)(nil), "ibc.core.channel.v1.QueryPrunableRangeRequest")
+	proto.RegisterType((*QueryPrunableRangeResponse)(nil), "ibc.core.channel.v1.QueryPrunableRangeResponse")
+	proto.RegisterType((*QueryPacketRequest)(nil), "ibc.core.channel.v1.QueryPacketRequest")
+	proto.RegisterType((*QueryPacketResponse)(nil), "ibc.core.channel.v1.QueryPacketResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/query.proto", fileDescriptor_1034a1e9abc4cca1) }
 
 var fileDescriptor_1034a1e9abc4cca1 = []byte{
-	// 1490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xcf, 0x38, 0x06, 0x92, 0x07, 0x5f, 0x7e, 0x4c, 0x12, 0x08, 0x4b, 0x70, 0x82, 0xbf, 0x6a,
-	0x09, 0x48, 0xec, 0x90, 0x40, 0x29, 0xad, 0x5a, 0x24, 0x12, 0xa9, 0x90, 0xaa, 0xfc, 0xda, 0x14,
-	0x15, 0x90, 0x5a, 0x77, 0xbd, 0x1e, 0x9c, 0x55, 0xe2, 0xdd, 0xc5, 0xbb, 0x36, 0xa0, 0xd4, 0x55,
-	0xd5, 0x03, 0x45, 0xea, 0xa5, 0x2a, 0x87, 0x4a, 0xbd, 0x54, 0xea, 0x8d, 0x43, 0x0f, 0xfd, 0x0b,
-	0x7a, 0xe5, 0x56, 0x24, 0x7a, 0xa8, 0x84, 0x44, 0x2b, 0x82, 0x44, 0xaf, 0xbd, 0xf4, 0x5c, 0xed,
-	0xfc, 0x58, 0xef, 0xda, 0xbb, 0x1b, 0x3b, 0x8e, 0x25, 0xd4, 0xdb, 0xee, 0xec, 0xbc, 0x37, 0x9f,
-	0xcf, 0xe7, 0xcd, 0x7b, 0x79, 0xcf, 0x81, 0x49, 0xb3, 0x68, 0x10, 0xc3, 0xae, 0x52, 0x62, 0x2c,
-	0xe9, 0x96, 0x45, 0x57, 0x48, 0x7d, 0x86, 0xdc, 0xaa, 0xd1, 0xea, 0x5d, 0xd5, 0xa9, 0xda, 0x9e,
-	0x8d, 0x47, 0xcc, 0xa2, 0xa1, 0xfa, 0x1b, 0x54, 0xb1, 0x41, 0xad, 0xcf, 0x28, 0x21, 0xab, 0x15,
-	0x93, 0x5a, 0x9e, 0x6f, 0xc4, 0x9f, 0xb8, 0x95, 0x72, 0xd4, 0xb0, 0xdd, 0x8a, 0xed, 0x92, 0xa2,
-	0xee, 0x52, 0xee, 0x8e, 0xd4, 0x67, 0x8a, 0xd4, 0xd3, 0x67, 0x88, 0xa3, 0x97, 0x4d, 0x4b, 0xf7,
-	0x4c, 0xdb, 0x12, 0x7b, 0x0f, 0xc5, 0x41, 0x90, 0x87, 0xf1, 0x2d, 0x13, 0x65, 0xdb, 0x2e, 0xaf,
-	0x50, 0xa2, 0x3b, 0x26, 0xd1, 0x2d, 0xcb, 0xf6, 0x98, 0xbd, 0x2b, 0xbe, 0xee, 0x17, 0x5f, 0xd9,
-	0x5b, 0xb1, 0x76, 0x93, 0xe8, 0x96, 0x40, 0xaf, 0x8c, 0x96, 0xed, 0xb2, 0xcd, 0x1e, 0x89, 0xff,
-	0xc4, 0x57, 0xf3, 0x17, 0x60, 0xe4, 0x8a, 0x8f, 0x69, 0x9e, 0x1f, 0xa2, 0xd1, 0x5b, 0x35, 0xea,
-	0x7a, 0x78, 0x1f, 0x6c, 0x73, 0xec, 0xaa, 0x57, 0x30, 0x4b, 0xe3, 0x68, 0x0a, 0x4d, 0x0f, 0x6b,
-	0x5b, 0xfd, 0xd7, 0x85, 0x12, 0x3e, 0x08, 0x20, 0xf0, 0xf8, 0xdf, 0x32, 0xec, 0xdb, 0xb0, 0x58,
-	0x59, 0x28, 0xe5, 0x1f, 0x22, 0x18, 0x8d, 0xfa, 0x73, 0x1d, 0xdb, 0x72, 0x29, 0x3e, 0x05, 0xdb,
-	0xc4, 0x2e, 0xe6, 0x70, 0xfb, 0xec, 0x84, 0x1a, 0xa3, 0xa6, 0x2a, 0xcd, 0xe4, 0x66, 0x3c, 0x0a,
-	0x5b, 0x9c, 0xaa, 0x6d, 0xdf, 0x64, 0x47, 0xed, 0xd0, 0xf8, 0x0b, 0x9e, 0x87, 0x1d, 0xec, 0xa1,
-	0xb0, 0x44, 0xcd, 0xf2, 0x92, 0x37, 0x3e, 0xc8, 0x5c, 0x2a, 0x21, 0x97, 0x3c, 0x02, 0xf5, 0x19,
-	0xf5, 0x3c, 0xdb, 0x31, 0x97, 0x7d, 0xf4, 0x6c, 0x72, 0x40, 0xdb, 0xce, 0xac, 0xf8, 0x52, 0xfe,
-	0x93, 0x28, 0x54, 0x57, 0x72, 0x7f, 0x0f, 0xa0, 0x19, 0x18, 0x81, 0xf6, 0x75, 0x95, 0x47, 0x51,
-	0xf5, 0xa3, 0xa8, 0xf2, 0x4b, 0x21, 0xa2, 0xa8, 0x5e, 0xd6, 0xcb, 0x54, 0xd8, 0x6a, 0x21, 0xcb,
-	0xfc, 0x33, 0x04, 0x63, 0x2d, 0x07, 0x08, 0x31, 0xe6, 0x60, 0x48, 0xf0, 0x73, 0xc7, 0xd1, 0xd4,
-	0x20, 0xf3, 0x1f, 0xa7, 0xc6, 0x42, 0x89, 0x5a, 0x9e, 0x79, 0xd3, 0xa4, 0x25, 0xa9, 0x4b, 0x60,
-	0x87, 0xcf, 0x45, 0x50, 0x66, 0x18, 0xca, 0xc3, 0xeb, 0xa2, 0xe4, 0x00, 0xc2, 0x30, 0xf1, 0x69,
-	0xd8, 0xda, 0xa5, 0x8a, 0x62, 0x7f, 0xfe, 0x3e, 0x82, 0x1c, 0x27, 0x68, 0x5b, 0x16, 0x35, 0x7c,
-	0x6f, 0xad, 0x5a, 0xe6, 0x00, 0x8c, 0xe0, 0xa3, 0xb8, 0x4a, 0xa1, 0x95, 0x16, 0xad, 0x33, 0x1b,
-	0xd6, 0xfa, 0x2f, 0x04, 0x93, 0x89, 0x50, 0xfe, 0x5b, 0xaa, 0x5f, 0x93, 0xa2, 0x73, 0x4c, 0xf3,
-	0x6c, 0xf7, 0xa2, 0xa7, 0x7b, 0xb4, 0xd7, 0xe4, 0xfd, 0x23, 0x10, 0x31, 0xc6, 0xb5, 0x10, 0x51,
-	0x87, 0x7d, 0x66, 0xa0, 0x4f, 0x81, 0x43, 0x2d, 0xb8, 0xfe, 0x16, 0x91, 0x29, 0x47, 0xe2, 0x88,
-	0x84, 0x24, 0x0d, 0xf9, 0x1c, 0x33, 0xe3, 0x96, 0xfb, 0x99, 0xf2, 0x3f, 0x21, 0x38, 0x14, 0x61,
-	0xe8, 0x73, 0xb2, 0xdc, 0x9a, 0xbb, 0x19, 0xfa, 0xe1, 0xc3, 0xb0, 0xab, 0x4a, 0xeb, 0xa6, 0x6b,
-	0xda, 0x56, 0xc1, 0xaa, 0x55, 0x8a, 0xb4, 0xca, 0x50, 0x66, 0xb5, 0x9d, 0x72, 0xf9, 0x22, 0x5b,
-	0x8d, 0x6c, 0x14, 0x74, 0xb2, 0xd1, 0x8d, 0x02, 0xef, 0x53, 0x04, 0xf9, 0x34, 0xbc, 0x22, 0x28,
-	0xef, 0xc2, 0x2e, 0x43, 0x7e, 0x89, 0x04, 0x63, 0x54, 0xe5, 0x7f, 0x0f, 0x54, 0xf9, 0xf7, 0x40,
-	0x3d, 0x6b, 0xdd, 0xd5, 0x76, 0x1a, 0x11, 0x37, 0xf8, 0x00, 0x0c, 0x8b, 0x40, 0x06, 0xac, 0x86,
-	0xf8, 0xc2, 0x42, 0xa9, 0x19, 0x8d, 0xc1, 0xb4, 0x68, 0x64, 0x37, 0x12, 0x8d, 0x2a, 0x4c, 0x30,
-	0x72, 0x97, 0x75, 0x63, 0x99, 0x7a, 0xf3, 0x76, 0xa5, 0x62, 0x7a, 0x15, 0x6a, 0x79, 0xbd, 0xc6,
-	0x41, 0x81, 0x21, 0xd7, 0x77, 0x61, 0x19, 0x54, 0x04, 0x20, 0x78, 0xcf, 0x7f, 0x8f, 0xe0, 0x60,
-	0xc2, 0xa1, 0x42, 0x4c, 0x56, 0xb2, 0xe4, 0x2a, 0x3b, 0x78, 0x87, 0x16, 0x5a, 0xe9, 0xe7, 0xf5,
-	0xfc, 0x21, 0x09, 0x9c, 0xdb, 0xab, 0x24, 0xd1, 0x3a, 0x3b, 0xb8, 0xe1, 0x3a, 0xfb, 0x52, 0x96,
-	0xfc, 0x18, 0x84, 0x41, 0x99, 0xdd, 0xde, 0x54, 0x4b, 0x56, 0xda, 0xa9, 0xd8, 0x4a, 0xcb, 0x9d,
-	0xf0, 0xbb, 0x1c, 0x36, 0x7a, 0x15, 0xca, 0xac, 0x0d, 0xfb, 0x43, 0x44, 0x35, 0x6a, 0x50, 0xd3,
-	0xe9, 0xeb, 0xcd, 0x7c, 0x80, 0x40, 0x89, 0x3b, 0x51, 0xc8, 0xaa, 0xc0, 0x50, 0xd5, 0x5f, 0xaa,
-	0x53, 0xee, 0x77, 0x48, 0x0b, 0xde, 0xfb, 0x99, 0xa3, 0xb7, 0x45, 0xc1, 0xe4, 0xa0, 0xce, 0x1a,
-	0xcb, 0x96, 0x7d, 0x7b, 0x85, 0x96, 0xca, 0xb4, 0xdf, 0x89, 0xfa, 0x50, 0x96, 0xbe, 0x84, 0x93,
-	0x85, 0x2c, 0xd3, 0xb0, 0x4b, 0x8f, 0x7e, 0x12, 0x29, 0xdb, 0xba, 0xdc, 0xcf, 0xbc, 0x7d, 0x91,
-	0x8a, 0xf5, 0x55, 0x49, 0x5e, 0x7c, 0x06, 0x0e, 0x38, 0x0c, 0x60, 0xa1, 0x99, 0x6b, 0x05, 0x29,
-	0xb8, 0x3b, 0x9e, 0x9d, 0x1a, 0x9c, 0xce, 0x6a, 0xfb, 0x9d, 0x96, 0xcc, 0x5e, 0x94, 0x1b, 0xf2,
-	0xff, 0x20, 0xf8, 0x7f, 0x2a, 0x4d, 0x11, 0x93, 0x0f, 0x60, 0x77, 0x8b, 0xf8, 0x9d, 0x97, 0x81,
-	0x36, 0xcb, 0x57, 0xa1, 0x16, 0x7c, 0x27, 0xeb, 0xf2, 0x55, 0x4b, 0xe6, 0x1c, 0xc7, 0xdc, 0x73,
-	0x68, 0xd7, 0x09, 0xc9, 0xe0, 0x7a, 0x21, 0xb9, 0x23, 0xca, 0x71, 0x0c, 0x30, 0x11, 0x8c, 0x09,
-	0x18, 0x6e, 0xfa, 0x43, 0xcc, 0x5f, 0x73, 0x21, 0xa4, 0x49, 0xa6, 0x4b, 0x4d, 0xee, 0xc9, 0x72,
-	0xd5, 0x3c, 0xfa, 0xac, 0xb1, 0xdc, 0xb3, 0x20, 0xc7, 0x61, 0x54, 0x08, 0xa2, 0x1b, 0xcb, 0x6d,
-	0x4a, 0x60, 0x47, 0xde, 0xbc, 0xa6, 0x04, 0x35, 0x38, 0x10, 0x8b, 0xa3, 0xcf, 0xfc, 0xaf, 0x8b,
-	0x5e, 0xf9, 0x22, 0xbd, 0x13, 0xc4, 0x43, 0xe3, 0x00, 0x7a, 0xed, 0xc3, 0x7f, 0x46, 0x30, 0x95,
-	0xec, 0x5b, 0xf0, 0x9a, 0x85, 0x31, 0x8b, 0xde, 0x69, 0x5e, 0x96, 0x82, 0x60, 0xcf, 0x8e, 0xca,
-	0x6a, 0x23, 0x56, 0xbb, 0x6d, 0x1f, 0x4b, 0xe0, 0xec, 0xd7, 0x7b, 0x61, 0x0b, 0xc3, 0x8c, 0x7f,
-	0x44, 0xb0, 0x4d, 0xb4, 0xab, 0x78, 0x3a, 0x36, 0xdf, 0x63, 0x7e, 0x70, 0x50, 0x8e, 0x74, 0xb0,
-	0x93, 0x33, 0xcf, 0xcf, 0x7d, 0xf9, 0xe4, 0xc5, 0x83, 0xcc, 0x3b, 0xf8, 0x6d, 0x92, 0xf2, 0x6b,
-	0x89, 0x4b, 0x56, 0x9b, 0x12, 0x37, 0x88, 0x2f, 0xbc, 0x4b, 0x56, 0x45, 0x38, 0x1a, 0xf8, 0x3e,
-	0x82, 0x21, 0x39, 0x20, 0xe2, 0xf5, 0xcf, 0x96, 0xd7, 0x5a, 0x39, 0xda, 0xc9, 0x56, 0x81, 0xf3,
-	0x35, 0x86, 0x73, 0x12, 0x1f, 0x4c, 0xc5, 0x89, 0x7f, 0x41, 0x80, 0xdb, 0xa7, 0x56, 0x7c, 0x22,
-	0xe5, 0xa4, 0xa4, 0x71, 0x5b, 0x39, 0xd9, 0x9d, 0x91, 0x00, 0x7a, 0x86, 0x01, 0x3d, 0x8d, 0x4f,
-	0xc5, 0x03, 0x0d, 0x0c, 0x7d, 0x4d, 0x83, 0x97, 0x46, 0x93, 0xc1, 0x63, 0x9f, 0x41, 0xdb, 0xc8,
-	0x98, 0xca, 0x20, 0x69, 0x76, 0x4d, 0x65, 0x90, 0x38, 0x95, 0xe6, 0x2f, 0x31, 0x06, 0x0b, 0xf8,
-	0xdc, 0xc6, 0xaf, 0x04, 0x09, 0xcf, 0xb2, 0xf8, 0xdb, 0x0c, 0x8c, 0xc5, 0xce, 0x5c, 0xf8, 0xd4,
-	0xfa, 0x00, 0xe3, 0x86, 0x4a, 0xe5, 0xcd, 0xae, 0xed, 0x04, 0xb7, 0xaf, 0x10, 0x23, 0xf7, 0x05,
-	0xc2, 0x9f, 0xf7, 0xc2, 0x2e, 0x3a, 0x1f, 0x12, 0x39, 0x68, 0x92, 0xd5, 0x96, 0x91, 0xb5, 0x41,
-	0x78, 0x19, 0x08, 0x7d, 0xe0, 0x0b, 0x0d, 0xfc, 0x14, 0xc1, 0xee, 0xd6, 0xbe, 0x1f, 0xcf, 0x24,
-	0xf3, 0x4a, 0x98, 0xeb, 0x94, 0xd9, 0x6e, 0x4c, 0x84, 0x0a, 0x9f, 0x32, 0x11, 0x6e, 0xe0, 0x6b,
-	0x3d, 0x68, 0xd0, 0xf6, 0x97, 0xd6, 0x25, 0xab, 0xb2, 0x7c, 0x36, 0xf0, 0x13, 0x04, 0x7b, 0xda,
-	0xa6, 0x1a, 0xdc, 0x05, 0xd6, 0x20, 0x0b, 0x4f, 0x74, 0x65, 0x23, 0x08, 0x5e, 0x65, 0x04, 0x2f,
-	0xe1, 0x0b, 0x9b, 0x4a, 0x10, 0xff, 0x8a, 0xe0, 0x7f, 0x91, 0x81, 0x02, 0xab, 0xeb, 0xa1, 0x8b,
-	0xce, 0x3a, 0x0a, 0xe9, 0x78, 0xbf, 0x60, 0xf2, 0x31, 0x63, 0xf2, 0x11, 0xbe, 0xda, 0x3b, 0x93,
-	0x2a, 0x77, 0x1d, 0x89, 0xd3, 0x1a, 0x82, 0xb1, 0xd8, 0x06, 0x34, 0x2d, 0x35, 0xd3, 0xc6, 0x97,
-	0xb4, 0xd4, 0x4c, 0x1d, 0x3e, 0xf2, 0xd7, 0x19, 0xd3, 0x45, 0x7c, 0xa5, 0x77, 0xa6, 0xba, 0xb1,
-	0x1c, 0x61, 0xf9, 0x12, 0xc1, 0xde, 0xf8, 0x36, 0x1b, 0x77, 0x0b, 0x37, 0xb8, 0x97, 0xa7, 0xbb,
-	0x37, 0x14, 0x44, 0x6f, 0x30, 0xa2, 0x1f, 0x62, 0x6d, 0x53, 0x88, 0x46, 0xe9, 0xdc, 0xcb, 0xc0,
-	0x9e, 0xb6, 0xf6, 0x35, 0x2d, 0xef, 0x92, 0x9a, 0xf0, 0xb4, 0xbc, 0x4b, 0xec, 0x8f, 0x37, 0xa9,
-	0xbc, 0xc6, 0x95, 0x96, 0x94, 0xc6, 0xbe, 0x41, 0x6a, 0x01, 0xa0, 0x82, 0x23, 0x28, 0xff, 0x8d,
-	0x60, 0x67, 0xb4, 0x89, 0xc5, 0xa4, 0x13, 0x46, 0xa1, 0xb6, 0x5b, 0x39, 0xde, 0xb9, 0x81, 0xe0,
-	0xff, 0x19, 0xa3, 0x5f, 0xc7, 0x5e, 0x7f, 0xd8, 0x47, 0xba, 0xf8, 0x08, 0x6d, 0xff, 0xc6, 0xe3,
-	0xdf, 0x10, 0x8c, 0xc4, 0x74, 0xb9, 0x38, 0xa5, 0x0d, 0x48, 0x6e, 0xb8, 0x95, 0x37, 0xba, 0xb4,
-	0x12, 0x12, 0x5c, 0x66, 0x12, 0xbc, 0x8f, 0xcf, 0xf7, 0x20, 0x41, 0xa4, 0x17, 0x9f, 0x5b, 0x7c,
-	0xf4, 0x3c, 0x87, 0x1e, 0x3f, 0xcf, 0xa1, 0x3f, 0x9f, 0xe7, 0xd0, 0x37, 0x6b, 0xb9, 0x81, 0xc7,
-	0x6b, 0xb9, 0x81, 0xdf, 0xd7, 0x72, 0x03, 0x37, 0xde, 0x2a, 0x9b, 0xde, 0x52, 0xad, 0xa8, 0x1a,
-	0x76, 0x85, 0x88, 0x7f, 0x0c, 0x9a, 0x45, 0xe3, 0x58, 0xd9, 0x26, 0xf5, 0x93, 0xa4, 0x62, 0x97,
-	0x6a, 0x2b, 0xd4, 0xe5, 0x10, 0x8e, 0x9f, 0x3c, 0x26, 0x51, 0x78, 0x77, 0x1d, 0xea, 0x16, 0xb7,
-	0xb2, 0x1f, 0x71, 0x4f, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x44, 0xc0, 0x72, 0xa8, 0x1c,
-	0x00, 0x00,
+	// 1692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0xcf, 0x24, 0x69, 0x9a, 0xbc, 0x7e, 0x4f, 0x92, 0x36, 0x71, 0x92, 0x4d, 0xba, 0x15, 0x34,
+	0xad, 0x54, 0xbb, 0xf9, 0xa0, 0x1f, 0x08, 0x2a, 0x35, 0x11, 0x6d, 0x03, 0xb4, 0x4d, 0x36, 0x54,
+	0xb4, 0x95, 0x60, 0xf1, 0x7a, 0xa7, 0x1b, 0x2b, 0x59, 0x7b, 0x6b, 0x7b, 0xb7, 0xad, 0x42, 0x10,
+	0x42, 0xa8, 0xf4, 0x88, 0xa8, 0x10, 0x12, 0x07, 0x90, 0xb8, 0xf5, 0xc0, 0x01, 0xfe, 0x01, 0x0e,
+	0x5c, 0x7a, 0xa3, 0x52, 0x39, 0x20, 0x55, 0x2a, 0xa8, 0xa9, 0x54, 0xae, 0x5c, 0x38, 0x23, 0xcf,
+	0x3c, 0x7b, 0xed, 0x8d, 0xd7, 0xbb, 0x9b, 0xcd, 0x4a, 0x15, 0xb7, 0xf5, 0xcc, 0x7b, 0x6f, 0x7e,
+	0xbf, 0xdf, 0x9b, 0x79, 0xf6, 0x9b, 0x04, 0x46, 0xf5, 0x8c, 0xa6, 0x68, 0xa6, 0xc5, 0x14, 0x6d,
+	0x49, 0x35, 0x0c, 0xb6, 0xa2, 0x94, 0x26, 0x94, 0x9b, 0x45, 0x66, 0xdd, 0x91, 0x0b, 0x96, 0xe9,
+	0x98, 0xb4, 0x57, 0xcf, 0x68, 0xb2, 0x6b, 0x20, 0xa3, 0x81, 0x5c, 0x9a, 0x90, 0x02, 0x5e, 0x2b,
+	0x3a, 0x33, 0x1c, 0xd7, 0x49, 0xfc, 0x12, 0x5e, 0xd2, 0x51, 0xcd, 0xb4, 0xf3, 0xa6, 0xad, 0x64,
+	0x54, 0x9b, 0x89, 0x70, 0x4a, 0x69, 0x22, 0xc3, 0x1c, 0x75, 0x42, 0x29, 0xa8, 0x39, 0xdd, 0x50,
+	0x1d, 0xdd, 0x34, 0xd0, 0xf6, 0x60, 0x14, 0x04, 0x6f, 0x31, 0x61, 0x32, 0x9c, 0x33, 0xcd, 0xdc,
+	0x0a, 0x53, 0xd4, 0x82, 0xae, 0xa8, 0x86, 0x61, 0x3a, 0xdc, 0xdf, 0xc6, 0xd9, 0x41, 0x9c, 0xe5,
+	0x4f, 0x99, 0xe2, 0x0d, 0x45, 0x35, 0x10, 0xbd, 0xd4, 0x97, 0x33, 0x73, 0x26, 0xff, 0xa9, 0xb8,
+	0xbf, 0xc4, 0x68, 0xf2, 0x22, 0xf4, 0x2e, 0xb8, 0x98, 0x66, 0xc5, 0x22, 0x29, 0x76, 0xb3, 0xc8,
+	0x6c, 0x87, 0x1e, 0x80, 0xed, 0x05, 0xd3, 0x72, 0xd2, 0x7a, 0x76, 0x80, 0x8c, 0x91, 0xf1, 0x9e,
+	0x54, 0x97, 0xfb, 0x38, 0x97, 0xa5, 0x23, 0x00, 0x88, 0xc7, 0x9d, 0x6b, 0xe7, 0x73, 0x3d, 0x38,
+	0x32, 0x97, 0x4d, 0x3e, 0x20, 0xd0, 0x17, 0x8e, 0x67, 0x17, 0x4c, 0xc3, 0x66, 0xf4, 0x04, 0x6c,
+	0x47, 0x2b, 0x1e, 0x70, 0xc7, 0xe4, 0xb0, 0x1c, 0xa1, 0xa6, 0xec, 0xb9, 0x79, 0xc6, 0xb4, 0x0f,
+	0xb6, 0x15, 0x2c, 0xd3, 0xbc, 0xc1, 0x97, 0xda, 0x99, 0x12, 0x0f, 0x74, 0x16, 0x76, 0xf2, 0x1f,
+	0xe9, 0x25, 0xa6, 0xe7, 0x96, 0x9c, 0x81, 0x0e, 0x1e, 0x52, 0x0a, 0x84, 0x14, 0x19, 0x28, 0x4d,
+	0xc8, 0x17, 0xb8, 0xc5, 0x4c, 0xe7, 0xc3, 0xa7, 0xa3, 0x6d, 0xa9, 0x1d, 0xdc, 0x4b, 0x0c, 0x25,
+	0x3f, 0x0c, 0x43, 0xb5, 0x3d, 0xee, 0xe7, 0x00, 0xca, 0x89, 0x41, 0xb4, 0xaf, 0xca, 0x22, 0x8b,
+	0xb2, 0x9b, 0x45, 0x59, 0x6c, 0x0a, 0xcc, 0xa2, 0x3c, 0xaf, 0xe6, 0x18, 0xfa, 0xa6, 0x02, 0x9e,
+	0xc9, 0xa7, 0x04, 0xfa, 0x2b, 0x16, 0x40, 0x31, 0x66, 0xa0, 0x1b, 0xf9, 0xd9, 0x03, 0x64, 0xac,
+	0x83, 0xc7, 0x8f, 0x52, 0x63, 0x2e, 0xcb, 0x0c, 0x47, 0xbf, 0xa1, 0xb3, 0xac, 0xa7, 0x8b, 0xef,
+	0x47, 0xcf, 0x87, 0x50, 0xb6, 0x73, 0x94, 0x87, 0x6b, 0xa2, 0x14, 0x00, 0x82, 0x30, 0xe9, 0x29,
+	0xe8, 0x6a, 0x50, 0x45, 0xb4, 0x4f, 0xde, 0x23, 0x90, 0x10, 0x04, 0x4d, 0xc3, 0x60, 0x9a, 0x1b,
+	0xad, 0x52, 0xcb, 0x04, 0x80, 0xe6, 0x4f, 0xe2, 0x56, 0x0a, 0x8c, 0xd0, 0x73, 0x11, 0x2c, 0x36,
+	0xa3, 0xf5, 0xdf, 0x04, 0x46, 0xab, 0x42, 0xf9, 0x7f, 0xa9, 0x7e, 0xd5, 0x13, 0x5d, 0x60, 0x9a,
+	0xe5, 0xd6, 0x8b, 0x8e, 0xea, 0xb0, 0x66, 0x0f, 0xef, 0x9f, 0xbe, 0x88, 0x11, 0xa1, 0x51, 0x44,
+	0x15, 0x0e, 0xe8, 0xbe, 0x3e, 0x69, 0x01, 0x35, 0x6d, 0xbb, 0x26, 0x78, 0x52, 0x8e, 0x44, 0x11,
+	0x09, 0x48, 0x1a, 0x88, 0xd9, 0xaf, 0x47, 0x0d, 0xb7, 0xf2, 0xc8, 0xff, 0x48, 0xe0, 0x60, 0x88,
+	0xa1, 0xcb, 0xc9, 0xb0, 0x8b, 0xf6, 0x56, 0xe8, 0x47, 0x0f, 0xc3, 0x1e, 0x8b, 0x95, 0x74, 0x5b,
+	0x37, 0x8d, 0xb4, 0x51, 0xcc, 0x67, 0x98, 0xc5, 0x51, 0x76, 0xa6, 0x76, 0x7b, 0xc3, 0x97, 0xf8,
+	0x68, 0xc8, 0x10, 0xe9, 0x74, 0x86, 0x0d, 0x11, 0xef, 0x13, 0x02, 0xc9, 0x38, 0xbc, 0x98, 0x94,
+	0x37, 0x61, 0x8f, 0xe6, 0xcd, 0x84, 0x92, 0xd1, 0x27, 0x8b, 0xf7, 0x81, 0xec, 0xbd, 0x0f, 0xe4,
+	0xb3, 0xc6, 0x9d, 0xd4, 0x6e, 0x2d, 0x14, 0x86, 0x0e, 0x41, 0x0f, 0x26, 0xd2, 0x67, 0xd5, 0x2d,
+	0x06, 0xe6, 0xb2, 0xe5, 0x6c, 0x74, 0xc4, 0x65, 0xa3, 0x73, 0x33, 0xd9, 0xb0, 0x60, 0x98, 0x93,
+	0x9b, 0x57, 0xb5, 0x65, 0xe6, 0xcc, 0x9a, 0xf9, 0xbc, 0xee, 0xe4, 0x99, 0xe1, 0x34, 0x9b, 0x07,
+	0x09, 0xba, 0x6d, 0x37, 0x84, 0xa1, 0x31, 0x4c, 0x80, 0xff, 0x9c, 0xfc, 0x96, 0xc0, 0x48, 0x95,
+	0x45, 0x51, 0x4c, 0x5e, 0xb2, 0xbc, 0x51, 0xbe, 0xf0, 0xce, 0x54, 0x60, 0xa4, 0x95, 0xdb, 0xf3,
+	0xfb, 0x6a, 0xe0, 0xec, 0x66, 0x25, 0x09, 0xd7, 0xd9, 0x8e, 0x4d, 0xd7, 0xd9, 0x17, 0x5e, 0xc9,
+	0x8f, 0x40, 0xe8, 0x97, 0xd9, 0x1d, 0x65, 0xb5, 0xbc, 0x4a, 0x3b, 0x16, 0x59, 0x69, 0x45, 0x10,
+	0xb1, 0x97, 0x83, 0x4e, 0x2f, 0x43, 0x99, 0x35, 0x61, 0x30, 0x40, 0x34, 0xc5, 0x34, 0xa6, 0x17,
+	0x5a, 0xba, 0x33, 0xef, 0x13, 0x90, 0xa2, 0x56, 0x44, 0x59, 0x25, 0xe8, 0xb6, 0xdc, 0xa1, 0x12,
+	0x13, 0x71, 0xbb, 0x53, 0xfe, 0x73, 0x2b, 0xcf, 0xe8, 0x2d, 0x38, 0x18, 0x00, 0x75, 0x56, 0x5b,
+	0x36, 0xcc, 0x5b, 0x2b, 0x2c, 0x9b, 0x63, 0xad, 0x3e, 0xa8, 0x0f, 0xbc, 0xd2, 0x57, 0x65, 0x65,
+	0x94, 0x65, 0x1c, 0xf6, 0xa8, 0xe1, 0x29, 0x3c, 0xb2, 0x95, 0xc3, 0xad, 0x3c, 0xb7, 0xcf, 0x63,
+	0xb1, 0xbe, 0x2c, 0x87, 0x97, 0x9e, 0x81, 0xa1, 0x02, 0x07, 0x98, 0x2e, 0x9f, 0xb5, 0xb4, 0x27,
+	0xb8, 0x3d, 0xd0, 0x39, 0xd6, 0x31, 0xde, 0x99, 0x1a, 0x2c, 0x54, 0x9c, 0xec, 0x45, 0xcf, 0x20,
+	0xf9, 0x2f, 0x81, 0x43, 0xb1, 0x34, 0x31, 0x27, 0xef, 0xc2, 0xde, 0x0a, 0xf1, 0xeb, 0x2f, 0x03,
+	0x1b, 0x3c, 0x5f, 0x86, 0x5a, 0xf0, 0x8d, 0x57, 0x97, 0xaf, 0x18, 0xde, 0x99, 0x13, 0x98, 0x9b,
+	0x4e, 0x6d, 0x8d, 0x94, 0x74, 0xd4, 0x4a, 0xc9, 0x6d, 0x48, 0x54, 0x03, 0x86, 0xc9, 0x18, 0x86,
+	0x9e, 0x72, 0x3c, 0xc2, 0xe3, 0x95, 0x07, 0x02, 0x9a, 0xb4, 0x37, 0xa8, 0xc9, 0x5d, 0xaf, 0x5c,
+	0x95, 0x97, 0x3e, 0xab, 0x2d, 0x37, 0x2d, 0xc8, 0x71, 0xe8, 0x43, 0x41, 0x54, 0x6d, 0x79, 0x83,
+	0x12, 0xb4, 0xe0, 0xed, 0xbc, 0xb2, 0x04, 0x45, 0x18, 0x8a, 0xc4, 0xd1, 0x62, 0xfe, 0xd7, 0xf0,
+	0x5b, 0xf9, 0x12, 0xbb, 0xed, 0xe7, 0x23, 0x25, 0x00, 0x34, 0xfb, 0x1d, 0xfe, 0x13, 0x81, 0xb1,
+	0xea, 0xb1, 0x91, 0xd7, 0x24, 0xf4, 0x1b, 0xec, 0x76, 0x79, 0xb3, 0xa4, 0x91, 0x3d, 0x5f, 0xaa,
+	0x33, 0xd5, 0x6b, 0x6c, 0xf4, 0x6d, 0x65, 0x09, 0x1c, 0x82, 0xc1, 0xe0, 0x87, 0xea, 0xbc, 0x6a,
+	0xa9, 0x79, 0x6f, 0x33, 0x24, 0x17, 0x40, 0x8a, 0x9a, 0x44, 0x26, 0x53, 0xd0, 0x55, 0xe0, 0x23,
+	0xf8, 0xd1, 0x3a, 0x54, 0xa5, 0x48, 0x70, 0x27, 0x34, 0x4d, 0x2e, 0x7a, 0xaf, 0x67, 0xab, 0x68,
+	0xa8, 0x99, 0x15, 0x96, 0x52, 0x8d, 0x5c, 0xd3, 0xc2, 0x7f, 0xee, 0xbf, 0x82, 0xc3, 0x51, 0x11,
+	0xe8, 0x34, 0xec, 0x2f, 0x58, 0x45, 0x43, 0x37, 0x72, 0x65, 0xd5, 0x6d, 0x47, 0xb5, 0x1c, 0xd4,
+	0xbc, 0x0f, 0x67, 0x3d, 0xd9, 0x17, 0xdd, 0x39, 0xbe, 0xa3, 0x2b, 0xbd, 0x98, 0x21, 0x56, 0x77,
+	0x77, 0x74, 0xd8, 0xe7, 0x2d, 0x23, 0x9b, 0x5c, 0x02, 0x1a, 0xfa, 0x10, 0x68, 0xdd, 0x4b, 0x76,
+	0x1e, 0x7a, 0x43, 0x2b, 0x21, 0xd1, 0xd3, 0x6e, 0x46, 0xdc, 0x91, 0x1a, 0x19, 0x71, 0x4d, 0xbc,
+	0x63, 0x21, 0x1c, 0x26, 0xbf, 0x93, 0x60, 0x1b, 0x0f, 0x49, 0x7f, 0x20, 0xb0, 0x1d, 0x13, 0x4e,
+	0xc7, 0x23, 0x03, 0x44, 0x5c, 0x3c, 0x49, 0x47, 0xea, 0xb0, 0x14, 0x28, 0x93, 0x33, 0x9f, 0x3d,
+	0x7e, 0x7e, 0xbf, 0xfd, 0x0d, 0xfa, 0xba, 0x12, 0x73, 0x6b, 0x66, 0x2b, 0xab, 0x65, 0x71, 0xd6,
+	0x14, 0x57, 0x32, 0x5b, 0x59, 0x45, 0x21, 0xd7, 0xe8, 0x3d, 0x02, 0xdd, 0x18, 0xd7, 0xa6, 0xb5,
+	0xd7, 0xf6, 0x76, 0xb4, 0x74, 0xb4, 0x1e, 0x53, 0xc4, 0xf9, 0x0a, 0xc7, 0x39, 0x4a, 0x47, 0x62,
+	0x71, 0xd2, 0x5f, 0x08, 0xd0, 0x8d, 0xb7, 0x17, 0x74, 0x2a, 0x66, 0xa5, 0x6a, 0xd7, 0x2e, 0xd2,
+	0x74, 0x63, 0x4e, 0x08, 0xf4, 0x0c, 0x07, 0x7a, 0x8a, 0x9e, 0x88, 0x06, 0xea, 0x3b, 0xba, 0x9a,
+	0xfa, 0x0f, 0x6b, 0x65, 0x06, 0x8f, 0x5c, 0x06, 0x1b, 0xae, 0x0e, 0x62, 0x19, 0x54, 0xbb, 0xc3,
+	0x90, 0xa6, 0x1b, 0x73, 0x42, 0x06, 0x97, 0x39, 0x83, 0x39, 0x7a, 0x7e, 0xf3, 0x5b, 0x42, 0x09,
+	0xde, 0x69, 0xd0, 0xaf, 0xda, 0xa1, 0x3f, 0xb2, 0xf7, 0xa6, 0x27, 0x6a, 0x03, 0x8c, 0xba, 0x5c,
+	0x90, 0x4e, 0x36, 0xec, 0x87, 0xdc, 0xbe, 0x20, 0x9c, 0xdc, 0xa7, 0x84, 0x7e, 0xd2, 0x0c, 0xbb,
+	0xf0, 0x3d, 0x81, 0xe2, 0x5d, 0x38, 0x28, 0xab, 0x15, 0x57, 0x17, 0x6b, 0x8a, 0x78, 0x1d, 0x04,
+	0x26, 0xc4, 0xc0, 0x1a, 0x7d, 0x42, 0x60, 0x6f, 0x65, 0xff, 0x47, 0x27, 0xaa, 0xf3, 0xaa, 0xd2,
+	0xdf, 0x4b, 0x93, 0x8d, 0xb8, 0xa0, 0x0a, 0x1f, 0x71, 0x11, 0xae, 0xd3, 0xab, 0x4d, 0x68, 0xb0,
+	0xe1, 0x8b, 0xcb, 0x56, 0x56, 0xbd, 0x92, 0xb8, 0x46, 0x1f, 0x13, 0xd8, 0x57, 0xb9, 0xbc, 0x4d,
+	0x1b, 0xc0, 0xea, 0x9f, 0xc2, 0xa9, 0x86, 0x7c, 0x90, 0xe0, 0x15, 0x4e, 0xf0, 0x32, 0xbd, 0xb8,
+	0xa5, 0x04, 0xe9, 0x6f, 0x04, 0x76, 0x85, 0x1a, 0x4b, 0x2a, 0xd7, 0x42, 0x17, 0xee, 0x79, 0x25,
+	0xa5, 0x6e, 0x7b, 0x64, 0xf2, 0x01, 0x67, 0xf2, 0x3e, 0xbd, 0xd2, 0x3c, 0x13, 0x4b, 0x84, 0x0e,
+	0xe5, 0x69, 0x9d, 0x40, 0x7f, 0x64, 0x23, 0x12, 0x77, 0x34, 0xe3, 0xda, 0x58, 0xe9, 0x64, 0xc3,
+	0x7e, 0xc8, 0xf4, 0x1a, 0x67, 0xba, 0x48, 0x17, 0x9a, 0x67, 0xaa, 0x6a, 0xcb, 0x21, 0x96, 0x2f,
+	0x08, 0xec, 0x8f, 0x5c, 0xdc, 0xa6, 0x8d, 0xc2, 0xf5, 0xf7, 0xe5, 0xa9, 0xc6, 0x1d, 0x91, 0xe8,
+	0x75, 0x4e, 0xf4, 0x3d, 0x9a, 0xda, 0x12, 0xa2, 0x61, 0x3a, 0x77, 0xdb, 0x61, 0xdf, 0x86, 0x36,
+	0x26, 0xee, 0xdc, 0x55, 0x6b, 0xc6, 0xa4, 0xa9, 0x86, 0x7c, 0xb6, 0xb4, 0xbc, 0x46, 0x95, 0x96,
+	0x98, 0x06, 0x6f, 0x4d, 0x29, 0xfa, 0x80, 0xd2, 0x05, 0xa4, 0xfc, 0x0f, 0x81, 0xdd, 0xe1, 0x66,
+	0x86, 0x2a, 0xf5, 0x30, 0x0a, 0xb4, 0x5f, 0xd2, 0xf1, 0xfa, 0x1d, 0x90, 0xff, 0xc7, 0x9c, 0x7e,
+	0x89, 0x3a, 0xad, 0x61, 0x1f, 0xea, 0xe6, 0x42, 0xb4, 0xdd, 0x1d, 0x4f, 0x7f, 0x27, 0xd0, 0x1b,
+	0xd1, 0xed, 0xd0, 0x98, 0xcf, 0x80, 0xea, 0x8d, 0x97, 0xf4, 0x5a, 0x83, 0x5e, 0x28, 0xc1, 0x3c,
+	0x97, 0xe0, 0x6d, 0x7a, 0xa1, 0x09, 0x09, 0x42, 0x3d, 0x19, 0xfd, 0x9a, 0xc0, 0xae, 0x50, 0xd3,
+	0x13, 0x57, 0x75, 0xa3, 0x5a, 0x27, 0x49, 0xa9, 0xdb, 0x1e, 0x49, 0x1c, 0xe2, 0x24, 0x46, 0xe8,
+	0x50, 0x24, 0x09, 0xd1, 0x3d, 0xd1, 0x5f, 0xdd, 0xb7, 0x41, 0xb0, 0xc7, 0x89, 0x7d, 0x1b, 0x44,
+	0xb4, 0x58, 0x92, 0x52, 0xb7, 0x3d, 0xe2, 0x5a, 0xe0, 0xb8, 0xde, 0xa1, 0x73, 0xcd, 0xec, 0x2f,
+	0x8c, 0x9c, 0xb6, 0x38, 0xe6, 0x9f, 0x09, 0x74, 0x89, 0x63, 0x4c, 0x0f, 0xd7, 0x7e, 0x39, 0x09,
+	0xdc, 0xe3, 0xb5, 0x0d, 0xb7, 0xfc, 0x45, 0x1c, 0x2c, 0xe8, 0x33, 0x8b, 0x0f, 0x9f, 0x25, 0xc8,
+	0xa3, 0x67, 0x09, 0xf2, 0xd7, 0xb3, 0x04, 0xf9, 0x72, 0x3d, 0xd1, 0xf6, 0x68, 0x3d, 0xd1, 0xf6,
+	0xc7, 0x7a, 0xa2, 0xed, 0xfa, 0xe9, 0x9c, 0xee, 0x2c, 0x15, 0x33, 0xb2, 0x66, 0xe6, 0x15, 0xfc,
+	0x9f, 0x01, 0x3d, 0xa3, 0x1d, 0xcb, 0x99, 0x4a, 0x69, 0x5a, 0xc9, 0x9b, 0xd9, 0xe2, 0x0a, 0xb3,
+	0x05, 0x8e, 0xe3, 0xd3, 0xc7, 0x3c, 0x28, 0xce, 0x9d, 0x02, 0xb3, 0x33, 0x5d, 0xfc, 0xef, 0x3b,
+	0x53, 0xff, 0x0d, 0x00, 0x67, 0xaa, 0xdc, 0xd3, 0xc3, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1851,6 +2177,14 @@ type QueryClient interface {
 	UnreceivedAcks(ctx context.Context, in *QueryUnreceivedAcksRequest, opts ...grpc.CallOption) (*QueryUnreceivedAcksResponse, error)
 	// NextSequenceReceive returns the next receive sequence for a given channel.
 	NextSequenceReceive(ctx context.Context, in *QueryNextSequenceReceiveRequest, opts ...grpc.CallOption) (*QueryNextSequenceReceiveResponse, error)
+	// ChannelParams queries all parameters of the ibc channel submodule.
+	ChannelParams(ctx context.Context, in *QueryChannelParamsRequest, opts ...grpc.CallOption) (*QueryChannelParamsResponse, error)
+	// PrunableRange returns the range of sequences whose packet receipts and
+	// acknowledgements can be pruned for a given unordered channel.
+	PrunableRange(ctx context.Context, in *QueryPrunableRangeRequest, opts ...grpc.CallOption) (*QueryPrunableRangeResponse, error)
+	// Packet queries a sent packet stored until it is acknowledged or timed out.
+	// Sent packets are only stored if enabled by the MaxStoredPackets parameter.
+	Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error)
 }
 
 type queryClient struct {
@@ -1978,6 +2312,33 @@ func (c *queryClient) NextSequenceReceive(ctx context.Context, in *QueryNextSequ
 	return out, nil
 }
 
+func (c *queryClient) ChannelParams(ctx context.Context, in *QueryChannelParamsRequest, opts ...grpc.CallOption) (*QueryChannelParamsResponse, error) {
+	out := new(QueryChannelParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/ChannelParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PrunableRange(ctx context.Context, in *QueryPrunableRangeRequest, opts ...grpc.CallOption) (*QueryPrunableRangeResponse, error) {
+	out := new(QueryPrunableRangeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/PrunableRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Packet(ctx context.Context, in *QueryPacketRequest, opts ...grpc.CallOption) (*QueryPacketResponse, error) {
+	out := new(QueryPacketResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/Packet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Channel queries an IBC Channel.
@@ -2014,6 +2375,14 @@ type QueryServer interface {
 	UnreceivedAcks(context.Context, *QueryUnreceivedAcksRequest) (*QueryUnreceivedAcksResponse, error)
 	// NextSequenceReceive returns the next receive sequence for a given channel.
 	NextSequenceReceive(context.Context, *QueryNextSequenceReceiveRequest) (*QueryNextSequenceReceiveResponse, error)
+	// ChannelParams queries all parameters of the ibc channel submodule.
+	ChannelParams(context.Context, *QueryChannelParamsRequest) (*QueryChannelParamsResponse, error)
+	// PrunableRange returns the range of sequences whose packet receipts and
+	// acknowledgements can be pruned for a given unordered channel.
+	PrunableRange(context.Context, *QueryPrunableRangeRequest) (*QueryPrunableRangeResponse, error)
+	// Packet queries a sent packet stored until it is acknowledged or timed out.
+	// Sent packets are only stored if enabled by the MaxStoredPackets parameter.
+	Packet(context.Context, *QueryPacketRequest) (*QueryPacketResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2059,6 +2428,15 @@ func (*UnimplementedQueryServer) UnreceivedAcks(ctx context.Context, req *QueryU
 func (*UnimplementedQueryServer) NextSequenceReceive(ctx context.Context, req *QueryNextSequenceReceiveRequest) (*QueryNextSequenceReceiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextSequenceReceive not implemented")
 }
+func (*UnimplementedQueryServer) ChannelParams(ctx context.Context, req *QueryChannelParamsRequest) (*QueryChannelParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelParams not implemented")
+}
+func (*UnimplementedQueryServer) PrunableRange(ctx context.Context, req *QueryPrunableRangeRequest) (*QueryPrunableRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrunableRange not implemented")
+}
+func (*UnimplementedQueryServer) Packet(ctx context.Context, req *QueryPacketRequest) (*QueryPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Packet not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2298,6 +2676,60 @@ func _Query_NextSequenceReceive_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ChannelParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Query/ChannelParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelParams(ctx, req.(*QueryChannelParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PrunableRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrunableRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrunableRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Query/PrunableRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrunableRange(ctx, req.(*QueryPrunableRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Packet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Packet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Query/Packet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Packet(ctx, req.(*QueryPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.channel.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2354,6 +2786,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextSequenceReceive",
 			Handler:    _Query_NextSequenceReceive_Handler,
 		},
+		{
+			MethodName: "ChannelParams",
+			Handler:    _Query_ChannelParams_Handler,
+		},
+		{
+			MethodName: "PrunableRange",
+			Handler:    _Query_PrunableRange_Handler,
+		},
+		{
+			MethodName: "Packet",
+			Handler:    _Query_Packet_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/channel/v1/query.proto",
@@ -3636,11 +4080,214 @@ func (m *QueryNextSequenceReceiveResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
+func (m *QueryChannelParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrunableRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrunableRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrunableRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrunableRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrunableRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrunableRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PruningSequenceEnd != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PruningSequenceEnd))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PruningSequenceStart != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PruningSequenceStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
@@ -4163,6 +4810,91 @@ func (m *QueryNextSequenceReceiveResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryChannelParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryChannelParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPrunableRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPrunableRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PruningSequenceStart != 0 {
+		n += 1 + sovQuery(uint64(m.PruningSequenceStart))
+	}
+	if m.PruningSequenceEnd != 0 {
+		n += 1 + sovQuery(uint64(m.PruningSequenceEnd))
+	}
+	return n
+}
+
+func (m *QueryPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -8018,6 +8750,560 @@ func (m *QueryNextSequenceReceiveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryChannelParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChannelParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPrunableRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrunableRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrunableRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPrunableRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrunableRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrunableRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningSequenceStart", wireType)
+			}
+			m.PruningSequenceStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruningSequenceStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningSequenceEnd", wireType)
+			}
+			m.PruningSequenceEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruningSequenceEnd |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -891,6 +891,108 @@ func (m *MsgAcknowledgementResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAcknowledgementResponse proto.InternalMessageInfo
 
+// MsgPruneAcknowledgements defines a message to prune the packet receipts and
+// acknowledgements of an unordered channel below the counterparty next sequence
+// acknowledgement. The counterparty next sequence acknowledgement is only
+// updated if a proof is provided for a sequence greater than the stored one.
+type MsgPruneAcknowledgements struct {
+	PortId               string       `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId            string       `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	NextSequenceAck      uint64       `protobuf:"varint,3,opt,name=next_sequence_ack,json=nextSequenceAck,proto3" json:"next_sequence_ack,omitempty" yaml:"next_sequence_ack"`
+	ProofNextSequenceAck []byte       `protobuf:"bytes,4,opt,name=proof_next_sequence_ack,json=proofNextSequenceAck,proto3" json:"proof_next_sequence_ack,omitempty" yaml:"proof_next_sequence_ack"`
+	ProofHeight          types.Height `protobuf:"bytes,5,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height" yaml:"proof_height"`
+	Limit                uint64       `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Signer               string       `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgPruneAcknowledgements) Reset()         { *m = MsgPruneAcknowledgements{} }
+func (m *MsgPruneAcknowledgements) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneAcknowledgements) ProtoMessage()    {}
+func (*MsgPruneAcknowledgements) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{20}
+}
+func (m *MsgPruneAcknowledgements) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneAcknowledgements) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneAcknowledgements.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneAcknowledgements) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneAcknowledgements.Merge(m, src)
+}
+func (m *MsgPruneAcknowledgements) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneAcknowledgements) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneAcknowledgements.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneAcknowledgements proto.InternalMessageInfo
+
+// MsgPruneAcknowledgementsResponse defines the Msg/PruneAcknowledgements response type.
+type MsgPruneAcknowledgementsResponse struct {
+	// number of sequences pruned by this message
+	TotalPrunedSequences uint64 `protobuf:"varint,1,opt,name=total_pruned_sequences,json=totalPrunedSequences,proto3" json:"total_pruned_sequences,omitempty"`
+	// number of sequences left to be pruned below the recv start sequence
+	TotalRemainingSequences uint64 `protobuf:"varint,2,opt,name=total_remaining_sequences,json=totalRemainingSequences,proto3" json:"total_remaining_sequences,omitempty"`
+}
+
+func (m *MsgPruneAcknowledgementsResponse) Reset()         { *m = MsgPruneAcknowledgementsResponse{} }
+func (m *MsgPruneAcknowledgementsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneAcknowledgementsResponse) ProtoMessage()    {}
+func (*MsgPruneAcknowledgementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{21}
+}
+func (m *MsgPruneAcknowledgementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneAcknowledgementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneAcknowledgementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneAcknowledgementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneAcknowledgementsResponse.Merge(m, src)
+}
+func (m *MsgPruneAcknowledgementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneAcknowledgementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneAcknowledgementsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneAcknowledgementsResponse proto.InternalMessageInfo
+
+func (m *MsgPruneAcknowledgementsResponse) GetTotalPrunedSequences() uint64 {
+	if m != nil {
+		return m.TotalPrunedSequences
+	}
+	return 0
+}
+
+func (m *MsgPruneAcknowledgementsResponse) GetTotalRemainingSequences() uint64 {
+	if m != nil {
+		return m.TotalRemainingSequences
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.ResponseResultType", ResponseResultType_name, ResponseResultType_value)
 	proto.RegisterType((*MsgChannelOpenInit)(nil), "ibc.core.channel.v1.MsgChannelOpenInit")
@@ -913,93 +1015,105 @@ func init() {
 	proto.RegisterType((*MsgTimeoutOnCloseResponse)(nil), "ibc.core.channel.v1.MsgTimeoutOnCloseResponse")
 	proto.RegisterType((*MsgAcknowledgement)(nil), "ibc.core.channel.v1.MsgAcknowledgement")
 	proto.RegisterType((*MsgAcknowledgementResponse)(nil), "ibc.core.channel.v1.MsgAcknowledgementResponse")
+	proto.RegisterType((*MsgPruneAcknowledgements)(nil), "ibc.core.channel.v1.MsgPruneAcknowledgements")
+	proto.RegisterType((*MsgPruneAcknowledgementsResponse)(nil), "ibc.core.channel.v1.MsgPruneAcknowledgementsResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/tx.proto", fileDescriptor_bc4637e0ac3fc7b7) }
 
 var fileDescriptor_bc4637e0ac3fc7b7 = []byte{
-	// 1294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0xe2, 0x56,
-	0x17, 0xc6, 0x40, 0x20, 0x39, 0xe4, 0x4d, 0x88, 0x49, 0x32, 0xc4, 0x24, 0x98, 0xd7, 0x8b, 0x49,
-	0x94, 0x2a, 0x30, 0xc9, 0x64, 0x54, 0x4d, 0x54, 0xa9, 0x0a, 0x94, 0x51, 0xa3, 0x36, 0x1f, 0x32,
-	0xa4, 0x52, 0xd3, 0xaa, 0x08, 0xcc, 0x1d, 0x62, 0x01, 0x36, 0xb5, 0x0d, 0x33, 0xfc, 0x83, 0x51,
-	0x56, 0xb3, 0x1e, 0x29, 0xd2, 0x54, 0x5d, 0x55, 0x5d, 0x4c, 0x7f, 0xc6, 0x2c, 0x67, 0xd5, 0x56,
-	0x5d, 0xa0, 0x2a, 0xd9, 0x74, 0xcd, 0x2f, 0xa8, 0x7c, 0x7d, 0x6d, 0x0c, 0xd8, 0x8a, 0x33, 0x93,
-	0x64, 0xba, 0xf3, 0xbd, 0xe7, 0xb9, 0xe7, 0x9c, 0xfb, 0x9c, 0xe7, 0x7e, 0x19, 0x96, 0xc5, 0x8a,
-	0x90, 0x11, 0x64, 0x05, 0x65, 0x84, 0xd3, 0xb2, 0x24, 0xa1, 0x46, 0xa6, 0xb3, 0x99, 0xd1, 0x9e,
-	0xa7, 0x5b, 0x8a, 0xac, 0xc9, 0x74, 0x4c, 0xac, 0x08, 0x69, 0xdd, 0x9a, 0x26, 0xd6, 0x74, 0x67,
-	0x93, 0x99, 0xaf, 0xc9, 0x35, 0x19, 0xdb, 0x33, 0xfa, 0x97, 0x01, 0x65, 0xd8, 0x81, 0xa3, 0x86,
-	0x88, 0x24, 0x4d, 0xf7, 0x63, 0x7c, 0x11, 0xc0, 0xff, 0x9d, 0x22, 0x99, 0x6e, 0x31, 0x84, 0xfb,
-	0x89, 0x02, 0x7a, 0x5f, 0xad, 0xe5, 0x8c, 0xce, 0xc3, 0x16, 0x92, 0xf6, 0x24, 0x51, 0xa3, 0x3f,
-	0x81, 0x70, 0x4b, 0x56, 0xb4, 0x92, 0x58, 0x8d, 0x53, 0x29, 0x6a, 0x6d, 0x2a, 0x4b, 0xf7, 0x7b,
-	0xec, 0x4c, 0xb7, 0xdc, 0x6c, 0xec, 0x70, 0xc4, 0xc0, 0xf1, 0x21, 0xfd, 0x6b, 0xaf, 0x4a, 0x7f,
-	0x06, 0x61, 0xe2, 0x34, 0xee, 0x4f, 0x51, 0x6b, 0x91, 0xad, 0xe5, 0xb4, 0xc3, 0x24, 0xd2, 0x24,
-	0x46, 0x36, 0xf8, 0xb6, 0xc7, 0xfa, 0x78, 0x73, 0x08, 0xbd, 0x08, 0x21, 0x55, 0xac, 0x49, 0x48,
-	0x89, 0x07, 0xf4, 0x48, 0x3c, 0x69, 0xed, 0x4c, 0xbe, 0x78, 0xcd, 0xfa, 0xfe, 0x79, 0xcd, 0xfa,
-	0xb8, 0x06, 0x30, 0xe3, 0x29, 0xf2, 0x48, 0x6d, 0xc9, 0x92, 0x8a, 0xe8, 0x6d, 0x00, 0xe2, 0x6a,
-	0x90, 0xed, 0x42, 0xbf, 0xc7, 0xce, 0x19, 0xd9, 0x0e, 0x6c, 0x1c, 0x3f, 0x45, 0x1a, 0x7b, 0x55,
-	0x3a, 0x0e, 0xe1, 0x0e, 0x52, 0x54, 0x51, 0x96, 0x70, 0xce, 0x53, 0xbc, 0xd9, 0xe4, 0x7e, 0x0f,
-	0xc0, 0xdc, 0x70, 0xb8, 0xa2, 0xd2, 0xbd, 0x1e, 0x21, 0x07, 0x10, 0x6b, 0x29, 0xa8, 0x23, 0xca,
-	0x6d, 0xb5, 0x64, 0xcb, 0x0d, 0x07, 0xca, 0x26, 0xfb, 0x3d, 0x96, 0x21, 0x03, 0xc7, 0x41, 0x1c,
-	0x3f, 0x67, 0xf6, 0xe6, 0xac, 0x64, 0x6d, 0x04, 0x07, 0xae, 0x4f, 0x30, 0x0f, 0xf3, 0x82, 0xdc,
-	0x96, 0x34, 0xa4, 0xb4, 0xca, 0x8a, 0xd6, 0x2d, 0x99, 0xf3, 0x0e, 0xe2, 0x74, 0xd8, 0x7e, 0x8f,
-	0x4d, 0x10, 0xaa, 0x1c, 0x50, 0x1c, 0x1f, 0xb3, 0x77, 0x7f, 0x63, 0xf4, 0xea, 0xa4, 0xb7, 0x14,
-	0x59, 0x7e, 0x5a, 0x12, 0x25, 0x51, 0x8b, 0x4f, 0xa4, 0xa8, 0xb5, 0x69, 0x3b, 0xe9, 0x03, 0x1b,
-	0xc7, 0x4f, 0xe1, 0x06, 0x56, 0xd5, 0x09, 0x4c, 0x1b, 0x96, 0x53, 0x24, 0xd6, 0x4e, 0xb5, 0x78,
-	0x08, 0x4f, 0x86, 0xb1, 0x4d, 0xc6, 0x50, 0x6f, 0x67, 0x33, 0xfd, 0x25, 0x46, 0x64, 0x13, 0xfa,
-	0x54, 0xfa, 0x3d, 0x36, 0x66, 0xf7, 0x6b, 0x8c, 0xe6, 0xf8, 0x08, 0x6e, 0x1a, 0x48, 0x9b, 0x8c,
-	0xc2, 0x2e, 0x32, 0x7a, 0x04, 0x4b, 0x63, 0x75, 0xb5, 0x54, 0x64, 0xd3, 0x03, 0x35, 0xac, 0x87,
-	0x3f, 0xc6, 0xf4, 0xb0, 0x2b, 0xd4, 0xaf, 0xa7, 0x87, 0x61, 0x89, 0xfa, 0x3d, 0x4a, 0xf4, 0x04,
-	0xee, 0x0d, 0x55, 0xc4, 0xe6, 0x02, 0xaf, 0x94, 0x2c, 0xd7, 0xef, 0xb1, 0x49, 0x87, 0xd2, 0xd9,
-	0xfd, 0x2d, 0xd8, 0x2d, 0x03, 0x45, 0xdd, 0x86, 0x26, 0x36, 0xc1, 0x28, 0x75, 0x49, 0x53, 0xba,
-	0x44, 0x12, 0xf3, 0xfd, 0x1e, 0x1b, 0xb5, 0x97, 0x4e, 0x53, 0xba, 0x1c, 0x3f, 0x89, 0xbf, 0xf5,
-	0x55, 0xf5, 0x71, 0x05, 0x91, 0x18, 0x15, 0xc4, 0xae, 0x50, 0x37, 0x05, 0xc1, 0xfd, 0xea, 0x87,
-	0x85, 0x61, 0x6b, 0x4e, 0x96, 0x9e, 0x8a, 0x4a, 0xf3, 0x2e, 0x4a, 0x6f, 0x51, 0x59, 0x16, 0xea,
-	0xb8, 0xd8, 0x0e, 0x54, 0x96, 0x85, 0xba, 0x49, 0xa5, 0x2e, 0xc8, 0x51, 0x2a, 0x83, 0xb7, 0x42,
-	0xe5, 0x84, 0x0b, 0x95, 0x2c, 0xac, 0x38, 0x92, 0x65, 0xd1, 0xf9, 0x8a, 0x82, 0xd8, 0x00, 0x91,
-	0x6b, 0xc8, 0x2a, 0xba, 0xfe, 0x41, 0xf3, 0x7e, 0x64, 0x5e, 0x7d, 0xc0, 0xac, 0x40, 0xc2, 0x21,
-	0x37, 0x2b, 0xf7, 0x37, 0x7e, 0x58, 0x1c, 0xb1, 0xdf, 0xa1, 0x16, 0x86, 0xb7, 0xda, 0xc0, 0x7b,
-	0x6e, 0xb5, 0x77, 0x2b, 0x87, 0x14, 0x24, 0x9d, 0x09, 0xb3, 0x38, 0x7d, 0xe9, 0x87, 0xff, 0xed,
-	0xab, 0x35, 0x1e, 0x09, 0x9d, 0xa3, 0xb2, 0x50, 0x47, 0x1a, 0xfd, 0x18, 0x42, 0x2d, 0xfc, 0x85,
-	0x99, 0x8c, 0x6c, 0x25, 0x1c, 0xcf, 0x38, 0x03, 0x4c, 0x8e, 0x38, 0x32, 0x80, 0x7e, 0x02, 0x51,
-	0x23, 0x5d, 0x41, 0x6e, 0x36, 0x45, 0xad, 0x89, 0x24, 0x0d, 0xd3, 0x3b, 0x9d, 0x4d, 0xf4, 0x7b,
-	0xec, 0x3d, 0xfb, 0x84, 0x06, 0x08, 0x8e, 0x9f, 0xc5, 0x5d, 0x39, 0xab, 0x67, 0x8c, 0xb4, 0xc0,
-	0xad, 0x90, 0x16, 0x74, 0x21, 0xed, 0x07, 0xbc, 0xe1, 0x0c, 0x18, 0xb1, 0xce, 0xa6, 0xcf, 0x21,
-	0xa4, 0x20, 0xb5, 0xdd, 0x30, 0x98, 0x99, 0xd9, 0x5a, 0x75, 0x64, 0xc6, 0x84, 0xf3, 0x18, 0x5a,
-	0xec, 0xb6, 0x10, 0x4f, 0x86, 0xed, 0x04, 0xf5, 0x18, 0xdc, 0x5f, 0x7e, 0x80, 0x7d, 0xb5, 0x56,
-	0x14, 0x9b, 0x48, 0x6e, 0xdf, 0x0c, 0xdf, 0x6d, 0x49, 0x41, 0x02, 0x12, 0x3b, 0xa8, 0xea, 0xc6,
-	0xf7, 0x00, 0x61, 0xf2, 0x7d, 0x6c, 0xf5, 0xdc, 0x2a, 0xdf, 0x5f, 0x01, 0x2d, 0xa1, 0xe7, 0x5a,
-	0x49, 0x45, 0x3f, 0xb6, 0x91, 0x24, 0xa0, 0x92, 0x82, 0x84, 0x0e, 0xe6, 0x3e, 0x98, 0x5d, 0xe9,
-	0xf7, 0xd8, 0x25, 0xc3, 0xc3, 0x38, 0x86, 0xe3, 0xa3, 0x7a, 0x67, 0x81, 0xf4, 0xe9, 0xf5, 0xf0,
-	0xa0, 0xf8, 0xef, 0xf0, 0x35, 0x9a, 0x70, 0x7b, 0xd3, 0x95, 0x7b, 0x65, 0x5c, 0x41, 0x88, 0xf7,
-	0x43, 0x09, 0xaf, 0xa8, 0xff, 0x42, 0x01, 0x3f, 0x85, 0x08, 0x59, 0x56, 0x7a, 0x46, 0x64, 0x73,
-	0x5a, 0xec, 0xf7, 0x58, 0x7a, 0x68, 0xcd, 0xe9, 0x46, 0x8e, 0x37, 0xb6, 0x31, 0x23, 0xf7, 0xdb,
-	0xdc, 0x9e, 0x9c, 0x2b, 0x3f, 0xf1, 0xa1, 0x95, 0x0f, 0xb9, 0x54, 0xbe, 0x82, 0x6f, 0x11, 0xc3,
-	0xb5, 0xb9, 0x69, 0x01, 0xfc, 0xe6, 0xc7, 0xf2, 0xda, 0x15, 0xea, 0x92, 0xfc, 0xac, 0x81, 0xaa,
-	0x35, 0x84, 0xf7, 0xab, 0x0f, 0x50, 0xc0, 0x1a, 0xcc, 0x96, 0x87, 0xbd, 0x19, 0x02, 0xe0, 0x47,
-	0xbb, 0x07, 0x35, 0xd6, 0x07, 0x56, 0xdd, 0x6a, 0x8c, 0x8d, 0x66, 0x8d, 0x77, 0xf5, 0xc6, 0x47,
-	0x3e, 0x82, 0x04, 0xfc, 0x68, 0x1c, 0x61, 0xec, 0x86, 0xeb, 0xb2, 0xfe, 0x0b, 0x05, 0xf4, 0x38,
-	0x88, 0x7e, 0x04, 0x29, 0x3e, 0x5f, 0x38, 0x3a, 0x3c, 0x28, 0xe4, 0x4b, 0x7c, 0xbe, 0x70, 0xfc,
-	0x75, 0xb1, 0x54, 0xfc, 0xf6, 0x28, 0x5f, 0x3a, 0x3e, 0x28, 0x1c, 0xe5, 0x73, 0x7b, 0x4f, 0xf6,
-	0xf2, 0x5f, 0x44, 0x7d, 0xcc, 0xec, 0xd9, 0x79, 0x2a, 0x62, 0xeb, 0xa2, 0x57, 0x61, 0xc9, 0x71,
-	0xd8, 0xc1, 0xe1, 0xe1, 0x51, 0x94, 0x62, 0x26, 0xcf, 0xce, 0x53, 0x41, 0xfd, 0x9b, 0xde, 0x80,
-	0x65, 0x47, 0x60, 0xe1, 0x38, 0x97, 0xcb, 0x17, 0x0a, 0x51, 0x3f, 0x13, 0x39, 0x3b, 0x4f, 0x85,
-	0x49, 0x93, 0x09, 0xbe, 0xf8, 0x39, 0xe9, 0xdb, 0x7a, 0x33, 0x09, 0x81, 0x7d, 0xb5, 0x46, 0xd7,
-	0x61, 0x76, 0xf4, 0xb5, 0xef, 0x3c, 0xfb, 0xf1, 0x37, 0x37, 0x93, 0xf1, 0x08, 0xb4, 0x78, 0x3e,
-	0x85, 0x99, 0x91, 0x87, 0xf4, 0x7d, 0x0f, 0x2e, 0x8a, 0x4a, 0x97, 0x49, 0x7b, 0xc3, 0xb9, 0x44,
-	0xd2, 0x6f, 0xc4, 0x5e, 0x22, 0xed, 0x0a, 0x75, 0x4f, 0x91, 0x6c, 0x2f, 0x03, 0x5a, 0x03, 0xda,
-	0xe1, 0x55, 0xb0, 0xee, 0xc1, 0x0b, 0xc1, 0x32, 0x5b, 0xde, 0xb1, 0x56, 0x54, 0x09, 0xa2, 0x63,
-	0x97, 0xe7, 0xb5, 0x2b, 0xfc, 0x58, 0x48, 0xe6, 0x81, 0x57, 0xa4, 0x15, 0xef, 0x19, 0xc4, 0x1c,
-	0x2f, 0xbc, 0x5e, 0x1c, 0x99, 0xf3, 0x7c, 0x78, 0x0d, 0xb0, 0x15, 0xf8, 0x7b, 0x00, 0xdb, 0xad,
-	0x90, 0x73, 0x73, 0x31, 0xc0, 0x30, 0xeb, 0x57, 0x63, 0x2c, 0xef, 0x05, 0x08, 0x9b, 0x17, 0x20,
-	0xd6, 0x6d, 0x18, 0x01, 0x30, 0xab, 0x57, 0x00, 0xec, 0xda, 0x1b, 0x39, 0x9b, 0xef, 0x5f, 0x31,
-	0x94, 0xe0, 0xdc, 0xb5, 0xe7, 0x72, 0x9e, 0xd4, 0x61, 0x76, 0xf4, 0x10, 0x70, 0xcd, 0x72, 0x04,
-	0xe8, 0xbe, 0x78, 0x5d, 0x36, 0xc9, 0x6c, 0xe1, 0xed, 0x45, 0x92, 0x7a, 0x77, 0x91, 0xa4, 0xfe,
-	0xbe, 0x48, 0x52, 0x2f, 0x2f, 0x93, 0xbe, 0x77, 0x97, 0x49, 0xdf, 0x9f, 0x97, 0x49, 0xdf, 0xc9,
-	0xe3, 0x9a, 0xa8, 0x9d, 0xb6, 0x2b, 0x69, 0x41, 0x6e, 0x66, 0x04, 0x59, 0x6d, 0xca, 0x6a, 0x46,
-	0xac, 0x08, 0x1b, 0x35, 0x39, 0xd3, 0xd9, 0xce, 0x34, 0xe5, 0x6a, 0xbb, 0x81, 0x54, 0xe3, 0xc7,
-	0xe3, 0x83, 0xed, 0x0d, 0xf3, 0xdf, 0xa3, 0xd6, 0x6d, 0x21, 0xb5, 0x12, 0xc2, 0xff, 0x1d, 0x1f,
-	0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x1a, 0x39, 0xd7, 0x06, 0x15, 0x00, 0x00,
+	// 1447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xbb, 0x6f, 0xdb, 0x46,
+	0x18, 0x17, 0x25, 0x5a, 0x8e, 0x3f, 0xa7, 0xb1, 0x4c, 0xbf, 0x64, 0xda, 0x11, 0x55, 0x0e, 0x89,
+	0x91, 0xc2, 0x52, 0xec, 0x24, 0x28, 0x62, 0x14, 0x28, 0x2c, 0x55, 0x41, 0x8c, 0xd6, 0x0f, 0x9c,
+	0xec, 0x02, 0x49, 0x8b, 0x0a, 0x32, 0x75, 0xa1, 0x09, 0x49, 0xa4, 0x4a, 0x52, 0x4a, 0x34, 0x74,
+	0x0f, 0x32, 0x65, 0xe8, 0x14, 0x20, 0x40, 0x8a, 0x4e, 0x45, 0x81, 0xb6, 0x53, 0xff, 0x86, 0x8c,
+	0x99, 0xda, 0xa2, 0x03, 0x51, 0x24, 0x4b, 0x67, 0xfd, 0x05, 0x05, 0x8f, 0x0f, 0x51, 0x7c, 0xc0,
+	0x74, 0x62, 0x3b, 0xdd, 0x78, 0xf7, 0xfd, 0xbe, 0xc7, 0xfd, 0xbe, 0x1f, 0xc9, 0xbb, 0x83, 0x65,
+	0xe9, 0x50, 0x28, 0x0a, 0x8a, 0x8a, 0x8b, 0xc2, 0x51, 0x5d, 0x96, 0x71, 0xab, 0xd8, 0x5b, 0x2b,
+	0xea, 0x8f, 0x0a, 0x1d, 0x55, 0xd1, 0x15, 0x66, 0x46, 0x3a, 0x14, 0x0a, 0xa6, 0xb5, 0x60, 0x5b,
+	0x0b, 0xbd, 0x35, 0x76, 0x56, 0x54, 0x44, 0x85, 0xd8, 0x8b, 0xe6, 0x93, 0x05, 0x65, 0xb9, 0x61,
+	0xa0, 0x96, 0x84, 0x65, 0xdd, 0x8c, 0x63, 0x3d, 0xd9, 0x80, 0x0f, 0xc3, 0x32, 0x39, 0x61, 0x09,
+	0x84, 0xff, 0x81, 0x02, 0x66, 0x5b, 0x13, 0xcb, 0xd6, 0xe4, 0x6e, 0x07, 0xcb, 0x5b, 0xb2, 0xa4,
+	0x33, 0x1f, 0xc1, 0x78, 0x47, 0x51, 0xf5, 0x9a, 0xd4, 0xc8, 0x52, 0x79, 0x6a, 0x65, 0xa2, 0xc4,
+	0x0c, 0x0c, 0xee, 0x52, 0xbf, 0xde, 0x6e, 0x6d, 0xf0, 0xb6, 0x81, 0x47, 0x69, 0xf3, 0x69, 0xab,
+	0xc1, 0x7c, 0x02, 0xe3, 0x76, 0xd0, 0x6c, 0x32, 0x4f, 0xad, 0x4c, 0xae, 0x2f, 0x17, 0x42, 0x16,
+	0x51, 0xb0, 0x73, 0x94, 0xe8, 0x97, 0x06, 0x97, 0x40, 0x8e, 0x0b, 0x33, 0x0f, 0x69, 0x4d, 0x12,
+	0x65, 0xac, 0x66, 0x53, 0x66, 0x26, 0x64, 0x8f, 0x36, 0x2e, 0x3c, 0x7e, 0xc1, 0x25, 0xfe, 0x7d,
+	0xc1, 0x25, 0xf8, 0x16, 0xb0, 0xc1, 0x12, 0x11, 0xd6, 0x3a, 0x8a, 0xac, 0x61, 0xe6, 0x26, 0x80,
+	0x1d, 0x6a, 0x58, 0xed, 0xdc, 0xc0, 0xe0, 0xa6, 0xad, 0x6a, 0x87, 0x36, 0x1e, 0x4d, 0xd8, 0x83,
+	0xad, 0x06, 0x93, 0x85, 0xf1, 0x1e, 0x56, 0x35, 0x49, 0x91, 0x49, 0xcd, 0x13, 0xc8, 0x19, 0xf2,
+	0x7f, 0xa4, 0x60, 0x7a, 0x34, 0xdd, 0xbe, 0xda, 0x3f, 0x19, 0x21, 0x3b, 0x30, 0xd3, 0x51, 0x71,
+	0x4f, 0x52, 0xba, 0x5a, 0xcd, 0x53, 0x1b, 0x49, 0x54, 0xca, 0x0d, 0x0c, 0x8e, 0xb5, 0x1d, 0x83,
+	0x20, 0x1e, 0x4d, 0x3b, 0xb3, 0x65, 0xb7, 0x58, 0x0f, 0xc1, 0xa9, 0x93, 0x13, 0x8c, 0x60, 0x56,
+	0x50, 0xba, 0xb2, 0x8e, 0xd5, 0x4e, 0x5d, 0xd5, 0xfb, 0x35, 0x67, 0xdd, 0x34, 0x29, 0x87, 0x1b,
+	0x18, 0xdc, 0x92, 0x4d, 0x55, 0x08, 0x8a, 0x47, 0x33, 0xde, 0xe9, 0x2f, 0xad, 0x59, 0x93, 0xf4,
+	0x8e, 0xaa, 0x28, 0x0f, 0x6a, 0x92, 0x2c, 0xe9, 0xd9, 0xb1, 0x3c, 0xb5, 0x72, 0xd1, 0x4b, 0xfa,
+	0xd0, 0xc6, 0xa3, 0x09, 0x32, 0x20, 0xaa, 0xba, 0x0f, 0x17, 0x2d, 0xcb, 0x11, 0x96, 0xc4, 0x23,
+	0x3d, 0x9b, 0x26, 0x8b, 0x61, 0x3d, 0x8b, 0xb1, 0xd4, 0xdb, 0x5b, 0x2b, 0xdc, 0x25, 0x88, 0xd2,
+	0x92, 0xb9, 0x94, 0x81, 0xc1, 0xcd, 0x78, 0xe3, 0x5a, 0xde, 0x3c, 0x9a, 0x24, 0x43, 0x0b, 0xe9,
+	0x91, 0xd1, 0x78, 0x84, 0x8c, 0x6e, 0xc1, 0x62, 0xa0, 0xaf, 0xae, 0x8a, 0x3c, 0x7a, 0xa0, 0x46,
+	0xf5, 0xf0, 0x67, 0x40, 0x0f, 0x9b, 0x42, 0xf3, 0x64, 0x7a, 0x18, 0x95, 0x68, 0x32, 0xa6, 0x44,
+	0xef, 0xc3, 0xc2, 0x48, 0x47, 0x3c, 0x21, 0xc8, 0x9b, 0x52, 0xe2, 0x07, 0x06, 0x97, 0x0b, 0x69,
+	0x9d, 0x37, 0xde, 0x9c, 0xd7, 0x32, 0x54, 0xd4, 0x59, 0x68, 0x62, 0x0d, 0xac, 0x56, 0xd7, 0x74,
+	0xb5, 0x6f, 0x4b, 0x62, 0x76, 0x60, 0x70, 0x19, 0x6f, 0xeb, 0x74, 0xb5, 0xcf, 0xa3, 0x0b, 0xe4,
+	0xd9, 0x7c, 0xab, 0xde, 0xaf, 0x20, 0x96, 0xfc, 0x82, 0xd8, 0x14, 0x9a, 0x8e, 0x20, 0xf8, 0x9f,
+	0x93, 0x30, 0x37, 0x6a, 0x2d, 0x2b, 0xf2, 0x03, 0x49, 0x6d, 0x9f, 0x47, 0xeb, 0x5d, 0x2a, 0xeb,
+	0x42, 0x33, 0x9b, 0x0a, 0xa7, 0xb2, 0x2e, 0x34, 0x1d, 0x2a, 0x4d, 0x41, 0xfa, 0xa9, 0xa4, 0xcf,
+	0x84, 0xca, 0xb1, 0x08, 0x2a, 0x39, 0xb8, 0x1c, 0x4a, 0x96, 0x4b, 0xe7, 0x33, 0x0a, 0x66, 0x86,
+	0x88, 0x72, 0x4b, 0xd1, 0xf0, 0xc9, 0x7f, 0x34, 0x6f, 0x47, 0xe6, 0xf1, 0x3f, 0x98, 0xcb, 0xb0,
+	0x14, 0x52, 0x9b, 0x5b, 0xfb, 0xaf, 0x49, 0x98, 0xf7, 0xd9, 0xcf, 0x51, 0x0b, 0xa3, 0x9f, 0xda,
+	0xd4, 0x5b, 0x7e, 0x6a, 0xcf, 0x57, 0x0e, 0x79, 0xc8, 0x85, 0x13, 0xe6, 0x72, 0xfa, 0x34, 0x09,
+	0x1f, 0x6c, 0x6b, 0x22, 0xc2, 0x42, 0x6f, 0xaf, 0x2e, 0x34, 0xb1, 0xce, 0xdc, 0x86, 0x74, 0x87,
+	0x3c, 0x11, 0x26, 0x27, 0xd7, 0x97, 0x42, 0xff, 0x71, 0x16, 0xd8, 0xfe, 0xc5, 0xd9, 0x0e, 0xcc,
+	0x1d, 0xc8, 0x58, 0xe5, 0x0a, 0x4a, 0xbb, 0x2d, 0xe9, 0x6d, 0x2c, 0xeb, 0x84, 0xde, 0x8b, 0xa5,
+	0xa5, 0x81, 0xc1, 0x2d, 0x78, 0x17, 0x34, 0x44, 0xf0, 0x68, 0x8a, 0x4c, 0x95, 0xdd, 0x99, 0x00,
+	0x69, 0xa9, 0x33, 0x21, 0x8d, 0x8e, 0x20, 0xed, 0x1b, 0x98, 0x1b, 0x61, 0xc4, 0xfd, 0x37, 0x7d,
+	0x0a, 0x69, 0x15, 0x6b, 0xdd, 0x96, 0xc5, 0xcc, 0xa5, 0xf5, 0xab, 0xa1, 0xcc, 0x38, 0x70, 0x44,
+	0xa0, 0xfb, 0xfd, 0x0e, 0x46, 0xb6, 0xdb, 0x06, 0x6d, 0xe6, 0xe0, 0xff, 0x4e, 0x02, 0x6c, 0x6b,
+	0xe2, 0xbe, 0xd4, 0xc6, 0x4a, 0xf7, 0x74, 0xf8, 0xee, 0xca, 0x2a, 0x16, 0xb0, 0xd4, 0xc3, 0x8d,
+	0x28, 0xbe, 0x87, 0x08, 0x87, 0xef, 0x03, 0x77, 0xe6, 0x4c, 0xf9, 0xfe, 0x1c, 0x18, 0x19, 0x3f,
+	0xd2, 0x6b, 0x1a, 0xfe, 0xb6, 0x8b, 0x65, 0x01, 0xd7, 0x54, 0x2c, 0xf4, 0x08, 0xf7, 0x74, 0xe9,
+	0xf2, 0xc0, 0xe0, 0x16, 0xad, 0x08, 0x41, 0x0c, 0x8f, 0x32, 0xe6, 0x64, 0xd5, 0x9e, 0x33, 0xfb,
+	0x11, 0x43, 0xf1, 0x5f, 0x01, 0x33, 0xe4, 0xf6, 0xb4, 0x3b, 0xf7, 0xcc, 0xda, 0x82, 0xd8, 0xd1,
+	0x77, 0x65, 0xf2, 0x46, 0xfd, 0x1f, 0x1a, 0xf8, 0x31, 0x4c, 0xda, 0xaf, 0x95, 0x59, 0x91, 0xfd,
+	0x71, 0x9a, 0x1f, 0x18, 0x1c, 0x33, 0xf2, 0xce, 0x99, 0x46, 0x1e, 0x59, 0x9f, 0x31, 0xab, 0xf6,
+	0xb3, 0xfc, 0x3c, 0x85, 0x77, 0x7e, 0xec, 0x5d, 0x3b, 0x9f, 0x8e, 0xe8, 0xfc, 0x21, 0x2c, 0x06,
+	0x7a, 0x73, 0xda, 0x02, 0xf8, 0x2d, 0x49, 0xe4, 0xb5, 0x29, 0x34, 0x65, 0xe5, 0x61, 0x0b, 0x37,
+	0x44, 0x4c, 0xbe, 0x57, 0xef, 0xa0, 0x80, 0x15, 0x98, 0xaa, 0x8f, 0x46, 0xb3, 0x04, 0x80, 0xfc,
+	0xd3, 0xc3, 0x1e, 0x9b, 0x8e, 0x8d, 0xa8, 0x1e, 0x13, 0xa3, 0xd3, 0xe3, 0x4d, 0x73, 0xf0, 0x9e,
+	0x7f, 0x41, 0x02, 0xb0, 0x41, 0xc6, 0x4e, 0xbb, 0x2f, 0xbf, 0xa7, 0x20, 0xbb, 0xad, 0x89, 0x7b,
+	0x6a, 0x57, 0xc6, 0xbe, 0x54, 0xda, 0x79, 0xec, 0x0d, 0xee, 0xc2, 0xf4, 0xa8, 0x8c, 0x9d, 0xfd,
+	0x22, 0x5d, 0x5a, 0x1e, 0x18, 0x5c, 0x36, 0x4c, 0xe9, 0x64, 0xdf, 0x38, 0xe5, 0x15, 0xba, 0xb9,
+	0x7d, 0xbc, 0x07, 0x0b, 0x16, 0xdd, 0xc1, 0x78, 0x34, 0xe9, 0xb8, 0xe7, 0xb0, 0x11, 0x01, 0xe4,
+	0xd1, 0x2c, 0xb1, 0xec, 0xf8, 0x42, 0xfb, 0x75, 0x30, 0x76, 0x8a, 0x3a, 0x98, 0x85, 0xb1, 0x96,
+	0xd4, 0x96, 0xac, 0x93, 0x03, 0x8d, 0xac, 0x41, 0x8c, 0xad, 0xff, 0xf7, 0x14, 0xe4, 0xa3, 0x1a,
+	0xe7, 0xb9, 0x59, 0x98, 0xd7, 0x15, 0xbd, 0xde, 0xaa, 0x75, 0x4c, 0x58, 0xc3, 0x5d, 0xac, 0x46,
+	0xfa, 0x49, 0xa3, 0x59, 0x62, 0x25, 0x31, 0x1a, 0xce, 0x82, 0x35, 0x66, 0x03, 0x16, 0x2d, 0x2f,
+	0x15, 0xb7, 0xeb, 0x92, 0x2c, 0xc9, 0xa2, 0xc7, 0x31, 0x49, 0x1c, 0x17, 0x08, 0x00, 0x39, 0x76,
+	0xd7, 0xf7, 0xda, 0x4f, 0x14, 0x30, 0x41, 0xd1, 0x31, 0xb7, 0x20, 0x8f, 0x2a, 0xd5, 0xbd, 0xdd,
+	0x9d, 0x6a, 0xa5, 0x86, 0x2a, 0xd5, 0x83, 0x2f, 0xf6, 0x6b, 0xfb, 0xf7, 0xf6, 0x2a, 0xb5, 0x83,
+	0x9d, 0xea, 0x5e, 0xa5, 0xbc, 0x75, 0x67, 0xab, 0xf2, 0x59, 0x26, 0xc1, 0x4e, 0x3d, 0x79, 0x9e,
+	0x9f, 0xf4, 0x4c, 0x31, 0x57, 0x61, 0x31, 0xd4, 0x6d, 0x67, 0x77, 0x77, 0x2f, 0x43, 0xb1, 0x17,
+	0x9e, 0x3c, 0xcf, 0xd3, 0xe6, 0x33, 0xb3, 0x0a, 0xcb, 0xa1, 0xc0, 0xea, 0x41, 0xb9, 0x5c, 0xa9,
+	0x56, 0x33, 0x49, 0x76, 0xf2, 0xc9, 0xf3, 0xfc, 0xb8, 0x3d, 0x64, 0xe9, 0xc7, 0x3f, 0xe6, 0x12,
+	0xeb, 0xbf, 0x4c, 0x40, 0x6a, 0x5b, 0x13, 0x99, 0x26, 0x4c, 0xf9, 0x6f, 0x8f, 0xc2, 0xdf, 0xa6,
+	0xe0, 0x1d, 0x0e, 0x5b, 0x8c, 0x09, 0x74, 0x5b, 0x72, 0x04, 0x97, 0x7c, 0x17, 0x33, 0x57, 0x62,
+	0x84, 0xd8, 0x57, 0xfb, 0x6c, 0x21, 0x1e, 0x2e, 0x22, 0x93, 0xa9, 0xe3, 0x38, 0x99, 0x36, 0x85,
+	0x66, 0xac, 0x4c, 0x9e, 0x93, 0x26, 0xa3, 0x03, 0x13, 0x72, 0xca, 0xbc, 0x16, 0x23, 0x8a, 0x8d,
+	0x65, 0xd7, 0xe3, 0x63, 0xdd, 0xac, 0x32, 0x64, 0x02, 0x87, 0xb1, 0x95, 0x63, 0xe2, 0xb8, 0x48,
+	0xf6, 0x7a, 0x5c, 0xa4, 0x9b, 0xef, 0x21, 0xcc, 0x84, 0x1e, 0xa0, 0xe2, 0x04, 0x72, 0xd6, 0x79,
+	0xe3, 0x04, 0x60, 0x37, 0xf1, 0xd7, 0x00, 0x9e, 0x53, 0x06, 0x1f, 0x15, 0x62, 0x88, 0x61, 0xaf,
+	0x1d, 0x8f, 0x71, 0xa3, 0x57, 0x61, 0xdc, 0xd9, 0x50, 0x73, 0x51, 0x6e, 0x36, 0x80, 0xbd, 0x7a,
+	0x0c, 0xc0, 0xab, 0x3d, 0xdf, 0x5e, 0xef, 0xca, 0x31, 0xae, 0x36, 0x8e, 0x2d, 0xc4, 0xc3, 0xb9,
+	0x99, 0x9a, 0x30, 0xe5, 0xdf, 0x54, 0x44, 0x56, 0xe9, 0x03, 0xb2, 0xc5, 0x98, 0x40, 0x37, 0xd9,
+	0x77, 0x30, 0x17, 0xfe, 0xa7, 0x5c, 0x8d, 0x8a, 0x14, 0x0a, 0x67, 0x6f, 0x9d, 0x08, 0xee, 0xa4,
+	0x2f, 0x55, 0x5f, 0xbe, 0xce, 0x51, 0xaf, 0x5e, 0xe7, 0xa8, 0x7f, 0x5e, 0xe7, 0xa8, 0xa7, 0x6f,
+	0x72, 0x89, 0x57, 0x6f, 0x72, 0x89, 0xbf, 0xde, 0xe4, 0x12, 0xf7, 0x6f, 0x8b, 0x92, 0x7e, 0xd4,
+	0x3d, 0x2c, 0x08, 0x4a, 0xbb, 0x28, 0x28, 0x5a, 0x5b, 0xd1, 0x8a, 0xd2, 0xa1, 0xb0, 0x2a, 0x2a,
+	0xc5, 0xde, 0xcd, 0x62, 0x5b, 0x69, 0x74, 0x5b, 0x58, 0xb3, 0xee, 0xd1, 0xaf, 0xdf, 0x5c, 0x75,
+	0xae, 0xd2, 0xf5, 0x7e, 0x07, 0x6b, 0x87, 0x69, 0x72, 0x8d, 0x7e, 0xe3, 0xbf, 0x01, 0x00, 0xff,
+	0xdc, 0xfe, 0x0f, 0xd5, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1035,6 +1149,8 @@ type MsgClient interface {
 	TimeoutOnClose(ctx context.Context, in *MsgTimeoutOnClose, opts ...grpc.CallOption) (*MsgTimeoutOnCloseResponse, error)
 	// Acknowledgement defines a rpc handler method for MsgAcknowledgement.
 	Acknowledgement(ctx context.Context, in *MsgAcknowledgement, opts ...grpc.CallOption) (*MsgAcknowledgementResponse, error)
+	// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
+	PruneAcknowledgements(ctx context.Context, in *MsgPruneAcknowledgements, opts ...grpc.CallOption) (*MsgPruneAcknowledgementsResponse, error)
 }
 
 type msgClient struct {
@@ -1135,6 +1251,15 @@ func (c *msgClient) Acknowledgement(ctx context.Context, in *MsgAcknowledgement,
 	return out, nil
 }
 
+func (c *msgClient) PruneAcknowledgements(ctx context.Context, in *MsgPruneAcknowledgements, opts ...grpc.CallOption) (*MsgPruneAcknowledgementsResponse, error) {
+	out := new(MsgPruneAcknowledgementsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/PruneAcknowledgements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ChannelOpenInit defines a rpc handler method for MsgChannelOpenInit.
@@ -1158,6 +1283,8 @@ type MsgServer interface {
 	TimeoutOnClose(context.Context, *MsgTimeoutOnClose) (*MsgTimeoutOnCloseResponse, error)
 	// Acknowledgement defines a rpc handler method for MsgAcknowledgement.
 	Acknowledgement(context.Context, *MsgAcknowledgement) (*MsgAcknowledgementResponse, error)
+	// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
+	PruneAcknowledgements(context.Context, *MsgPruneAcknowledgements) (*MsgPruneAcknowledgementsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1194,6 +1321,9 @@ func (*UnimplementedMsgServer) TimeoutOnClose(ctx context.Context, req *MsgTimeo
 func (*UnimplementedMsgServer) Acknowledgement(ctx context.Context, req *MsgAcknowledgement) (*MsgAcknowledgementResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Acknowledgement not implemented")
 }
+func (*UnimplementedMsgServer) PruneAcknowledgements(ctx context.Context, req *MsgPruneAcknowledgements) (*MsgPruneAcknowledgementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneAcknowledgements not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1379,6 +1509,24 @@ func _Msg_Acknowledgement_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PruneAcknowledgements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneAcknowledgements)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneAcknowledgements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/PruneAcknowledgements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneAcknowledgements(ctx, req.(*MsgPruneAcknowledgements))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.channel.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1423,6 +1571,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Acknowledgement",
 			Handler:    _Msg_Acknowledgement_Handler,
 		},
+		{
+			MethodName: "PruneAcknowledgements",
+			Handler:    _Msg_PruneAcknowledgements_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/channel/v1/tx.proto",
@@ -2317,6 +2469,110 @@ func (m *MsgAcknowledgementResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPruneAcknowledgements) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneAcknowledgements) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneAcknowledgements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ProofNextSequenceAck) > 0 {
+		i -= len(m.ProofNextSequenceAck)
+		copy(dAtA[i:], m.ProofNextSequenceAck)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofNextSequenceAck)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NextSequenceAck != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NextSequenceAck))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneAcknowledgementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneAcknowledgementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneAcknowledgementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalRemainingSequences != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalRemainingSequences))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalPrunedSequences != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalPrunedSequences))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2702,6 +2958,54 @@ func (m *MsgAcknowledgementResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPruneAcknowledgements) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NextSequenceAck != 0 {
+		n += 1 + sovTx(uint64(m.NextSequenceAck))
+	}
+	l = len(m.ProofNextSequenceAck)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneAcknowledgementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalPrunedSequences != 0 {
+		n += 1 + sovTx(uint64(m.TotalPrunedSequences))
+	}
+	if m.TotalRemainingSequences != 0 {
+		n += 1 + sovTx(uint64(m.TotalRemainingSequences))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5488,6 +5792,345 @@ func (m *MsgAcknowledgementResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPruneAcknowledgements) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneAcknowledgements: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneAcknowledgements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceAck", wireType)
+			}
+			m.NextSequenceAck = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceAck |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofNextSequenceAck", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofNextSequenceAck = append(m.ProofNextSequenceAck[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofNextSequenceAck == nil {
+				m.ProofNextSequenceAck = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPruneAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPruneAcknowledgementsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPruneAcknowledgementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrunedSequences", wireType)
+			}
+			m.TotalPrunedSequences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPrunedSequences |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingSequences", wireType)
+			}
+			m.TotalRemainingSequences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRemainingSequences |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -133,3 +133,21 @@ type Middleware interface {
 	IBCModule
 	ICS4Wrapper
 }
+
+// PacketDataUnmarshaler defines an optional interface which allows a middleware to
+// request the packet data to be unmarshaled by the base application.
+type PacketDataUnmarshaler interface {
+	// UnmarshalPacketData unmarshals the packet data sent over the provided port and channel
+	// into a concrete type.
+	UnmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (interface{}, error)
+}
+
+// RecvPacketStateRetainer defines an optional interface which allows an IBC application to retain the
+// state changes of an OnRecvPacket callback resulting in an unsuccessful acknowledgement, which are
+// otherwise discarded by core IBC. The application is responsible for reverting the state changes which
+// must not be committed for an unsuccessful acknowledgement itself.
+type RecvPacketStateRetainer interface {
+	// RetainStateOnErrorAcknowledgement returns true if the state changes of the OnRecvPacket callback
+	// must be committed for unsuccessful acknowledgements.
+	RetainStateOnErrorAcknowledgement() bool
+}
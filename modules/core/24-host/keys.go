@@ -27,20 +27,25 @@ var (
 
 // KVStore key prefixes for IBC
 const (
-	KeyClientState             = "clientState"
-	KeyConsensusStatePrefix    = "consensusStates"
-	KeyConnectionPrefix        = "connections"
-	KeyChannelEndPrefix        = "channelEnds"
-	KeyChannelPrefix           = "channels"
-	KeyPortPrefix              = "ports"
-	KeySequencePrefix          = "sequences"
-	KeyChannelCapabilityPrefix = "capabilities"
-	KeyNextSeqSendPrefix       = "nextSequenceSend"
-	KeyNextSeqRecvPrefix       = "nextSequenceRecv"
-	KeyNextSeqAckPrefix        = "nextSequenceAck"
-	KeyPacketCommitmentPrefix  = "commitments"
-	KeyPacketAckPrefix         = "acks"
-	KeyPacketReceiptPrefix     = "receipts"
+	KeyClientState                = "clientState"
+	KeyConsensusStatePrefix       = "consensusStates"
+	KeyConnectionPrefix           = "connections"
+	KeyConnectionChannelsPrefix   = "connectionChannels"
+	KeyChannelEndPrefix           = "channelEnds"
+	KeyChannelPrefix              = "channels"
+	KeyPortPrefix                 = "ports"
+	KeySequencePrefix             = "sequences"
+	KeyChannelCapabilityPrefix    = "capabilities"
+	KeyNextSeqSendPrefix          = "nextSequenceSend"
+	KeyNextSeqRecvPrefix          = "nextSequenceRecv"
+	KeyNextSeqAckPrefix           = "nextSequenceAck"
+	KeyPacketCommitmentPrefix     = "commitments"
+	KeyPacketAckPrefix            = "acks"
+	KeyPacketReceiptPrefix        = "receipts"
+	KeyRecvStartSequencePrefix    = "recvStartSequence"
+	KeyPruningSequenceStartPrefix = "pruningSequenceStart"
+	KeyStoredPacketPrefix         = "storedPackets"
+	KeyStoredPacketCountPrefix    = "storedPacketCount"
 )
 
 // FullClientPath returns the full path of a specific client path in the format:
@@ -123,6 +128,24 @@ func ConnectionKey(connectionID string) []byte {
 	return []byte(ConnectionPath(connectionID))
 }
 
+// ConnectionChannelsPrefixPath defines the prefix under which the channels built on
+// top of a connection are indexed.
+func ConnectionChannelsPrefixPath(connectionID string) string {
+	return fmt.Sprintf("%s/%s", KeyConnectionChannelsPrefix, connectionID)
+}
+
+// ConnectionChannelPath defines the path under which a channel built on top of a
+// connection is indexed. The path ends with the path under which the channel is stored.
+func ConnectionChannelPath(connectionID, portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", ConnectionChannelsPrefixPath(connectionID), ChannelPath(portID, channelID))
+}
+
+// ConnectionChannelKey returns the store key under which a channel built on top of a
+// connection is indexed.
+func ConnectionChannelKey(connectionID, portID, channelID string) []byte {
+	return []byte(ConnectionChannelPath(connectionID, portID, channelID))
+}
+
 // ICS04
 // The following paths are the keys to the store as defined in https://github.com/cosmos/ibc/tree/master/spec/core/ics-004-channel-and-packet-semantics#store-paths
 
@@ -175,6 +198,58 @@ func NextSequenceAckKey(portID, channelID string) []byte {
 	return []byte(NextSequenceAckPath(portID, channelID))
 }
 
+// RecvStartSequencePath defines the path under which the sequence below which
+// packets are no longer received is stored.
+func RecvStartSequencePath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyRecvStartSequencePrefix, channelPath(portID, channelID))
+}
+
+// RecvStartSequenceKey returns the store key for the recv start sequence of a
+// particular channel binded to a specific port.
+func RecvStartSequenceKey(portID, channelID string) []byte {
+	return []byte(RecvStartSequencePath(portID, channelID))
+}
+
+// PruningSequenceStartPath defines the path under which the sequence from which
+// the next pruning of packet receipts and acknowledgements starts is stored.
+func PruningSequenceStartPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyPruningSequenceStartPrefix, channelPath(portID, channelID))
+}
+
+// PruningSequenceStartKey returns the store key for the pruning sequence start
+// of a particular channel binded to a specific port.
+func PruningSequenceStartKey(portID, channelID string) []byte {
+	return []byte(PruningSequenceStartPath(portID, channelID))
+}
+
+// StoredPacketCountPath defines the path under which the number of sent packets
+// stored for a channel is stored.
+func StoredPacketCountPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyStoredPacketCountPrefix, channelPath(portID, channelID))
+}
+
+// StoredPacketCountKey returns the store key for the stored packet count of a
+// particular channel binded to a specific port.
+func StoredPacketCountKey(portID, channelID string) []byte {
+	return []byte(StoredPacketCountPath(portID, channelID))
+}
+
+// StoredPacketPath defines the path under which a sent packet is stored until it
+// is acknowledged or timed out.
+func StoredPacketPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%d", StoredPacketPrefixPath(portID, channelID), sequence)
+}
+
+// StoredPacketKey returns the store key under which a sent packet is stored
+func StoredPacketKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(StoredPacketPath(portID, channelID, sequence))
+}
+
+// StoredPacketPrefixPath defines the prefix for the sent packets store path.
+func StoredPacketPrefixPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyStoredPacketPrefix, channelPath(portID, channelID), KeySequencePrefix)
+}
+
 // PacketCommitmentPath defines the commitments to packet data fields store path
 func PacketCommitmentPath(portID, channelID string, sequence uint64) string {
 	return fmt.Sprintf("%s/%d", PacketCommitmentPrefixPath(portID, channelID), sequence)
@@ -6,13 +6,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 
 	ibcclient "github.com/cosmos/ibc-go/v4/modules/core/02-client"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	connection "github.com/cosmos/ibc-go/v4/modules/core/03-connection"
 	channel "github.com/cosmos/ibc-go/v4/modules/core/04-channel"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 )
 
-// GetTxCmd returns the transaction commands for this module
-func GetTxCmd() *cobra.Command {
+// GetTxCmd returns the transaction commands for this module. The tx commands
+// of the provided client modules are added to the root command.
+func GetTxCmd(clientModules ...clienttypes.ClientModule) *cobra.Command {
 	ibcTxCmd := &cobra.Command{
 		Use:                        host.ModuleName,
 		Short:                      "IBC transaction subcommands",
@@ -23,14 +25,22 @@ func GetTxCmd() *cobra.Command {
 
 	ibcTxCmd.AddCommand(
 		ibcclient.GetTxCmd(),
+		connection.GetTxCmd(),
 		channel.GetTxCmd(),
 	)
 
+	for _, clientModule := range clientModules {
+		if cmd := clientModule.GetTxCmd(); cmd != nil {
+			ibcTxCmd.AddCommand(cmd)
+		}
+	}
+
 	return ibcTxCmd
 }
 
-// GetQueryCmd returns the cli query commands for this module
-func GetQueryCmd() *cobra.Command {
+// GetQueryCmd returns the cli query commands for this module. The query commands
+// of the provided client modules are added to the root command.
+func GetQueryCmd(clientModules ...clienttypes.ClientModule) *cobra.Command {
 	// Group ibc queries under a subcommand
 	ibcQueryCmd := &cobra.Command{
 		Use:                        host.ModuleName,
@@ -46,5 +56,11 @@ func GetQueryCmd() *cobra.Command {
 		channel.GetQueryCmd(),
 	)
 
+	for _, clientModule := range clientModules {
+		if cmd := clientModule.GetQueryCmd(); cmd != nil {
+			ibcQueryCmd.AddCommand(cmd)
+		}
+	}
+
 	return ibcQueryCmd
 }
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+// FlagCounterpartyNode defines the flag for the Tendermint RPC endpoint of the counterparty chain node
+const FlagCounterpartyNode = "counterparty-node"
+
+// AddCounterpartyFlagsToCmd adds the flags required to query proofs from the counterparty chain to the provided command
+func AddCounterpartyFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().String(FlagCounterpartyNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface of the counterparty chain")
+}
+
+// GetCounterpartyQueryContext returns a client context querying the counterparty chain node provided with the
+// counterparty node flag. Queries are performed at the provided proof height, which should be the latest height of
+// the client tracking the counterparty chain, such that the queried proofs can be verified by that client. The client
+// must therefore be updated beforehand if the counterparty state changed after its latest height.
+func GetCounterpartyQueryContext(cmd *cobra.Command, clientCtx client.Context, proofHeight exported.Height) (client.Context, error) {
+	nodeURI, err := cmd.Flags().GetString(FlagCounterpartyNode)
+	if err != nil {
+		return client.Context{}, err
+	}
+
+	counterpartyCtx, err := NewCounterpartyQueryContext(clientCtx, nodeURI)
+	if err != nil {
+		return client.Context{}, err
+	}
+
+	return WithCounterpartyProofHeight(counterpartyCtx, proofHeight)
+}
+
+// NewCounterpartyQueryContext returns a client context querying the counterparty chain node at the provided
+// Tendermint RPC endpoint. The chain ID of the context is set to the network reported by the node.
+func NewCounterpartyQueryContext(clientCtx client.Context, nodeURI string) (client.Context, error) {
+	node, err := client.NewClientFromNode(nodeURI)
+	if err != nil {
+		return client.Context{}, err
+	}
+
+	status, err := node.Status(context.Background())
+	if err != nil {
+		return client.Context{}, err
+	}
+
+	return clientCtx.
+		WithNodeURI(nodeURI).
+		WithClient(node).
+		WithChainID(status.NodeInfo.Network), nil
+}
+
+// WithCounterpartyProofHeight returns the provided counterparty client context querying proofs verifiable at the
+// provided proof height. The revision number of the proof height must match the counterparty chain ID.
+func WithCounterpartyProofHeight(counterpartyCtx client.Context, proofHeight exported.Height) (client.Context, error) {
+	if revision := clienttypes.ParseChainID(counterpartyCtx.ChainID); revision != proofHeight.GetRevisionNumber() {
+		return client.Context{}, fmt.Errorf("revision number %d of counterparty chain %s does not match proof height %s", revision, counterpartyCtx.ChainID, proofHeight)
+	}
+
+	return counterpartyCtx.WithHeight(int64(proofHeight.GetRevisionHeight())), nil
+}
@@ -176,6 +176,19 @@ type ClientState interface {
 		channelID string,
 		nextSequenceRecv uint64,
 	) error
+	VerifyNextSequenceAck(
+		ctx sdk.Context,
+		store sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		prefix Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		nextSequenceAck uint64,
+	) error
 }
 
 // ConsensusState is the state of the consensus process
@@ -147,6 +147,7 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 						channeltypes.NewPacketSequence(port2, channel2, 1),
 					},
 					0,
+					channeltypes.DefaultParams(),
 				),
 			},
 			expPass: true,
@@ -311,6 +312,7 @@ func (suite *IBCTestSuite) TestInitGenesis() {
 						channeltypes.NewPacketSequence(port2, channel2, 1),
 					},
 					0,
+					channeltypes.DefaultParams(),
 				),
 			},
 		},
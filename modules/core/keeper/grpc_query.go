@@ -33,11 +33,26 @@ func (q Keeper) ConsensusStateHeights(c context.Context, req *clienttypes.QueryC
 	return q.ClientKeeper.ConsensusStateHeights(c, req)
 }
 
+// UpdateInfo implements the IBC QueryServer interface
+func (q Keeper) UpdateInfo(c context.Context, req *clienttypes.QueryUpdateInfoRequest) (*clienttypes.QueryUpdateInfoResponse, error) {
+	return q.ClientKeeper.UpdateInfo(c, req)
+}
+
+// ConsensusStateAtTime implements the IBC QueryServer interface
+func (q Keeper) ConsensusStateAtTime(c context.Context, req *clienttypes.QueryConsensusStateAtTimeRequest) (*clienttypes.QueryConsensusStateAtTimeResponse, error) {
+	return q.ClientKeeper.ConsensusStateAtTime(c, req)
+}
+
 // ClientStatus implements the IBC QueryServer interface
 func (q Keeper) ClientStatus(c context.Context, req *clienttypes.QueryClientStatusRequest) (*clienttypes.QueryClientStatusResponse, error) {
 	return q.ClientKeeper.ClientStatus(c, req)
 }
 
+// ClientHealth implements the IBC QueryServer interface
+func (q Keeper) ClientHealth(c context.Context, req *clienttypes.QueryClientHealthRequest) (*clienttypes.QueryClientHealthResponse, error) {
+	return q.ClientKeeper.ClientHealth(c, req)
+}
+
 // ClientParams implements the IBC QueryServer interface
 func (q Keeper) ClientParams(c context.Context, req *clienttypes.QueryClientParamsRequest) (*clienttypes.QueryClientParamsResponse, error) {
 	return q.ClientKeeper.ClientParams(c, req)
@@ -137,3 +152,18 @@ func (q Keeper) UnreceivedAcks(c context.Context, req *channeltypes.QueryUnrecei
 func (q Keeper) NextSequenceReceive(c context.Context, req *channeltypes.QueryNextSequenceReceiveRequest) (*channeltypes.QueryNextSequenceReceiveResponse, error) {
 	return q.ChannelKeeper.NextSequenceReceive(c, req)
 }
+
+// ChannelParams implements the IBC QueryServer interface
+func (q Keeper) ChannelParams(c context.Context, req *channeltypes.QueryChannelParamsRequest) (*channeltypes.QueryChannelParamsResponse, error) {
+	return q.ChannelKeeper.ChannelParams(c, req)
+}
+
+// PrunableRange implements the IBC QueryServer interface
+func (q Keeper) PrunableRange(c context.Context, req *channeltypes.QueryPrunableRangeRequest) (*channeltypes.QueryPrunableRangeResponse, error) {
+	return q.ChannelKeeper.PrunableRange(c, req)
+}
+
+// Packet implements the IBC QueryServer interface
+func (q Keeper) Packet(c context.Context, req *channeltypes.QueryPacketRequest) (*channeltypes.QueryPacketResponse, error) {
+	return q.ChannelKeeper.Packet(c, req)
+}
@@ -12,8 +12,11 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	ibchost "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v4/modules/core/keeper"
+	solomachine "github.com/cosmos/ibc-go/v4/modules/light-clients/06-solomachine"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 )
 
@@ -62,6 +65,7 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 		stakingKeeper clienttypes.StakingKeeper
 		upgradeKeeper clienttypes.UpgradeKeeper
 		scopedKeeper  capabilitykeeper.ScopedKeeper
+		clientRouter  *clienttypes.ClientRouter
 		newIBCKeeper  = func() {
 			ibckeeper.NewKeeper(
 				suite.chainA.GetSimApp().AppCodec(),
@@ -70,6 +74,7 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 				stakingKeeper,
 				upgradeKeeper,
 				scopedKeeper,
+				clientRouter,
 			)
 		}
 	)
@@ -100,6 +105,9 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 
 			scopedKeeper = emptyScopedKeeper
 		}, false},
+		{"failure: nil client router", func() {
+			clientRouter = nil
+		}, false},
 		{"success: replace stakingKeeper with non-empty MockStakingKeeper", func() {
 			// use a different implementation of clienttypes.StakingKeeper
 			mockStakingKeeper := MockStakingKeeper{"not empty"}
@@ -116,6 +124,7 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 			stakingKeeper = suite.chainA.GetSimApp().StakingKeeper
 			upgradeKeeper = suite.chainA.GetSimApp().UpgradeKeeper
 			scopedKeeper = suite.chainA.GetSimApp().ScopedIBCKeeper
+			clientRouter = suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.GetRouter()
 
 			tc.malleate()
 
@@ -131,3 +140,45 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 		})
 	}
 }
+
+// TestClientRouter verifies that the client router passed to the IBC keeper is used by the
+// connection and channel keepers as well as by copies of the client keeper.
+func (suite *KeeperTestSuite) TestClientRouter() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// client router without the 07-tendermint client module
+	clientRouter := clienttypes.NewClientRouter().AddRoute(solomachine.NewClientModule())
+
+	app := suite.chainA.GetSimApp()
+	ibcKeeper := ibckeeper.NewKeeper(
+		app.AppCodec(), app.GetKey(ibchost.StoreKey), app.GetSubspace(ibchost.ModuleName),
+		app.StakingKeeper, app.UpgradeKeeper, app.ScopedIBCKeeper, clientRouter,
+	)
+
+	// the client router is sealed upon keeper creation
+	suite.Require().True(clientRouter.Sealed())
+
+	// copies of the client keeper, e.g. the one of the client proposal handler, share the client router
+	clientKeeper := ibcKeeper.ClientKeeper
+	suite.Require().Same(clientRouter, clientKeeper.GetRouter())
+
+	ctx := suite.chainA.GetContext()
+	clientState := path.EndpointA.GetClientState()
+	connection := path.EndpointA.GetConnection()
+	packet := channeltypes.NewPacket(
+		ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0,
+	)
+	chanCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+	suite.Require().Equal(exported.Unknown, clientKeeper.GetClientStatus(ctx, clientState, path.EndpointA.ClientID))
+
+	err := ibcKeeper.ConnectionKeeper.VerifyConnectionState(
+		ctx, connection, clientState.GetLatestHeight(), []byte("proof"), path.EndpointB.ConnectionID, connection,
+	)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientModuleNotFound)
+
+	err = ibcKeeper.ChannelKeeper.SendPacket(ctx, chanCap, packet)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientNotActive)
+}
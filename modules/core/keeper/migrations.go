@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	clientkeeper "github.com/cosmos/ibc-go/v4/modules/core/02-client/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -30,3 +31,38 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+// This migration sets the default ibc channel parameters, which limit the size of
+// the data of packets sent and configure the gas consumed per byte of packet data received.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.ChannelKeeper.SetParams(ctx, channeltypes.DefaultParams())
+
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// This migration sets the ibc channel parameter limiting the number of sent packets
+// stored to its default, which disables the storage of sent packets. The existing
+// channel parameters are retained.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params := channeltypes.NewParams(
+		m.keeper.ChannelKeeper.GetMaxPacketDataSize(ctx),
+		m.keeper.ChannelKeeper.GetRecvPacketGasPerByte(ctx),
+		channeltypes.DefaultMaxStoredPackets,
+	)
+	m.keeper.ChannelKeeper.SetParams(ctx, params)
+
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+// This migration indexes every existing channel under the connection it is built on top of.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.ChannelKeeper.IterateChannels(ctx, func(channel channeltypes.IdentifiedChannel) bool {
+		m.keeper.ChannelKeeper.SetConnectionChannel(ctx, channel.ConnectionHops[0], channel.PortId, channel.ChannelId)
+		return false
+	})
+
+	return nil
+}
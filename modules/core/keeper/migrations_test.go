@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibchost "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibckeeper "github.com/cosmos/ibc-go/v4/modules/core/keeper"
+	ibctesting "github.com/cosmos/ibc-go/v4/testing"
+)
+
+// TestMigrate3to4 asserts the MaxStoredPackets param is set to its default for
+// stores without the param, and the existing channel params are retained.
+func (suite *KeeperTestSuite) TestMigrate3to4() {
+	ctx := suite.chainA.GetContext()
+	app := suite.chainA.GetSimApp()
+
+	params := channeltypes.NewParams(1024, 3, 10)
+	app.IBCKeeper.ChannelKeeper.SetParams(ctx, params)
+
+	// remove the MaxStoredPackets param as stored at consensus version 3
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), append([]byte(ibchost.ModuleName), '/'))
+	paramStore.Delete(channeltypes.KeyMaxStoredPackets)
+	suite.Require().Panics(func() { app.IBCKeeper.ChannelKeeper.GetMaxStoredPackets(ctx) })
+
+	migrator := ibckeeper.NewMigrator(*app.IBCKeeper)
+	err := migrator.Migrate3to4(ctx)
+	suite.Require().NoError(err)
+
+	expParams := channeltypes.NewParams(1024, 3, channeltypes.DefaultMaxStoredPackets)
+	suite.Require().Equal(expParams, app.IBCKeeper.ChannelKeeper.GetParams(ctx))
+}
+
+// TestMigrate4to5 asserts every existing channel is indexed under its connection.
+func (suite *KeeperTestSuite) TestMigrate4to5() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctx := suite.chainA.GetContext()
+	app := suite.chainA.GetSimApp()
+	store := ctx.KVStore(app.GetKey(ibchost.StoreKey))
+
+	key := ibchost.ConnectionChannelKey(path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(store.Has(key))
+
+	// remove the index as stored at consensus version 4
+	store.Delete(key)
+
+	migrator := ibckeeper.NewMigrator(*app.IBCKeeper)
+	err := migrator.Migrate4to5(ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().True(store.Has(key))
+}
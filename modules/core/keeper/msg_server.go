@@ -56,6 +56,8 @@ func (k Keeper) UpdateClient(goCtx context.Context, msg *clienttypes.MsgUpdateCl
 		return nil, err
 	}
 
+	k.ClientKeeper.RecordClientUpdate(ctx, msg.ClientId, header, msg.Signer)
+
 	return &clienttypes.MsgUpdateClientResponse{}, nil
 }
 
@@ -159,6 +161,58 @@ func (k Keeper) ConnectionOpenConfirm(goCtx context.Context, msg *connectiontype
 	return &connectiontypes.MsgConnectionOpenConfirmResponse{}, nil
 }
 
+// ConnectionUpgradeTry defines a rpc handler method for MsgConnectionUpgradeTry.
+func (k Keeper) ConnectionUpgradeTry(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeTry) (*connectiontypes.MsgConnectionUpgradeTryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnUpgradeTry(
+		ctx, msg.ConnectionId, msg.ProofInit, msg.ProofHeight,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "connection upgrade try failed")
+	}
+
+	return &connectiontypes.MsgConnectionUpgradeTryResponse{}, nil
+}
+
+// ConnectionUpgradeAck defines a rpc handler method for MsgConnectionUpgradeAck.
+func (k Keeper) ConnectionUpgradeAck(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeAck) (*connectiontypes.MsgConnectionUpgradeAckResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnUpgradeAck(
+		ctx, msg.ConnectionId, msg.ProofTry, msg.ProofHeight,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "connection upgrade ack failed")
+	}
+
+	return &connectiontypes.MsgConnectionUpgradeAckResponse{}, nil
+}
+
+// ConnectionUpgradeConfirm defines a rpc handler method for MsgConnectionUpgradeConfirm.
+func (k Keeper) ConnectionUpgradeConfirm(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeConfirm) (*connectiontypes.MsgConnectionUpgradeConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnUpgradeConfirm(
+		ctx, msg.ConnectionId, msg.ProofAck, msg.ProofHeight,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "connection upgrade confirm failed")
+	}
+
+	return &connectiontypes.MsgConnectionUpgradeConfirmResponse{}, nil
+}
+
+// ConnectionUpgradeCancel defines a rpc handler method for MsgConnectionUpgradeCancel.
+func (k Keeper) ConnectionUpgradeCancel(goCtx context.Context, msg *connectiontypes.MsgConnectionUpgradeCancel) (*connectiontypes.MsgConnectionUpgradeCancelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnUpgradeCancel(
+		ctx, msg.ConnectionId, msg.CounterpartyConnection, msg.ProofConnection, msg.ProofHeight,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "connection upgrade cancel failed")
+	}
+
+	return &connectiontypes.MsgConnectionUpgradeCancelResponse{}, nil
+}
+
 // ChannelOpenInit defines a rpc handler method for MsgChannelOpenInit.
 // ChannelOpenInit will perform 04-channel checks, route to the application
 // callback, and write an OpenInit channel into state upon successful execution.
@@ -414,8 +468,10 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
 	// Events from callback are emitted regardless of acknowledgement success
 	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
-	if ack == nil || ack.Success() {
-		// write application state changes for asynchronous and successful acknowledgements
+	retainer, ok := cbs.(porttypes.RecvPacketStateRetainer)
+	if ack == nil || ack.Success() || (ok && retainer.RetainStateOnErrorAcknowledgement()) {
+		// write application state changes for asynchronous and successful acknowledgements, as well as
+		// for unsuccessful acknowledgements of applications retaining their state
 		writeFn()
 	}
 
@@ -644,3 +700,20 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 
 	return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.SUCCESS}, nil
 }
+
+// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
+func (k Keeper) PruneAcknowledgements(goCtx context.Context, msg *channeltypes.MsgPruneAcknowledgements) (*channeltypes.MsgPruneAcknowledgementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	totalPruned, totalRemaining, err := k.ChannelKeeper.PruneAcknowledgements(
+		ctx, msg.PortId, msg.ChannelId, msg.NextSequenceAck, msg.ProofNextSequenceAck, msg.ProofHeight, msg.Limit,
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "prune acknowledgements failed")
+	}
+
+	return &channeltypes.MsgPruneAcknowledgementsResponse{
+		TotalPrunedSequences:    totalPruned,
+		TotalRemainingSequences: totalRemaining,
+	}, nil
+}
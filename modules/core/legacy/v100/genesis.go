@@ -9,6 +9,7 @@ import (
 	clientv100 "github.com/cosmos/ibc-go/v4/modules/core/02-client/legacy/v100"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v4/modules/core/types"
 )
@@ -44,6 +45,9 @@ func MigrateGenesis(appState genutiltypes.AppMap, clientCtx client.Context, genD
 
 		ibcGenState.ConnectionGenesis = connectionGenesis
 
+		// set the default channel params
+		ibcGenState.ChannelGenesis.Params = channeltypes.DefaultParams()
+
 		// delete old genesis state
 		delete(appState, host.ModuleName)
 
@@ -36,7 +36,19 @@ var (
 )
 
 // AppModuleBasic defines the basic application module used by the ibc module.
-type AppModuleBasic struct{}
+type AppModuleBasic struct {
+	// client router whose client modules register their interfaces and CLI commands
+	clientRouter *clienttypes.ClientRouter
+}
+
+// NewAppModuleBasic creates a new AppModuleBasic which registers the interfaces and CLI commands
+// of the client modules registered on the client router. The same client router must be passed
+// to the IBC keeper.
+func NewAppModuleBasic(clientRouter *clienttypes.ClientRouter) AppModuleBasic {
+	return AppModuleBasic{
+		clientRouter: clientRouter,
+	}
+}
 
 var _ module.AppModuleBasic = AppModuleBasic{}
 
@@ -75,18 +87,31 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 }
 
 // GetTxCmd returns the root tx command for the ibc module.
-func (AppModuleBasic) GetTxCmd() *cobra.Command {
-	return cli.GetTxCmd()
+func (a AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.GetTxCmd(a.clientModules()...)
 }
 
 // GetQueryCmd returns no root query command for the ibc module.
-func (AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return cli.GetQueryCmd()
+func (a AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd(a.clientModules()...)
 }
 
 // RegisterInterfaces registers module concrete types into protobuf Any.
-func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+func (a AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
+
+	for _, clientModule := range a.clientModules() {
+		clientModule.RegisterInterfaces(registry)
+	}
+}
+
+// clientModules returns the client modules registered on the client router of the AppModuleBasic.
+func (a AppModuleBasic) clientModules() []clienttypes.ClientModule {
+	if a.clientRouter == nil {
+		return nil
+	}
+
+	return a.clientRouter.ClientModules()
 }
 
 // AppModule implements an application module for the ibc module.
@@ -101,7 +126,8 @@ type AppModule struct {
 // NewAppModule creates a new AppModule object
 func NewAppModule(k *keeper.Keeper) AppModule {
 	return AppModule{
-		keeper: k,
+		AppModuleBasic: NewAppModuleBasic(k.ClientKeeper.GetRouter()),
+		keeper:         k,
 	}
 }
 
@@ -139,6 +165,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 
 	m := clientkeeper.NewMigrator(am.keeper.ClientKeeper)
 	cfg.RegisterMigration(host.ModuleName, 1, m.Migrate1to2)
+
+	coreMigrator := keeper.NewMigrator(*am.keeper)
+	cfg.RegisterMigration(host.ModuleName, 2, coreMigrator.Migrate2to3)
+	cfg.RegisterMigration(host.ModuleName, 3, coreMigrator.Migrate3to4)
+	cfg.RegisterMigration(host.ModuleName, 4, coreMigrator.Migrate4to5)
 }
 
 // InitGenesis performs genesis initialization for the ibc module. It returns
@@ -160,7 +191,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 5 }
 
 // BeginBlock returns the begin blocker for the ibc module.
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
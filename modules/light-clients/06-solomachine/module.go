@@ -1,10 +1,34 @@
 package solomachine
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 	"github.com/cosmos/ibc-go/v4/modules/light-clients/06-solomachine/types"
 )
 
+var _ clienttypes.ClientModule = ClientModule{}
+
 // Name returns the solo machine client name.
 func Name() string {
 	return types.SubModuleName
 }
+
+// ClientModule implements the 02-client ClientModule interface for 06-solomachine clients. The light
+// client logic is dispatched to the 06-solomachine ClientState by the embedded ClientStateModule.
+type ClientModule struct {
+	clienttypes.ClientStateModule
+}
+
+// NewClientModule creates a new 06-solomachine ClientModule.
+func NewClientModule() ClientModule {
+	return ClientModule{
+		ClientStateModule: clienttypes.NewClientStateModule(exported.Solomachine),
+	}
+}
+
+// RegisterInterfaces implements the ClientModule interface.
+func (ClientModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+}
@@ -415,6 +415,47 @@ func (cs *ClientState) VerifyNextSequenceRecv(
 	return nil
 }
 
+// VerifyNextSequenceAck verifies a proof of the next sequence number to be
+// acknowledged of the specified channel at the specified port.
+func (cs *ClientState) VerifyNextSequenceAck(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	_ uint64,
+	_ uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+) error {
+	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	nextSequenceAckPath := commitmenttypes.NewMerklePath(host.NextSequenceAckPath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, nextSequenceAckPath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := NextSequenceAckSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, nextSequenceAck)
+	if err != nil {
+		return err
+	}
+
+	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+		return err
+	}
+
+	cs.Sequence++
+	cs.ConsensusState.Timestamp = timestamp
+	setClientState(store, cdc, cs)
+	return nil
+}
+
 // produceVerificationArgs perfoms the basic checks on the arguments that are
 // shared between the verification functions and returns the public key of the
 // consensus state, the unmarshalled proof representing the signature and timestamp
@@ -842,3 +842,97 @@ func (suite *SoloMachineTestSuite) TestVerifyNextSeqRecv() {
 		}
 	}
 }
+
+func (suite *SoloMachineTestSuite) TestVerifyNextSeqAck() {
+	// test singlesig and multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+
+		nextSeqAck := solomachine.Sequence + 1
+		path := solomachine.GetNextSequenceAckPath(testPortID, testChannelID)
+
+		value, err := types.NextSequenceAckSignBytes(suite.chainA.Codec, solomachine.Sequence, solomachine.Time, solomachine.Diversifier, path, nextSeqAck)
+		suite.Require().NoError(err)
+
+		sig := solomachine.GenerateSignature(value)
+		signatureDoc := &types.TimestampedSignatureData{
+			SignatureData: sig,
+			Timestamp:     solomachine.Time,
+		}
+
+		proof, err := suite.chainA.Codec.Marshal(signatureDoc)
+		suite.Require().NoError(err)
+
+		// a signature over the next sequence recv must not be accepted as a next sequence ack proof
+		recvValue, err := types.NextSequenceRecvSignBytes(suite.chainA.Codec, solomachine.Sequence, solomachine.Time, solomachine.Diversifier, solomachine.GetNextSequenceRecvPath(testPortID, testChannelID), nextSeqAck)
+		suite.Require().NoError(err)
+
+		recvProof, err := suite.chainA.Codec.Marshal(&types.TimestampedSignatureData{
+			SignatureData: solomachine.GenerateSignature(recvValue),
+			Timestamp:     solomachine.Time,
+		})
+		suite.Require().NoError(err)
+
+		testCases := []struct {
+			name        string
+			clientState *types.ClientState
+			prefix      exported.Prefix
+			proof       []byte
+			expPass     bool
+		}{
+			{
+				"successful verification",
+				solomachine.ClientState(),
+				prefix,
+				proof,
+				true,
+			},
+			{
+				"ApplyPrefix failed",
+				solomachine.ClientState(),
+				commitmenttypes.NewMerklePrefix([]byte{}),
+				proof,
+				false,
+			},
+			{
+				"proof is nil",
+				solomachine.ClientState(),
+				prefix,
+				nil,
+				false,
+			},
+			{
+				"proof verification failed",
+				solomachine.ClientState(),
+				prefix,
+				suite.GetInvalidProof(),
+				false,
+			},
+			{
+				"proof of next sequence recv",
+				solomachine.ClientState(),
+				prefix,
+				recvProof,
+				false,
+			},
+		}
+
+		for i, tc := range testCases {
+			tc := tc
+
+			expSeq := tc.clientState.Sequence + 1
+			ctx := suite.chainA.GetContext()
+
+			err := tc.clientState.VerifyNextSequenceAck(
+				ctx, suite.store, suite.chainA.Codec, solomachine.GetHeight(), 0, 0, tc.prefix, tc.proof, testPortID, testChannelID, nextSeqAck,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.name)
+				suite.Require().Equal(expSeq, tc.clientState.Sequence)
+				suite.Require().Equal(expSeq, suite.GetSequenceFromStore(), "sequence not updated in the store (%d) on valid test case %d: %s", suite.GetSequenceFromStore(), i, tc.name)
+			} else {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.name)
+			}
+		}
+	}
+}
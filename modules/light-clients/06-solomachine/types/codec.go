@@ -125,6 +125,14 @@ func UnmarshalDataByType(cdc codec.BinaryCodec, dataType DataType, data []byte)
 
 		return nextSeqRecvData, nil
 
+	case NEXTSEQUENCEACK:
+		nextSeqAckData := &NextSequenceAckData{}
+		if err := cdc.Unmarshal(data, nextSeqAckData); err != nil {
+			return nil, err
+		}
+
+		return nextSeqAckData, nil
+
 	default:
 		return nil, sdkerrors.Wrapf(ErrInvalidDataType, "unsupported data type %T", dataType)
 	}
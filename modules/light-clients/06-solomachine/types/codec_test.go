@@ -159,6 +159,23 @@ func (suite SoloMachineTestSuite) TestUnmarshalDataByType() {
 					commitment := []byte("packet commitment")
 					path := solomachine.GetPacketCommitmentPath("portID", "channelID")
 
+					data, err = types.PacketCommitmentDataBytes(cdc, path, commitment)
+					suite.Require().NoError(err)
+				}, false,
+			},
+			{
+				"next sequence ack", types.NEXTSEQUENCEACK, func() {
+					path := solomachine.GetNextSequenceAckPath("portID", "channelID")
+
+					data, err = types.NextSequenceAckDataBytes(cdc, path, 10)
+					suite.Require().NoError(err)
+				}, true,
+			},
+			{
+				"bad next sequence ack (uses packet commitment)", types.NEXTSEQUENCEACK, func() {
+					commitment := []byte("packet commitment")
+					path := solomachine.GetPacketCommitmentPath("portID", "channelID")
+
 					data, err = types.PacketCommitmentDataBytes(cdc, path, commitment)
 					suite.Require().NoError(err)
 				}, false,
@@ -475,3 +475,48 @@ func NextSequenceRecvDataBytes(
 
 	return dataBz, nil
 }
+
+// NextSequenceAckSignBytes returns the sign bytes for verification of the next
+// sequence to be acknowledged.
+func NextSequenceAckSignBytes(
+	cdc codec.BinaryCodec,
+	sequence, timestamp uint64,
+	diversifier string,
+	path commitmenttypes.MerklePath,
+	nextSequenceAck uint64,
+) ([]byte, error) {
+	dataBz, err := NextSequenceAckDataBytes(cdc, path, nextSequenceAck)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes := &SignBytes{
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: diversifier,
+		DataType:    NEXTSEQUENCEACK,
+		Data:        dataBz,
+	}
+
+	return cdc.Marshal(signBytes)
+}
+
+// NextSequenceAckDataBytes returns the next sequence ack data bytes used in constructing
+// SignBytes.
+func NextSequenceAckDataBytes(
+	cdc codec.BinaryCodec,
+	path commitmenttypes.MerklePath, // nolint: interfacer
+	nextSequenceAck uint64,
+) ([]byte, error) {
+	data := &NextSequenceAckData{
+		Path:       []byte(path.String()),
+		NextSeqAck: nextSequenceAck,
+	}
+
+	dataBz, err := cdc.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataBz, nil
+}
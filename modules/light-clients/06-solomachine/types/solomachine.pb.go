@@ -51,19 +51,22 @@ const (
 	NEXTSEQUENCERECV DataType = 8
 	// Data type for header verification
 	HEADER DataType = 9
+	// Data type for next sequence ack verification
+	NEXTSEQUENCEACK DataType = 10
 )
 
 var DataType_name = map[int32]string{
-	0: "DATA_TYPE_UNINITIALIZED_UNSPECIFIED",
-	1: "DATA_TYPE_CLIENT_STATE",
-	2: "DATA_TYPE_CONSENSUS_STATE",
-	3: "DATA_TYPE_CONNECTION_STATE",
-	4: "DATA_TYPE_CHANNEL_STATE",
-	5: "DATA_TYPE_PACKET_COMMITMENT",
-	6: "DATA_TYPE_PACKET_ACKNOWLEDGEMENT",
-	7: "DATA_TYPE_PACKET_RECEIPT_ABSENCE",
-	8: "DATA_TYPE_NEXT_SEQUENCE_RECV",
-	9: "DATA_TYPE_HEADER",
+	0:  "DATA_TYPE_UNINITIALIZED_UNSPECIFIED",
+	1:  "DATA_TYPE_CLIENT_STATE",
+	2:  "DATA_TYPE_CONSENSUS_STATE",
+	3:  "DATA_TYPE_CONNECTION_STATE",
+	4:  "DATA_TYPE_CHANNEL_STATE",
+	5:  "DATA_TYPE_PACKET_COMMITMENT",
+	6:  "DATA_TYPE_PACKET_ACKNOWLEDGEMENT",
+	7:  "DATA_TYPE_PACKET_RECEIPT_ABSENCE",
+	8:  "DATA_TYPE_NEXT_SEQUENCE_RECV",
+	9:  "DATA_TYPE_HEADER",
+	10: "DATA_TYPE_NEXT_SEQUENCE_ACK",
 }
 
 var DataType_value = map[string]int32{
@@ -77,6 +80,7 @@ var DataType_value = map[string]int32{
 	"DATA_TYPE_PACKET_RECEIPT_ABSENCE":    7,
 	"DATA_TYPE_NEXT_SEQUENCE_RECV":        8,
 	"DATA_TYPE_HEADER":                    9,
+	"DATA_TYPE_NEXT_SEQUENCE_ACK":         10,
 }
 
 func (x DataType) String() string {
@@ -798,6 +802,60 @@ func (m *NextSequenceRecvData) GetNextSeqRecv() uint64 {
 	return 0
 }
 
+// NextSequenceAckData returns the SignBytes data for verification of the next
+// sequence to be acknowledged.
+type NextSequenceAckData struct {
+	Path       []byte `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	NextSeqAck uint64 `protobuf:"varint,2,opt,name=next_seq_ack,json=nextSeqAck,proto3" json:"next_seq_ack,omitempty" yaml:"next_seq_ack"`
+}
+
+func (m *NextSequenceAckData) Reset()         { *m = NextSequenceAckData{} }
+func (m *NextSequenceAckData) String() string { return proto.CompactTextString(m) }
+func (*NextSequenceAckData) ProtoMessage()    {}
+func (*NextSequenceAckData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141333b361aae010, []int{16}
+}
+func (m *NextSequenceAckData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NextSequenceAckData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NextSequenceAckData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NextSequenceAckData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextSequenceAckData.Merge(m, src)
+}
+func (m *NextSequenceAckData) XXX_Size() int {
+	return m.Size()
+}
+func (m *NextSequenceAckData) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextSequenceAckData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextSequenceAckData proto.InternalMessageInfo
+
+func (m *NextSequenceAckData) GetPath() []byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *NextSequenceAckData) GetNextSeqAck() uint64 {
+	if m != nil {
+		return m.NextSeqAck
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ibc.lightclients.solomachine.v2.DataType", DataType_name, DataType_value)
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.solomachine.v2.ClientState")
@@ -816,6 +874,7 @@ func init() {
 	proto.RegisterType((*PacketAcknowledgementData)(nil), "ibc.lightclients.solomachine.v2.PacketAcknowledgementData")
 	proto.RegisterType((*PacketReceiptAbsenceData)(nil), "ibc.lightclients.solomachine.v2.PacketReceiptAbsenceData")
 	proto.RegisterType((*NextSequenceRecvData)(nil), "ibc.lightclients.solomachine.v2.NextSequenceRecvData")
+	proto.RegisterType((*NextSequenceAckData)(nil), "ibc.lightclients.solomachine.v2.NextSequenceAckData")
 }
 
 func init() {
@@ -823,93 +882,96 @@ func init() {
 }
 
 var fileDescriptor_141333b361aae010 = []byte{
-	// 1370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5f, 0x8f, 0xdb, 0x44,
-	0x10, 0x3f, 0xa7, 0xe9, 0xf5, 0x32, 0xb9, 0xde, 0x05, 0x37, 0x6d, 0x73, 0x6e, 0x95, 0x18, 0x23,
-	0xca, 0x81, 0x68, 0xc2, 0x1d, 0x50, 0xa1, 0x0a, 0x01, 0x8e, 0xe3, 0xd2, 0xb4, 0x77, 0xbe, 0xe0,
-	0xf8, 0x80, 0x56, 0x48, 0xc6, 0x71, 0xf6, 0x12, 0xab, 0x89, 0x37, 0x8d, 0x9d, 0xa4, 0x41, 0x42,
-	0x42, 0x3c, 0x95, 0x88, 0x07, 0xbe, 0x40, 0x24, 0x04, 0xe2, 0x73, 0xf0, 0x06, 0x3c, 0xf6, 0x91,
-	0xa7, 0x80, 0xda, 0x6f, 0x90, 0x4f, 0x80, 0xec, 0xdd, 0xc4, 0x76, 0xae, 0x97, 0x13, 0xff, 0xde,
-	0x76, 0xe7, 0x37, 0xf3, 0x9b, 0xd9, 0x99, 0xf1, 0xec, 0x1a, 0x76, 0xac, 0x9a, 0x59, 0x68, 0x59,
-	0x8d, 0xa6, 0x6b, 0xb6, 0x2c, 0x64, 0xbb, 0x4e, 0xc1, 0xc1, 0x2d, 0xdc, 0x36, 0xcc, 0xa6, 0x65,
-	0xa3, 0x42, 0x7f, 0x37, 0xbc, 0xcd, 0x77, 0xba, 0xd8, 0xc5, 0x6c, 0xce, 0xaa, 0x99, 0xf9, 0xb0,
-	0x49, 0x3e, 0xac, 0xd3, 0xdf, 0xe5, 0x5e, 0xf1, 0x38, 0x4d, 0xdc, 0x45, 0x05, 0x13, 0xdb, 0x36,
-	0x32, 0x5d, 0x0b, 0xdb, 0x85, 0xfe, 0x4e, 0x68, 0x47, 0x98, 0xb8, 0x17, 0x03, 0xc5, 0xa6, 0x61,
-	0xdb, 0xa8, 0xe5, 0x6b, 0x91, 0x25, 0x55, 0x49, 0x37, 0x70, 0x03, 0xfb, 0xcb, 0x82, 0xb7, 0xa2,
-	0xd2, 0xad, 0x06, 0xc6, 0x8d, 0x16, 0x2a, 0xf8, 0xbb, 0x5a, 0xef, 0xa8, 0x60, 0xd8, 0x43, 0x02,
-	0x09, 0x3f, 0xc7, 0x20, 0x29, 0xf9, 0x71, 0x55, 0x5d, 0xc3, 0x45, 0x2c, 0x07, 0x6b, 0x0e, 0x7a,
-	0xd8, 0x43, 0xb6, 0x89, 0x32, 0x0c, 0xcf, 0x6c, 0xc7, 0xd5, 0xf9, 0x9e, 0xdd, 0x81, 0x84, 0xe5,
-	0xe8, 0x47, 0x5d, 0xfc, 0x05, 0xb2, 0x33, 0x31, 0x9e, 0xd9, 0x5e, 0x2b, 0xa6, 0xa7, 0x93, 0x5c,
-	0x6a, 0x68, 0xb4, 0x5b, 0x37, 0x85, 0x39, 0x24, 0xa8, 0x6b, 0x96, 0x73, 0xcb, 0x5f, 0xb2, 0x2e,
-	0x6c, 0x9a, 0xd8, 0x76, 0x90, 0xed, 0xf4, 0x1c, 0xdd, 0xf1, 0x3c, 0x64, 0xce, 0xf0, 0xcc, 0x76,
-	0x72, 0xb7, 0x90, 0x3f, 0x25, 0x2d, 0x79, 0x69, 0x66, 0xe7, 0x07, 0x56, 0xe4, 0xa6, 0x93, 0xdc,
-	0x25, 0xe2, 0x69, 0x81, 0x51, 0x50, 0x37, 0xcc, 0x88, 0x2e, 0x8b, 0xe0, 0x8a, 0xd1, 0x6a, 0xe1,
-	0x81, 0xde, 0xeb, 0xd4, 0x0d, 0x17, 0xe9, 0xc6, 0x91, 0x8b, 0xba, 0x7a, 0xa7, 0x8b, 0x3b, 0xd8,
-	0x31, 0x5a, 0x99, 0xb8, 0x1f, 0xfa, 0xb5, 0xe9, 0x24, 0x27, 0x10, 0xc2, 0x25, 0xca, 0x82, 0x9a,
-	0xf1, 0xd1, 0x43, 0x1f, 0x14, 0x3d, 0xac, 0x42, 0xa1, 0x9b, 0xf1, 0xc7, 0xdf, 0xe7, 0x56, 0x84,
-	0x1f, 0x18, 0xd8, 0x88, 0xc6, 0xca, 0xde, 0x01, 0xe8, 0xf4, 0x6a, 0x2d, 0xcb, 0xd4, 0x1f, 0xa0,
-	0xa1, 0x9f, 0xc6, 0xe4, 0x6e, 0x3a, 0x4f, 0x8a, 0x90, 0x9f, 0x15, 0x21, 0x2f, 0xda, 0xc3, 0xe2,
-	0xc5, 0xe9, 0x24, 0xf7, 0x02, 0x09, 0x22, 0xb0, 0x10, 0xd4, 0x04, 0xd9, 0xdc, 0x45, 0x43, 0x96,
-	0x87, 0x64, 0xdd, 0xea, 0xa3, 0xae, 0x63, 0x1d, 0x59, 0xa8, 0xeb, 0xa7, 0x3d, 0xa1, 0x86, 0x45,
-	0xec, 0x55, 0x48, 0xb8, 0x56, 0x1b, 0x39, 0xae, 0xd1, 0xee, 0xf8, 0xd9, 0x8d, 0xab, 0x81, 0x80,
-	0x06, 0xf9, 0x75, 0x0c, 0x56, 0x6f, 0x23, 0xa3, 0x8e, 0xba, 0x4b, 0x2b, 0x1c, 0xa1, 0x8a, 0x2d,
-	0x50, 0x79, 0xa8, 0x63, 0x35, 0x6c, 0xc3, 0xed, 0x75, 0x49, 0x19, 0xd7, 0xd5, 0x40, 0xc0, 0x1e,
-	0xc2, 0x86, 0x8d, 0x06, 0x7a, 0xe8, 0xe0, 0xf1, 0x25, 0x07, 0xdf, 0x9a, 0x4e, 0x72, 0x17, 0xc9,
-	0xc1, 0xa3, 0x56, 0x82, 0xba, 0x6e, 0xa3, 0x41, 0x65, 0x7e, 0x7e, 0x09, 0x36, 0x3d, 0x85, 0x70,
-	0x0e, 0xce, 0x7a, 0x39, 0x08, 0x37, 0xc4, 0x82, 0x82, 0xa0, 0x7a, 0x91, 0x94, 0x02, 0x01, 0x4d,
-	0xc2, 0xaf, 0x31, 0x58, 0xdf, 0xb7, 0x9c, 0x1a, 0x6a, 0x1a, 0x7d, 0x0b, 0xf7, 0xba, 0x5e, 0x43,
-	0x93, 0xe6, 0xd3, 0xad, 0xba, 0x9f, 0x8b, 0x44, 0xb8, 0xa1, 0xe7, 0x90, 0xa0, 0xae, 0x91, 0x75,
-	0xb9, 0x1e, 0xc9, 0x5e, 0x6c, 0x21, 0x7b, 0x1d, 0x38, 0x3f, 0x4f, 0x87, 0x8e, 0xed, 0x59, 0xab,
-	0xef, 0x9c, 0xda, 0xea, 0xd5, 0x99, 0x95, 0x68, 0xd7, 0x4b, 0x86, 0x6b, 0x14, 0x33, 0xd3, 0x49,
-	0x2e, 0x4d, 0xa2, 0x88, 0x30, 0x0a, 0xea, 0xfa, 0x7c, 0x7f, 0x60, 0x2f, 0x78, 0x74, 0x07, 0x98,
-	0xa6, 0xfc, 0xbf, 0xf2, 0xe8, 0x0e, 0x70, 0xd8, 0xa3, 0x36, 0xc0, 0x34, 0x93, 0xbf, 0x30, 0x90,
-	0x5a, 0xa4, 0x88, 0xb6, 0x07, 0xb3, 0xd8, 0x1e, 0x9f, 0x41, 0xa2, 0x6e, 0xb8, 0x86, 0xee, 0x0e,
-	0x3b, 0x24, 0x73, 0x1b, 0xbb, 0xaf, 0x9e, 0x1a, 0xa6, 0xc7, 0xab, 0x0d, 0x3b, 0x28, 0x5c, 0x96,
-	0x39, 0x8b, 0xa0, 0xae, 0xd5, 0x29, 0xce, 0xb2, 0x10, 0xf7, 0xd6, 0xb4, 0x2b, 0xfd, 0x75, 0xb4,
-	0x99, 0xe3, 0xcf, 0xff, 0x2e, 0xbe, 0x62, 0x20, 0xa3, 0xcd, 0x64, 0xa8, 0x3e, 0x3f, 0x93, 0x7f,
-	0xa0, 0x0f, 0x60, 0x23, 0xc8, 0x85, 0x4f, 0xef, 0x9f, 0x2a, 0xdc, 0xbb, 0x51, 0x5c, 0x50, 0x83,
-	0x72, 0x94, 0x8e, 0x85, 0x10, 0x7b, 0x7e, 0x08, 0x7f, 0x30, 0x90, 0xf0, 0xfc, 0x16, 0x87, 0x2e,
-	0x72, 0xfe, 0xc5, 0xd7, 0xb9, 0x30, 0x28, 0xce, 0x1c, 0x1f, 0x14, 0x91, 0x12, 0xc4, 0xff, 0xaf,
-	0x12, 0x9c, 0x0d, 0x4a, 0x40, 0x4f, 0xf8, 0x13, 0x03, 0x40, 0x86, 0x8f, 0x9f, 0x94, 0x3d, 0x48,
-	0xd2, 0x4f, 0xfe, 0xd4, 0xf1, 0x78, 0x69, 0x3a, 0xc9, 0xb1, 0x91, 0x29, 0x41, 0xe7, 0x23, 0x19,
-	0x11, 0x27, 0xcc, 0x87, 0xd8, 0x3f, 0x9c, 0x0f, 0x5f, 0xc2, 0x66, 0xe8, 0x2a, 0xf4, 0x63, 0x65,
-	0x21, 0xde, 0x31, 0xdc, 0x26, 0x6d, 0x67, 0x7f, 0xcd, 0x56, 0x60, 0x9d, 0x8e, 0x06, 0x72, 0xa1,
-	0xc5, 0x96, 0x1c, 0xe0, 0xf2, 0x74, 0x92, 0xbb, 0x10, 0x19, 0x27, 0xf4, 0xca, 0x4a, 0x9a, 0x81,
-	0x27, 0xea, 0xfe, 0x1b, 0x06, 0xd8, 0xe8, 0x45, 0x72, 0x62, 0x08, 0xf7, 0x8e, 0x5f, 0xab, 0xcb,
-	0xa2, 0xf8, 0x1b, 0x77, 0x27, 0x8d, 0xa5, 0x0f, 0x17, 0xa4, 0xf9, 0xf3, 0x63, 0x79, 0x2c, 0x32,
-	0x40, 0xf0, 0x52, 0xa1, 0x61, 0xbc, 0xec, 0xb7, 0x95, 0xf7, 0x54, 0xc9, 0x87, 0x5e, 0x31, 0xfd,
-	0x9d, 0x7c, 0x40, 0x2a, 0xdb, 0x75, 0x35, 0x64, 0x48, 0xfd, 0xd6, 0x21, 0x25, 0x91, 0x07, 0xcd,
-	0x72, 0xa7, 0x37, 0xe0, 0x1c, 0x7d, 0xf8, 0x50, 0x8f, 0x57, 0x43, 0x1e, 0xe9, 0x8b, 0xc8, 0x73,
-	0x47, 0x96, 0xea, 0x4c, 0x99, 0x7a, 0xb9, 0x03, 0xe9, 0x8a, 0x61, 0x3e, 0x40, 0xae, 0x84, 0xdb,
-	0x6d, 0xcb, 0x6d, 0x23, 0xdb, 0x3d, 0xd1, 0x53, 0xd6, 0x3b, 0xde, 0x4c, 0xcb, 0x77, 0xb6, 0xae,
-	0x86, 0x24, 0xc2, 0x3d, 0xd8, 0x22, 0x5c, 0xa2, 0xf9, 0xc0, 0xc6, 0x83, 0x16, 0xaa, 0x37, 0xd0,
-	0x52, 0xc2, 0x6d, 0xd8, 0x34, 0xa2, 0xaa, 0x94, 0x75, 0x51, 0x2c, 0xe4, 0x21, 0x43, 0xa8, 0x55,
-	0x64, 0x22, 0xab, 0xe3, 0x8a, 0x35, 0xc7, 0x9b, 0x03, 0x27, 0x31, 0x0b, 0x4d, 0x48, 0x2b, 0xe8,
-	0x91, 0x5b, 0xa5, 0xf3, 0x42, 0x45, 0x66, 0xff, 0xc4, 0x28, 0xde, 0x85, 0xf3, 0x36, 0x7a, 0xe4,
-	0xea, 0x0e, 0x7a, 0xa8, 0x77, 0x91, 0xd9, 0x27, 0xf3, 0x24, 0x7c, 0x0d, 0x44, 0x60, 0x41, 0x4d,
-	0xda, 0x84, 0xda, 0x63, 0x7d, 0xed, 0xdb, 0x38, 0xac, 0xcd, 0x06, 0x03, 0xfb, 0x0e, 0xbc, 0x54,
-	0x12, 0x35, 0x51, 0xd7, 0xee, 0x55, 0x64, 0xfd, 0x50, 0x29, 0x2b, 0x65, 0xad, 0x2c, 0xee, 0x95,
-	0xef, 0xcb, 0x25, 0xfd, 0x50, 0xa9, 0x56, 0x64, 0xa9, 0x7c, 0xab, 0x2c, 0x97, 0x52, 0x2b, 0xdc,
-	0xe6, 0x68, 0xcc, 0x27, 0x43, 0x22, 0xf6, 0x1a, 0x5c, 0x0a, 0x2c, 0xa5, 0xbd, 0xb2, 0xac, 0x68,
-	0x7a, 0x55, 0x13, 0x35, 0x39, 0xc5, 0x70, 0x30, 0x1a, 0xf3, 0xab, 0x44, 0xc6, 0xbe, 0x0e, 0x5b,
-	0x21, 0xbd, 0x03, 0xa5, 0x2a, 0x2b, 0xd5, 0xc3, 0x2a, 0x55, 0x8d, 0x71, 0xe7, 0x47, 0x63, 0x3e,
-	0x31, 0x17, 0xb3, 0x79, 0xe0, 0x22, 0xda, 0x8a, 0x2c, 0x69, 0xe5, 0x03, 0x85, 0xaa, 0x9f, 0xe1,
-	0x36, 0x46, 0x63, 0x1e, 0x02, 0x39, 0xbb, 0x0d, 0x97, 0x43, 0xfa, 0xb7, 0x45, 0x45, 0x91, 0xf7,
-	0xa8, 0x72, 0x9c, 0x4b, 0x8e, 0xc6, 0xfc, 0x39, 0x2a, 0x64, 0xdf, 0x86, 0x2b, 0x81, 0x66, 0x45,
-	0x94, 0xee, 0xca, 0x9a, 0x2e, 0x1d, 0xec, 0xef, 0x97, 0xb5, 0x7d, 0x59, 0xd1, 0x52, 0x67, 0xb9,
-	0xf4, 0x68, 0xcc, 0xa7, 0x08, 0x10, 0xc8, 0xd9, 0xf7, 0x81, 0x3f, 0x66, 0x26, 0x4a, 0x77, 0x95,
-	0x83, 0x4f, 0xf6, 0xe4, 0xd2, 0x87, 0xb2, 0x6f, 0xbb, 0xca, 0x6d, 0x8d, 0xc6, 0xfc, 0x45, 0x82,
-	0x2e, 0x80, 0xec, 0x7b, 0xcf, 0x21, 0x50, 0x65, 0x49, 0x2e, 0x57, 0x34, 0x5d, 0x2c, 0x56, 0x65,
-	0x45, 0x92, 0x53, 0xe7, 0xb8, 0xcc, 0x68, 0xcc, 0xa7, 0x09, 0x4a, 0x41, 0x8a, 0xb1, 0x37, 0xe0,
-	0x6a, 0x60, 0xaf, 0xc8, 0x9f, 0x6a, 0x7a, 0x55, 0xfe, 0xe8, 0xd0, 0x83, 0x3c, 0x9a, 0x8f, 0x53,
-	0x6b, 0x24, 0x70, 0x0f, 0x99, 0x01, 0x9e, 0x9c, 0xe5, 0x21, 0x15, 0xd8, 0xdd, 0x96, 0xc5, 0x92,
-	0xac, 0xa6, 0x12, 0xa4, 0x32, 0x64, 0xc7, 0xc5, 0x1f, 0xff, 0x98, 0x5d, 0x29, 0x7e, 0xfe, 0xdb,
-	0xd3, 0x2c, 0xf3, 0xe4, 0x69, 0x96, 0xf9, 0xf3, 0x69, 0x96, 0xf9, 0xee, 0x59, 0x76, 0xe5, 0xc9,
-	0xb3, 0xec, 0xca, 0xef, 0xcf, 0xb2, 0x2b, 0xf7, 0x6f, 0x35, 0x2c, 0xb7, 0xd9, 0xab, 0xe5, 0x4d,
-	0xdc, 0x2e, 0x98, 0xd8, 0x69, 0x63, 0xa7, 0x60, 0xd5, 0xcc, 0xeb, 0x0d, 0x5c, 0xe8, 0xbf, 0x55,
-	0x68, 0xe3, 0x7a, 0xaf, 0x85, 0x1c, 0xf2, 0x3f, 0x75, 0x7d, 0xf6, 0x43, 0xf5, 0xc6, 0x8d, 0xeb,
-	0xe1, 0x7f, 0x2a, 0xef, 0x9a, 0x71, 0x6a, 0xab, 0xfe, 0x3c, 0x7b, 0xf3, 0xaf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x3f, 0x26, 0x7b, 0x3d, 0x80, 0x0d, 0x00, 0x00,
+	// 1415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0xaf, 0xb3, 0xac, 0x6b, 0x4e, 0xfa, 0x27, 0xb8, 0xd9, 0x96, 0x7a, 0x53, 0x62, 0x8c, 0x18,
+	0x05, 0xb1, 0x84, 0x96, 0x31, 0xc1, 0x84, 0x00, 0xd7, 0xf5, 0x58, 0xd6, 0xd6, 0x0d, 0x8e, 0x0b,
+	0x6c, 0x42, 0x32, 0x8e, 0x7d, 0x9b, 0x5a, 0x4d, 0x7c, 0xb3, 0xd8, 0x49, 0x17, 0x24, 0x24, 0xc4,
+	0xd3, 0xc8, 0x13, 0x5f, 0x20, 0x12, 0x02, 0xf1, 0x39, 0x90, 0x78, 0x00, 0x1e, 0xf7, 0xc8, 0x53,
+	0x40, 0xdb, 0x37, 0xc8, 0x27, 0x40, 0xf6, 0xbd, 0x89, 0xed, 0x6c, 0x4d, 0xc5, 0xbf, 0xb7, 0x7b,
+	0xcf, 0xef, 0x9c, 0xdf, 0xf9, 0x73, 0x8f, 0xcf, 0xbd, 0x86, 0x0d, 0xbb, 0x66, 0x96, 0x1a, 0x76,
+	0xfd, 0xc8, 0x33, 0x1b, 0x36, 0x72, 0x3c, 0xb7, 0xe4, 0xe2, 0x06, 0x6e, 0x1a, 0xe6, 0x91, 0xed,
+	0xa0, 0x52, 0x77, 0x33, 0xba, 0x2d, 0xb6, 0xda, 0xd8, 0xc3, 0x6c, 0xc1, 0xae, 0x99, 0xc5, 0xa8,
+	0x49, 0x31, 0xaa, 0xd3, 0xdd, 0xe4, 0x5e, 0xf1, 0x39, 0x4d, 0xdc, 0x46, 0x25, 0x13, 0x3b, 0x0e,
+	0x32, 0x3d, 0x1b, 0x3b, 0xa5, 0xee, 0x46, 0x64, 0x47, 0x98, 0xb8, 0x17, 0x43, 0xc5, 0x23, 0xc3,
+	0x71, 0x50, 0x23, 0xd0, 0x22, 0x4b, 0xaa, 0x92, 0xad, 0xe3, 0x3a, 0x0e, 0x96, 0x25, 0x7f, 0x45,
+	0xa5, 0x6b, 0x75, 0x8c, 0xeb, 0x0d, 0x54, 0x0a, 0x76, 0xb5, 0xce, 0x61, 0xc9, 0x70, 0x7a, 0x04,
+	0x12, 0x7e, 0x4a, 0x40, 0x5a, 0x0a, 0xe2, 0xaa, 0x7a, 0x86, 0x87, 0x58, 0x0e, 0x16, 0x5c, 0xf4,
+	0xa0, 0x83, 0x1c, 0x13, 0xe5, 0x18, 0x9e, 0x59, 0x4f, 0xaa, 0x93, 0x3d, 0xbb, 0x01, 0x29, 0xdb,
+	0xd5, 0x0f, 0xdb, 0xf8, 0x0b, 0xe4, 0xe4, 0x12, 0x3c, 0xb3, 0xbe, 0xb0, 0x95, 0x1d, 0x0d, 0x0b,
+	0x99, 0x9e, 0xd1, 0x6c, 0xdc, 0x12, 0x26, 0x90, 0xa0, 0x2e, 0xd8, 0xee, 0xed, 0x60, 0xc9, 0x7a,
+	0xb0, 0x62, 0x62, 0xc7, 0x45, 0x8e, 0xdb, 0x71, 0x75, 0xd7, 0xf7, 0x90, 0x3b, 0xc7, 0x33, 0xeb,
+	0xe9, 0xcd, 0x52, 0xf1, 0x8c, 0xb2, 0x14, 0xa5, 0xb1, 0x5d, 0x10, 0xd8, 0x16, 0x37, 0x1a, 0x16,
+	0x2e, 0x11, 0x4f, 0x53, 0x8c, 0x82, 0xba, 0x6c, 0xc6, 0x74, 0x59, 0x04, 0x57, 0x8c, 0x46, 0x03,
+	0x9f, 0xe8, 0x9d, 0x96, 0x65, 0x78, 0x48, 0x37, 0x0e, 0x3d, 0xd4, 0xd6, 0x5b, 0x6d, 0xdc, 0xc2,
+	0xae, 0xd1, 0xc8, 0x25, 0x83, 0xd0, 0xaf, 0x8d, 0x86, 0x05, 0x81, 0x10, 0xce, 0x50, 0x16, 0xd4,
+	0x5c, 0x80, 0x1e, 0x04, 0xa0, 0xe8, 0x63, 0x15, 0x0a, 0xdd, 0x4a, 0x3e, 0xfa, 0xae, 0x30, 0x27,
+	0x7c, 0xcf, 0xc0, 0x72, 0x3c, 0x56, 0xf6, 0x2e, 0x40, 0xab, 0x53, 0x6b, 0xd8, 0xa6, 0x7e, 0x8c,
+	0x7a, 0x41, 0x19, 0xd3, 0x9b, 0xd9, 0x22, 0x39, 0x84, 0xe2, 0xf8, 0x10, 0x8a, 0xa2, 0xd3, 0xdb,
+	0xba, 0x38, 0x1a, 0x16, 0x5e, 0x20, 0x41, 0x84, 0x16, 0x82, 0x9a, 0x22, 0x9b, 0x1d, 0xd4, 0x63,
+	0x79, 0x48, 0x5b, 0x76, 0x17, 0xb5, 0x5d, 0xfb, 0xd0, 0x46, 0xed, 0xa0, 0xec, 0x29, 0x35, 0x2a,
+	0x62, 0xaf, 0x42, 0xca, 0xb3, 0x9b, 0xc8, 0xf5, 0x8c, 0x66, 0x2b, 0xa8, 0x6e, 0x52, 0x0d, 0x05,
+	0x34, 0xc8, 0xaf, 0x13, 0x30, 0x7f, 0x07, 0x19, 0x16, 0x6a, 0xcf, 0x3c, 0xe1, 0x18, 0x55, 0x62,
+	0x8a, 0xca, 0x47, 0x5d, 0xbb, 0xee, 0x18, 0x5e, 0xa7, 0x4d, 0x8e, 0x71, 0x51, 0x0d, 0x05, 0xec,
+	0x01, 0x2c, 0x3b, 0xe8, 0x44, 0x8f, 0x24, 0x9e, 0x9c, 0x91, 0xf8, 0xda, 0x68, 0x58, 0xb8, 0x48,
+	0x12, 0x8f, 0x5b, 0x09, 0xea, 0xa2, 0x83, 0x4e, 0x2a, 0x93, 0xfc, 0x25, 0x58, 0xf1, 0x15, 0xa2,
+	0x35, 0x38, 0xef, 0xd7, 0x20, 0xda, 0x10, 0x53, 0x0a, 0x82, 0xea, 0x47, 0xb2, 0x1d, 0x0a, 0x68,
+	0x11, 0x7e, 0x4d, 0xc0, 0xe2, 0x9e, 0xed, 0xd6, 0xd0, 0x91, 0xd1, 0xb5, 0x71, 0xa7, 0xed, 0x37,
+	0x34, 0x69, 0x3e, 0xdd, 0xb6, 0x82, 0x5a, 0xa4, 0xa2, 0x0d, 0x3d, 0x81, 0x04, 0x75, 0x81, 0xac,
+	0xcb, 0x56, 0xac, 0x7a, 0x89, 0xa9, 0xea, 0xb5, 0x60, 0x69, 0x52, 0x0e, 0x1d, 0x3b, 0xe3, 0x56,
+	0xdf, 0x38, 0xb3, 0xd5, 0xab, 0x63, 0x2b, 0xd1, 0xb1, 0xb6, 0x0d, 0xcf, 0xd8, 0xca, 0x8d, 0x86,
+	0x85, 0x2c, 0x89, 0x22, 0xc6, 0x28, 0xa8, 0x8b, 0x93, 0xfd, 0xbe, 0x33, 0xe5, 0xd1, 0x3b, 0xc1,
+	0xb9, 0xe4, 0x7f, 0xea, 0xd1, 0x3b, 0xc1, 0x51, 0x8f, 0xda, 0x09, 0xa6, 0x95, 0xfc, 0x85, 0x81,
+	0xcc, 0x34, 0x45, 0xbc, 0x3d, 0x98, 0xe9, 0xf6, 0xf8, 0x0c, 0x52, 0x96, 0xe1, 0x19, 0xba, 0xd7,
+	0x6b, 0x91, 0xca, 0x2d, 0x6f, 0xbe, 0x7a, 0x66, 0x98, 0x3e, 0xaf, 0xd6, 0x6b, 0xa1, 0xe8, 0xb1,
+	0x4c, 0x58, 0x04, 0x75, 0xc1, 0xa2, 0x38, 0xcb, 0x42, 0xd2, 0x5f, 0xd3, 0xae, 0x4c, 0x5a, 0x34,
+	0x9e, 0xb0, 0x99, 0x93, 0xcf, 0xff, 0x2e, 0xbe, 0x62, 0x20, 0xa7, 0x8d, 0x65, 0xc8, 0x9a, 0xe4,
+	0x14, 0x24, 0xf4, 0x01, 0x2c, 0x87, 0xb5, 0x08, 0xe8, 0x83, 0xac, 0xa2, 0xbd, 0x1b, 0xc7, 0x05,
+	0x75, 0xc9, 0x8d, 0x31, 0xcc, 0xfc, 0x9e, 0x68, 0x08, 0x7f, 0x30, 0x90, 0xf2, 0xfd, 0x6e, 0xf5,
+	0x3c, 0xe4, 0xfe, 0x8b, 0xaf, 0x73, 0x6a, 0x50, 0x9c, 0x7b, 0x76, 0x50, 0xc4, 0x8e, 0x20, 0xf9,
+	0x7f, 0x1d, 0xc1, 0xf9, 0xf0, 0x08, 0x68, 0x86, 0x3f, 0x32, 0x00, 0x64, 0xf8, 0x04, 0x45, 0xd9,
+	0x85, 0x34, 0xfd, 0xe4, 0xcf, 0x1c, 0x8f, 0x97, 0x46, 0xc3, 0x02, 0x1b, 0x9b, 0x12, 0x74, 0x3e,
+	0x92, 0x11, 0x71, 0xca, 0x7c, 0x48, 0xfc, 0xc3, 0xf9, 0xf0, 0x25, 0xac, 0x44, 0xae, 0xc2, 0x20,
+	0x56, 0x16, 0x92, 0x2d, 0xc3, 0x3b, 0xa2, 0xed, 0x1c, 0xac, 0xd9, 0x0a, 0x2c, 0xd2, 0xd1, 0x40,
+	0x2e, 0xb4, 0xc4, 0x8c, 0x04, 0x2e, 0x8f, 0x86, 0x85, 0xd5, 0xd8, 0x38, 0xa1, 0x57, 0x56, 0xda,
+	0x0c, 0x3d, 0x51, 0xf7, 0xdf, 0x30, 0xc0, 0xc6, 0x2f, 0x92, 0x53, 0x43, 0xb8, 0xf7, 0xec, 0xb5,
+	0x3a, 0x2b, 0x8a, 0xbf, 0x71, 0x77, 0xd2, 0x58, 0xba, 0xb0, 0x2a, 0x4d, 0x9e, 0x1f, 0xb3, 0x63,
+	0x91, 0x01, 0xc2, 0x97, 0x0a, 0x0d, 0xe3, 0xe5, 0xa0, 0xad, 0xfc, 0xa7, 0x4a, 0x31, 0xc4, 0x8a,
+	0xdd, 0x8d, 0x62, 0x48, 0x2a, 0x3b, 0x96, 0x1a, 0x31, 0xa4, 0x7e, 0x2d, 0xc8, 0x48, 0xe4, 0x41,
+	0x33, 0xdb, 0xe9, 0x4d, 0xb8, 0x40, 0x1f, 0x3e, 0xd4, 0xe3, 0xd5, 0x88, 0x47, 0x02, 0x04, 0xee,
+	0xc8, 0x52, 0x1d, 0x2b, 0x53, 0x2f, 0x77, 0x21, 0x5b, 0x31, 0xcc, 0x63, 0xe4, 0x49, 0xb8, 0xd9,
+	0xb4, 0xbd, 0x26, 0x72, 0xbc, 0x53, 0x3d, 0xe5, 0xfd, 0xf4, 0xc6, 0x5a, 0x81, 0xb3, 0x45, 0x35,
+	0x22, 0x11, 0xee, 0xc1, 0x1a, 0xe1, 0x12, 0xcd, 0x63, 0x07, 0x9f, 0x34, 0x90, 0x55, 0x47, 0x33,
+	0x09, 0xd7, 0x61, 0xc5, 0x88, 0xab, 0x52, 0xd6, 0x69, 0xb1, 0x50, 0x84, 0x1c, 0xa1, 0x56, 0x91,
+	0x89, 0xec, 0x96, 0x27, 0xd6, 0x5c, 0x7f, 0x0e, 0x9c, 0xc6, 0x2c, 0x1c, 0x41, 0x56, 0x41, 0x0f,
+	0xbd, 0x2a, 0x9d, 0x17, 0x2a, 0x32, 0xbb, 0xa7, 0x46, 0xf1, 0x2e, 0x2c, 0x39, 0xe8, 0xa1, 0xa7,
+	0xbb, 0xe8, 0x81, 0xde, 0x46, 0x66, 0x97, 0xcc, 0x93, 0xe8, 0x35, 0x10, 0x83, 0x05, 0x35, 0xed,
+	0x10, 0x6a, 0x9f, 0x55, 0xb0, 0x60, 0x35, 0xea, 0x49, 0x34, 0x8f, 0x4f, 0x75, 0xf4, 0x0e, 0x2c,
+	0x4e, 0x98, 0x0c, 0xf3, 0x98, 0xfa, 0x89, 0x7c, 0x17, 0x51, 0x54, 0x50, 0x81, 0xba, 0x11, 0xcd,
+	0xe3, 0xd7, 0x7e, 0x4e, 0xc2, 0xc2, 0x78, 0xfc, 0xb0, 0x6f, 0xc3, 0x4b, 0xdb, 0xa2, 0x26, 0xea,
+	0xda, 0xbd, 0x8a, 0xac, 0x1f, 0x28, 0x65, 0xa5, 0xac, 0x95, 0xc5, 0xdd, 0xf2, 0x7d, 0x79, 0x5b,
+	0x3f, 0x50, 0xaa, 0x15, 0x59, 0x2a, 0xdf, 0x2e, 0xcb, 0xdb, 0x99, 0x39, 0x6e, 0xa5, 0x3f, 0xe0,
+	0xd3, 0x11, 0x11, 0x7b, 0x0d, 0x2e, 0x85, 0x96, 0xd2, 0x6e, 0x59, 0x56, 0x34, 0xbd, 0xaa, 0x89,
+	0x9a, 0x9c, 0x61, 0x38, 0xe8, 0x0f, 0xf8, 0x79, 0x22, 0x63, 0x5f, 0x87, 0xb5, 0x88, 0xde, 0xbe,
+	0x52, 0x95, 0x95, 0xea, 0x41, 0x95, 0xaa, 0x26, 0xb8, 0xa5, 0xfe, 0x80, 0x4f, 0x4d, 0xc4, 0x6c,
+	0x11, 0xb8, 0x98, 0xb6, 0x22, 0x4b, 0x5a, 0x79, 0x5f, 0xa1, 0xea, 0xe7, 0xb8, 0xe5, 0xfe, 0x80,
+	0x87, 0x50, 0xce, 0xae, 0xc3, 0xe5, 0x88, 0xfe, 0x1d, 0x51, 0x51, 0xe4, 0x5d, 0xaa, 0x9c, 0xe4,
+	0xd2, 0xfd, 0x01, 0x7f, 0x81, 0x0a, 0xd9, 0xb7, 0xe0, 0x4a, 0xa8, 0x59, 0x11, 0xa5, 0x1d, 0x59,
+	0xd3, 0xa5, 0xfd, 0xbd, 0xbd, 0xb2, 0xb6, 0x27, 0x2b, 0x5a, 0xe6, 0x3c, 0x97, 0xed, 0x0f, 0xf8,
+	0x0c, 0x01, 0x42, 0x39, 0xfb, 0x3e, 0xf0, 0xcf, 0x98, 0x89, 0xd2, 0x8e, 0xb2, 0xff, 0xc9, 0xae,
+	0xbc, 0xfd, 0xa1, 0x1c, 0xd8, 0xce, 0x73, 0x6b, 0xfd, 0x01, 0x7f, 0x91, 0xa0, 0x53, 0x20, 0xfb,
+	0xde, 0x73, 0x08, 0x54, 0x59, 0x92, 0xcb, 0x15, 0x4d, 0x17, 0xb7, 0xaa, 0xb2, 0x22, 0xc9, 0x99,
+	0x0b, 0x5c, 0xae, 0x3f, 0xe0, 0xb3, 0x04, 0xa5, 0x20, 0xc5, 0xd8, 0x9b, 0x70, 0x35, 0xb4, 0x57,
+	0xe4, 0x4f, 0x35, 0xbd, 0x2a, 0x7f, 0x74, 0xe0, 0x43, 0x3e, 0xcd, 0xc7, 0x99, 0x05, 0x12, 0xb8,
+	0x8f, 0x8c, 0x01, 0x5f, 0xce, 0xf2, 0x90, 0x09, 0xed, 0xee, 0xc8, 0xe2, 0xb6, 0xac, 0x66, 0x52,
+	0xe4, 0x64, 0xc8, 0x8e, 0xbd, 0x11, 0xad, 0x48, 0x9c, 0x59, 0x94, 0x76, 0x32, 0xc0, 0xad, 0xf6,
+	0x07, 0xfc, 0x4a, 0x94, 0x58, 0x94, 0x76, 0xb8, 0xe4, 0xa3, 0x1f, 0xf2, 0x73, 0x5b, 0x9f, 0xff,
+	0xf6, 0x24, 0xcf, 0x3c, 0x7e, 0x92, 0x67, 0xfe, 0x7c, 0x92, 0x67, 0xbe, 0x7d, 0x9a, 0x9f, 0x7b,
+	0xfc, 0x34, 0x3f, 0xf7, 0xfb, 0xd3, 0xfc, 0xdc, 0xfd, 0xdb, 0x75, 0xdb, 0x3b, 0xea, 0xd4, 0x8a,
+	0x26, 0x6e, 0x96, 0x4c, 0xec, 0x36, 0xb1, 0x5b, 0xb2, 0x6b, 0xe6, 0xf5, 0x3a, 0x2e, 0x75, 0x6f,
+	0x94, 0x9a, 0xd8, 0xea, 0x34, 0x90, 0x4b, 0xfe, 0xf5, 0xae, 0x8f, 0x7f, 0xf6, 0xde, 0xb8, 0x79,
+	0x3d, 0xfa, 0xbf, 0xe7, 0x5f, 0x81, 0x6e, 0x6d, 0x3e, 0x98, 0xb5, 0x6f, 0xfe, 0x35, 0x00, 0x05,
+	0x4c, 0x94, 0xc8, 0x1c, 0x0e, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -1620,6 +1682,41 @@ func (m *NextSequenceRecvData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NextSequenceAckData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NextSequenceAckData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NextSequenceAckData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextSeqAck != 0 {
+		i = encodeVarintSolomachine(dAtA, i, uint64(m.NextSeqAck))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSolomachine(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSolomachine(v)
 	base := offset
@@ -1937,6 +2034,22 @@ func (m *NextSequenceRecvData) Size() (n int) {
 	return n
 }
 
+func (m *NextSequenceAckData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	if m.NextSeqAck != 0 {
+		n += 1 + sovSolomachine(uint64(m.NextSeqAck))
+	}
+	return n
+}
+
 func sovSolomachine(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4041,6 +4154,109 @@ func (m *NextSequenceRecvData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NextSequenceAckData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextSequenceAckData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextSequenceAckData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = append(m.Path[:0], dAtA[iNdEx:postIndex]...)
+			if m.Path == nil {
+				m.Path = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSeqAck", wireType)
+			}
+			m.NextSeqAck = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSeqAck |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSolomachine(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,10 +1,34 @@
 package tendermint
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
 	"github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint/types"
 )
 
+var _ clienttypes.ClientModule = ClientModule{}
+
 // Name returns the IBC client name
 func Name() string {
 	return types.SubModuleName
 }
+
+// ClientModule implements the 02-client ClientModule interface for 07-tendermint clients. The light
+// client logic is dispatched to the 07-tendermint ClientState by the embedded ClientStateModule.
+type ClientModule struct {
+	clienttypes.ClientStateModule
+}
+
+// NewClientModule creates a new 07-tendermint ClientModule.
+func NewClientModule() ClientModule {
+	return ClientModule{
+		ClientStateModule: clienttypes.NewClientStateModule(exported.Tendermint),
+	}
+}
+
+// RegisterInterfaces implements the ClientModule interface.
+func (ClientModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+}
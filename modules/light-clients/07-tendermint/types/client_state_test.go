@@ -878,3 +878,110 @@ func (suite *TendermintTestSuite) TestVerifyNextSeqRecv() {
 		})
 	}
 }
+
+// test verification of the next acknowledgement sequence on chainA by chainB
+func (suite *TendermintTestSuite) TestVerifyNextSeqAck() {
+	var (
+		clientState     *types.ClientState
+		proof           []byte
+		delayTimePeriod uint64
+		proofHeight     exported.Height
+		prefix          commitmenttypes.MerklePrefix
+		nextSequenceAck uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			name: "delay time period has not passed",
+			malleate: func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			},
+			expPass: false,
+		},
+		{
+			"ApplyPrefix failed", func() {
+				prefix = commitmenttypes.MerklePrefix{}
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+		{
+			"next sequence ack does not match", func() {
+				nextSequenceAck = 1
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			// send packet
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			// next seq ack advanced over the acknowledged packet
+			err = path.EndpointA.AcknowledgePacket(packet, ibctesting.MockAcknowledgement)
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			var ok bool
+			clientStateI := suite.chainB.GetClientState(path.EndpointB.ClientID)
+			clientState, ok = clientStateI.(*types.ClientState)
+			suite.Require().True(ok)
+
+			prefix = suite.chainA.GetPrefix()
+
+			// make next seq ack proof
+			nextSeqAckKey := host.NextSequenceAckKey(packet.GetSourcePort(), packet.GetSourceChannel())
+			proof, proofHeight = suite.chainA.QueryProof(nextSeqAckKey)
+
+			nextSequenceAck = packet.GetSequence() + 1
+
+			// reset time delay to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			tc.malleate() // make changes as necessary
+
+			ctx := suite.chainB.GetContext()
+			store := suite.chainB.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointB.ClientID)
+
+			err = clientState.VerifyNextSequenceAck(
+				ctx, store, suite.chainB.Codec, proofHeight, delayTimePeriod, 0, &prefix, proof,
+				packet.GetSourcePort(), packet.GetSourceChannel(), nextSequenceAck,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -238,6 +238,22 @@ func IterateConsensusStateAscending(clientStore sdk.KVStore, cb func(height expo
 	return nil
 }
 
+// IterateConsensusStateDescending iterates through the consensus states in descending order. It calls the provided
+// callback on each height, until stop=true is returned.
+func IterateConsensusStateDescending(clientStore sdk.KVStore, cb func(height exported.Height) (stop bool)) error {
+	iterator := sdk.KVStoreReversePrefixIterator(clientStore, []byte(KeyIterateConsensusStatePrefix))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		iterKey := iterator.Key()
+		height := GetHeightFromIterationKey(iterKey)
+		if cb(height) {
+			return nil
+		}
+	}
+	return nil
+}
+
 // GetNextConsensusState returns the lowest consensus state that is larger than the given height.
 // The Iterator returns a storetypes.Iterator which iterates from start (inclusive) to end (exclusive).
 // If the starting height exists in store, we need to call iterator.Next() to get the next consenus state.
@@ -363,8 +379,10 @@ func setConsensusMetadataWithValues(
 }
 
 // deleteConsensusMetadata deletes the metadata stored for a particular consensus state.
+// The update info recorded by the client keeper is pruned together with the consensus state.
 func deleteConsensusMetadata(clientStore sdk.KVStore, height exported.Height) {
 	deleteProcessedTime(clientStore, height)
 	deleteProcessedHeight(clientStore, height)
 	deleteIterationKey(clientStore, height)
+	clientStore.Delete(clienttypes.UpdateInfoKey(height))
 }
@@ -159,6 +159,11 @@ func (suite *TendermintTestSuite) TestIterateConsensusStates() {
 	types.IterateConsensusStateAscending(suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), "testClient"), cb)
 	expectedArr := []string{"0-1", "0-4", "0-10", "4-9", "40-1"}
 	suite.Require().Equal(expectedArr, testArr)
+
+	testArr = nil
+	types.IterateConsensusStateDescending(suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), "testClient"), cb)
+	expectedArr = []string{"40-1", "4-9", "0-10", "0-4", "0-1"}
+	suite.Require().Equal(expectedArr, testArr)
 }
 
 func (suite *TendermintTestSuite) TestGetNeighboringConsensusStates() {
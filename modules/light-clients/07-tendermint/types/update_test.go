@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
@@ -383,6 +384,10 @@ func (suite *TendermintTestSuite) TestPruneConsensusState() {
 	err := types.IterateConsensusStateAscending(clientStore, getFirstHeightCb)
 	suite.Require().Nil(err)
 
+	// the first consensus state is created on client creation, set its update info manually
+	updateInfo := clienttypes.NewUpdateInfo(suite.chainA.SenderAccount.GetAddress().String(), nil, tmhash.Sum([]byte("header")))
+	path.EndpointA.Chain.App.GetIBCKeeper().ClientKeeper.SetUpdateInfo(ctx, path.EndpointA.ClientID, pruneHeight, updateInfo)
+
 	// this height will be expired but not pruned
 	path.EndpointA.UpdateClient()
 	expiredHeight := path.EndpointA.GetClientState().GetLatestHeight()
@@ -429,6 +434,10 @@ func (suite *TendermintTestSuite) TestPruneConsensusState() {
 	consKey := types.GetIterationKey(clientStore, pruneHeight)
 	suite.Require().Nil(consKey, "iteration key not pruned")
 
+	// check update info is pruned
+	_, ok = path.EndpointA.Chain.App.GetIBCKeeper().ClientKeeper.GetUpdateInfo(ctx, path.EndpointA.ClientID, pruneHeight)
+	suite.Require().False(ok, "update info not pruned")
+
 	// check that second expired consensus state doesn't get deleted
 	// this ensures that there is a cap on gas cost of UpdateClient
 	consState, ok = path.EndpointA.Chain.GetConsensusState(path.EndpointA.ClientID, expiredHeight)
@@ -447,4 +456,8 @@ func (suite *TendermintTestSuite) TestPruneConsensusState() {
 	// check iteration key metadata is not pruned
 	consKey = types.GetIterationKey(clientStore, expiredHeight)
 	suite.Require().Equal(expectedConsKey, consKey, "iteration key incorrectly pruned")
+
+	// check update info is not pruned
+	_, ok = path.EndpointA.Chain.App.GetIBCKeeper().ClientKeeper.GetUpdateInfo(ctx, path.EndpointA.ClientID, expiredHeight)
+	suite.Require().True(ok, "update info incorrectly pruned")
 }
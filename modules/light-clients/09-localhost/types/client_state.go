@@ -338,3 +338,36 @@ func (cs ClientState) VerifyNextSequenceRecv(
 
 	return nil
 }
+
+// VerifyNextSequenceAck verifies a proof of the next sequence number to be
+// acknowledged of the specified channel at the specified port.
+func (cs ClientState) VerifyNextSequenceAck(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	_ codec.BinaryCodec,
+	_ exported.Height,
+	_ uint64,
+	_ uint64,
+	_ exported.Prefix,
+	_ []byte,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+) error {
+	path := host.NextSequenceAckKey(portID, channelID)
+
+	data := store.Get(path)
+	if len(data) == 0 {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedNextSeqAckVerification, "not found for path %s", path)
+	}
+
+	prevSequenceAck := binary.BigEndian.Uint64(data)
+	if prevSequenceAck != nextSequenceAck {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrFailedNextSeqAckVerification,
+			"next sequence acknowledgement ≠ previous stored sequence (%d ≠ %d)", nextSequenceAck, prevSequenceAck,
+		)
+	}
+
+	return nil
+}
@@ -524,3 +524,66 @@ func (suite *LocalhostTestSuite) TestVerifyNextSeqRecv() {
 		})
 	}
 }
+
+func (suite *LocalhostTestSuite) TestVerifyNextSeqAck() {
+	nextSeqAck := uint64(5)
+
+	testCases := []struct {
+		name        string
+		clientState *types.ClientState
+		malleate    func()
+		nextSeqAck  uint64
+		expPass     bool
+	}{
+		{
+			name:        "proof verification success",
+			clientState: types.NewClientState("chainID", clientHeight),
+			malleate: func() {
+				suite.store.Set(
+					host.NextSequenceAckKey(testPortID, testChannelID),
+					sdk.Uint64ToBigEndian(nextSeqAck),
+				)
+			},
+			nextSeqAck: nextSeqAck,
+			expPass:    true,
+		},
+		{
+			name:        "proof verification failed: different nextSeqAck stored",
+			clientState: types.NewClientState("chainID", clientHeight),
+			malleate: func() {
+				suite.store.Set(
+					host.NextSequenceAckKey(testPortID, testChannelID),
+					sdk.Uint64ToBigEndian(3),
+				)
+			},
+			nextSeqAck: nextSeqAck,
+			expPass:    false,
+		},
+		{
+			name:        "proof verification failed: no nextSeqAck stored",
+			clientState: types.NewClientState("chainID", clientHeight),
+			malleate:    func() {},
+			nextSeqAck:  nextSeqAck,
+			expPass:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			err := tc.clientState.VerifyNextSequenceAck(
+				suite.ctx, suite.store, suite.cdc, clientHeight, 0, 0, nil, []byte{}, testPortID, testChannelID, nextSeqAck,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package mock
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	callbacktypes "github.com/cosmos/ibc-go/v4/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v4/modules/core/exported"
+)
+
+const (
+	// StoreKey defines the store key used by the mock contract keeper
+	StoreKey = ModuleName
+
+	// SuccessContract is the callback address of a contract which successfully executes all callbacks
+	SuccessContract = "successcontract"
+	// ErrorContract is the callback address of a contract which returns an error in all callbacks
+	ErrorContract = "errorcontract"
+	// PanicContract is the callback address of a contract which panics in all callbacks
+	PanicContract = "paniccontract"
+	// OutOfGasContract is the callback address of a contract which runs out of gas in all callbacks
+	OutOfGasContract = "oogcontract"
+
+	// EventTypeMockCallback is the event type emitted by the mock contract keeper on each callback
+	EventTypeMockCallback = "mock_callback"
+)
+
+// MockApplicationCallbackError is the error returned by the ErrorContract
+var MockApplicationCallbackError = errors.New("mock application callback failed")
+
+var _ callbacktypes.ContractKeeper = ContractKeeper{}
+
+// ContractKeeper implements the callbacks middleware ContractKeeper interface for testing.
+// Each callback increments a counter for the callback type in the store before the behaviour
+// of the contract, determined by the callback address, is executed. This allows tests to
+// assert whether the state changes of a callback were committed.
+type ContractKeeper struct {
+	storeKey sdk.StoreKey
+}
+
+// NewContractKeeper creates a new mock ContractKeeper.
+func NewContractKeeper(storeKey sdk.StoreKey) ContractKeeper {
+	return ContractKeeper{
+		storeKey: storeKey,
+	}
+}
+
+// GetCallbackCounter returns the number of committed callbacks of the provided type.
+func (k ContractKeeper) GetCallbackCounter(ctx sdk.Context, callbackType callbacktypes.CallbackType) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(callbackType))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IBCSendPacketCallback implements the ContractKeeper interface.
+func (k ContractKeeper) IBCSendPacketCallback(
+	ctx sdk.Context,
+	packet exported.PacketI,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	return k.processMockCallback(ctx, callbacktypes.CallbackTypeSendPacket, contractAddress)
+}
+
+// IBCOnAcknowledgementPacketCallback implements the ContractKeeper interface.
+func (k ContractKeeper) IBCOnAcknowledgementPacketCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	return k.processMockCallback(ctx, callbacktypes.CallbackTypeAcknowledgementPacket, contractAddress)
+}
+
+// IBCOnTimeoutPacketCallback implements the ContractKeeper interface.
+func (k ContractKeeper) IBCOnTimeoutPacketCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+	contractAddress,
+	packetSenderAddress string,
+) error {
+	return k.processMockCallback(ctx, callbacktypes.CallbackTypeTimeoutPacket, contractAddress)
+}
+
+// IBCReceivePacketCallback implements the ContractKeeper interface.
+func (k ContractKeeper) IBCReceivePacketCallback(
+	ctx sdk.Context,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+	contractAddress string,
+) error {
+	return k.processMockCallback(ctx, callbacktypes.CallbackTypeReceivePacket, contractAddress)
+}
+
+// processMockCallback increments the callback counter, emits an event and then executes
+// the behaviour of the contract with the provided address.
+func (k ContractKeeper) processMockCallback(ctx sdk.Context, callbackType callbacktypes.CallbackType, contractAddress string) error {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(callbackType), sdk.Uint64ToBigEndian(k.GetCallbackCounter(ctx, callbackType)+1))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMockCallback,
+			sdk.NewAttribute(callbacktypes.AttributeKeyCallbackType, string(callbackType)),
+			sdk.NewAttribute(callbacktypes.AttributeKeyCallbackAddress, contractAddress),
+		),
+	)
+
+	switch contractAddress {
+	case ErrorContract:
+		return MockApplicationCallbackError
+	case PanicContract:
+		panic("mock contract panic")
+	case OutOfGasContract:
+		ctx.GasMeter().ConsumeGas(ctx.GasMeter().Limit()+1, "mock out of gas")
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nfttransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/nft-transfer/types"
+)
+
+// NFTStoreKey defines the store key used by the mock NFT keeper
+const NFTStoreKey = "nftmock"
+
+var (
+	classKeyPrefix = []byte{0x01}
+	nftKeyPrefix   = []byte{0x02}
+)
+
+var _ nfttransfertypes.NFTKeeper = NFTKeeper{}
+
+// Class implements the nft-transfer Class interface for testing.
+type Class struct {
+	ID   string `json:"id"`
+	URI  string `json:"uri"`
+	Data string `json:"data"`
+}
+
+// GetID implements the nft-transfer Class interface.
+func (c Class) GetID() string { return c.ID }
+
+// GetURI implements the nft-transfer Class interface.
+func (c Class) GetURI() string { return c.URI }
+
+// GetData implements the nft-transfer Class interface.
+func (c Class) GetData() string { return c.Data }
+
+// NFT implements the nft-transfer NFT interface for testing.
+type NFT struct {
+	ClassID string         `json:"class_id"`
+	ID      string         `json:"id"`
+	URI     string         `json:"uri"`
+	Data    string         `json:"data"`
+	Owner   sdk.AccAddress `json:"owner"`
+}
+
+// GetClassID implements the nft-transfer NFT interface.
+func (n NFT) GetClassID() string { return n.ClassID }
+
+// GetID implements the nft-transfer NFT interface.
+func (n NFT) GetID() string { return n.ID }
+
+// GetURI implements the nft-transfer NFT interface.
+func (n NFT) GetURI() string { return n.URI }
+
+// GetData implements the nft-transfer NFT interface.
+func (n NFT) GetData() string { return n.Data }
+
+// NFTKeeper implements a minimal store backed nft-transfer NFTKeeper for testing. It follows
+// the semantics of the SDK x/nft keeper: classes must exist before tokens are minted and a
+// token id may only be minted once per class. It is not a replacement of the x/nft keeper,
+// which is not available in the SDK version used by ibc-go v4, and must not be used outside
+// of tests.
+type NFTKeeper struct {
+	storeKey sdk.StoreKey
+}
+
+// NewNFTKeeper creates a new mock NFTKeeper.
+func NewNFTKeeper(storeKey sdk.StoreKey) NFTKeeper {
+	return NFTKeeper{
+		storeKey: storeKey,
+	}
+}
+
+// SaveClass implements the NFTKeeper interface.
+func (k NFTKeeper) SaveClass(ctx sdk.Context, classID, classURI, classData string) error {
+	if k.HasClass(ctx, classID) {
+		return fmt.Errorf("class %s already exists", classID)
+	}
+
+	k.set(ctx, classKey(classID), Class{ID: classID, URI: classURI, Data: classData})
+	return nil
+}
+
+// Mint implements the NFTKeeper interface.
+func (k NFTKeeper) Mint(ctx sdk.Context, classID, tokenID, tokenURI, tokenData string, receiver sdk.AccAddress) error {
+	if !k.HasClass(ctx, classID) {
+		return fmt.Errorf("class %s not found", classID)
+	}
+
+	if _, found := k.GetNFT(ctx, classID, tokenID); found {
+		return fmt.Errorf("token %s of class %s already exists", tokenID, classID)
+	}
+
+	k.set(ctx, nftKey(classID, tokenID), NFT{ClassID: classID, ID: tokenID, URI: tokenURI, Data: tokenData, Owner: receiver})
+	return nil
+}
+
+// Transfer implements the NFTKeeper interface.
+func (k NFTKeeper) Transfer(ctx sdk.Context, classID, tokenID string, receiver sdk.AccAddress) error {
+	nft, found := k.getNFT(ctx, classID, tokenID)
+	if !found {
+		return fmt.Errorf("token %s of class %s not found", tokenID, classID)
+	}
+
+	nft.Owner = receiver
+	k.set(ctx, nftKey(classID, tokenID), nft)
+	return nil
+}
+
+// Burn implements the NFTKeeper interface.
+func (k NFTKeeper) Burn(ctx sdk.Context, classID, tokenID string) error {
+	if _, found := k.getNFT(ctx, classID, tokenID); !found {
+		return fmt.Errorf("token %s of class %s not found", tokenID, classID)
+	}
+
+	ctx.KVStore(k.storeKey).Delete(nftKey(classID, tokenID))
+	return nil
+}
+
+// GetOwner implements the NFTKeeper interface.
+func (k NFTKeeper) GetOwner(ctx sdk.Context, classID, tokenID string) sdk.AccAddress {
+	nft, found := k.getNFT(ctx, classID, tokenID)
+	if !found {
+		return nil
+	}
+
+	return nft.Owner
+}
+
+// HasClass implements the NFTKeeper interface.
+func (k NFTKeeper) HasClass(ctx sdk.Context, classID string) bool {
+	return ctx.KVStore(k.storeKey).Has(classKey(classID))
+}
+
+// GetClass implements the NFTKeeper interface.
+func (k NFTKeeper) GetClass(ctx sdk.Context, classID string) (nfttransfertypes.Class, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(classKey(classID))
+	if bz == nil {
+		return nil, false
+	}
+
+	var class Class
+	mustUnmarshal(bz, &class)
+	return class, true
+}
+
+// GetNFT implements the NFTKeeper interface.
+func (k NFTKeeper) GetNFT(ctx sdk.Context, classID, tokenID string) (nfttransfertypes.NFT, bool) {
+	nft, found := k.getNFT(ctx, classID, tokenID)
+	if !found {
+		return nil, false
+	}
+
+	return nft, true
+}
+
+func (k NFTKeeper) getNFT(ctx sdk.Context, classID, tokenID string) (NFT, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(nftKey(classID, tokenID))
+	if bz == nil {
+		return NFT{}, false
+	}
+
+	var nft NFT
+	mustUnmarshal(bz, &nft)
+	return nft, true
+}
+
+func (k NFTKeeper) set(ctx sdk.Context, key []byte, value interface{}) {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(key, bz)
+}
+
+func mustUnmarshal(bz []byte, value interface{}) {
+	if err := json.Unmarshal(bz, value); err != nil {
+		panic(err)
+	}
+}
+
+func classKey(classID string) []byte {
+	return append(append([]byte{}, classKeyPrefix...), classID...)
+}
+
+func nftKey(classID, tokenID string) []byte {
+	key := append(append([]byte{}, nftKeyPrefix...), classID...)
+	key = append(key, 0)
+	return append(key, tokenID...)
+}
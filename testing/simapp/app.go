@@ -89,8 +89,21 @@ import (
 	icahosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	ibcfee "github.com/cosmos/ibc-go/v4/modules/apps/29-fee"
+	ibcfeeclient "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/client"
 	ibcfeekeeper "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/keeper"
 	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
+	ibccallbacks "github.com/cosmos/ibc-go/v4/modules/apps/callbacks"
+	icq "github.com/cosmos/ibc-go/v4/modules/apps/icq"
+	icqcontroller "github.com/cosmos/ibc-go/v4/modules/apps/icq/controller"
+	icqcontrollerkeeper "github.com/cosmos/ibc-go/v4/modules/apps/icq/controller/keeper"
+	icqcontrollertypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/controller/types"
+	icqhost "github.com/cosmos/ibc-go/v4/modules/apps/icq/host"
+	icqhostkeeper "github.com/cosmos/ibc-go/v4/modules/apps/icq/host/keeper"
+	icqhosttypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/host/types"
+	icqtypes "github.com/cosmos/ibc-go/v4/modules/apps/icq/types"
+	nfttransfer "github.com/cosmos/ibc-go/v4/modules/apps/nft-transfer"
+	nfttransferkeeper "github.com/cosmos/ibc-go/v4/modules/apps/nft-transfer/keeper"
+	nfttransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/nft-transfer/types"
 	transfer "github.com/cosmos/ibc-go/v4/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v4/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
@@ -98,9 +111,15 @@ import (
 	ibcclient "github.com/cosmos/ibc-go/v4/modules/core/02-client"
 	ibcclientclient "github.com/cosmos/ibc-go/v4/modules/core/02-client/client"
 	ibcclienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	ibcconnection "github.com/cosmos/ibc-go/v4/modules/core/03-connection"
+	ibcconnectionclient "github.com/cosmos/ibc-go/v4/modules/core/03-connection/client"
+	ibcconnectiontypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
 	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
 	ibchost "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	ibckeeper "github.com/cosmos/ibc-go/v4/modules/core/keeper"
+	solomachine "github.com/cosmos/ibc-go/v4/modules/light-clients/06-solomachine"
+	ibctm "github.com/cosmos/ibc-go/v4/modules/light-clients/07-tendermint"
+	localhost "github.com/cosmos/ibc-go/v4/modules/light-clients/09-localhost"
 	ibcmock "github.com/cosmos/ibc-go/v4/testing/mock"
         simappparams "github.com/cosmos/ibc-go/v4/testing/simapp/params"
 
@@ -119,6 +138,9 @@ const (
 	MockFeePort string = ibcmock.ModuleName + ibcfeetypes.ModuleName
 )
 
+// MaxCallbackGas is the maximum gas which may be used by a callback of the callbacks middleware
+const MaxCallbackGas = uint64(1_000_000)
+
 var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
@@ -137,22 +159,33 @@ var (
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
 			ibcclientclient.UpdateClientProposalHandler, ibcclientclient.UpgradeProposalHandler,
+			ibcconnectionclient.ConnectionUpgradeProposalHandler, ibcfeeclient.UnlockFeeModuleProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
-		ibc.AppModuleBasic{},
+		ibc.NewAppModuleBasic(ClientRouter),
 		feegrantmodule.AppModuleBasic{},
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
+		nfttransfer.AppModuleBasic{},
 		ibcmock.AppModuleBasic{},
 		ica.AppModuleBasic{},
+		icq.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		ibcfee.AppModuleBasic{},
 	)
 
+	// ClientRouter registers the light clients supported by the application. The same client router is
+	// used by the IBC AppModuleBasic to register the codec and CLI commands of the light clients and by
+	// the IBC keeper, which seals it, to dispatch the light client logic.
+	ClientRouter = ibcclienttypes.NewClientRouter().
+		AddRoute(solomachine.NewClientModule()).
+		AddRoute(ibctm.NewClientModule()).
+		AddRoute(localhost.NewClientModule())
+
 	// module account permissions
 	maccPerms = map[string][]string{
 		authtypes.FeeCollectorName:     nil,
@@ -206,17 +239,23 @@ type SimApp struct {
 	IBCFeeKeeper        ibcfeekeeper.Keeper
 	ICAControllerKeeper icacontrollerkeeper.Keeper
 	ICAHostKeeper       icahostkeeper.Keeper
+	ICQControllerKeeper icqcontrollerkeeper.Keeper
+	ICQHostKeeper       icqhostkeeper.Keeper
 	EvidenceKeeper      evidencekeeper.Keeper
 	TransferKeeper      ibctransferkeeper.Keeper
+	NFTTransferKeeper   nfttransferkeeper.Keeper
 	FeeGrantKeeper      feegrantkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
+	ScopedNFTTransferKeeper   capabilitykeeper.ScopedKeeper
 	ScopedIBCFeeKeeper        capabilitykeeper.ScopedKeeper
 	ScopedFeeMockKeeper       capabilitykeeper.ScopedKeeper
 	ScopedICAControllerKeeper capabilitykeeper.ScopedKeeper
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
+	ScopedICQControllerKeeper capabilitykeeper.ScopedKeeper
+	ScopedICQHostKeeper       capabilitykeeper.ScopedKeeper
 	ScopedIBCMockKeeper       capabilitykeeper.ScopedKeeper
 	ScopedICAMockKeeper       capabilitykeeper.ScopedKeeper
 
@@ -225,6 +264,12 @@ type SimApp struct {
 	ICAAuthModule ibcmock.IBCModule
 	FeeMockModule ibcmock.IBCModule
 
+	// make IBC mock contract keeper public for test purposes
+	MockContractKeeper ibcmock.ContractKeeper
+
+	// make IBC mock NFT keeper public for test purposes
+	MockNFTKeeper ibcmock.NFTKeeper
+
 	// the module manager
 	mm *module.Manager
 
@@ -264,7 +309,8 @@ func NewSimApp(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, icacontrollertypes.StoreKey, icahosttypes.StoreKey, capabilitytypes.StoreKey,
-		authzkeeper.StoreKey, ibcfeetypes.StoreKey,
+		icqcontrollertypes.StoreKey, icqhosttypes.StoreKey,
+		authzkeeper.StoreKey, ibcfeetypes.StoreKey, ibcmock.StoreKey, nfttransfertypes.StoreKey, ibcmock.NFTStoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -289,8 +335,11 @@ func NewSimApp(
 	app.CapabilityKeeper = capabilitykeeper.NewKeeper(appCodec, keys[capabilitytypes.StoreKey], memKeys[capabilitytypes.MemStoreKey])
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedNFTTransferKeeper := app.CapabilityKeeper.ScopeToModule(nfttransfertypes.ModuleName)
 	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	scopedICQControllerKeeper := app.CapabilityKeeper.ScopeToModule(icqcontrollertypes.SubModuleName)
+	scopedICQHostKeeper := app.CapabilityKeeper.ScopeToModule(icqhosttypes.SubModuleName)
 
 	// NOTE: the IBC mock keeper and application module is used only for testing core IBC. Do
 	// not replicate if you do not need to test core IBC or light clients.
@@ -341,7 +390,16 @@ func NewSimApp(
 	// IBC Keepers
 
 	app.IBCKeeper = ibckeeper.NewKeeper(
-		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
+		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper, ClientRouter,
+	)
+
+	// IBC Fee Module keeper
+	// NOTE: the fee keeper must be created before the gov router as it handles the fee module proposals
+	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
+		appCodec, keys[ibcfeetypes.StoreKey], app.GetSubspace(ibcfeetypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper, // may be replaced with IBC middleware
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper, app.AccountKeeper, app.BankKeeper, app.DistrKeeper,
 	)
 
 	// register the proposal types
@@ -350,20 +408,14 @@ func NewSimApp(
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(ibcconnectiontypes.RouterKey, ibcconnection.NewConnectionProposalHandler(app.IBCKeeper.ConnectionKeeper)).
+		AddRoute(ibcfeetypes.RouterKey, ibcfee.NewFeeProposalHandler(app.IBCFeeKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
 	)
 
-	// IBC Fee Module keeper
-	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(
-		appCodec, keys[ibcfeetypes.StoreKey], app.GetSubspace(ibcfeetypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper, // may be replaced with IBC middleware
-		app.IBCKeeper.ChannelKeeper,
-		&app.IBCKeeper.PortKeeper, app.AccountKeeper, app.BankKeeper,
-	)
-
 	// ICA Controller keeper
 	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
 		appCodec, keys[icacontrollertypes.StoreKey], app.GetSubspace(icacontrollertypes.SubModuleName),
@@ -376,7 +428,22 @@ func NewSimApp(
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec, keys[icahosttypes.StoreKey], app.GetSubspace(icahosttypes.SubModuleName),
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
-		app.AccountKeeper, scopedICAHostKeeper, app.MsgServiceRouter(),
+		app.AccountKeeper, app.BankKeeper, scopedICAHostKeeper, app.MsgServiceRouter(),
+	)
+
+	// ICQ Controller keeper
+	app.ICQControllerKeeper = icqcontrollerkeeper.NewKeeper(
+		appCodec, keys[icqcontrollertypes.StoreKey], app.GetSubspace(icqcontrollertypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
+		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		scopedICQControllerKeeper,
+	)
+
+	// ICQ Host keeper, queries are executed through the gRPC query router of the application
+	app.ICQHostKeeper = icqhostkeeper.NewKeeper(
+		appCodec, keys[icqhosttypes.StoreKey], app.GetSubspace(icqhosttypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		scopedICQHostKeeper, app.GRPCQueryRouter(),
 	)
 
 	// Create IBC Router
@@ -393,6 +460,24 @@ func NewSimApp(
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 	)
 
+	// Mock NFT keeper used by the nft-transfer application for testing purposes only, as the SDK
+	// x/nft module is not available in the SDK version used by ibc-go v4
+	// NOTE: applications must provide their own implementation of the nft-transfer NFTKeeper interface,
+	// e.g. an adapter of the x/nft keeper for SDK versions including x/nft.
+	app.MockNFTKeeper = ibcmock.NewNFTKeeper(keys[ibcmock.NFTStoreKey])
+
+	// Create NFT Transfer Keeper and pass IBCFeeKeeper as expected ICS4Wrapper
+	// since fee middleware will wrap the nft-transfer application.
+	app.NFTTransferKeeper = nfttransferkeeper.NewKeeper(
+		appCodec, keys[nfttransfertypes.StoreKey], app.GetSubspace(nfttransfertypes.ModuleName),
+		app.IBCFeeKeeper, // ISC4 Wrapper: fee IBC middleware
+		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
+		app.MockNFTKeeper, scopedNFTTransferKeeper,
+	)
+
+	// Mock contract keeper used as the contract keeper of the callbacks middleware
+	app.MockContractKeeper = ibcmock.NewContractKeeper(keys[ibcmock.StoreKey])
+
 	// Mock Module Stack
 
 	// Mock Module setup for testing IBC and also acts as the interchain accounts authentication module
@@ -406,33 +491,51 @@ func NewSimApp(
 
 	// Create Transfer Stack
 	// SendPacket, since it is originating from the application to core IBC:
-	// transferKeeper.SendPacket -> fee.SendPacket -> channel.SendPacket
+	// transferKeeper.SendPacket -> callbacks.SendPacket -> fee.SendPacket -> channel.SendPacket
 
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-	// channel.RecvPacket -> fee.OnRecvPacket -> transfer.OnRecvPacket
+	// channel.RecvPacket -> fee.OnRecvPacket -> callbacks.OnRecvPacket -> transfer.OnRecvPacket
 
 	// transfer stack contains (from top to bottom):
 	// - IBC Fee Middleware
+	// - IBC Callbacks Middleware
 	// - Transfer
 
 	// create IBC module from bottom to top of stack
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
-	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper)
+	transferCallbacksStack := ibccallbacks.NewIBCMiddleware(transferStack, app.IBCFeeKeeper, app.MockContractKeeper, MaxCallbackGas)
+	// the transfer keeper sends packets through the callbacks middleware
+	app.TransferKeeper.SetICS4Wrapper(transferCallbacksStack)
+	transferStack = ibcfee.NewIBCMiddleware(transferCallbacksStack, app.IBCFeeKeeper)
 
 	// Add transfer stack to IBC Router
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
 
+	// Create NFT Transfer Stack
+	// nft-transfer stack contains (from top to bottom):
+	// - IBC Fee Middleware
+	// - NFT Transfer
+	var nftTransferStack porttypes.IBCModule
+	nftTransferStack = nfttransfer.NewIBCModule(app.NFTTransferKeeper)
+	nftTransferStack = ibcfee.NewIBCMiddleware(nftTransferStack, app.IBCFeeKeeper)
+
+	// Add nft-transfer stack to IBC Router
+	ibcRouter.AddRoute(nfttransfertypes.ModuleName, nftTransferStack)
+
 	// Create Interchain Accounts Stack
 	// SendPacket, since it is originating from the application to core IBC:
-	// icaAuthModuleKeeper.SendTx -> icaController.SendPacket -> fee.SendPacket -> channel.SendPacket
+	// icaAuthModuleKeeper.SendTx -> icaController.SendPacket -> callbacks.SendPacket -> fee.SendPacket -> channel.SendPacket
 
 	// initialize ICA module with mock module as the authentication module on the controller side
 	var icaControllerStack porttypes.IBCModule
 	icaControllerStack = ibcmock.NewIBCModule(&mockModule, ibcmock.NewMockIBCApp("", scopedICAMockKeeper))
 	app.ICAAuthModule = icaControllerStack.(ibcmock.IBCModule)
 	icaControllerStack = icacontroller.NewIBCMiddleware(icaControllerStack, app.ICAControllerKeeper)
-	icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, app.IBCFeeKeeper)
+	icaCallbacksStack := ibccallbacks.NewIBCMiddleware(icaControllerStack, app.IBCFeeKeeper, app.MockContractKeeper, MaxCallbackGas)
+	// the ICA controller keeper sends packets through the callbacks middleware
+	app.ICAControllerKeeper.SetICS4Wrapper(icaCallbacksStack)
+	icaControllerStack = ibcfee.NewIBCMiddleware(icaCallbacksStack, app.IBCFeeKeeper)
 
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
 	// channel.RecvPacket -> fee.OnRecvPacket -> icaHost.OnRecvPacket
@@ -450,6 +553,13 @@ func NewSimApp(
 		AddRoute(icahosttypes.SubModuleName, icaHostStack).
 		AddRoute(ibcmock.ModuleName+icacontrollertypes.SubModuleName, icaControllerStack) // ica with mock auth module stack route to ica (top level of middleware stack)
 
+	// Create Interchain Queries Stack
+	// The requesting modules set the controller hooks on the ICQ controller keeper to be notified
+	// of the query responses. The controller and host modules are added to the IBC router.
+	ibcRouter.
+		AddRoute(icqcontrollertypes.SubModuleName, icqcontroller.NewIBCModule(app.ICQControllerKeeper)).
+		AddRoute(icqhosttypes.SubModuleName, icqhost.NewIBCModule(app.ICQHostKeeper))
+
 	// Create Mock IBC Fee module stack for testing
 	// SendPacket, since it is originating from the application to core IBC:
 	// mockModule.SendPacket -> fee.SendPacket -> channel.SendPacket
@@ -509,8 +619,10 @@ func NewSimApp(
 
 		// IBC modules
 		transfer.NewAppModule(app.TransferKeeper),
+		nfttransfer.NewAppModule(app.NFTTransferKeeper),
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
 		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
+		icq.NewAppModule(&app.ICQControllerKeeper, &app.ICQHostKeeper),
 		mockModule,
 	)
 
@@ -524,12 +636,14 @@ func NewSimApp(
 		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName, ibctransfertypes.ModuleName, authtypes.ModuleName,
 		banktypes.ModuleName, govtypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName, authz.ModuleName, feegrant.ModuleName,
 		paramstypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName,
+		nfttransfertypes.ModuleName, icqtypes.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName, ibctransfertypes.ModuleName,
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		minttypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, feegrant.ModuleName, paramstypes.ModuleName,
 		upgradetypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName,
+		nfttransfertypes.ModuleName, icqtypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -542,6 +656,7 @@ func NewSimApp(
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, ibctransfertypes.ModuleName,
 		icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName, feegrant.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
+		nfttransfertypes.ModuleName, icqtypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -611,8 +726,11 @@ func NewSimApp(
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedNFTTransferKeeper = scopedNFTTransferKeeper
 	app.ScopedICAControllerKeeper = scopedICAControllerKeeper
 	app.ScopedICAHostKeeper = scopedICAHostKeeper
+	app.ScopedICQControllerKeeper = scopedICQControllerKeeper
+	app.ScopedICQHostKeeper = scopedICQHostKeeper
 
 	// NOTE: the IBC mock keeper and application module is used only for testing core IBC. Do
 	// note replicate if you do not need to test core IBC or light clients.
@@ -820,9 +938,13 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
+	paramsKeeper.Subspace(nfttransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(ibcfeetypes.ModuleName)
 	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(icqcontrollertypes.SubModuleName)
+	paramsKeeper.Subspace(icqhosttypes.SubModuleName)
 
 	return paramsKeeper
 }